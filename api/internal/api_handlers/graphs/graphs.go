@@ -4,6 +4,7 @@ import (
 	"log/slog"
 
 	graphsclient "github.com/liriquew/control_system/api/internal/grpc/clients/graphs"
+	groupsclient "github.com/liriquew/control_system/api/internal/grpc/clients/groups"
 	tasksclient "github.com/liriquew/control_system/api/internal/grpc/clients/tasks"
 )
 
@@ -12,8 +13,8 @@ type GraphsService struct {
 	GraphsMiddleware
 }
 
-func New(log *slog.Logger, client graphsclient.GraphClient, tasksClient tasksclient.TasksClient) GraphsService {
-	service := NewGraphsService(log, client, tasksClient)
+func New(log *slog.Logger, client graphsclient.GraphClient, tasksClient tasksclient.TasksClient, groupsClient groupsclient.GroupsClient) GraphsService {
+	service := NewGraphsService(log, client, tasksClient, groupsClient)
 	middleware := NewGraphsMiddleware(log)
 
 	return GraphsService{
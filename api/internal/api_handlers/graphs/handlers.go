@@ -1,16 +1,22 @@
 package graphs
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 
+	"github.com/liriquew/control_system/api/internal/api_handlers/auth"
 	"github.com/liriquew/control_system/api/internal/api_handlers/groups"
 	"github.com/liriquew/control_system/api/internal/entities"
 	graphsclient "github.com/liriquew/control_system/api/internal/grpc/clients/graphs"
+	groupsclient "github.com/liriquew/control_system/api/internal/grpc/clients/groups"
 	tasksclient "github.com/liriquew/control_system/api/internal/grpc/clients/tasks"
 	jsontools "github.com/liriquew/control_system/api/internal/lib/json_tools"
+	planimport "github.com/liriquew/control_system/api/internal/lib/plan_import"
 	"github.com/liriquew/control_system/api/pkg/logger/sl"
 
 	"github.com/liriquew/control_system/api/internal/models"
@@ -24,15 +30,32 @@ type GpraphsAPI interface {
 	GetNode(w http.ResponseWriter, r *http.Request)
 	UpdateNode(w http.ResponseWriter, r *http.Request)
 	RemoveNode(w http.ResponseWriter, r *http.Request)
+	RestoreNode(w http.ResponseWriter, r *http.Request)
 	GetDependencies(w http.ResponseWriter, r *http.Request)
 	AddDependency(w http.ResponseWriter, r *http.Request)
 	RemoveDependency(w http.ResponseWriter, r *http.Request)
 	PredictGraph(w http.ResponseWriter, r *http.Request)
+	PredictScenario(w http.ResponseWriter, r *http.Request)
+	SimulateGraph(w http.ResponseWriter, r *http.Request)
+	CrashGraph(w http.ResponseWriter, r *http.Request)
+	CreateBaseline(w http.ResponseWriter, r *http.Request)
+	ListBaselines(w http.ResponseWriter, r *http.Request)
+	CompareBaseline(w http.ResponseWriter, r *http.Request)
+	ExportGraph(w http.ResponseWriter, r *http.Request)
+	AnalyzeGraph(w http.ResponseWriter, r *http.Request)
+	GetEVMReport(w http.ResponseWriter, r *http.Request)
+	WatchGraph(w http.ResponseWriter, r *http.Request)
+	ApplyGraphPatch(w http.ResponseWriter, r *http.Request)
+	ImportGraph(w http.ResponseWriter, r *http.Request)
+	CloneGraph(w http.ResponseWriter, r *http.Request)
+	InstantiateTemplate(w http.ResponseWriter, r *http.Request)
+	SetGraphDeadline(w http.ResponseWriter, r *http.Request)
 }
 
 type Graphs struct {
 	graphsClient graphsclient.GraphClient
 	tasksClient  tasksclient.TasksClient
+	groupsClient groupsclient.GroupsClient
 	log          *slog.Logger
 }
 
@@ -40,10 +63,12 @@ func NewGraphsService(
 	log *slog.Logger,
 	graphsClient graphsclient.GraphClient,
 	tasksClient tasksclient.TasksClient,
+	groupsClient groupsclient.GroupsClient,
 ) *Graphs {
 	return &Graphs{
 		graphsClient: graphsClient,
 		tasksClient:  tasksClient,
+		groupsClient: groupsClient,
 		log:          log,
 	}
 }
@@ -58,6 +83,17 @@ func (g *Graphs) CreateGroupGraph(w http.ResponseWriter, r *http.Request) {
 	graph.GraphInfo.GroupID = groupID
 
 	for _, node := range graph.Nodes {
+		if !node.ValidKind() {
+			http.Error(w, fmt.Sprintf("unknown node kind: nodeID %d", node.ID), http.StatusBadRequest)
+			return
+		}
+		if !validLinks(node) {
+			http.Error(w, fmt.Sprintf("unknown link type: nodeID %d", node.ID), http.StatusBadRequest)
+			return
+		}
+		if !node.IsTask() {
+			continue
+		}
 		if node.TaskID == 0 {
 			http.Error(w, fmt.Sprintf("taskID required: nodeID %d", node.ID), http.StatusBadRequest)
 			return
@@ -80,6 +116,14 @@ func (g *Graphs) CreateGroupGraph(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
+		if errors.Is(err, graphsclient.ErrNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
 
 		g.log.Error("error while creating group graph", slog.Any("graph", graph), sl.Err(err))
 		w.WriteHeader(http.StatusInternalServerError)
@@ -89,6 +133,36 @@ func (g *Graphs) CreateGroupGraph(w http.ResponseWriter, r *http.Request) {
 	jsontools.WriteInt64ID(w, graphID)
 }
 
+type cycleResponse struct {
+	Error string  `json:"error"`
+	Cycle []int64 `json:"cycle"`
+}
+
+// writeBadGraph responds with 412, found cycle is returned in the body
+func writeBadGraph(w http.ResponseWriter, err error) {
+	var cycleErr *graphsclient.CycleError
+	if !errors.As(err, &cycleErr) {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusPreconditionFailed)
+	json.NewEncoder(w).Encode(cycleResponse{
+		Error: cycleErr.Message,
+		Cycle: cycleErr.Cycle,
+	})
+}
+
+func validLinks(node *models.Node) bool {
+	for _, link := range node.Links {
+		if !link.ValidLinkType() {
+			return false
+		}
+	}
+	return true
+}
+
 func (g *Graphs) ListGroupGraphs(w http.ResponseWriter, r *http.Request) {
 	groupID := groups.GetGroupID(r)
 	offset := GetOffset(r)
@@ -129,16 +203,26 @@ func (g *Graphs) CreateNode(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	node.GraphID = graphID
+	if !validLinks(node) {
+		http.Error(w, "unknown link type", http.StatusBadRequest)
+		return
+	}
+	if !node.ValidKind() {
+		http.Error(w, "unknown node kind", http.StatusBadRequest)
+		return
+	}
 
 	groupID := groups.GetGroupID(r)
-	if err := g.tasksClient.TaskExists(r.Context(), node.TaskID, groupID); err != nil {
-		if errors.Is(err, tasksclient.ErrNotFound) {
-			http.Error(w, "task not found in group", http.StatusNotFound)
+	if node.IsTask() {
+		if err := g.tasksClient.TaskExists(r.Context(), node.TaskID, groupID); err != nil {
+			if errors.Is(err, tasksclient.ErrNotFound) {
+				http.Error(w, "task not found in group", http.StatusNotFound)
+				return
+			}
+
+			g.log.Error("error while checking is task exists in group", slog.Any("node", node), slog.Int64("groupID", groupID), sl.Err(err))
 			return
 		}
-
-		g.log.Error("error while checking is task exists in group", slog.Any("node", node), slog.Int64("groupID", groupID), sl.Err(err))
-		return
 	}
 
 	nodeID, err := g.graphsClient.CreateNode(r.Context(), node)
@@ -151,6 +235,10 @@ func (g *Graphs) CreateNode(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
 
 		g.log.Error("error while creating node", slog.Any("node", node), sl.Err(err))
 		w.WriteHeader(http.StatusInternalServerError)
@@ -186,6 +274,10 @@ func (g *Graphs) UpdateNode(w http.ResponseWriter, r *http.Request) {
 	}
 	node.ID = GetNodeID(r)
 	node.GraphID = GetGraphID(r)
+	if !node.ValidKind() {
+		http.Error(w, "unknown node kind", http.StatusBadRequest)
+		return
+	}
 
 	err = g.graphsClient.UpdateNode(r.Context(), node)
 	if err != nil {
@@ -197,6 +289,10 @@ func (g *Graphs) UpdateNode(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
 
 		g.log.Error("error while updating node", sl.Err(err))
 		w.WriteHeader(http.StatusInternalServerError)
@@ -225,6 +321,33 @@ func (g *Graphs) RemoveNode(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+func (g *Graphs) RestoreNode(w http.ResponseWriter, r *http.Request) {
+	graphID := GetGraphID(r)
+	nodeID := GetNodeID(r)
+
+	node, err := g.graphsClient.RestoreNode(r.Context(), graphID, nodeID)
+	if err != nil {
+		if errors.Is(err, graphsclient.ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrVersionConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
+
+		g.log.Error("error while restoring node", slog.Int64("graphID", graphID), slog.Int64("nodeID", nodeID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, node)
+}
+
 func (g *Graphs) GetDependencies(w http.ResponseWriter, r *http.Request) {
 	graphID := r.Context().Value(GraphID{}).(int64)
 	nodeID := r.Context().Value(NodeID{}).(int64)
@@ -249,13 +372,20 @@ func (g *Graphs) AddDependency(w http.ResponseWriter, r *http.Request) {
 	nodeID := GetNodeID(r)
 	dependencyNodeID := GetDependencyNodeID(r)
 
-	dependency := &models.Dependency{
-		FromNodeID: nodeID,
-		ToNodeID:   dependencyNodeID,
-		GraphID:    graphID,
+	dependency, err := models.DependencyModelFromJson(r.Body)
+	if err != nil {
+		http.Error(w, "bad json", http.StatusBadRequest)
+		return
+	}
+	if !dependency.ValidLinkType() {
+		http.Error(w, "unknown link type, expected one of FS, SS, FF, SF", http.StatusBadRequest)
+		return
 	}
+	dependency.FromNodeID = nodeID
+	dependency.ToNodeID = dependencyNodeID
+	dependency.GraphID = graphID
 
-	err := g.graphsClient.AddDependency(r.Context(), dependency)
+	err = g.graphsClient.AddDependency(r.Context(), dependency)
 	if err != nil {
 		if errors.Is(err, graphsclient.ErrNotFound) {
 			http.Error(w, err.Error(), http.StatusNotFound)
@@ -269,6 +399,14 @@ func (g *Graphs) AddDependency(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusConflict)
 			return
 		}
+		if errors.Is(err, graphsclient.ErrVersionConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
 
 		g.log.Error("error while adding dependency", sl.Err(err))
 		w.WriteHeader(http.StatusInternalServerError)
@@ -313,15 +451,24 @@ func (g *Graphs) PredictGraph(w http.ResponseWriter, r *http.Request) {
 
 	g.log.Info("query info", slog.Int64("id", graphID), slog.Int64("priority", priority))
 
-	predictedGraph, err := g.graphsClient.PredictGraph(r.Context(), graphID, int(priority))
-	g.log.Info("graph", predictedGraph)
+	startAt, err := GetStartAt(r)
+	if err != nil {
+		http.Error(w, "bad startAt param", http.StatusBadRequest)
+		return
+	}
+
+	predictedGraph, err := g.graphsClient.PredictGraph(r.Context(), graphID, int(priority), startAt)
 	if err != nil {
 		if errors.Is(err, graphsclient.ErrNotFound) {
 			http.Error(w, err.Error(), http.StatusNotFound)
 			return
 		}
+		if errors.Is(err, graphsclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
 		if errors.Is(err, graphsclient.ErrBadGraph) {
-			http.Error(w, err.Error(), http.StatusPreconditionFailed)
+			writeBadGraph(w, err)
 			return
 		}
 
@@ -332,3 +479,603 @@ func (g *Graphs) PredictGraph(w http.ResponseWriter, r *http.Request) {
 
 	jsontools.WtiteJSON(w, predictedGraph)
 }
+
+// PredictScenario predicts graph with ephemeral overrides, stored graph and tasks are not changed
+func (g *Graphs) PredictScenario(w http.ResponseWriter, r *http.Request) {
+	graphID := GetGraphID(r)
+	priority := r.Context().Value(Priority{}).(int64)
+
+	scenario, err := models.ScenarioModelFromJson(r.Body)
+	if err != nil {
+		http.Error(w, "bad json", http.StatusBadRequest)
+		return
+	}
+	for _, dep := range scenario.AddDependencies {
+		if !dep.ValidLinkType() {
+			http.Error(w, "unknown link type", http.StatusBadRequest)
+			return
+		}
+	}
+
+	startAt, err := GetStartAt(r)
+	if err != nil {
+		http.Error(w, "bad startAt param", http.StatusBadRequest)
+		return
+	}
+
+	predictedGraph, err := g.graphsClient.PredictScenario(r.Context(), graphID, int(priority), startAt, scenario)
+	if err != nil {
+		if errors.Is(err, graphsclient.ErrNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
+
+		g.log.Error("error while predicting graph scenario", slog.Int64("graphID", graphID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, predictedGraph)
+}
+
+func (g *Graphs) SimulateGraph(w http.ResponseWriter, r *http.Request) {
+	graphID := r.Context().Value(GraphID{}).(int64)
+	priority := r.Context().Value(Priority{}).(int64)
+
+	startAt, err := GetStartAt(r)
+	if err != nil {
+		http.Error(w, "bad startAt param", http.StatusBadRequest)
+		return
+	}
+
+	simulatedGraph, err := g.graphsClient.SimulateGraph(r.Context(), graphID, int(priority), GetIterations(r), GetSeed(r), startAt)
+	if err != nil {
+		if errors.Is(err, graphsclient.ErrNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
+
+		g.log.Error("error while simulating graph", sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, simulatedGraph)
+}
+
+func (g *Graphs) CrashGraph(w http.ResponseWriter, r *http.Request) {
+	graphID := GetGraphID(r)
+	priority := r.Context().Value(Priority{}).(int64)
+
+	startAt, err := GetStartAt(r)
+	if err != nil {
+		http.Error(w, "bad startAt param", http.StatusBadRequest)
+		return
+	}
+	target, err := GetTarget(r)
+	if err != nil {
+		http.Error(w, "bad target param", http.StatusBadRequest)
+		return
+	}
+
+	plan, err := g.graphsClient.CrashGraph(r.Context(), graphID, int(priority), target, startAt)
+	if err != nil {
+		if errors.Is(err, graphsclient.ErrNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
+
+		g.log.Error("error while crashing graph", slog.Int64("graphID", graphID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, plan)
+}
+
+func (g *Graphs) CreateBaseline(w http.ResponseWriter, r *http.Request) {
+	graphID := GetGraphID(r)
+	priority := r.Context().Value(Priority{}).(int64)
+
+	baseline, err := models.BaselineModelFromJson(r.Body)
+	if err != nil {
+		http.Error(w, "bad json", http.StatusBadRequest)
+		return
+	}
+	if baseline.Name == "" {
+		http.Error(w, "empty baseline name", http.StatusBadRequest)
+		return
+	}
+	baseline.GraphID = graphID
+
+	startAt, err := GetStartAt(r)
+	if err != nil {
+		http.Error(w, "bad startAt param", http.StatusBadRequest)
+		return
+	}
+	if !startAt.IsZero() {
+		baseline.StartAt = startAt
+	}
+
+	baseline, err = g.graphsClient.CreateBaseline(r.Context(), baseline, int(priority))
+	if err != nil {
+		if errors.Is(err, graphsclient.ErrNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
+
+		g.log.Error("error while creating baseline", slog.Int64("graphID", graphID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, baseline)
+}
+
+func (g *Graphs) ListBaselines(w http.ResponseWriter, r *http.Request) {
+	graphID := GetGraphID(r)
+
+	baselines, err := g.graphsClient.ListBaselines(r.Context(), graphID)
+	if err != nil {
+		g.log.Error("error while listing baselines", slog.Int64("graphID", graphID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, baselines)
+}
+
+func (g *Graphs) CompareBaseline(w http.ResponseWriter, r *http.Request) {
+	graphID := GetGraphID(r)
+	baselineID := GetBaselineID(r)
+	priority := r.Context().Value(Priority{}).(int64)
+
+	startAt, err := GetStartAt(r)
+	if err != nil {
+		http.Error(w, "bad startAt param", http.StatusBadRequest)
+		return
+	}
+
+	comparison, err := g.graphsClient.CompareBaseline(r.Context(), graphID, baselineID, int(priority), startAt)
+	if err != nil {
+		if errors.Is(err, graphsclient.ErrNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
+
+		g.log.Error("error while comparing baseline", slog.Int64("graphID", graphID), slog.Int64("baselineID", baselineID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, comparison)
+}
+
+func (g *Graphs) ExportGraph(w http.ResponseWriter, r *http.Request) {
+	graphID := GetGraphID(r)
+	priority := r.Context().Value(Priority{}).(int64)
+
+	format, ok := GetExportFormat(r)
+	if !ok {
+		http.Error(w, "bad format param, expected one of: dot, mermaid, mspdi", http.StatusBadRequest)
+		return
+	}
+
+	startAt, err := GetStartAt(r)
+	if err != nil {
+		http.Error(w, "bad startAt param", http.StatusBadRequest)
+		return
+	}
+
+	exportedGraph, err := g.graphsClient.ExportGraph(r.Context(), graphID, format, int(priority), startAt)
+	if err != nil {
+		if errors.Is(err, graphsclient.ErrNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
+
+		g.log.Error("error while exporting graph", slog.Int64("graphID", graphID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", exportedGraph.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", exportedGraph.FileName))
+	w.WriteHeader(http.StatusOK)
+	w.Write(exportedGraph.Content)
+}
+
+// ApplyGraphPatch applies all operations of the patch or none of them,
+// 409 is returned if the graph was changed after the version from the patch
+func (g *Graphs) ApplyGraphPatch(w http.ResponseWriter, r *http.Request) {
+	graphID := GetGraphID(r)
+
+	patch, err := models.GraphPatchModelFromJson(r.Body)
+	if err != nil {
+		http.Error(w, "bad json", http.StatusBadRequest)
+		return
+	}
+	if err := patch.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := g.graphsClient.ApplyGraphPatch(r.Context(), graphID, patch)
+	if err != nil {
+		if errors.Is(err, graphsclient.ErrNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrVersionConflict) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
+
+		g.log.Error("error while applying graph patch", slog.Int64("graphID", graphID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, result)
+}
+
+func (g *Graphs) CloneGraph(w http.ResponseWriter, r *http.Request) {
+	g.copyGraph(w, r, g.graphsClient.CloneGraph)
+}
+
+func (g *Graphs) InstantiateTemplate(w http.ResponseWriter, r *http.Request) {
+	g.copyGraph(w, r, g.graphsClient.InstantiateTemplate)
+}
+
+// copyGraph creates a copy of the graph from path in the group from body,
+// creating graph in the group requires admin permission like CreateGroupGraph
+func (g *Graphs) copyGraph(w http.ResponseWriter, r *http.Request, copyFunc func(context.Context, int64, *models.CloneGraph) (int64, error)) {
+	graphID := GetGraphID(r)
+
+	clone, err := models.CloneGraphModelFromJson(r.Body)
+	if err != nil {
+		http.Error(w, "bad json", http.StatusBadRequest)
+		return
+	}
+	if clone.GroupID == 0 {
+		clone.GroupID = groups.GetGroupID(r)
+	}
+
+	if err := g.groupsClient.CheckAdminPermission(r.Context(), auth.GetUserID(r), clone.GroupID); err != nil {
+		if errors.Is(err, groupsclient.ErrPermissionDenied) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		g.log.Error("error while checking admin permission", slog.Int64("groupID", clone.GroupID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	newGraphID, err := copyFunc(r.Context(), graphID, clone)
+	if err != nil {
+		if errors.Is(err, graphsclient.ErrNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
+
+		g.log.Error("error while copying graph", slog.Int64("graphID", graphID), slog.Int64("groupID", clone.GroupID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WriteInt64ID(w, newGraphID)
+}
+
+// writeEvent writes graph event in Server-Sent Events format
+func writeEvent(w http.ResponseWriter, event *entities.GraphEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+	return err
+}
+
+// WatchGraph relays graph changes to the client as Server-Sent Events
+// until the client disconnects, the first event contains current critical paths
+func (g *Graphs) WatchGraph(w http.ResponseWriter, r *http.Request) {
+	graphID := GetGraphID(r)
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		g.log.Error("response writer does not support flushing")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	stream, err := g.graphsClient.WatchGraph(r.Context(), graphID)
+	if err != nil {
+		g.log.Error("error while watching graph", slog.Int64("graphID", graphID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// subscription errors come with the first event, the status can't be changed after it
+	event, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, graphsclient.ErrNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		g.log.Error("error while watching graph", slog.Int64("graphID", graphID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	for {
+		if err := writeEvent(w, event); err != nil {
+			g.log.Debug("error while writing graph event", sl.Err(err))
+			return
+		}
+		flusher.Flush()
+
+		event, err = stream.Recv()
+		if err != nil {
+			if r.Context().Err() == nil && !errors.Is(err, io.EOF) {
+				g.log.Error("error while watching graph", slog.Int64("graphID", graphID), sl.Err(err))
+			}
+			return
+		}
+	}
+}
+
+// GetEVMReport returns earned value report as of asOf query param (now by default)
+// against baseline from baselineID query param (latest by default)
+func (g *Graphs) GetEVMReport(w http.ResponseWriter, r *http.Request) {
+	graphID := GetGraphID(r)
+
+	asOf, err := GetAsOf(r)
+	if err != nil {
+		http.Error(w, "bad asOf param", http.StatusBadRequest)
+		return
+	}
+
+	report, err := g.graphsClient.GetEVMReport(r.Context(), graphID, GetOptionalBaselineID(r), asOf)
+	if err != nil {
+		if errors.Is(err, graphsclient.ErrNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
+
+		g.log.Error("error while getting evm report", slog.Int64("graphID", graphID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, report)
+}
+
+func (g *Graphs) AnalyzeGraph(w http.ResponseWriter, r *http.Request) {
+	graphID := GetGraphID(r)
+
+	analysis, err := g.graphsClient.AnalyzeGraph(r.Context(), graphID)
+	if err != nil {
+		if errors.Is(err, graphsclient.ErrNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
+
+		g.log.Error("error while analyzing graph", slog.Int64("graphID", graphID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, analysis)
+}
+
+const maxImportFileSize = 10 << 20
+
+func (g *Graphs) ImportGraph(w http.ResponseWriter, r *http.Request) {
+	groupID := groups.GetGroupID(r)
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = planimport.FormatCSV
+	}
+
+	plan, err := planimport.Parse(http.MaxBytesReader(w, r.Body, maxImportFileSize), format)
+	if err != nil {
+		if errors.Is(err, planimport.ErrUnknownFormat) {
+			http.Error(w, "bad format param, expected one of: csv, mspdi", http.StatusBadRequest)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if name := r.URL.Query().Get("name"); name != "" {
+		plan.Name = name
+	}
+	if plan.Name == "" {
+		http.Error(w, "empty graph name", http.StatusBadRequest)
+		return
+	}
+
+	imported, err := g.importPlan(r.Context(), groupID, plan)
+	if err != nil {
+		if errors.Is(err, tasksclient.ErrBadParams) || errors.Is(err, graphsclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, graphsclient.ErrBadGraph) {
+			writeBadGraph(w, err)
+			return
+		}
+
+		g.log.Error("error while importing graph", slog.Int64("groupID", groupID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, imported)
+}
+
+// importPlan creates plan tasks and then the graph, on failure created tasks are deleted
+func (g *Graphs) importPlan(ctx context.Context, groupID int64, plan *planimport.Plan) (_ *entities.ImportedGraph, err error) {
+	imported := &entities.ImportedGraph{
+		Tasks: make([]*entities.ImportedTask, 0, len(plan.Tasks)),
+	}
+
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		// compensation must complete even if client has gone
+		ctx := context.WithoutCancel(ctx)
+		for _, task := range imported.Tasks {
+			if err := g.tasksClient.DeleteTask(ctx, task.TaskID); err != nil && !errors.Is(err, tasksclient.ErrNotFound) {
+				g.log.Error("error while deleting imported task", slog.Int64("taskID", task.TaskID), sl.Err(err))
+			}
+		}
+	}()
+
+	for _, planTask := range plan.Tasks {
+		taskID, err := g.tasksClient.CreateTask(ctx, &models.Task{
+			GroupID:     groupID,
+			Title:       planTask.Title,
+			Description: planTask.Description,
+			PlannedTime: planTask.PlannedTime,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error while creating task %d: %w", planTask.Key, err)
+		}
+
+		imported.Tasks = append(imported.Tasks, &entities.ImportedTask{
+			Key:    planTask.Key,
+			TaskID: taskID,
+		})
+	}
+
+	successors := plan.Successors()
+	graph := &entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name:    plan.Name,
+			GroupID: groupID,
+		},
+		Nodes: make([]*models.Node, 0, len(plan.Tasks)),
+	}
+	for i, planTask := range plan.Tasks {
+		graph.Nodes = append(graph.Nodes, &models.Node{
+			ID:                planTask.Key,
+			TaskID:            imported.Tasks[i].TaskID,
+			DependencyNodeIDs: successors[planTask.Key],
+		})
+	}
+
+	imported.GraphID, err = g.graphsClient.CreateGroupGraph(ctx, graph)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating graph: %w", err)
+	}
+
+	return imported, nil
+}
+
+func (g *Graphs) SetGraphDeadline(w http.ResponseWriter, r *http.Request) {
+	graphID := GetGraphID(r)
+
+	deadline, err := models.GraphDeadlineModelFromJson(r.Body)
+	if err != nil {
+		http.Error(w, "bad json", http.StatusBadRequest)
+		return
+	}
+
+	if err := g.graphsClient.SetGraphDeadline(r.Context(), graphID, deadline.Deadline); err != nil {
+		if errors.Is(err, graphsclient.ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		g.log.Error("error while setting graph deadline", slog.Int64("graphID", graphID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
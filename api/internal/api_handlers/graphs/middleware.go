@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 )
@@ -13,6 +14,14 @@ type GraphID struct{}
 type NodeID struct{}
 type DependencyNodeID struct{}
 type Priority struct{}
+type BaselineID struct{}
+
+// exportFormats - значения query параметра format и соответствующие им форматы экспорта
+var exportFormats = map[string]int{
+	"dot":     0,
+	"mermaid": 1,
+	"mspdi":   2,
+}
 
 type GraphsMiddleware struct {
 	log *slog.Logger
@@ -65,13 +74,26 @@ func (g *GraphsMiddleware) DependencyNodeIDGetter(next http.Handler) http.Handle
 
 func (g *GraphsMiddleware) PriorityNodePredictGraphGetter(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		priority, _ := strconv.ParseInt(chi.URLParam(r, "priority"), 10, 64)
+		priority, _ := strconv.ParseInt(r.URL.Query().Get("priority"), 10, 64)
 
 		ctx := context.WithValue(r.Context(), Priority{}, priority)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+func (g *GraphsMiddleware) BaselineIDGetter(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		baselineID, err := strconv.ParseInt(chi.URLParam(r, "baselineID"), 10, 64)
+		if err != nil {
+			http.Error(w, "baselineID path param required", http.StatusBadRequest)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), BaselineID{}, baselineID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 func GetGraphID(r *http.Request) int64 {
 	id, _ := r.Context().Value(GraphID{}).(int64)
 	return id
@@ -87,7 +109,73 @@ func GetDependencyNodeID(r *http.Request) int64 {
 	return id
 }
 
+func GetBaselineID(r *http.Request) int64 {
+	id, _ := r.Context().Value(BaselineID{}).(int64)
+	return id
+}
+
 func GetOffset(r *http.Request) int64 {
 	offset, _ := strconv.ParseInt(r.URL.Query().Get("offset"), 10, 64)
 	return offset
 }
+
+func GetIterations(r *http.Request) int64 {
+	iterations, _ := strconv.ParseInt(r.URL.Query().Get("iterations"), 10, 64)
+	return iterations
+}
+
+func GetSeed(r *http.Request) int64 {
+	seed, _ := strconv.ParseInt(r.URL.Query().Get("seed"), 10, 64)
+	return seed
+}
+
+// GetTarget returns target duration in hours from target query param,
+// zero if param is empty
+func GetTarget(r *http.Request) (float64, error) {
+	target := r.URL.Query().Get("target")
+	if target == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseFloat(target, 64)
+}
+
+// GetStartAt returns schedule start from startAt query param (RFC 3339),
+// zero time if param is empty
+func GetStartAt(r *http.Request) (time.Time, error) {
+	startAt := r.URL.Query().Get("startAt")
+	if startAt == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, startAt)
+}
+
+// GetAsOf returns report date from asOf query param (RFC 3339),
+// zero time if param is empty
+func GetAsOf(r *http.Request) (time.Time, error) {
+	asOf := r.URL.Query().Get("asOf")
+	if asOf == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, asOf)
+}
+
+// GetOptionalBaselineID returns baselineID query param, zero if param is empty
+func GetOptionalBaselineID(r *http.Request) int64 {
+	baselineID, _ := strconv.ParseInt(r.URL.Query().Get("baselineID"), 10, 64)
+	return baselineID
+}
+
+// GetExportFormat returns export format from format query param,
+// DOT if param is empty, false if format is unknown
+func GetExportFormat(r *http.Request) (int, bool) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		return exportFormats["dot"], true
+	}
+
+	res, ok := exportFormats[format]
+	return res, ok
+}
@@ -0,0 +1,210 @@
+package groups
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/liriquew/control_system/api/internal/entities"
+	graphsclient "github.com/liriquew/control_system/api/internal/grpc/clients/graphs"
+	groupsclient "github.com/liriquew/control_system/api/internal/grpc/clients/groups"
+	tasksclient "github.com/liriquew/control_system/api/internal/grpc/clients/tasks"
+	jsontools "github.com/liriquew/control_system/api/internal/lib/json_tools"
+	"github.com/liriquew/control_system/api/internal/models"
+	"github.com/liriquew/control_system/api/pkg/logger/sl"
+)
+
+const (
+	auditDefaultLimit = 50
+	auditMaxLimit     = 100
+)
+
+var auditSources = []string{"groups", "tasks", "graphs"}
+
+// auditPosition is the last event of a source returned to the client
+type auditPosition struct {
+	Time time.Time `json:"t,omitempty"`
+	ID   int64     `json:"i,omitempty"`
+	// source has no more events
+	Done bool `json:"d,omitempty"`
+}
+
+// auditCursor keeps a position per source, events of every source
+// are ordered by (created_at, id) desc independently
+type auditCursor map[string]auditPosition
+
+func (c auditCursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func parseAuditCursor(cursor string) (auditCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	c := auditCursor{}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	for source := range c {
+		if !slices.Contains(auditSources, source) {
+			return nil, errors.New("invalid cursor")
+		}
+	}
+
+	return c, nil
+}
+
+// getAuditEventsRequest returns audit filter and page from query params
+func getAuditEventsRequest(r *http.Request) (*models.AuditEventsRequest, auditCursor, error) {
+	query := r.URL.Query()
+	req := &models.AuditEventsRequest{
+		GroupID: GetGroupID(r),
+		Entity:  query.Get("entity"),
+		Limit:   auditDefaultLimit,
+	}
+
+	var err error
+	if entityID := query.Get("entityID"); entityID != "" {
+		if req.EntityID, err = strconv.ParseInt(entityID, 10, 64); err != nil {
+			return nil, nil, errors.New("invalid entityID")
+		}
+	}
+	if actorID := query.Get("actorID"); actorID != "" {
+		if req.ActorID, err = strconv.ParseInt(actorID, 10, 64); err != nil {
+			return nil, nil, errors.New("invalid actorID")
+		}
+	}
+	if from := query.Get("from"); from != "" {
+		t, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return nil, nil, errors.New("invalid from")
+		}
+		req.From = &t
+	}
+	if to := query.Get("to"); to != "" {
+		t, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return nil, nil, errors.New("invalid to")
+		}
+		req.To = &t
+	}
+	if limit := query.Get("limit"); limit != "" {
+		l, err := strconv.ParseInt(limit, 10, 32)
+		if err != nil || l <= 0 || l > auditMaxLimit {
+			return nil, nil, errors.New("invalid limit")
+		}
+		req.Limit = int32(l)
+	}
+
+	cursor := auditCursor{}
+	if c := query.Get("cursor"); c != "" {
+		if cursor, err = parseAuditCursor(c); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return req, cursor, nil
+}
+
+// listSourceAuditEvents returns a page of audit events of the source after its position
+func (g *Groups) listSourceAuditEvents(ctx context.Context, source string, req models.AuditEventsRequest, pos auditPosition) ([]*entities.AuditEvent, error) {
+	if !pos.Time.IsZero() {
+		req.BeforeTime = &pos.Time
+		req.BeforeID = pos.ID
+	}
+
+	switch source {
+	case "groups":
+		return g.groupsClient.ListAuditEvents(ctx, &req)
+	case "tasks":
+		return g.tasksClient.ListAuditEvents(ctx, &req)
+	default:
+		return g.graphsClient.ListAuditEvents(ctx, &req)
+	}
+}
+
+// GetAuditEvents returns group audit events of all services newest first,
+// each service is queried for a full page and the pages are merged
+func (g *Groups) GetAuditEvents(w http.ResponseWriter, r *http.Request) {
+	req, cursor, err := getAuditEventsRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var events []*entities.AuditEvent
+	fetched := make(map[string]int)
+	for _, source := range auditSources {
+		if cursor[source].Done {
+			continue
+		}
+
+		sourceEvents, err := g.listSourceAuditEvents(r.Context(), source, *req, cursor[source])
+		if err != nil {
+			if errors.Is(err, groupsclient.ErrBadParams) ||
+				errors.Is(err, tasksclient.ErrBadParams) ||
+				errors.Is(err, graphsclient.ErrBadParams) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			g.log.Error("error while listing audit events", slog.String("source", source), slog.Int64("groupID", req.GroupID), sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		fetched[source] = len(sourceEvents)
+		events = append(events, sourceEvents...)
+	}
+
+	slices.SortStableFunc(events, func(a, b *entities.AuditEvent) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.ID, a.ID)
+	})
+	if len(events) > int(req.Limit) {
+		events = events[:req.Limit]
+	}
+
+	consumed := make(map[string]int)
+	for _, event := range events {
+		consumed[event.Source]++
+		cursor[event.Source] = auditPosition{Time: event.CreatedAt, ID: event.ID}
+	}
+
+	done := true
+	for _, source := range auditSources {
+		n, ok := fetched[source]
+		if !ok {
+			continue
+		}
+		if n < int(req.Limit) && consumed[source] == n {
+			cursor[source] = auditPosition{Done: true}
+			continue
+		}
+		done = false
+	}
+
+	result := &entities.AuditEventList{
+		Events: events,
+	}
+	if result.Events == nil {
+		result.Events = []*entities.AuditEvent{}
+	}
+	if !done {
+		result.NextCursor = cursor.encode()
+	}
+
+	jsontools.WtiteJSON(w, result)
+}
@@ -4,6 +4,7 @@ import (
 	"log/slog"
 
 	authclient "github.com/liriquew/control_system/api/internal/grpc/clients/auth"
+	graphsclient "github.com/liriquew/control_system/api/internal/grpc/clients/graphs"
 	groupsclient "github.com/liriquew/control_system/api/internal/grpc/clients/groups"
 	tasksclient "github.com/liriquew/control_system/api/internal/grpc/clients/tasks"
 )
@@ -13,8 +14,8 @@ type GroupsService struct {
 	GroupsMiddleware
 }
 
-func New(log *slog.Logger, client groupsclient.GroupsClient, tasksClient tasksclient.TasksClient, authClient authclient.AuthClient) GroupsService {
-	service := NewGroupsService(log, client, tasksClient, authClient)
+func New(log *slog.Logger, client groupsclient.GroupsClient, tasksClient tasksclient.TasksClient, graphsClient graphsclient.GraphClient, authClient authclient.AuthClient) GroupsService {
+	service := NewGroupsService(log, client, tasksClient, graphsClient, authClient)
 	middleware := NewAuthMiddleware(log, client)
 
 	return GroupsService{
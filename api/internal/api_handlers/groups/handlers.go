@@ -4,10 +4,13 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/liriquew/control_system/api/internal/api_handlers/auth"
 	"github.com/liriquew/control_system/api/internal/entities"
 	authclient "github.com/liriquew/control_system/api/internal/grpc/clients/auth"
+	graphsclient "github.com/liriquew/control_system/api/internal/grpc/clients/graphs"
 	groupsclient "github.com/liriquew/control_system/api/internal/grpc/clients/groups"
 	tasksclient "github.com/liriquew/control_system/api/internal/grpc/clients/tasks"
 	jsontools "github.com/liriquew/control_system/api/internal/lib/json_tools"
@@ -20,24 +23,31 @@ type GroupsAPI interface {
 	ListUserGroups(w http.ResponseWriter, r *http.Request)
 	GetGroup(w http.ResponseWriter, r *http.Request)
 	DeleteGroup(w http.ResponseWriter, r *http.Request)
+	RestoreGroup(w http.ResponseWriter, r *http.Request)
 	UpdateGroup(w http.ResponseWriter, r *http.Request)
 	ListGroupMembers(w http.ResponseWriter, r *http.Request)
 	AddGroupMember(w http.ResponseWriter, r *http.Request)
 	RemoveGroupMember(w http.ResponseWriter, r *http.Request)
 	ChangeMemberRole(w http.ResponseWriter, r *http.Request)
+	SetUserCalendar(w http.ResponseWriter, r *http.Request)
+	GetUserCalendar(w http.ResponseWriter, r *http.Request)
+	GetGroupCalendars(w http.ResponseWriter, r *http.Request)
+	GetAuditEvents(w http.ResponseWriter, r *http.Request)
 }
 
 type Groups struct {
 	groupsClient groupsclient.GroupsClient
 	tasksClient  tasksclient.TasksClient
+	graphsClient graphsclient.GraphClient
 	authClient   authclient.AuthClient
 	log          *slog.Logger
 }
 
-func NewGroupsService(log *slog.Logger, groupsClient groupsclient.GroupsClient, tasksclient tasksclient.TasksClient, authClient authclient.AuthClient) *Groups {
+func NewGroupsService(log *slog.Logger, groupsClient groupsclient.GroupsClient, tasksclient tasksclient.TasksClient, graphsClient graphsclient.GraphClient, authClient authclient.AuthClient) *Groups {
 	return &Groups{
 		groupsClient: groupsClient,
 		tasksClient:  tasksclient,
+		graphsClient: graphsClient,
 		authClient:   authClient,
 		log:          log,
 	}
@@ -144,6 +154,34 @@ func (g *Groups) DeleteGroup(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// RestoreGroup restores a deleted group, permission middlewares
+// don't see deleted groups, so the owner is checked by groups service
+func (g *Groups) RestoreGroup(w http.ResponseWriter, r *http.Request) {
+	groupID, err := strconv.ParseInt(chi.URLParam(r, "groupID"), 10, 64)
+	if err != nil {
+		http.Error(w, "groupID path param required", http.StatusBadRequest)
+		return
+	}
+
+	err = g.groupsClient.RestoreGroup(r.Context(), groupID)
+	if err != nil {
+		if errors.Is(err, groupsclient.ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, groupsclient.ErrPermissionDenied) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		g.log.Error("error while restoring group", slog.Int64("groupID", groupID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
 func (g *Groups) UpdateGroup(w http.ResponseWriter, r *http.Request) {
 	groupID := GetGroupID(r)
 
@@ -315,3 +353,54 @@ func (g *Groups) ChangeMemberRole(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusOK)
 }
+
+func (g *Groups) SetUserCalendar(w http.ResponseWriter, r *http.Request) {
+	calendar, err := models.UserCalendarModelFromJson(r.Body)
+	if err != nil {
+		http.Error(w, "bad json", http.StatusBadRequest)
+		return
+	}
+
+	err = g.groupsClient.SetUserCalendar(r.Context(), calendar)
+	if err != nil {
+		if errors.Is(err, groupsclient.ErrBadCalendar) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		g.log.Error("error while setting user calendar", slog.Any("calendar", calendar), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (g *Groups) GetUserCalendar(w http.ResponseWriter, r *http.Request) {
+	calendar, err := g.groupsClient.GetUserCalendar(r.Context())
+	if err != nil {
+		if errors.Is(err, groupsclient.ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		g.log.Error("error while getting user calendar", sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, calendar)
+}
+
+func (g *Groups) GetGroupCalendars(w http.ResponseWriter, r *http.Request) {
+	groupID := GetGroupID(r)
+
+	calendars, err := g.groupsClient.GetGroupCalendars(r.Context(), groupID)
+	if err != nil {
+		g.log.Error("error while getting group calendars", slog.Int64("groupID", groupID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, calendars)
+}
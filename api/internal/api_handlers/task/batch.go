@@ -0,0 +1,175 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/liriquew/control_system/api/internal/api_handlers/groups"
+	"github.com/liriquew/control_system/api/internal/entities"
+	groupsclient "github.com/liriquew/control_system/api/internal/grpc/clients/groups"
+	tasksclient "github.com/liriquew/control_system/api/internal/grpc/clients/tasks"
+	jsontools "github.com/liriquew/control_system/api/internal/lib/json_tools"
+	"github.com/liriquew/control_system/api/internal/models"
+	"github.com/liriquew/control_system/api/pkg/logger/sl"
+)
+
+// batchItems keeps results of items rejected by the gateway,
+// the rest of items is sent to tasks service
+type batchItems struct {
+	results []*entities.TaskResult
+	// indices of items sent to tasks service
+	valid []int
+}
+
+// checkAssignees rejects tasks assigned to users outside the group
+func (t *Tasks) checkAssignees(ctx context.Context, groupID int64, tasks []*models.Task) (*batchItems, []*models.Task, error) {
+	items := &batchItems{
+		results: make([]*entities.TaskResult, len(tasks)),
+	}
+	validTasks := make([]*models.Task, 0, len(tasks))
+	members := make(map[int64]bool)
+
+	for i, task := range tasks {
+		if task.AssignedTo != 0 && groupID == 0 {
+			items.results[i] = &entities.TaskResult{ID: task.ID, Status: http.StatusBadRequest, Error: "groupID required to assign user"}
+			continue
+		}
+
+		if task.AssignedTo != 0 {
+			isMember, ok := members[task.AssignedTo]
+			if !ok {
+				err := t.groupsClient.CheckMemberPermission(ctx, task.AssignedTo, groupID)
+				if err != nil && !errors.Is(err, groupsclient.ErrPermissionDenied) {
+					return nil, nil, err
+				}
+				isMember = err == nil
+				members[task.AssignedTo] = isMember
+			}
+			if !isMember {
+				items.results[i] = &entities.TaskResult{ID: task.ID, Status: http.StatusBadRequest, Error: "assigned_to user not in group"}
+				continue
+			}
+		}
+
+		items.valid = append(items.valid, i)
+		validTasks = append(validTasks, task)
+	}
+
+	return items, validTasks, nil
+}
+
+// merge places tasks service results to the positions of sent items
+func (b *batchItems) merge(result *entities.TasksBatchResult) *entities.TasksBatchResult {
+	if result != nil {
+		for j, i := range b.valid {
+			b.results[i] = result.Results[j]
+		}
+	}
+
+	return &entities.TasksBatchResult{
+		Results: b.results,
+	}
+}
+
+func (t *Tasks) CreateTasks(w http.ResponseWriter, r *http.Request) {
+	batch, err := models.TasksBatchModelFromJson(r.Body)
+	if err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+	if len(batch.Tasks) == 0 {
+		http.Error(w, "empty batch", http.StatusBadRequest)
+		return
+	}
+
+	groupID := groups.GetGroupID(r)
+	items, tasks, err := t.checkAssignees(r.Context(), groupID, batch.Tasks)
+	if err != nil {
+		t.log.Error("error while checking assigned_to users group permission", slog.Int64("groupID", groupID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	var result *entities.TasksBatchResult
+	if len(tasks) != 0 {
+		result, err = t.taskClient.CreateTasks(r.Context(), groupID, tasks)
+		if err != nil {
+			if errors.Is(err, tasksclient.ErrBadParams) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			t.log.Error("error while creating tasks", slog.Int64("groupID", groupID), sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	jsontools.WtiteJSON(w, items.merge(result))
+}
+
+func (t *Tasks) UpdateTasks(w http.ResponseWriter, r *http.Request) {
+	batch, err := models.TasksBatchModelFromJson(r.Body)
+	if err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+	if len(batch.Tasks) == 0 {
+		http.Error(w, "empty batch", http.StatusBadRequest)
+		return
+	}
+
+	groupID := groups.GetGroupID(r)
+	items, tasks, err := t.checkAssignees(r.Context(), groupID, batch.Tasks)
+	if err != nil {
+		t.log.Error("error while checking assigned_to users group permission", slog.Int64("groupID", groupID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	var result *entities.TasksBatchResult
+	if len(tasks) != 0 {
+		result, err = t.taskClient.UpdateTasks(r.Context(), groupID, tasks)
+		if err != nil {
+			if errors.Is(err, tasksclient.ErrBadParams) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			t.log.Error("error while updating tasks", slog.Int64("groupID", groupID), sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	jsontools.WtiteJSON(w, items.merge(result))
+}
+
+func (t *Tasks) DeleteTasks(w http.ResponseWriter, r *http.Request) {
+	ids, err := models.TasksIDsModelFromJson(r.Body)
+	if err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+	if len(ids.IDs) == 0 {
+		http.Error(w, "empty batch", http.StatusBadRequest)
+		return
+	}
+
+	groupID := groups.GetGroupID(r)
+	result, err := t.taskClient.DeleteTasks(r.Context(), groupID, ids.IDs)
+	if err != nil {
+		if errors.Is(err, tasksclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		t.log.Error("error while deleting tasks", slog.Int64("groupID", groupID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, result)
+}
@@ -0,0 +1,166 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/liriquew/control_system/api/internal/api_handlers/groups"
+	groupsclient "github.com/liriquew/control_system/api/internal/grpc/clients/groups"
+	tasksclient "github.com/liriquew/control_system/api/internal/grpc/clients/tasks"
+	jsontools "github.com/liriquew/control_system/api/internal/lib/json_tools"
+	"github.com/liriquew/control_system/api/internal/models"
+	"github.com/liriquew/control_system/api/pkg/logger/sl"
+)
+
+var errMentionNotMember = errors.New("mentioned user not in group")
+
+// checkMentions checks that mentioned users are group members
+func (t *Tasks) checkMentions(ctx context.Context, groupID int64, mentions []int64) error {
+	for _, userID := range mentions {
+		err := t.groupsClient.CheckMemberPermission(ctx, userID, groupID)
+		if err != nil {
+			if errors.Is(err, groupsclient.ErrPermissionDenied) {
+				return errMentionNotMember
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// commentFromRequest parses comment from body and checks its mentions
+func (t *Tasks) commentFromRequest(w http.ResponseWriter, r *http.Request) (*models.Comment, bool) {
+	comment, err := models.CommentModelFromJson(r.Body)
+	if err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return nil, false
+	}
+	if comment.Text == "" {
+		http.Error(w, "empty text", http.StatusBadRequest)
+		return nil, false
+	}
+
+	groupID := groups.GetGroupID(r)
+	if err := t.checkMentions(r.Context(), groupID, comment.Mentions); err != nil {
+		if errors.Is(err, errMentionNotMember) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return nil, false
+		}
+
+		t.log.Error("error while checking mentioned users group permission", slog.Int64("groupID", groupID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return comment, true
+}
+
+func (t *Tasks) CreateComment(w http.ResponseWriter, r *http.Request) {
+	taskID := GetTaskID(r)
+
+	comment, ok := t.commentFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	created, err := t.taskClient.CreateComment(r.Context(), taskID, comment)
+	if err != nil {
+		if errors.Is(err, tasksclient.ErrPermissionDenied) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		t.log.Error("error while creating comment", slog.Int64("taskID", taskID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, created)
+}
+
+func (t *Tasks) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	taskID, commentID := GetTaskID(r), GetCommentID(r)
+
+	comment, ok := t.commentFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	updated, err := t.taskClient.UpdateComment(r.Context(), taskID, commentID, comment)
+	if err != nil {
+		if errors.Is(err, tasksclient.ErrPermissionDenied) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		t.log.Error("error while updating comment", slog.Int64("commentID", commentID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, updated)
+}
+
+func (t *Tasks) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	taskID, commentID := GetTaskID(r), GetCommentID(r)
+
+	err := t.taskClient.DeleteComment(r.Context(), taskID, commentID)
+	if err != nil {
+		if errors.Is(err, tasksclient.ErrPermissionDenied) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		t.log.Error("error while deleting comment", slog.Int64("commentID", commentID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetTaskActivity returns comments and field changes of the task in chronological order
+func (t *Tasks) GetTaskActivity(w http.ResponseWriter, r *http.Request) {
+	taskID := GetTaskID(r)
+
+	activity, err := t.taskClient.GetTaskActivity(r.Context(), taskID)
+	if err != nil {
+		if errors.Is(err, tasksclient.ErrPermissionDenied) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		t.log.Error("error while getting task activity", slog.Int64("taskID", taskID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, activity)
+}
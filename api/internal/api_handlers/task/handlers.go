@@ -19,12 +19,26 @@ type TaskAPI interface {
 	CreateTask(w http.ResponseWriter, r *http.Request)
 	GetTask(w http.ResponseWriter, r *http.Request)
 	GetTaskList(w http.ResponseWriter, r *http.Request)
+	GetGroupTaskList(w http.ResponseWriter, r *http.Request)
 	UpdateTask(w http.ResponseWriter, r *http.Request)
 	DeleteTask(w http.ResponseWriter, r *http.Request)
+	RestoreTask(w http.ResponseWriter, r *http.Request)
+	TaskDone(w http.ResponseWriter, r *http.Request)
+	TransitionTask(w http.ResponseWriter, r *http.Request)
+	GetTaskStatusHistory(w http.ResponseWriter, r *http.Request)
+	StartWork(w http.ResponseWriter, r *http.Request)
+	StopWork(w http.ResponseWriter, r *http.Request)
+	CreateComment(w http.ResponseWriter, r *http.Request)
+	UpdateComment(w http.ResponseWriter, r *http.Request)
+	DeleteComment(w http.ResponseWriter, r *http.Request)
+	GetTaskActivity(w http.ResponseWriter, r *http.Request)
 	PredictTask(w http.ResponseWriter, r *http.Request)
 	GetTags(w http.ResponseWriter, r *http.Request)
 	PredictTags(w http.ResponseWriter, r *http.Request)
 	PredictUncreatedTask(w http.ResponseWriter, r *http.Request)
+	CreateTasks(w http.ResponseWriter, r *http.Request)
+	UpdateTasks(w http.ResponseWriter, r *http.Request)
+	DeleteTasks(w http.ResponseWriter, r *http.Request)
 }
 
 type Tasks struct {
@@ -65,6 +79,14 @@ func (t *Tasks) CreateTask(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "actualTime must be greated than zero", http.StatusBadRequest)
 		return
 	}
+	if task.PercentComplete < 0 || task.PercentComplete >= 100 {
+		http.Error(w, "percentComplete must be in range [0, 100)", http.StatusBadRequest)
+		return
+	}
+	if task.CrashCost < 0 || task.CrashMinTime < 0 {
+		http.Error(w, "crashCost and crashMinTime can't be negative", http.StatusBadRequest)
+		return
+	}
 	if task.Title == "" {
 		http.Error(w, "empty title", http.StatusBadRequest)
 		return
@@ -139,11 +161,47 @@ func (t *Tasks) GetTask(w http.ResponseWriter, r *http.Request) {
 }
 
 func (t *Tasks) GetTaskList(w http.ResponseWriter, r *http.Request) {
-	offset := GetOffset(r)
-	tasks, err := t.taskClient.GetTaskList(r.Context(), offset)
+	req, err := GetTaskListRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tasks, err := t.taskClient.GetTaskList(r.Context(), req)
+	if err != nil {
+		if errors.Is(err, tasksclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		t.log.Error("error while getting task list", slog.Any("request", req), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, tasks)
+}
+
+// GetGroupTaskList returns a page of group tasks, group from path overrides groupID query param.
+// Tasks are filtered by state, status, title, assignedTo, tags (allTags) and createdFrom/createdTo,
+// sorted by sortBy in order, limit tasks per page, next page is requested with cursor from the previous one
+func (t *Tasks) GetGroupTaskList(w http.ResponseWriter, r *http.Request) {
+	req, err := GetTaskListRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.GroupID = groups.GetGroupID(r)
+
+	tasks, err := t.taskClient.GetGroupTaskList(r.Context(), req)
 	if err != nil {
-		t.log.Error("error while getting task list", slog.Int64("offset", offset), sl.Err(err))
-		w.WriteHeader(http.StatusBadRequest)
+		if errors.Is(err, tasksclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		t.log.Error("error while getting group task list", slog.Any("request", req), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
@@ -157,7 +215,8 @@ func (t *Tasks) UpdateTask(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if task.Title == "" && task.Description == "" && task.PlannedTime == 0.0 && task.ActualTime == 0 && task.AssignedTo == 0 && len(task.Tags) == 0 {
+	if task.Title == "" && task.Description == "" && task.PlannedTime == 0.0 && task.ActualTime == 0 && task.AssignedTo == 0 && len(task.Tags) == 0 && task.PercentComplete == 0 &&
+		task.CrashCost == 0 && task.CrashMinTime == 0 {
 		http.Error(w, "nothing to update", http.StatusBadRequest)
 		return
 	}
@@ -165,6 +224,14 @@ func (t *Tasks) UpdateTask(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "plannedTime must be greated than zero", http.StatusBadRequest)
 		return
 	}
+	if task.PercentComplete < 0 || task.PercentComplete >= 100 {
+		http.Error(w, "percentComplete must be in range [0, 100), use done to complete task", http.StatusBadRequest)
+		return
+	}
+	if task.CrashCost < 0 || task.CrashMinTime < 0 {
+		http.Error(w, "crashCost and crashMinTime can't be negative", http.StatusBadRequest)
+		return
+	}
 	if groupID := groups.GetGroupID(r); task.AssignedTo != 0 && groupID == 0 {
 		http.Error(w, "groupID required to assign user", http.StatusBadRequest)
 		return
@@ -234,6 +301,189 @@ func (t *Tasks) DeleteTask(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// RestoreTask restores a deleted task, group tasks are restored
+// by group editors, personal tasks only by their creator
+func (t *Tasks) RestoreTask(w http.ResponseWriter, r *http.Request) {
+	taskID := GetTaskID(r)
+
+	err := t.taskClient.RestoreTask(r.Context(), groups.GetGroupID(r), taskID)
+	if err != nil {
+		if errors.Is(err, tasksclient.ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		t.log.Error("error while restoring task", slog.Int64("taskID", taskID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// TaskDone completes task, body contains actual_time in hours
+func (t *Tasks) TaskDone(w http.ResponseWriter, r *http.Request) {
+	taskID := GetTaskID(r)
+
+	task, err := models.TaskModelFromJson(r.Body)
+	if err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+	if task.ActualTime < 0 {
+		http.Error(w, "actualTime can't be negative", http.StatusBadRequest)
+		return
+	}
+
+	err = t.taskClient.TaskDone(r.Context(), taskID, task.ActualTime)
+	if err != nil {
+		if errors.Is(err, tasksclient.ErrPermissionDenied) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		t.log.Error("error while completing task", slog.Int64("taskID", taskID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// TransitionTask moves task to another status, body contains status
+// and actual_time in hours for done status
+func (t *Tasks) TransitionTask(w http.ResponseWriter, r *http.Request) {
+	taskID := GetTaskID(r)
+
+	transition, err := models.TaskTransitionModelFromJson(r.Body)
+	if err != nil {
+		http.Error(w, "invalid json", http.StatusBadRequest)
+		return
+	}
+	if transition.Status == "" {
+		http.Error(w, "empty status", http.StatusBadRequest)
+		return
+	}
+	if transition.ActualTime < 0 {
+		http.Error(w, "actualTime must be greated than zero", http.StatusBadRequest)
+		return
+	}
+
+	err = t.taskClient.TransitionTask(r.Context(), taskID, transition)
+	if err != nil {
+		if errors.Is(err, tasksclient.ErrPermissionDenied) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrStatusChanged) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+
+		t.log.Error("error while moving task", slog.Int64("taskID", taskID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (t *Tasks) GetTaskStatusHistory(w http.ResponseWriter, r *http.Request) {
+	taskID := GetTaskID(r)
+
+	history, err := t.taskClient.GetTaskStatusHistory(r.Context(), groups.GetGroupID(r), taskID)
+	if err != nil {
+		if errors.Is(err, tasksclient.ErrPermissionDenied) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		t.log.Error("error while getting task status history", slog.Int64("taskID", taskID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, history)
+}
+
+// StartWork starts work session of the task executor,
+// time of sessions becomes actual time of the completed task
+func (t *Tasks) StartWork(w http.ResponseWriter, r *http.Request) {
+	taskID := GetTaskID(r)
+
+	session, err := t.taskClient.StartWork(r.Context(), taskID)
+	if err != nil {
+		if errors.Is(err, tasksclient.ErrPermissionDenied) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrStatusChanged) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+
+		t.log.Error("error while starting work session", slog.Int64("taskID", taskID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, session)
+}
+
+func (t *Tasks) StopWork(w http.ResponseWriter, r *http.Request) {
+	taskID := GetTaskID(r)
+
+	session, err := t.taskClient.StopWork(r.Context(), taskID)
+	if err != nil {
+		if errors.Is(err, tasksclient.ErrNotFound) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrPermissionDenied) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if errors.Is(err, tasksclient.ErrBadParams) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		t.log.Error("error while stopping work session", slog.Int64("taskID", taskID), sl.Err(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	jsontools.WtiteJSON(w, session)
+}
+
 func (t *Tasks) PredictTask(w http.ResponseWriter, r *http.Request) {
 	taskID := GetTaskID(r)
 
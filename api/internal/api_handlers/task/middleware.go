@@ -2,12 +2,19 @@ package task
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/liriquew/control_system/api/internal/api_handlers/groups"
 	tasksclient "github.com/liriquew/control_system/api/internal/grpc/clients/tasks"
+	"github.com/liriquew/control_system/api/internal/lib/converter"
+	"github.com/liriquew/control_system/api/internal/models"
+	"github.com/liriquew/control_system/api/pkg/logger/sl"
 )
 
 type TaskID struct{}
@@ -47,7 +54,120 @@ func GetTaskID(r *http.Request) int64 {
 	return id
 }
 
-func GetOffset(r *http.Request) int64 {
-	offset, _ := strconv.ParseInt(r.URL.Query().Get("offset"), 10, 64)
-	return offset
+type CommentID struct{}
+
+func (g *TasksMiddleware) ExtractCommentID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		commentID, err := strconv.ParseInt(chi.URLParam(r, "commentID"), 10, 64)
+		if err != nil {
+			http.Error(w, "commentID path param required", http.StatusBadRequest)
+			return
+		}
+		if commentID <= 0 {
+			http.Error(w, "invalid commentID", http.StatusBadRequest)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), CommentID{}, commentID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func GetCommentID(r *http.Request) int64 {
+	id, _ := r.Context().Value(CommentID{}).(int64)
+	return id
+}
+
+// CheckTaskInGroup checks that the task belongs to the group,
+// must be used after group permission check
+func (g *TasksMiddleware) CheckTaskInGroup(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		taskID, groupID := GetTaskID(r), groups.GetGroupID(r)
+
+		if err := g.client.TaskExists(r.Context(), taskID, groupID); err != nil {
+			if errors.Is(err, tasksclient.ErrNotFound) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			g.log.Error("error while checking task in group", slog.Int64("taskID", taskID), slog.Int64("groupID", groupID), sl.Err(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// GetTaskListRequest returns task list filter, sort and page from query params
+func GetTaskListRequest(r *http.Request) (*models.TaskListRequest, error) {
+	query := r.URL.Query()
+	req := &models.TaskListRequest{
+		State:  query.Get("state"),
+		Title:  query.Get("title"),
+		SortBy: query.Get("sortBy"),
+		Cursor: query.Get("cursor"),
+	}
+
+	var err error
+	if groupID := query.Get("groupID"); groupID != "" {
+		if req.GroupID, err = strconv.ParseInt(groupID, 10, 64); err != nil {
+			return nil, errors.New("invalid groupID")
+		}
+	}
+	if assignedTo := query.Get("assignedTo"); assignedTo != "" {
+		if req.AssignedTo, err = strconv.ParseInt(assignedTo, 10, 64); err != nil {
+			return nil, errors.New("invalid assignedTo")
+		}
+	}
+	if tags := query.Get("tags"); tags != "" {
+		for _, tag := range strings.Split(tags, ",") {
+			tagID, err := strconv.ParseInt(tag, 10, 32)
+			if err != nil {
+				return nil, errors.New("invalid tags")
+			}
+			req.Tags = append(req.Tags, int32(tagID))
+		}
+	}
+	if statuses := query.Get("status"); statuses != "" {
+		req.Statuses = strings.Split(statuses, ",")
+	}
+	if allTags := query.Get("allTags"); allTags != "" {
+		if req.AllTags, err = strconv.ParseBool(allTags); err != nil {
+			return nil, errors.New("invalid allTags")
+		}
+	}
+	if createdFrom := query.Get("createdFrom"); createdFrom != "" {
+		t, err := time.Parse(time.RFC3339, createdFrom)
+		if err != nil {
+			return nil, errors.New("invalid createdFrom")
+		}
+		req.CreatedFrom = &t
+	}
+	if createdTo := query.Get("createdTo"); createdTo != "" {
+		t, err := time.Parse(time.RFC3339, createdTo)
+		if err != nil {
+			return nil, errors.New("invalid createdTo")
+		}
+		req.CreatedTo = &t
+	}
+	switch query.Get("order") {
+	case "", "desc":
+	case "asc":
+		req.Asc = true
+	default:
+		return nil, errors.New("invalid order")
+	}
+	if limit := query.Get("limit"); limit != "" {
+		l, err := strconv.ParseInt(limit, 10, 32)
+		if err != nil || l <= 0 {
+			return nil, errors.New("invalid limit")
+		}
+		req.Limit = int32(l)
+	}
+	if !converter.ValidTaskListRequest(req) {
+		return nil, errors.New("invalid state, status or sortBy")
+	}
+
+	return req, nil
 }
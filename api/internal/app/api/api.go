@@ -37,15 +37,34 @@ func New(
 			r.Post("/", http.HandlerFunc(taskAPI.CreateTask))
 			r.Get("/", http.HandlerFunc(taskAPI.GetTaskList))
 
+			r.Route("/batch", func(r chi.Router) {
+				r.Post("/", http.HandlerFunc(taskAPI.CreateTasks))
+				r.Patch("/", http.HandlerFunc(taskAPI.UpdateTasks))
+				r.Delete("/", http.HandlerFunc(taskAPI.DeleteTasks))
+			})
+
 			r.With(taskAPI.ExtractTaskID).Route("/{taskID}", func(r chi.Router) {
 				r.Get("/", http.HandlerFunc(taskAPI.GetTask))
 				r.Patch("/", http.HandlerFunc(taskAPI.UpdateTask))
 				r.Delete("/", http.HandlerFunc(taskAPI.DeleteTask))
+				r.Post("/restore", http.HandlerFunc(taskAPI.RestoreTask))
+				r.Post("/done", http.HandlerFunc(taskAPI.TaskDone))
+				r.Post("/transition", http.HandlerFunc(taskAPI.TransitionTask))
+				r.Get("/history", http.HandlerFunc(taskAPI.GetTaskStatusHistory))
+				r.Route("/work", func(r chi.Router) {
+					r.Post("/start", http.HandlerFunc(taskAPI.StartWork))
+					r.Post("/stop", http.HandlerFunc(taskAPI.StopWork))
+				})
 				r.Get("/predict", http.HandlerFunc(taskAPI.PredictTask))
 			})
 			r.Get("/predict", http.HandlerFunc(taskAPI.PredictUncreatedTask))
 		})
 
+		r.With(authAPI.Authenticate).Route("/calendar", func(r chi.Router) {
+			r.Get("/", http.HandlerFunc(groupsAPI.GetUserCalendar))
+			r.Put("/", http.HandlerFunc(groupsAPI.SetUserCalendar))
+		})
+
 		r.With(authAPI.Authenticate).Route("/groups", func(r chi.Router) {
 			r.Post("/", http.HandlerFunc(groupsAPI.CreateGroup))
 			r.Get("/", http.HandlerFunc(groupsAPI.ListUserGroups))
@@ -54,15 +73,39 @@ func New(
 				r.With(groupsAPI.CheckMemberPermission).Get("/", http.HandlerFunc(groupsAPI.GetGroup))
 				r.With(groupsAPI.CheckEditorPermission).Patch("/", http.HandlerFunc(groupsAPI.UpdateGroup))
 				r.With(groupsAPI.CheckAdminPermission).Delete("/", http.HandlerFunc(groupsAPI.DeleteGroup))
+				r.Post("/restore", http.HandlerFunc(groupsAPI.RestoreGroup))
 
 				r.Route("/tasks", func(r chi.Router) {
 					r.With(groupsAPI.CheckEditorPermission).Post("/", http.HandlerFunc(taskAPI.CreateTask))
+					r.With(groupsAPI.CheckMemberPermission).Get("/", http.HandlerFunc(taskAPI.GetGroupTaskList))
+
+					r.With(groupsAPI.CheckEditorPermission).Route("/batch", func(r chi.Router) {
+						r.Post("/", http.HandlerFunc(taskAPI.CreateTasks))
+						r.Patch("/", http.HandlerFunc(taskAPI.UpdateTasks))
+						r.Delete("/", http.HandlerFunc(taskAPI.DeleteTasks))
+					})
 
 					r.Route("/{taskID}", func(r chi.Router) {
 						r.Use(taskAPI.ExtractTaskID)
 						r.With(groupsAPI.CheckMemberPermission).Get("/", http.HandlerFunc(taskAPI.GetTask))
 						r.With(groupsAPI.CheckEditorPermission).Patch("/", http.HandlerFunc(taskAPI.UpdateTask))
 						r.With(groupsAPI.CheckEditorPermission).Delete("/", http.HandlerFunc(taskAPI.DeleteTask))
+						r.With(groupsAPI.CheckEditorPermission).Post("/restore", http.HandlerFunc(taskAPI.RestoreTask))
+						r.With(groupsAPI.CheckMemberPermission, taskAPI.CheckTaskInGroup).Post("/done", http.HandlerFunc(taskAPI.TaskDone))
+						r.With(groupsAPI.CheckMemberPermission, taskAPI.CheckTaskInGroup).Post("/transition", http.HandlerFunc(taskAPI.TransitionTask))
+						r.With(groupsAPI.CheckMemberPermission, taskAPI.CheckTaskInGroup).Get("/history", http.HandlerFunc(taskAPI.GetTaskStatusHistory))
+						r.With(groupsAPI.CheckMemberPermission, taskAPI.CheckTaskInGroup).Route("/work", func(r chi.Router) {
+							r.Post("/start", http.HandlerFunc(taskAPI.StartWork))
+							r.Post("/stop", http.HandlerFunc(taskAPI.StopWork))
+						})
+						r.With(groupsAPI.CheckMemberPermission, taskAPI.CheckTaskInGroup).Group(func(r chi.Router) {
+							r.Get("/activity", http.HandlerFunc(taskAPI.GetTaskActivity))
+							r.Post("/comments", http.HandlerFunc(taskAPI.CreateComment))
+							r.With(taskAPI.ExtractCommentID).Route("/comments/{commentID}", func(r chi.Router) {
+								r.Patch("/", http.HandlerFunc(taskAPI.UpdateComment))
+								r.Delete("/", http.HandlerFunc(taskAPI.DeleteComment))
+							})
+						})
 						r.With(groupsAPI.CheckEditorPermission).Get("/predict", http.HandlerFunc(taskAPI.PredictTask))
 					})
 
@@ -81,13 +124,35 @@ func New(
 						Patch("/{memberID}/role", http.HandlerFunc(groupsAPI.ChangeMemberRole))
 				})
 
+				r.With(groupsAPI.CheckMemberPermission).Get("/calendars", http.HandlerFunc(groupsAPI.GetGroupCalendars))
+				r.With(groupsAPI.CheckAdminPermission).Get("/audit", http.HandlerFunc(groupsAPI.GetAuditEvents))
+
 				r.Route("/graphs", func(r chi.Router) {
 					r.With(groupsAPI.CheckAdminPermission).Post("/", http.HandlerFunc(graphsAPI.CreateGroupGraph))
 					r.With(groupsAPI.CheckMemberPermission).Get("/", http.HandlerFunc(graphsAPI.ListGroupGraphs))
+					r.With(groupsAPI.CheckAdminPermission).Post("/import", http.HandlerFunc(graphsAPI.ImportGraph))
 
 					r.With(graphsAPI.GraphIDGetter).Route("/{graphID}", func(r chi.Router) {
 						r.With(groupsAPI.CheckMemberPermission).Get("/", http.HandlerFunc(graphsAPI.GetGraph))
 						r.With(groupsAPI.CheckMemberPermission, graphsAPI.PriorityNodePredictGraphGetter).Get("/predict", http.HandlerFunc(graphsAPI.PredictGraph))
+						r.With(groupsAPI.CheckMemberPermission, graphsAPI.PriorityNodePredictGraphGetter).Post("/scenario", http.HandlerFunc(graphsAPI.PredictScenario))
+						r.With(groupsAPI.CheckMemberPermission, graphsAPI.PriorityNodePredictGraphGetter).Get("/simulate", http.HandlerFunc(graphsAPI.SimulateGraph))
+						r.With(groupsAPI.CheckMemberPermission, graphsAPI.PriorityNodePredictGraphGetter).Get("/crash", http.HandlerFunc(graphsAPI.CrashGraph))
+						r.With(groupsAPI.CheckMemberPermission, graphsAPI.PriorityNodePredictGraphGetter).Get("/export", http.HandlerFunc(graphsAPI.ExportGraph))
+						r.With(groupsAPI.CheckMemberPermission).Get("/analyze", http.HandlerFunc(graphsAPI.AnalyzeGraph))
+						r.With(groupsAPI.CheckMemberPermission).Get("/evm", http.HandlerFunc(graphsAPI.GetEVMReport))
+						r.With(groupsAPI.CheckMemberPermission).Get("/watch", http.HandlerFunc(graphsAPI.WatchGraph))
+						r.With(groupsAPI.CheckEditorPermission).Post("/patch", http.HandlerFunc(graphsAPI.ApplyGraphPatch))
+						r.With(groupsAPI.CheckMemberPermission).Post("/clone", http.HandlerFunc(graphsAPI.CloneGraph))
+						r.With(groupsAPI.CheckMemberPermission).Post("/instantiate", http.HandlerFunc(graphsAPI.InstantiateTemplate))
+						r.With(groupsAPI.CheckEditorPermission).Put("/deadline", http.HandlerFunc(graphsAPI.SetGraphDeadline))
+
+						r.Route("/baselines", func(r chi.Router) {
+							r.With(groupsAPI.CheckEditorPermission, graphsAPI.PriorityNodePredictGraphGetter).Post("/", http.HandlerFunc(graphsAPI.CreateBaseline))
+							r.With(groupsAPI.CheckMemberPermission).Get("/", http.HandlerFunc(graphsAPI.ListBaselines))
+							r.With(groupsAPI.CheckMemberPermission, graphsAPI.BaselineIDGetter, graphsAPI.PriorityNodePredictGraphGetter).
+								Get("/{baselineID}/compare", http.HandlerFunc(graphsAPI.CompareBaseline))
+						})
 
 						r.Route("/nodes", func(r chi.Router) {
 							r.With(groupsAPI.CheckEditorPermission).Post("/", http.HandlerFunc(graphsAPI.CreateNode))
@@ -95,6 +160,7 @@ func New(
 							r.With(graphsAPI.NodeIDGetter).Route("/{nodeID}", func(r chi.Router) {
 								r.With(groupsAPI.CheckMemberPermission).Get("/", http.HandlerFunc(graphsAPI.GetNode))
 								r.With(groupsAPI.CheckEditorPermission).Delete("/", http.HandlerFunc(graphsAPI.RemoveNode))
+								r.With(groupsAPI.CheckEditorPermission).Post("/restore", http.HandlerFunc(graphsAPI.RestoreNode))
 								r.With(groupsAPI.CheckEditorPermission).Patch("/", http.HandlerFunc(graphsAPI.UpdateNode))
 
 								r.Route("/dependencies", func(r chi.Router) {
@@ -51,9 +51,9 @@ func New(log *slog.Logger, cfg config.AppConfig) *App {
 
 	tasks := tasks.New(log, tasksClient, groupsClient, predictionsClient)
 
-	groups := groups.New(log, groupsClient, tasksClient, authClient)
+	groups := groups.New(log, groupsClient, tasksClient, graphsClient, authClient)
 
-	graphs := graphs.New(log, graphsClient, tasksClient)
+	graphs := graphs.New(log, graphsClient, tasksClient, groupsClient)
 
 	r := appapi.New(auth, tasks, groups, graphs)
 
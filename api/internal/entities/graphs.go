@@ -3,6 +3,7 @@ package entities
 import (
 	"encoding/json"
 	"io"
+	"time"
 
 	"github.com/liriquew/control_system/api/internal/models"
 )
@@ -26,11 +27,24 @@ func GraphWithNodesFromJSON(jsonBody io.ReadCloser) (*GraphWithNodes, error) {
 	return &graph, err
 }
 
+type NodeSchedule struct {
+	EarlyStart  float64   `json:"early_start"`
+	EarlyFinish float64   `json:"early_finish"`
+	LateStart   float64   `json:"late_start"`
+	LateFinish  float64   `json:"late_finish"`
+	TotalFloat  float64   `json:"total_float"`
+	FreeFloat   float64   `json:"free_float"`
+	Start       time.Time `json:"start"`
+	Finish      time.Time `json:"finish"`
+}
+
 type NodeWithTask struct {
-	Node                   *models.Node `json:"node"`
-	Task                   *models.Task `json:"task"`
-	AdditionalDependencies []int64      `json:"additional_priority_deps"`
-	PredictedTime          float64      `json:"predicted_time"`
+	Node                   *models.Node  `json:"node"`
+	Task                   *models.Task  `json:"task"`
+	AdditionalDependencies []int64       `json:"additional_priority_deps"`
+	PredictedTime          float64       `json:"predicted_time"`
+	RemainingTime          float64       `json:"remaining_time"`
+	Schedule               *NodeSchedule `json:"schedule"`
 }
 
 type PredictedNodes struct {
@@ -61,6 +75,198 @@ type PredictedGraph struct {
 	Nodes           []*NodeWithTask
 	UnpredictedUIDs []int64
 	Paths           [][]int64
+	Duration        float64
+	StartAt         time.Time
+	FinishAt        time.Time
+	// only for scenario prediction
+	Delta *ScenarioDelta `json:",omitempty"`
+	// only for graphs with deadline
+	Deadline *DeadlineStatus `json:",omitempty"`
+}
+
+type NodeShortening struct {
+	NodeID     int64   `json:"node_id"`
+	Duration   float64 `json:"duration"`
+	Shortening float64 `json:"shortening"`
+}
+
+// DeadlineStatus - how the predicted schedule fits the graph deadline,
+// Shortenings lists hours to cut from critical path nodes to meet the deadline
+type DeadlineStatus struct {
+	Deadline    time.Time         `json:"deadline"`
+	FinishAt    time.Time         `json:"finish_at"`
+	Met         bool              `json:"met"`
+	BufferDays  float64           `json:"buffer_days"`
+	Shortenings []*NodeShortening `json:"shortenings"`
+	Recoverable bool              `json:"recoverable"`
+	// only for simulation and watch events
+	MissProbability float64 `json:"miss_probability,omitempty"`
+}
+
+type NodeCriticality struct {
+	NodeID           int64   `json:"node_id"`
+	CriticalityIndex float64 `json:"criticality_index"`
+	MinTime          float64 `json:"min_time"`
+	MostLikelyTime   float64 `json:"most_likely_time"`
+	MaxTime          float64 `json:"max_time"`
+}
+
+type SimulatedGraph struct {
+	Graph           *models.Graph      `json:"graph"`
+	Iterations      int64              `json:"iterations"`
+	P50             float64            `json:"p50"`
+	P80             float64            `json:"p80"`
+	P95             float64            `json:"p95"`
+	Mean            float64            `json:"mean"`
+	Nodes           []*NodeCriticality `json:"nodes"`
+	UnpredictedUIDs []int64            `json:"unpredictedUIDs"`
+	Deadline        *DeadlineStatus    `json:"deadline,omitempty"`
+}
+
+type NodeCrash struct {
+	NodeID          int64   `json:"node_id"`
+	Duration        float64 `json:"duration"`
+	CrashedDuration float64 `json:"crashed_duration"`
+	Cost            float64 `json:"cost"`
+}
+
+// CrashPlan - cheapest shortening of critical path tasks to reach the target duration,
+// Met is false if the target can't be reached
+type CrashPlan struct {
+	Graph           *models.Graph `json:"graph"`
+	TargetDuration  float64       `json:"target_duration"`
+	InitialDuration float64       `json:"initial_duration"`
+	Duration        float64       `json:"duration"`
+	FinishAt        time.Time     `json:"finish_at"`
+	Met             bool          `json:"met"`
+	Cost            float64       `json:"cost"`
+	Nodes           []*NodeCrash  `json:"nodes"`
+	UnpredictedUIDs []int64       `json:"unpredictedUIDs"`
+}
+
+type NodeDrift struct {
+	NodeID          int64   `json:"node_id"`
+	BaselineWeight  float64 `json:"baseline_weight"`
+	CurrentWeight   float64 `json:"current_weight"`
+	StartDrift      float64 `json:"start_drift"`
+	FinishDrift     float64 `json:"finish_drift"`
+	TotalFloatDelta float64 `json:"total_float_delta"`
+	WasCritical     bool    `json:"was_critical"`
+	IsCritical      bool    `json:"is_critical"`
+}
+
+type BaselineComparison struct {
+	Baseline            *models.Baseline     `json:"baseline"`
+	CurrentDuration     float64              `json:"current_duration"`
+	DurationDelta       float64              `json:"duration_delta"`
+	CurrentFinishAt     time.Time            `json:"current_finish_at"`
+	FinishDrift         float64              `json:"finish_drift"`
+	Nodes               []*NodeDrift         `json:"nodes"`
+	AddedNodes          []int64              `json:"added_nodes"`
+	RemovedNodes        []int64              `json:"removed_nodes"`
+	AddedDependencies   []*models.Dependency `json:"added_dependencies"`
+	RemovedDependencies []*models.Dependency `json:"removed_dependencies"`
+	BaselinePaths       [][]int64            `json:"baseline_paths"`
+	CurrentPaths        [][]int64            `json:"current_paths"`
+	UnpredictedUIDs     []int64              `json:"unpredictedUIDs"`
+}
+
+type ScenarioDelta struct {
+	BaseDuration        float64              `json:"base_duration"`
+	DurationDelta       float64              `json:"duration_delta"`
+	BaseFinishAt        time.Time            `json:"base_finish_at"`
+	FinishDrift         float64              `json:"finish_drift"`
+	Nodes               []*NodeDrift         `json:"nodes"`
+	RemovedNodes        []int64              `json:"removed_nodes"`
+	AddedDependencies   []*models.Dependency `json:"added_dependencies"`
+	RemovedDependencies []*models.Dependency `json:"removed_dependencies"`
+	BasePaths           [][]int64            `json:"base_paths"`
+}
+
+type ImportedTask struct {
+	Key    int64 `json:"key"`
+	TaskID int64 `json:"task_id"`
+}
+
+type ImportedGraph struct {
+	GraphID int64           `json:"graph_id"`
+	Tasks   []*ImportedTask `json:"tasks"`
+}
+
+type NodeDegree struct {
+	NodeID int64 `json:"node_id"`
+	FanIn  int64 `json:"fan_in"`
+	FanOut int64 `json:"fan_out"`
+}
+
+type GraphAnalysis struct {
+	GraphID        int64         `json:"graph_id"`
+	Nodes          int64         `json:"nodes"`
+	Dependencies   int64         `json:"dependencies"`
+	Components     int64         `json:"components"`
+	LongestPath    []int64       `json:"longest_path"`
+	LevelWidths    []int64       `json:"level_widths"`
+	MaxParallelism int64         `json:"max_parallelism"`
+	FanOutHotSpots []*NodeDegree `json:"fan_out_hot_spots"`
+	FanInHotSpots  []*NodeDegree `json:"fan_in_hot_spots"`
+	OrphanNodeIDs  []int64       `json:"orphan_node_ids"`
+}
+
+type CreatedNode struct {
+	TempID int64 `json:"temp_id"`
+	NodeID int64 `json:"node_id"`
+}
+
+type GraphPatchResult struct {
+	Version      int64          `json:"version"`
+	CreatedNodes []*CreatedNode `json:"created_nodes"`
+}
+
+// GraphEvent is a graph change sent to watchers,
+// Node is set for node events, Dependency for dependency events,
+// Paths and Duration for critical path events, Deadline for deadline events
+type GraphEvent struct {
+	Type       string             `json:"type"`
+	GraphID    int64              `json:"graph_id"`
+	UserID     int64              `json:"user_id,omitempty"`
+	At         time.Time          `json:"at"`
+	Node       *models.Node       `json:"node,omitempty"`
+	Dependency *models.Dependency `json:"dependency,omitempty"`
+	Paths      [][]int64          `json:"paths,omitempty"`
+	Duration   float64            `json:"duration,omitempty"`
+	Deadline   *DeadlineStatus    `json:"deadline,omitempty"`
+}
+
+type NodeEarnedValue struct {
+	NodeID       int64   `json:"node_id"`
+	PlannedValue float64 `json:"pv"`
+	EarnedValue  float64 `json:"ev"`
+	ActualCost   float64 `json:"ac"`
+}
+
+// EVMReport - earned value metrics in hours, AC includes completed tasks only
+type EVMReport struct {
+	GraphID              int64              `json:"graph_id"`
+	Baseline             *models.Baseline   `json:"baseline"`
+	AsOf                 time.Time          `json:"as_of"`
+	BudgetAtCompletion   float64            `json:"bac"`
+	PlannedValue         float64            `json:"pv"`
+	EarnedValue          float64            `json:"ev"`
+	ActualCost           float64            `json:"ac"`
+	ScheduleVariance     float64            `json:"sv"`
+	CostVariance         float64            `json:"cv"`
+	SPI                  float64            `json:"spi"`
+	CPI                  float64            `json:"cpi"`
+	EstimateAtCompletion float64            `json:"eac"`
+	EstimateToCompletion float64            `json:"etc"`
+	Nodes                []*NodeEarnedValue `json:"nodes"`
+	UnbaselinedNodes     []int64            `json:"unbaselined_nodes"`
+}
+
+type ExportedGraph struct {
+	Content     []byte
+	ContentType string
+	FileName    string
 }
 
 type NodeWithDependency struct {
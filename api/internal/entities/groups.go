@@ -1,6 +1,11 @@
 package entities
 
-import "github.com/liriquew/control_system/api/internal/models"
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/liriquew/control_system/api/internal/models"
+)
 
 type GroupWithTasks struct {
 	Group *models.Group  `json:"group"`
@@ -11,3 +16,26 @@ type GroupMemberWithDetails struct {
 	Member  *models.GroupMember  `json:"member"`
 	Details *models.UsersDetails `json:"details"`
 }
+
+// AuditEvent is a change of a group entity, Source is the service
+// the event is recorded by: "tasks", "groups" or "graphs"
+type AuditEvent struct {
+	Source   string `json:"source"`
+	ID       int64  `json:"id"`
+	GroupID  int64  `json:"group_id"`
+	ActorID  int64  `json:"actor_id"`
+	Entity   string `json:"entity"`
+	EntityID int64  `json:"entity_id"`
+	// "create", "update" or "delete"
+	Action string `json:"action"`
+	// entity fields, update keeps only changed fields
+	Before    json.RawMessage `json:"before,omitempty"`
+	After     json.RawMessage `json:"after,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type AuditEventList struct {
+	Events []*AuditEvent `json:"events"`
+	// empty if there are no more events
+	NextCursor string `json:"next_cursor,omitempty"`
+}
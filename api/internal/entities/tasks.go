@@ -1,9 +1,77 @@
 package entities
 
-import "github.com/liriquew/control_system/api/internal/models"
+import (
+	"time"
+
+	"github.com/liriquew/control_system/api/internal/models"
+)
 
 type PredictedTask struct {
 	Task          models.Task `json:"task"`
 	PredictedTime float64     `json:"predicted_time"`
 	Predicted     bool        `json:"is_predicted"`
 }
+
+type TaskList struct {
+	Tasks []*models.Task `json:"tasks"`
+	// empty if there are no more tasks
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// TaskResult is a result of a single batch item, Status is an http status of the item
+type TaskResult struct {
+	ID     int64  `json:"id,omitempty"`
+	Status int    `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type TasksBatchResult struct {
+	Results []*TaskResult `json:"results"`
+}
+
+type TaskStatusChange struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	ChangedBy int64     `json:"changed_by"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+// WorkSession is a period of work on the task, Time is the duration in hours
+type WorkSession struct {
+	ID        int64      `json:"id"`
+	TaskID    int64      `json:"task_id"`
+	UserID    int64      `json:"user_id"`
+	StartedAt time.Time  `json:"started_at"`
+	StoppedAt *time.Time `json:"stopped_at,omitempty"`
+	Time      float64    `json:"time,omitempty"`
+}
+
+type Mention struct {
+	UserID   int64  `json:"user_id"`
+	Username string `json:"username"`
+}
+
+type Comment struct {
+	ID        int64      `json:"id"`
+	TaskID    int64      `json:"task_id"`
+	AuthorID  int64      `json:"author_id"`
+	Text      string     `json:"text"`
+	Mentions  []*Mention `json:"mentions"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+type TaskFieldChange struct {
+	ChangedBy int64     `json:"changed_by"`
+	ChangedAt time.Time `json:"changed_at"`
+	Field     string    `json:"field"`
+	OldValue  string    `json:"old_value"`
+	NewValue  string    `json:"new_value"`
+}
+
+// ActivityItem is a comment or a field change, Type is "comment" or "change"
+type ActivityItem struct {
+	Type    string           `json:"type"`
+	Comment *Comment         `json:"comment,omitempty"`
+	Change  *TaskFieldChange `json:"change,omitempty"`
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	grpclog "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
@@ -18,6 +19,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type GRPCGraphClient struct {
@@ -63,13 +65,42 @@ func InterceptorLogger(log *slog.Logger) grpclog.Logger {
 }
 
 var (
-	ErrTaskInNode    = errors.New("task in node")
-	ErrBadParams     = errors.New("")
-	ErrNotFound      = errors.New("")
-	ErrBadGraph      = errors.New("")
-	ErrAlreadyExists = errors.New("")
+	ErrTaskInNode      = errors.New("task in node")
+	ErrBadParams       = errors.New("")
+	ErrNotFound        = errors.New("")
+	ErrBadGraph        = errors.New("")
+	ErrAlreadyExists   = errors.New("")
+	ErrVersionConflict = errors.New("")
 )
 
+// CycleError is returned when the graph contains a cycle,
+// every node of Cycle depends on the previous one, the first node depends on the last one
+type CycleError struct {
+	Message string
+	Cycle   []int64
+}
+
+func (e *CycleError) Error() string {
+	return e.Message
+}
+
+func (e *CycleError) Unwrap() error {
+	return ErrBadGraph
+}
+
+func badGraphError(st *status.Status) error {
+	for _, detail := range st.Details() {
+		if cycle, ok := detail.(*grph_pb.CycleDetails); ok {
+			return &CycleError{
+				Message: st.Message(),
+				Cycle:   cycle.NodeIDs,
+			}
+		}
+	}
+
+	return fmt.Errorf("%w%s", ErrBadGraph, st.Message())
+}
+
 type GraphClient interface {
 	CreateGroupGraph(ctx context.Context, graph *entities.GraphWithNodes) (int64, error)
 	ListGroupGraphs(ctx context.Context, groupID, offset int64) ([]*entities.GraphWithNodes, error)
@@ -78,11 +109,27 @@ type GraphClient interface {
 	CreateNode(ctx context.Context, node *models.Node) (int64, error)
 	UpdateNode(ctx context.Context, node *models.Node) error
 	RemoveNode(ctx context.Context, graphID, nodeID int64) error
+	RestoreNode(ctx context.Context, graphID, nodeID int64) (*models.Node, error)
 	GetDependencies(ctx context.Context, graphID, nodeID int64) (*models.Node, error)
 	AddDependency(ctx context.Context, dep *models.Dependency) error
 	RemoveDependency(ctx context.Context, dep *models.Dependency) error
-	PredictGraph(ctx context.Context, graphID int64, priority int) (*entities.PredictedGraph, error)
+	PredictGraph(ctx context.Context, graphID int64, priority int, startAt time.Time) (*entities.PredictedGraph, error)
+	PredictScenario(ctx context.Context, graphID int64, priority int, startAt time.Time, scenario *models.Scenario) (*entities.PredictedGraph, error)
+	SimulateGraph(ctx context.Context, graphID int64, priority int, iterations, seed int64, startAt time.Time) (*entities.SimulatedGraph, error)
+	CrashGraph(ctx context.Context, graphID int64, priority int, targetDuration float64, startAt time.Time) (*entities.CrashPlan, error)
 	TaskInNode(ctx context.Context, taskID int64) (int64, error)
+	CreateBaseline(ctx context.Context, baseline *models.Baseline, priority int) (*models.Baseline, error)
+	ListBaselines(ctx context.Context, graphID int64) ([]*models.Baseline, error)
+	CompareBaseline(ctx context.Context, graphID, baselineID int64, priority int, startAt time.Time) (*entities.BaselineComparison, error)
+	ExportGraph(ctx context.Context, graphID int64, format, priority int, startAt time.Time) (*entities.ExportedGraph, error)
+	AnalyzeGraph(ctx context.Context, graphID int64) (*entities.GraphAnalysis, error)
+	GetEVMReport(ctx context.Context, graphID, baselineID int64, asOf time.Time) (*entities.EVMReport, error)
+	WatchGraph(ctx context.Context, graphID int64) (GraphEventStream, error)
+	ApplyGraphPatch(ctx context.Context, graphID int64, patch *models.GraphPatch) (*entities.GraphPatchResult, error)
+	CloneGraph(ctx context.Context, graphID int64, clone *models.CloneGraph) (int64, error)
+	InstantiateTemplate(ctx context.Context, templateID int64, clone *models.CloneGraph) (int64, error)
+	SetGraphDeadline(ctx context.Context, graphID int64, deadline *time.Time) error
+	ListAuditEvents(ctx context.Context, req *models.AuditEventsRequest) ([]*entities.AuditEvent, error)
 }
 
 func (c *GRPCGraphClient) CreateGroupGraph(ctx context.Context, graph *entities.GraphWithNodes) (int64, error) {
@@ -92,6 +139,10 @@ func (c *GRPCGraphClient) CreateGroupGraph(ctx context.Context, graph *entities.
 			switch st.Code() {
 			case codes.InvalidArgument:
 				return 0, fmt.Errorf("%w:%s", ErrBadParams, st.Message())
+			case codes.NotFound:
+				return 0, fmt.Errorf("%w%s", ErrNotFound, st.Message())
+			case codes.FailedPrecondition:
+				return 0, badGraphError(st)
 			}
 		}
 
@@ -141,6 +192,8 @@ func (c *GRPCGraphClient) CreateNode(ctx context.Context, node *models.Node) (in
 				return 0, ErrNotFound
 			case codes.InvalidArgument:
 				return 0, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.FailedPrecondition:
+				return 0, badGraphError(st)
 			}
 		}
 		return 0, err
@@ -177,6 +230,8 @@ func (c *GRPCGraphClient) UpdateNode(ctx context.Context, node *models.Node) err
 				return ErrNotFound
 			case codes.InvalidArgument:
 				return fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.FailedPrecondition:
+				return badGraphError(st)
 			}
 		}
 		return err
@@ -203,6 +258,28 @@ func (c *GRPCGraphClient) RemoveNode(ctx context.Context, graphID, nodeID int64)
 	return nil
 }
 
+func (c *GRPCGraphClient) RestoreNode(ctx context.Context, graphID, nodeID int64) (*models.Node, error) {
+	resp, err := c.client.RestoreNode(ctx, &grph_pb.RestoreNodeRequest{
+		GraphID: graphID,
+		NodeID:  nodeID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, ErrNotFound
+			case codes.Aborted:
+				return nil, fmt.Errorf("%w%s", ErrVersionConflict, st.Message())
+			case codes.FailedPrecondition:
+				return nil, badGraphError(st)
+			}
+		}
+		return nil, err
+	}
+
+	return converter.ConvertNodeToModel(resp.Node), nil
+}
+
 func (c *GRPCGraphClient) GetDependencies(ctx context.Context, graphID, nodeID int64) (*models.Node, error) {
 	resp, err := c.client.GetDependencies(ctx, &grph_pb.GetDependenciesRequest{
 		GraphID: graphID,
@@ -223,11 +300,8 @@ func (c *GRPCGraphClient) GetDependencies(ctx context.Context, graphID, nodeID i
 
 func (c *GRPCGraphClient) AddDependency(ctx context.Context, dep *models.Dependency) error {
 	_, err := c.client.AddDependency(ctx, &grph_pb.DependencyRequest{
-		GraphID: dep.GraphID,
-		Dependency: &grph_pb.Dependency{
-			FromNodeID: dep.FromNodeID,
-			ToNodeID:   dep.ToNodeID,
-		},
+		GraphID:    dep.GraphID,
+		Dependency: converter.ConvertDependencyToProto(dep),
 	})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
@@ -238,6 +312,10 @@ func (c *GRPCGraphClient) AddDependency(ctx context.Context, dep *models.Depende
 				return fmt.Errorf("%w%s", ErrBadParams, st.Message())
 			case codes.AlreadyExists:
 				return ErrAlreadyExists
+			case codes.Aborted:
+				return fmt.Errorf("%w%s", ErrVersionConflict, st.Message())
+			case codes.FailedPrecondition:
+				return badGraphError(st)
 			}
 		}
 		return err
@@ -269,18 +347,54 @@ func (c *GRPCGraphClient) RemoveDependency(ctx context.Context, dep *models.Depe
 	return nil
 }
 
-func (c *GRPCGraphClient) PredictGraph(ctx context.Context, graphID int64, priority int) (*entities.PredictedGraph, error) {
+// scheduleStart converts schedule start to proto, zero time means "now"
+func scheduleStart(startAt time.Time) *timestamppb.Timestamp {
+	if startAt.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(startAt)
+}
+
+func (c *GRPCGraphClient) PredictGraph(ctx context.Context, graphID int64, priority int, startAt time.Time) (*entities.PredictedGraph, error) {
+	resp, err := c.client.PredictGraph(ctx, &grph_pb.PredictGraphRequest{
+		GraphID:  graphID,
+		Priority: grph_pb.Priority(priority),
+		StartAt:  scheduleStart(startAt),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, fmt.Errorf("%w%s", ErrNotFound, st.Message())
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.FailedPrecondition:
+				return nil, badGraphError(st)
+			}
+		}
+		return nil, err
+	}
+
+	return converter.ConvertPredictedGraph(resp), nil
+}
+
+func (c *GRPCGraphClient) PredictScenario(ctx context.Context, graphID int64, priority int, startAt time.Time, scenario *models.Scenario) (*entities.PredictedGraph, error) {
 	resp, err := c.client.PredictGraph(ctx, &grph_pb.PredictGraphRequest{
 		GraphID:  graphID,
 		Priority: grph_pb.Priority(priority),
+		StartAt:  scheduleStart(startAt),
+		Scenario: converter.ConvertScenarioToProto(scenario),
 	})
 	if err != nil {
 		if st, ok := status.FromError(err); ok {
 			switch st.Code() {
 			case codes.NotFound:
 				return nil, fmt.Errorf("%w%s", ErrNotFound, st.Message())
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
 			case codes.FailedPrecondition:
-				return nil, fmt.Errorf("%w%s", ErrBadGraph, st.Message())
+				return nil, badGraphError(st)
 			}
 		}
 		return nil, err
@@ -289,6 +403,55 @@ func (c *GRPCGraphClient) PredictGraph(ctx context.Context, graphID int64, prior
 	return converter.ConvertPredictedGraph(resp), nil
 }
 
+func (c *GRPCGraphClient) SimulateGraph(ctx context.Context, graphID int64, priority int, iterations, seed int64, startAt time.Time) (*entities.SimulatedGraph, error) {
+	resp, err := c.client.SimulateGraph(ctx, &grph_pb.SimulateGraphRequest{
+		GraphID:    graphID,
+		Priority:   grph_pb.Priority(priority),
+		Iterations: iterations,
+		Seed:       seed,
+		StartAt:    scheduleStart(startAt),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, fmt.Errorf("%w%s", ErrNotFound, st.Message())
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.FailedPrecondition:
+				return nil, badGraphError(st)
+			}
+		}
+		return nil, err
+	}
+
+	return converter.ConvertSimulatedGraph(resp), nil
+}
+
+func (c *GRPCGraphClient) CrashGraph(ctx context.Context, graphID int64, priority int, targetDuration float64, startAt time.Time) (*entities.CrashPlan, error) {
+	resp, err := c.client.CrashGraph(ctx, &grph_pb.CrashGraphRequest{
+		GraphID:        graphID,
+		Priority:       grph_pb.Priority(priority),
+		StartAt:        scheduleStart(startAt),
+		TargetDuration: targetDuration,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, fmt.Errorf("%w%s", ErrNotFound, st.Message())
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.FailedPrecondition:
+				return nil, badGraphError(st)
+			}
+		}
+		return nil, err
+	}
+
+	return converter.ConvertCrashPlan(resp), nil
+}
+
 func (c *GRPCGraphClient) TaskInNode(ctx context.Context, taskID int64) (int64, error) {
 	nodeID, err := c.client.TaskInNode(ctx, &grph_pb.TaskInNodeRequest{
 		TaskID: taskID,
@@ -303,3 +466,267 @@ func (c *GRPCGraphClient) TaskInNode(ctx context.Context, taskID int64) (int64,
 
 	return 0, nil
 }
+
+func (c *GRPCGraphClient) CreateBaseline(ctx context.Context, baseline *models.Baseline, priority int) (*models.Baseline, error) {
+	resp, err := c.client.CreateBaseline(ctx, &grph_pb.CreateBaselineRequest{
+		GraphID:  baseline.GraphID,
+		Name:     baseline.Name,
+		Priority: grph_pb.Priority(priority),
+		StartAt:  scheduleStart(baseline.StartAt),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, fmt.Errorf("%w%s", ErrNotFound, st.Message())
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.FailedPrecondition:
+				return nil, badGraphError(st)
+			}
+		}
+		return nil, err
+	}
+
+	return converter.ConvertBaselineToModel(resp.Baseline), nil
+}
+
+func (c *GRPCGraphClient) ListBaselines(ctx context.Context, graphID int64) ([]*models.Baseline, error) {
+	resp, err := c.client.ListBaselines(ctx, &grph_pb.ListBaselinesRequest{
+		GraphID: graphID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertBaselinesToModel(resp.Baselines), nil
+}
+
+func (c *GRPCGraphClient) CompareBaseline(ctx context.Context, graphID, baselineID int64, priority int, startAt time.Time) (*entities.BaselineComparison, error) {
+	resp, err := c.client.CompareBaseline(ctx, &grph_pb.CompareBaselineRequest{
+		GraphID:    graphID,
+		BaselineID: baselineID,
+		Priority:   grph_pb.Priority(priority),
+		StartAt:    scheduleStart(startAt),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, fmt.Errorf("%w%s", ErrNotFound, st.Message())
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.FailedPrecondition:
+				return nil, badGraphError(st)
+			}
+		}
+		return nil, err
+	}
+
+	return converter.ConvertBaselineComparison(resp), nil
+}
+
+func (c *GRPCGraphClient) ExportGraph(ctx context.Context, graphID int64, format, priority int, startAt time.Time) (*entities.ExportedGraph, error) {
+	resp, err := c.client.ExportGraph(ctx, &grph_pb.ExportGraphRequest{
+		GraphID:  graphID,
+		Format:   grph_pb.ExportFormat(format),
+		Priority: grph_pb.Priority(priority),
+		StartAt:  scheduleStart(startAt),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, fmt.Errorf("%w%s", ErrNotFound, st.Message())
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.FailedPrecondition:
+				return nil, badGraphError(st)
+			}
+		}
+		return nil, err
+	}
+
+	return &entities.ExportedGraph{
+		Content:     resp.Content,
+		ContentType: resp.ContentType,
+		FileName:    resp.FileName,
+	}, nil
+}
+
+func (c *GRPCGraphClient) ApplyGraphPatch(ctx context.Context, graphID int64, patch *models.GraphPatch) (*entities.GraphPatchResult, error) {
+	// patch is not idempotent, retry after timeout may apply it twice
+	resp, err := c.client.ApplyGraphPatch(ctx, converter.ConvertGraphPatchToProto(graphID, patch), grpcretry.Disable())
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, fmt.Errorf("%w%s", ErrNotFound, st.Message())
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.Aborted:
+				return nil, fmt.Errorf("%w%s", ErrVersionConflict, st.Message())
+			case codes.FailedPrecondition:
+				return nil, badGraphError(st)
+			}
+		}
+		return nil, err
+	}
+
+	return converter.ConvertGraphPatchResult(resp), nil
+}
+
+// cloneError converts errors of graph copy methods
+func cloneError(err error) error {
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.NotFound:
+			return fmt.Errorf("%w%s", ErrNotFound, st.Message())
+		case codes.InvalidArgument:
+			return fmt.Errorf("%w%s", ErrBadParams, st.Message())
+		case codes.FailedPrecondition:
+			return badGraphError(st)
+		}
+	}
+	return err
+}
+
+func (c *GRPCGraphClient) CloneGraph(ctx context.Context, graphID int64, clone *models.CloneGraph) (int64, error) {
+	// copy creates tasks, retry after timeout may create them twice
+	resp, err := c.client.CloneGraph(ctx, &grph_pb.CloneGraphRequest{
+		GraphID:    graphID,
+		GroupID:    clone.GroupID,
+		Name:       clone.Name,
+		AsTemplate: clone.AsTemplate,
+	}, grpcretry.Disable())
+	if err != nil {
+		return 0, cloneError(err)
+	}
+
+	return resp.GraphID, nil
+}
+
+func (c *GRPCGraphClient) InstantiateTemplate(ctx context.Context, templateID int64, clone *models.CloneGraph) (int64, error) {
+	resp, err := c.client.InstantiateTemplate(ctx, &grph_pb.InstantiateTemplateRequest{
+		TemplateID: templateID,
+		GroupID:    clone.GroupID,
+		Name:       clone.Name,
+	}, grpcretry.Disable())
+	if err != nil {
+		return 0, cloneError(err)
+	}
+
+	return resp.GraphID, nil
+}
+
+func (c *GRPCGraphClient) SetGraphDeadline(ctx context.Context, graphID int64, deadline *time.Time) error {
+	_, err := c.client.SetGraphDeadline(ctx, &grph_pb.SetGraphDeadlineRequest{
+		GraphID:  graphID,
+		Deadline: converter.ConvertDeadlineToProto(deadline),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return fmt.Errorf("%w%s", ErrNotFound, st.Message())
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (c *GRPCGraphClient) ListAuditEvents(ctx context.Context, req *models.AuditEventsRequest) ([]*entities.AuditEvent, error) {
+	resp, err := c.client.ListAuditEvents(ctx, converter.ConvertGraphsAuditEventsRequestToProto(req))
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			}
+		}
+
+		return nil, err
+	}
+
+	return converter.ConvertGraphsAuditEventsToModel(resp.Events), nil
+}
+
+// GraphEventStream returns graph events until the stream is closed,
+// io.EOF is returned when the service closes the stream
+type GraphEventStream interface {
+	Recv() (*entities.GraphEvent, error)
+}
+
+type graphEventStream struct {
+	stream grph_pb.Graphs_WatchGraphClient
+}
+
+func (s *graphEventStream) Recv() (*entities.GraphEvent, error) {
+	event, err := s.stream.Recv()
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, fmt.Errorf("%w%s", ErrNotFound, st.Message())
+			}
+		}
+		return nil, err
+	}
+
+	return converter.ConvertGraphEvent(event), nil
+}
+
+// WatchGraph subscribes to graph changes, the stream is closed when ctx is done,
+// errors of the subscription are returned by the first Recv
+func (c *GRPCGraphClient) WatchGraph(ctx context.Context, graphID int64) (GraphEventStream, error) {
+	stream, err := c.client.WatchGraph(ctx, &grph_pb.WatchGraphRequest{
+		GraphID: graphID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphEventStream{stream: stream}, nil
+}
+
+func (c *GRPCGraphClient) GetEVMReport(ctx context.Context, graphID, baselineID int64, asOf time.Time) (*entities.EVMReport, error) {
+	resp, err := c.client.GetEVMReport(ctx, &grph_pb.EVMReportRequest{
+		GraphID:    graphID,
+		BaselineID: baselineID,
+		AsOf:       scheduleStart(asOf),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, fmt.Errorf("%w%s", ErrNotFound, st.Message())
+			case codes.FailedPrecondition:
+				return nil, badGraphError(st)
+			}
+		}
+		return nil, err
+	}
+
+	return converter.ConvertEVMReport(resp), nil
+}
+
+func (c *GRPCGraphClient) AnalyzeGraph(ctx context.Context, graphID int64) (*entities.GraphAnalysis, error) {
+	resp, err := c.client.AnalyzeGraph(ctx, &grph_pb.AnalyzeGraphRequest{
+		GraphID: graphID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, fmt.Errorf("%w%s", ErrNotFound, st.Message())
+			case codes.FailedPrecondition:
+				return nil, badGraphError(st)
+			}
+		}
+		return nil, err
+	}
+
+	return converter.ConvertGraphAnalysis(resp), nil
+}
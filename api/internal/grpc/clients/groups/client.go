@@ -9,6 +9,7 @@ import (
 	grpclog "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
 
+	"github.com/liriquew/control_system/api/internal/entities"
 	"github.com/liriquew/control_system/api/internal/lib/config"
 	"github.com/liriquew/control_system/api/internal/lib/converter"
 	"github.com/liriquew/control_system/api/internal/models"
@@ -17,6 +18,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type GRPCGroupsClient struct {
@@ -68,6 +70,8 @@ var (
 	ErrPermissionDenied = errors.New("permission denied")
 	ErrNothingToUpdate  = errors.New("nothing to update, empty fields")
 	ErrAlreadyExists    = errors.New("already exists")
+	ErrBadCalendar      = errors.New("bad calendar: ")
+	ErrBadParams        = errors.New("")
 )
 
 type GroupsClient interface {
@@ -75,6 +79,7 @@ type GroupsClient interface {
 	ListUserGroups(ctx context.Context, offset int64) ([]*models.Group, error)
 	GetGroup(ctx context.Context, groupID int64) (*models.Group, error)
 	DeleteGroup(ctx context.Context, groupID int64) error
+	RestoreGroup(ctx context.Context, groupID int64) error
 	UpdateGroup(ctx context.Context, group *models.Group) error
 	ListGroupMembers(ctx context.Context, groupID int64) ([]*models.GroupMember, error)
 	AddGroupMember(ctx context.Context, groupMember *models.GroupMember) error
@@ -83,6 +88,10 @@ type GroupsClient interface {
 	CheckAdminPermission(ctx context.Context, userID, groupID int64) error
 	CheckEditorPermission(ctx context.Context, userID, groupID int64) error
 	CheckMemberPermission(ctx context.Context, userID, groupID int64) error
+	SetUserCalendar(ctx context.Context, calendar *models.UserCalendar) error
+	GetUserCalendar(ctx context.Context) (*models.UserCalendar, error)
+	GetGroupCalendars(ctx context.Context, groupID int64) ([]*models.UserCalendar, error)
+	ListAuditEvents(ctx context.Context, req *models.AuditEventsRequest) ([]*entities.AuditEvent, error)
 }
 
 func (g *GRPCGroupsClient) CreateGroup(ctx context.Context, group *models.Group) (int64, error) {
@@ -154,6 +163,26 @@ func (g *GRPCGroupsClient) DeleteGroup(ctx context.Context, groupID int64) error
 	return nil
 }
 
+func (g *GRPCGroupsClient) RestoreGroup(ctx context.Context, groupID int64) error {
+	_, err := g.client.RestoreGroup(ctx, &grps_pb.GroupID{
+		ID: groupID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return ErrNotFound
+			case codes.PermissionDenied:
+				return ErrPermissionDenied
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
 func (g *GRPCGroupsClient) UpdateGroup(ctx context.Context, group *models.Group) error {
 	_, err := g.client.UpdateGroup(ctx, converter.ConvertGroupToProto(group))
 	if err != nil {
@@ -298,3 +327,64 @@ func (g *GRPCGroupsClient) CheckMemberPermission(ctx context.Context, userID, gr
 
 	return nil
 }
+
+func (g *GRPCGroupsClient) SetUserCalendar(ctx context.Context, calendar *models.UserCalendar) error {
+	_, err := g.client.SetUserCalendar(ctx, converter.ConvertUserCalendarToProto(calendar))
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return fmt.Errorf("%w%s", ErrBadCalendar, st.Message())
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (g *GRPCGroupsClient) GetUserCalendar(ctx context.Context) (*models.UserCalendar, error) {
+	resp, err := g.client.GetUserCalendar(ctx, &emptypb.Empty{})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, ErrNotFound
+			}
+		}
+
+		return nil, err
+	}
+
+	return converter.ConvertUserCalendarToModel(resp), nil
+}
+
+func (g *GRPCGroupsClient) GetGroupCalendars(ctx context.Context, groupID int64) ([]*models.UserCalendar, error) {
+	resp, err := g.client.GetGroupCalendars(ctx, &grps_pb.GroupID{
+		ID: groupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.ConvertUserCalendarsToModel(resp.Calendars), nil
+}
+
+func (g *GRPCGroupsClient) ListAuditEvents(ctx context.Context, req *models.AuditEventsRequest) ([]*entities.AuditEvent, error) {
+	resp, err := g.client.ListAuditEvents(ctx, converter.ConvertGroupsAuditEventsRequestToProto(req))
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.PermissionDenied:
+				return nil, ErrPermissionDenied
+			}
+		}
+
+		return nil, err
+	}
+
+	return converter.ConvertGroupsAuditEventsToModel(resp.Events), nil
+}
@@ -67,18 +67,34 @@ var (
 	ErrNotFound         = errors.New("not found")
 	ErrBadParams        = errors.New("")
 	ErrPermissionDenied = errors.New("permission denied")
+	ErrStatusChanged    = errors.New("task status changed")
 )
 
 type TasksClient interface {
 	CreateTask(context.Context, *models.Task) (int64, error)
 	GetTask(context.Context, int64) (*models.Task, error)
-	GetTaskList(context.Context, int64) ([]*models.Task, error)
+	GetTaskList(context.Context, *models.TaskListRequest) (*entities.TaskList, error)
+	GetGroupTaskList(context.Context, *models.TaskListRequest) (*entities.TaskList, error)
 	UpdateTask(context.Context, *models.Task) error
 	DeleteTask(context.Context, int64) error
+	RestoreTask(ctx context.Context, groupID, taskID int64) error
+	TaskDone(context.Context, int64, float64) error
+	TransitionTask(context.Context, int64, *models.TaskTransition) error
+	GetTaskStatusHistory(ctx context.Context, groupID, taskID int64) ([]*entities.TaskStatusChange, error)
+	StartWork(context.Context, int64) (*entities.WorkSession, error)
+	StopWork(context.Context, int64) (*entities.WorkSession, error)
+	CreateComment(context.Context, int64, *models.Comment) (*entities.Comment, error)
+	UpdateComment(context.Context, int64, int64, *models.Comment) (*entities.Comment, error)
+	DeleteComment(context.Context, int64, int64) error
+	GetTaskActivity(context.Context, int64) ([]*entities.ActivityItem, error)
+	ListAuditEvents(context.Context, *models.AuditEventsRequest) ([]*entities.AuditEvent, error)
 	PredictTask(context.Context, int64) (*entities.PredictedTask, error)
 	TaskExists(context.Context, int64, int64) error
 	GetGroupTasks(context.Context, int64) ([]*models.Task, error)
 	GetPredictedTasks(context.Context, []int64) ([]*tsks_pb.PredictedTask, []int64, error)
+	CreateTasks(context.Context, int64, []*models.Task) (*entities.TasksBatchResult, error)
+	UpdateTasks(context.Context, int64, []*models.Task) (*entities.TasksBatchResult, error)
+	DeleteTasks(context.Context, int64, []int64) (*entities.TasksBatchResult, error)
 }
 
 func (c *GRPCTasksClient) CreateTask(ctx context.Context, task *models.Task) (int64, error) {
@@ -97,6 +113,26 @@ func (c *GRPCTasksClient) CreateTask(ctx context.Context, task *models.Task) (in
 	return resp.ID, nil
 }
 
+// CreateTasks isn't retried, retry can create tasks twice
+func (c *GRPCTasksClient) CreateTasks(ctx context.Context, groupID int64, tasks []*models.Task) (*entities.TasksBatchResult, error) {
+	resp, err := c.client.CreateTasks(ctx, &tsks_pb.TasksBatch{
+		GroupID: groupID,
+		Tasks:   converter.ConvertTasksToProto(tasks),
+	}, grpcretry.Disable())
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			}
+		}
+
+		return nil, err
+	}
+
+	return converter.ConvertTasksBatchResultToModel(resp), nil
+}
+
 func (c *GRPCTasksClient) GetTask(ctx context.Context, taskID int64) (*models.Task, error) {
 	resp, err := c.client.GetTask(ctx, &tsks_pb.TaskID{
 		ID: taskID,
@@ -117,15 +153,36 @@ func (c *GRPCTasksClient) GetTask(ctx context.Context, taskID int64) (*models.Ta
 	return converter.ConvertTaskToModel(resp), nil
 }
 
-func (c *GRPCTasksClient) GetTaskList(ctx context.Context, offset int64) ([]*models.Task, error) {
-	resp, err := c.client.GetTaskList(ctx, &tsks_pb.TaskListRequest{
-		Offset: offset,
-	})
+func (c *GRPCTasksClient) GetTaskList(ctx context.Context, req *models.TaskListRequest) (*entities.TaskList, error) {
+	resp, err := c.client.GetTaskList(ctx, converter.ConvertTaskListRequestToProto(req))
 	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			}
+		}
+
 		return nil, err
 	}
 
-	return converter.ConvertTasksToModel(resp.Tasks), nil
+	return converter.ConvertTaskListToModel(resp), nil
+}
+
+func (c *GRPCTasksClient) GetGroupTaskList(ctx context.Context, req *models.TaskListRequest) (*entities.TaskList, error) {
+	resp, err := c.client.GetGroupTaskList(ctx, converter.ConvertTaskListRequestToProto(req))
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			}
+		}
+
+		return nil, err
+	}
+
+	return converter.ConvertTaskListToModel(resp), nil
 }
 
 func (c *GRPCTasksClient) UpdateTask(ctx context.Context, task *models.Task) error {
@@ -150,6 +207,25 @@ func (c *GRPCTasksClient) UpdateTask(ctx context.Context, task *models.Task) err
 	return nil
 }
 
+func (c *GRPCTasksClient) UpdateTasks(ctx context.Context, groupID int64, tasks []*models.Task) (*entities.TasksBatchResult, error) {
+	resp, err := c.client.UpdateTasks(ctx, &tsks_pb.TasksBatch{
+		GroupID: groupID,
+		Tasks:   converter.ConvertTasksToProto(tasks),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			}
+		}
+
+		return nil, err
+	}
+
+	return converter.ConvertTasksBatchResultToModel(resp), nil
+}
+
 func (c *GRPCTasksClient) DeleteTask(ctx context.Context, taskID int64) error {
 	_, err := c.client.DeleteTask(ctx, &tsks_pb.TaskID{
 		ID: taskID,
@@ -172,6 +248,272 @@ func (c *GRPCTasksClient) DeleteTask(ctx context.Context, taskID int64) error {
 	return nil
 }
 
+func (c *GRPCTasksClient) RestoreTask(ctx context.Context, groupID, taskID int64) error {
+	_, err := c.client.RestoreTask(ctx, &tsks_pb.TaskID{
+		ID:      taskID,
+		GroupID: groupID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return ErrNotFound
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (c *GRPCTasksClient) DeleteTasks(ctx context.Context, groupID int64, taskIDs []int64) (*entities.TasksBatchResult, error) {
+	resp, err := c.client.DeleteTasks(ctx, &tsks_pb.DeleteTasksRequest{
+		GroupID: groupID,
+		IDs:     taskIDs,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			}
+		}
+
+		return nil, err
+	}
+
+	return converter.ConvertTasksBatchResultToModel(resp), nil
+}
+
+func (c *GRPCTasksClient) TaskDone(ctx context.Context, taskID int64, actualTime float64) error {
+	_, err := c.client.TaskDone(ctx, &tsks_pb.TaskDoneRequest{
+		TaskID: taskID,
+		Time:   actualTime,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.PermissionDenied:
+				return ErrPermissionDenied
+			case codes.FailedPrecondition:
+				return fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.InvalidArgument:
+				return fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.NotFound:
+				return ErrNotFound
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (c *GRPCTasksClient) TransitionTask(ctx context.Context, taskID int64, transition *models.TaskTransition) error {
+	taskStatus, ok := converter.ConvertTaskStatusToProto(transition.Status)
+	if !ok {
+		return fmt.Errorf("%wunknown status", ErrBadParams)
+	}
+
+	_, err := c.client.TransitionTask(ctx, &tsks_pb.TransitionTaskRequest{
+		TaskID:     taskID,
+		Status:     taskStatus,
+		ActualTime: transition.ActualTime,
+	}, grpcretry.Disable())
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.PermissionDenied:
+				return ErrPermissionDenied
+			case codes.FailedPrecondition:
+				return fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.InvalidArgument:
+				return fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.NotFound:
+				return ErrNotFound
+			case codes.Aborted:
+				return ErrStatusChanged
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (c *GRPCTasksClient) GetTaskStatusHistory(ctx context.Context, groupID, taskID int64) ([]*entities.TaskStatusChange, error) {
+	resp, err := c.client.GetTaskStatusHistory(ctx, &tsks_pb.TaskID{
+		ID:      taskID,
+		GroupID: groupID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.PermissionDenied:
+				return nil, ErrPermissionDenied
+			case codes.NotFound:
+				return nil, ErrNotFound
+			}
+		}
+
+		return nil, err
+	}
+
+	return converter.ConvertTaskStatusHistoryToModel(resp), nil
+}
+
+// StartWork isn't retried, retry of the started session fails
+func (c *GRPCTasksClient) StartWork(ctx context.Context, taskID int64) (*entities.WorkSession, error) {
+	resp, err := c.client.StartWork(ctx, &tsks_pb.TaskID{
+		ID: taskID,
+	}, grpcretry.Disable())
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.PermissionDenied:
+				return nil, ErrPermissionDenied
+			case codes.FailedPrecondition:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.NotFound:
+				return nil, ErrNotFound
+			case codes.Aborted:
+				return nil, ErrStatusChanged
+			}
+		}
+
+		return nil, err
+	}
+
+	return converter.ConvertWorkSessionToModel(resp), nil
+}
+
+// StopWork isn't retried, retry of the stopped session fails
+func (c *GRPCTasksClient) StopWork(ctx context.Context, taskID int64) (*entities.WorkSession, error) {
+	resp, err := c.client.StopWork(ctx, &tsks_pb.TaskID{
+		ID: taskID,
+	}, grpcretry.Disable())
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.PermissionDenied:
+				return nil, ErrPermissionDenied
+			case codes.NotFound:
+				return nil, ErrNotFound
+			case codes.FailedPrecondition:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			}
+		}
+
+		return nil, err
+	}
+
+	return converter.ConvertWorkSessionToModel(resp), nil
+}
+
+// CreateComment isn't retried, retry can create comment twice
+func (c *GRPCTasksClient) CreateComment(ctx context.Context, taskID int64, comment *models.Comment) (*entities.Comment, error) {
+	resp, err := c.client.CreateComment(ctx, converter.ConvertCommentToProto(taskID, comment), grpcretry.Disable())
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.PermissionDenied:
+				return nil, ErrPermissionDenied
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.NotFound:
+				return nil, ErrNotFound
+			}
+		}
+
+		return nil, err
+	}
+
+	return converter.ConvertCommentToModel(resp), nil
+}
+
+func (c *GRPCTasksClient) UpdateComment(ctx context.Context, taskID, commentID int64, comment *models.Comment) (*entities.Comment, error) {
+	req := converter.ConvertCommentToProto(taskID, comment)
+	req.ID = commentID
+
+	resp, err := c.client.UpdateComment(ctx, req)
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.PermissionDenied:
+				return nil, ErrPermissionDenied
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			case codes.NotFound:
+				return nil, ErrNotFound
+			}
+		}
+
+		return nil, err
+	}
+
+	return converter.ConvertCommentToModel(resp), nil
+}
+
+func (c *GRPCTasksClient) DeleteComment(ctx context.Context, taskID, commentID int64) error {
+	_, err := c.client.DeleteComment(ctx, &tsks_pb.CommentID{
+		TaskID: taskID,
+		ID:     commentID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.PermissionDenied:
+				return ErrPermissionDenied
+			case codes.NotFound:
+				return ErrNotFound
+			}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (c *GRPCTasksClient) GetTaskActivity(ctx context.Context, taskID int64) ([]*entities.ActivityItem, error) {
+	resp, err := c.client.GetTaskActivity(ctx, &tsks_pb.TaskID{
+		ID: taskID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.PermissionDenied:
+				return nil, ErrPermissionDenied
+			case codes.NotFound:
+				return nil, ErrNotFound
+			}
+		}
+
+		return nil, err
+	}
+
+	return converter.ConvertTaskActivityToModel(resp), nil
+}
+
+func (c *GRPCTasksClient) ListAuditEvents(ctx context.Context, req *models.AuditEventsRequest) ([]*entities.AuditEvent, error) {
+	resp, err := c.client.ListAuditEvents(ctx, converter.ConvertTasksAuditEventsRequestToProto(req))
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.InvalidArgument:
+				return nil, fmt.Errorf("%w%s", ErrBadParams, st.Message())
+			}
+		}
+
+		return nil, err
+	}
+
+	return converter.ConvertTasksAuditEventsToModel(resp.Events), nil
+}
+
 func (c *GRPCTasksClient) PredictTask(ctx context.Context, taskID int64) (*entities.PredictedTask, error) {
 	resp, err := c.client.PredictTask(ctx, &tsks_pb.TaskID{
 		ID: taskID,
@@ -1,44 +1,217 @@
 package converter
 
 import (
+	"time"
+
 	"github.com/liriquew/control_system/api/internal/entities"
 	"github.com/liriquew/control_system/api/internal/models"
 	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ConvertGraphToModel(graph *grph_pb.Graph) *models.Graph {
-	return &models.Graph{
-		ID:        graph.ID,
-		CreatedBy: graph.CreatedBy,
-		Name:      graph.Name,
-		GroupID:   graph.GroupID,
+	res := &models.Graph{
+		ID:         graph.ID,
+		CreatedBy:  graph.CreatedBy,
+		Name:       graph.Name,
+		GroupID:    graph.GroupID,
+		Version:    graph.Version,
+		IsTemplate: graph.IsTemplate,
+	}
+	if graph.Deadline != nil {
+		deadline := graph.Deadline.AsTime()
+		res.Deadline = &deadline
 	}
+
+	return res
 }
 
 func ConvertGraphToProto(graph *models.Graph) *grph_pb.Graph {
 	return &grph_pb.Graph{
-		ID:        graph.ID,
-		CreatedBy: graph.CreatedBy,
-		Name:      graph.Name,
-		GroupID:   graph.GroupID,
+		ID:         graph.ID,
+		CreatedBy:  graph.CreatedBy,
+		Name:       graph.Name,
+		GroupID:    graph.GroupID,
+		IsTemplate: graph.IsTemplate,
+		Deadline:   ConvertDeadlineToProto(graph.Deadline),
+	}
+}
+
+// ConvertDeadlineToProto returns nil for graphs without deadline
+func ConvertDeadlineToProto(deadline *time.Time) *timestamppb.Timestamp {
+	if deadline == nil {
+		return nil
+	}
+	return timestamppb.New(*deadline)
+}
+
+func convertDeadlineStatusToModel(deadline *grph_pb.DeadlineStatus) *entities.DeadlineStatus {
+	if deadline == nil {
+		return nil
+	}
+
+	shortenings := make([]*entities.NodeShortening, 0, len(deadline.Shortenings))
+	for _, shortening := range deadline.Shortenings {
+		shortenings = append(shortenings, &entities.NodeShortening{
+			NodeID:     shortening.NodeID,
+			Duration:   shortening.Duration,
+			Shortening: shortening.Shortening,
+		})
+	}
+
+	return &entities.DeadlineStatus{
+		Deadline:        deadline.Deadline.AsTime(),
+		FinishAt:        deadline.FinishAt.AsTime(),
+		Met:             deadline.Met,
+		BufferDays:      deadline.BufferDays,
+		Shortenings:     shortenings,
+		Recoverable:     deadline.Recoverable,
+		MissProbability: deadline.MissProbability,
+	}
+}
+
+var linkTypes = map[string]grph_pb.LinkType{
+	"":                        grph_pb.LinkType_FinishToStart,
+	models.LinkFinishToStart:  grph_pb.LinkType_FinishToStart,
+	models.LinkStartToStart:   grph_pb.LinkType_StartToStart,
+	models.LinkFinishToFinish: grph_pb.LinkType_FinishToFinish,
+	models.LinkStartToFinish:  grph_pb.LinkType_StartToFinish,
+}
+
+var linkTypeNames = map[grph_pb.LinkType]string{
+	grph_pb.LinkType_FinishToStart:  models.LinkFinishToStart,
+	grph_pb.LinkType_StartToStart:   models.LinkStartToStart,
+	grph_pb.LinkType_FinishToFinish: models.LinkFinishToFinish,
+	grph_pb.LinkType_StartToFinish:  models.LinkStartToFinish,
+}
+
+// ConvertLinkTypeToProto returns false for unknown link type
+func ConvertLinkTypeToProto(linkType string) (grph_pb.LinkType, bool) {
+	res, ok := linkTypes[linkType]
+	return res, ok
+}
+
+func ConvertDependencyToModel(dep *grph_pb.Dependency) *models.Dependency {
+	return &models.Dependency{
+		FromNodeID: dep.FromNodeID,
+		ToNodeID:   dep.ToNodeID,
+		LinkType:   linkTypeNames[dep.Type],
+		Lag:        dep.Lag,
+	}
+}
+
+func ConvertDependencyToProto(dep *models.Dependency) *grph_pb.Dependency {
+	linkType, _ := ConvertLinkTypeToProto(dep.LinkType)
+	return &grph_pb.Dependency{
+		FromNodeID: dep.FromNodeID,
+		ToNodeID:   dep.ToNodeID,
+		Type:       linkType,
+		Lag:        dep.Lag,
+	}
+}
+
+var patchOperationTypes = map[string]grph_pb.PatchOperationType{
+	models.PatchAddNode:          grph_pb.PatchOperationType_AddNode,
+	models.PatchRemoveNode:       grph_pb.PatchOperationType_RemoveNode,
+	models.PatchAddDependency:    grph_pb.PatchOperationType_AddDependency,
+	models.PatchRemoveDependency: grph_pb.PatchOperationType_RemoveDependency,
+	models.PatchMoveNode:         grph_pb.PatchOperationType_MoveNode,
+}
+
+// ConvertGraphPatchToProto expects validated patch
+func ConvertGraphPatchToProto(graphID int64, patch *models.GraphPatch) *grph_pb.GraphPatchRequest {
+	ops := make([]*grph_pb.PatchOperation, 0, len(patch.Operations))
+	for _, op := range patch.Operations {
+		pbOp := &grph_pb.PatchOperation{
+			Type:   patchOperationTypes[op.Op],
+			NodeID: op.NodeID,
+		}
+		if op.Node != nil {
+			pbOp.Node = ConvertNodeToProto(op.Node)
+		}
+		if op.Dependency != nil {
+			pbOp.Dependency = ConvertDependencyToProto(op.Dependency)
+		}
+		ops = append(ops, pbOp)
+	}
+
+	return &grph_pb.GraphPatchRequest{
+		GraphID:    graphID,
+		Version:    patch.Version,
+		Operations: ops,
+	}
+}
+
+func ConvertGraphPatchResult(resp *grph_pb.GraphPatchResponse) *entities.GraphPatchResult {
+	createdNodes := make([]*entities.CreatedNode, 0, len(resp.CreatedNodes))
+	for _, node := range resp.CreatedNodes {
+		createdNodes = append(createdNodes, &entities.CreatedNode{
+			TempID: node.TempID,
+			NodeID: node.NodeID,
+		})
+	}
+
+	return &entities.GraphPatchResult{
+		Version:      resp.Version,
+		CreatedNodes: createdNodes,
 	}
 }
 
 func ConvertNodeToModel(node *grph_pb.Node) *models.Node {
+	links := make([]*models.Dependency, 0, len(node.Links))
+	for _, link := range node.Links {
+		links = append(links, ConvertDependencyToModel(link))
+	}
+
 	return &models.Node{
 		ID:                node.ID,
 		GraphID:           node.GraphID,
 		TaskID:            node.TaskID,
 		DependencyNodeIDs: node.DependencyNodeIDs,
+		Links:             links,
+		Kind:              convertNodeKindToModel(node.Kind),
+		SubGraphID:        node.SubGraphID,
+		Name:              node.Name,
+	}
+}
+
+func convertNodeKindToModel(kind grph_pb.NodeKind) string {
+	switch kind {
+	case grph_pb.NodeKind_Milestone:
+		return models.NodeKindMilestone
+	case grph_pb.NodeKind_SubGraph:
+		return models.NodeKindSubGraph
+	default:
+		return models.NodeKindTask
+	}
+}
+
+func convertNodeKindToProto(kind string) grph_pb.NodeKind {
+	switch kind {
+	case models.NodeKindMilestone:
+		return grph_pb.NodeKind_Milestone
+	case models.NodeKindSubGraph:
+		return grph_pb.NodeKind_SubGraph
+	default:
+		return grph_pb.NodeKind_TaskNode
 	}
 }
 
 func ConvertNodeToProto(node *models.Node) *grph_pb.Node {
+	links := make([]*grph_pb.Dependency, 0, len(node.Links))
+	for _, link := range node.Links {
+		links = append(links, ConvertDependencyToProto(link))
+	}
+
 	return &grph_pb.Node{
 		ID:                node.ID,
 		GraphID:           node.GraphID,
 		TaskID:            node.TaskID,
 		DependencyNodeIDs: node.DependencyNodeIDs,
+		Links:             links,
+		Kind:              convertNodeKindToProto(node.Kind),
+		SubGraphID:        node.SubGraphID,
+		Name:              node.Name,
 	}
 }
 
@@ -83,11 +256,19 @@ func ConvertGraphsWithNodesToModel(graphs []*grph_pb.GraphWithNodes) []*entities
 func ConvertPredictedGraph(graph *grph_pb.PredictedGraphResponse) *entities.PredictedGraph {
 	nodes := make([]*entities.NodeWithTask, 0, len(graph.Nodes))
 	for _, node := range graph.Nodes {
+		// milestones and subgraphs have no task
+		var task *models.Task
+		if node.Task != nil {
+			task = ConvertTaskToModel(node.Task)
+		}
+
 		nodes = append(nodes, &entities.NodeWithTask{
 			Node:                   ConvertNodeToModel(node.Node),
-			Task:                   ConvertTaskToModel(node.Task),
+			Task:                   task,
 			PredictedTime:          node.PredictedTime,
+			RemainingTime:          node.RemainingTime,
 			AdditionalDependencies: node.AdditionalDependencies,
+			Schedule:               convertNodeScheduleToModel(node.Schedule),
 		})
 	}
 
@@ -101,5 +282,319 @@ func ConvertPredictedGraph(graph *grph_pb.PredictedGraphResponse) *entities.Pred
 		Nodes:           nodes,
 		Paths:           paths,
 		UnpredictedUIDs: graph.UnpredictedUIDs,
+		Duration:        graph.Duration,
+		StartAt:         graph.StartAt.AsTime(),
+		FinishAt:        graph.FinishAt.AsTime(),
+		Delta:           convertScenarioDeltaToModel(graph.Delta),
+		Deadline:        convertDeadlineStatusToModel(graph.Deadline),
+	}
+}
+
+func convertScenarioDeltaToModel(delta *grph_pb.ScenarioDelta) *entities.ScenarioDelta {
+	if delta == nil {
+		return nil
+	}
+
+	return &entities.ScenarioDelta{
+		BaseDuration:        delta.BaseDuration,
+		DurationDelta:       delta.DurationDelta,
+		BaseFinishAt:        delta.BaseFinishAt.AsTime(),
+		FinishDrift:         delta.FinishDrift,
+		Nodes:               convertNodeDriftsToModel(delta.Nodes),
+		RemovedNodes:        delta.RemovedNodes,
+		AddedDependencies:   convertDependenciesToModel(delta.AddedDependencies),
+		RemovedDependencies: convertDependenciesToModel(delta.RemovedDependencies),
+		BasePaths:           convertPathsToModel(delta.BasePaths),
+	}
+}
+
+func ConvertScenarioToProto(scenario *models.Scenario) *grph_pb.Scenario {
+	res := &grph_pb.Scenario{
+		Reassignments:      make([]*grph_pb.TaskReassignment, 0, len(scenario.Reassignments)),
+		Durations:          make([]*grph_pb.DurationOverride, 0, len(scenario.Durations)),
+		AddDependencies:    make([]*grph_pb.Dependency, 0, len(scenario.AddDependencies)),
+		RemoveDependencies: make([]*grph_pb.Dependency, 0, len(scenario.RemoveDependencies)),
+		DropNodeIDs:        scenario.DropNodeIDs,
+	}
+
+	for _, reassignment := range scenario.Reassignments {
+		res.Reassignments = append(res.Reassignments, &grph_pb.TaskReassignment{
+			NodeID:     reassignment.NodeID,
+			AssignedTo: reassignment.AssignedTo,
+		})
+	}
+	for _, duration := range scenario.Durations {
+		res.Durations = append(res.Durations, &grph_pb.DurationOverride{
+			NodeID:   duration.NodeID,
+			Duration: duration.Duration,
+		})
+	}
+	for _, dep := range scenario.AddDependencies {
+		res.AddDependencies = append(res.AddDependencies, ConvertDependencyToProto(dep))
+	}
+	for _, dep := range scenario.RemoveDependencies {
+		res.RemoveDependencies = append(res.RemoveDependencies, ConvertDependencyToProto(dep))
+	}
+
+	return res
+}
+
+func convertNodeScheduleToModel(schedule *grph_pb.NodeSchedule) *entities.NodeSchedule {
+	if schedule == nil {
+		return nil
+	}
+
+	return &entities.NodeSchedule{
+		EarlyStart:  schedule.EarlyStart,
+		EarlyFinish: schedule.EarlyFinish,
+		LateStart:   schedule.LateStart,
+		LateFinish:  schedule.LateFinish,
+		TotalFloat:  schedule.TotalFloat,
+		FreeFloat:   schedule.FreeFloat,
+		Start:       schedule.Start.AsTime(),
+		Finish:      schedule.Finish.AsTime(),
 	}
 }
+
+func ConvertSimulatedGraph(graph *grph_pb.SimulatedGraphResponse) *entities.SimulatedGraph {
+	nodes := make([]*entities.NodeCriticality, 0, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		nodes = append(nodes, &entities.NodeCriticality{
+			NodeID:           node.NodeID,
+			CriticalityIndex: node.CriticalityIndex,
+			MinTime:          node.MinTime,
+			MostLikelyTime:   node.MostLikelyTime,
+			MaxTime:          node.MaxTime,
+		})
+	}
+
+	return &entities.SimulatedGraph{
+		Graph:           ConvertGraphToModel(graph.Graph),
+		Iterations:      graph.Iterations,
+		P50:             graph.P50,
+		P80:             graph.P80,
+		P95:             graph.P95,
+		Mean:            graph.Mean,
+		Nodes:           nodes,
+		UnpredictedUIDs: graph.UnpredictedUIDs,
+		Deadline:        convertDeadlineStatusToModel(graph.Deadline),
+	}
+}
+
+func ConvertCrashPlan(plan *grph_pb.CrashGraphResponse) *entities.CrashPlan {
+	nodes := make([]*entities.NodeCrash, 0, len(plan.Nodes))
+	for _, node := range plan.Nodes {
+		nodes = append(nodes, &entities.NodeCrash{
+			NodeID:          node.NodeID,
+			Duration:        node.Duration,
+			CrashedDuration: node.CrashedDuration,
+			Cost:            node.Cost,
+		})
+	}
+
+	return &entities.CrashPlan{
+		Graph:           ConvertGraphToModel(plan.Graph),
+		TargetDuration:  plan.TargetDuration,
+		InitialDuration: plan.InitialDuration,
+		Duration:        plan.Duration,
+		FinishAt:        plan.FinishAt.AsTime(),
+		Met:             plan.Met,
+		Cost:            plan.Cost,
+		Nodes:           nodes,
+		UnpredictedUIDs: plan.UnpredictedUIDs,
+	}
+}
+
+func ConvertBaselineToModel(baseline *grph_pb.Baseline) *models.Baseline {
+	return &models.Baseline{
+		ID:        baseline.ID,
+		GraphID:   baseline.GraphID,
+		Name:      baseline.Name,
+		CreatedBy: baseline.CreatedBy,
+		CreatedAt: baseline.CreatedAt.AsTime(),
+		Duration:  baseline.Duration,
+		StartAt:   baseline.StartAt.AsTime(),
+		FinishAt:  baseline.FinishAt.AsTime(),
+	}
+}
+
+func ConvertBaselinesToModel(baselines []*grph_pb.Baseline) []*models.Baseline {
+	res := make([]*models.Baseline, 0, len(baselines))
+	for _, baseline := range baselines {
+		res = append(res, ConvertBaselineToModel(baseline))
+	}
+	return res
+}
+
+func convertPathsToModel(paths []*grph_pb.Path) [][]int64 {
+	res := make([][]int64, 0, len(paths))
+	for _, path := range paths {
+		res = append(res, path.NodeIDs)
+	}
+	return res
+}
+
+func convertDependenciesToModel(deps []*grph_pb.Dependency) []*models.Dependency {
+	res := make([]*models.Dependency, 0, len(deps))
+	for _, dep := range deps {
+		res = append(res, ConvertDependencyToModel(dep))
+	}
+	return res
+}
+
+func convertNodeDriftsToModel(drifts []*grph_pb.NodeDrift) []*entities.NodeDrift {
+	res := make([]*entities.NodeDrift, 0, len(drifts))
+	for _, node := range drifts {
+		res = append(res, &entities.NodeDrift{
+			NodeID:          node.NodeID,
+			BaselineWeight:  node.BaselineWeight,
+			CurrentWeight:   node.CurrentWeight,
+			StartDrift:      node.StartDrift,
+			FinishDrift:     node.FinishDrift,
+			TotalFloatDelta: node.TotalFloatDelta,
+			WasCritical:     node.WasCritical,
+			IsCritical:      node.IsCritical,
+		})
+	}
+	return res
+}
+
+func ConvertBaselineComparison(comparison *grph_pb.BaselineComparisonResponse) *entities.BaselineComparison {
+	return &entities.BaselineComparison{
+		Baseline:            ConvertBaselineToModel(comparison.Baseline),
+		CurrentDuration:     comparison.CurrentDuration,
+		DurationDelta:       comparison.DurationDelta,
+		CurrentFinishAt:     comparison.CurrentFinishAt.AsTime(),
+		FinishDrift:         comparison.FinishDrift,
+		Nodes:               convertNodeDriftsToModel(comparison.Nodes),
+		AddedNodes:          comparison.AddedNodes,
+		RemovedNodes:        comparison.RemovedNodes,
+		AddedDependencies:   convertDependenciesToModel(comparison.AddedDependencies),
+		RemovedDependencies: convertDependenciesToModel(comparison.RemovedDependencies),
+		BaselinePaths:       convertPathsToModel(comparison.BaselinePaths),
+		CurrentPaths:        convertPathsToModel(comparison.CurrentPaths),
+		UnpredictedUIDs:     comparison.UnpredictedUIDs,
+	}
+}
+
+func convertNodeDegreesToModel(degrees []*grph_pb.NodeDegree) []*entities.NodeDegree {
+	res := make([]*entities.NodeDegree, 0, len(degrees))
+	for _, degree := range degrees {
+		res = append(res, &entities.NodeDegree{
+			NodeID: degree.NodeID,
+			FanIn:  degree.FanIn,
+			FanOut: degree.FanOut,
+		})
+	}
+	return res
+}
+
+var graphEventTypeNames = map[grph_pb.GraphEventType]string{
+	grph_pb.GraphEventType_NodeCreated:         "node_created",
+	grph_pb.GraphEventType_NodeUpdated:         "node_updated",
+	grph_pb.GraphEventType_NodeRemoved:         "node_removed",
+	grph_pb.GraphEventType_DependencyAdded:     "dependency_added",
+	grph_pb.GraphEventType_DependencyRemoved:   "dependency_removed",
+	grph_pb.GraphEventType_CriticalPathChanged: "critical_path_changed",
+	grph_pb.GraphEventType_DeadlineAtRisk:      "deadline_at_risk",
+	grph_pb.GraphEventType_DeadlineRecovered:   "deadline_recovered",
+}
+
+func ConvertGraphEvent(event *grph_pb.GraphEvent) *entities.GraphEvent {
+	res := &entities.GraphEvent{
+		Type:     graphEventTypeNames[event.Type],
+		GraphID:  event.GraphID,
+		UserID:   event.UserID,
+		At:       event.At.AsTime(),
+		Paths:    convertPathsToModel(event.Paths),
+		Duration: event.Duration,
+		Deadline: convertDeadlineStatusToModel(event.Deadline),
+	}
+	if event.Node != nil {
+		res.Node = ConvertNodeToModel(event.Node)
+	}
+	if event.Dependency != nil {
+		res.Dependency = ConvertDependencyToModel(event.Dependency)
+	}
+
+	return res
+}
+
+func ConvertEVMReport(report *grph_pb.EVMReportResponse) *entities.EVMReport {
+	nodes := make([]*entities.NodeEarnedValue, 0, len(report.Nodes))
+	for _, node := range report.Nodes {
+		nodes = append(nodes, &entities.NodeEarnedValue{
+			NodeID:       node.NodeID,
+			PlannedValue: node.PlannedValue,
+			EarnedValue:  node.EarnedValue,
+			ActualCost:   node.ActualCost,
+		})
+	}
+
+	return &entities.EVMReport{
+		GraphID:              report.Baseline.GraphID,
+		Baseline:             ConvertBaselineToModel(report.Baseline),
+		AsOf:                 report.AsOf.AsTime(),
+		BudgetAtCompletion:   report.BudgetAtCompletion,
+		PlannedValue:         report.PlannedValue,
+		EarnedValue:          report.EarnedValue,
+		ActualCost:           report.ActualCost,
+		ScheduleVariance:     report.ScheduleVariance,
+		CostVariance:         report.CostVariance,
+		SPI:                  report.SPI,
+		CPI:                  report.CPI,
+		EstimateAtCompletion: report.EstimateAtCompletion,
+		EstimateToCompletion: report.EstimateToCompletion,
+		Nodes:                nodes,
+		UnbaselinedNodes:     report.UnbaselinedNodes,
+	}
+}
+
+func ConvertGraphAnalysis(analysis *grph_pb.GraphAnalysisResponse) *entities.GraphAnalysis {
+	return &entities.GraphAnalysis{
+		GraphID:        analysis.GraphID,
+		Nodes:          analysis.Nodes,
+		Dependencies:   analysis.Dependencies,
+		Components:     analysis.Components,
+		LongestPath:    analysis.LongestPath,
+		LevelWidths:    analysis.LevelWidths,
+		MaxParallelism: analysis.MaxParallelism,
+		FanOutHotSpots: convertNodeDegreesToModel(analysis.FanOutHotSpots),
+		FanInHotSpots:  convertNodeDegreesToModel(analysis.FanInHotSpots),
+		OrphanNodeIDs:  analysis.OrphanNodeIDs,
+	}
+}
+
+func ConvertGraphsAuditEventsRequestToProto(req *models.AuditEventsRequest) *grph_pb.ListAuditEventsRequest {
+	return &grph_pb.ListAuditEventsRequest{
+		GroupID:    req.GroupID,
+		Entity:     req.Entity,
+		EntityID:   req.EntityID,
+		ActorID:    req.ActorID,
+		From:       optionalTimestamp(req.From),
+		To:         optionalTimestamp(req.To),
+		BeforeTime: optionalTimestamp(req.BeforeTime),
+		BeforeID:   req.BeforeID,
+		Limit:      req.Limit,
+	}
+}
+
+func ConvertGraphsAuditEventsToModel(events []*grph_pb.AuditEvent) []*entities.AuditEvent {
+	res := make([]*entities.AuditEvent, 0, len(events))
+	for _, event := range events {
+		res = append(res, &entities.AuditEvent{
+			Source:    "graphs",
+			ID:        event.ID,
+			GroupID:   event.GroupID,
+			ActorID:   event.ActorID,
+			Entity:    event.Entity,
+			EntityID:  event.EntityID,
+			Action:    event.Action,
+			Before:    auditFields(event.Before),
+			After:     auditFields(event.After),
+			CreatedAt: event.CreatedAt.AsTime(),
+		})
+	}
+
+	return res
+}
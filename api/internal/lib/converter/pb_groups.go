@@ -1,6 +1,10 @@
 package converter
 
 import (
+	"encoding/json"
+	"time"
+
+	"github.com/liriquew/control_system/api/internal/entities"
 	"github.com/liriquew/control_system/api/internal/models"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -58,3 +62,93 @@ func ConvertGroupMembersToModel(gms []*grps_pb.GroupMember) []*models.GroupMembe
 	}
 	return res
 }
+
+func ConvertUserCalendarToProto(c *models.UserCalendar) *grps_pb.UserCalendar {
+	daysOff := make([]*timestamppb.Timestamp, 0, len(c.DaysOff))
+	for _, day := range c.DaysOff {
+		daysOff = append(daysOff, timestamppb.New(day))
+	}
+
+	return &grps_pb.UserCalendar{
+		UserID:           c.UserID,
+		WorkStartMinute:  c.WorkStartMinute,
+		WorkEndMinute:    c.WorkEndMinute,
+		WorkDays:         c.WorkDays,
+		UTCOffsetMinutes: c.UTCOffsetMinutes,
+		DaysOff:          daysOff,
+	}
+}
+
+func ConvertUserCalendarToModel(c *grps_pb.UserCalendar) *models.UserCalendar {
+	daysOff := make([]time.Time, 0, len(c.DaysOff))
+	for _, day := range c.DaysOff {
+		daysOff = append(daysOff, day.AsTime())
+	}
+
+	return &models.UserCalendar{
+		UserID:           c.UserID,
+		WorkStartMinute:  c.WorkStartMinute,
+		WorkEndMinute:    c.WorkEndMinute,
+		WorkDays:         c.WorkDays,
+		UTCOffsetMinutes: c.UTCOffsetMinutes,
+		DaysOff:          daysOff,
+	}
+}
+
+func ConvertUserCalendarsToModel(calendars []*grps_pb.UserCalendar) []*models.UserCalendar {
+	res := make([]*models.UserCalendar, 0, len(calendars))
+	for _, c := range calendars {
+		res = append(res, ConvertUserCalendarToModel(c))
+	}
+	return res
+}
+
+// optionalTimestamp returns nil for unset time
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// auditFields returns json fields of audit event, nil if fields are empty
+func auditFields(fields string) json.RawMessage {
+	if fields == "" {
+		return nil
+	}
+	return json.RawMessage(fields)
+}
+
+func ConvertGroupsAuditEventsRequestToProto(req *models.AuditEventsRequest) *grps_pb.ListAuditEventsRequest {
+	return &grps_pb.ListAuditEventsRequest{
+		GroupID:    req.GroupID,
+		Entity:     req.Entity,
+		EntityID:   req.EntityID,
+		ActorID:    req.ActorID,
+		From:       optionalTimestamp(req.From),
+		To:         optionalTimestamp(req.To),
+		BeforeTime: optionalTimestamp(req.BeforeTime),
+		BeforeID:   req.BeforeID,
+		Limit:      req.Limit,
+	}
+}
+
+func ConvertGroupsAuditEventsToModel(events []*grps_pb.AuditEvent) []*entities.AuditEvent {
+	res := make([]*entities.AuditEvent, 0, len(events))
+	for _, event := range events {
+		res = append(res, &entities.AuditEvent{
+			Source:    "groups",
+			ID:        event.ID,
+			GroupID:   event.GroupID,
+			ActorID:   event.ActorID,
+			Entity:    event.Entity,
+			EntityID:  event.EntityID,
+			Action:    event.Action,
+			Before:    auditFields(event.Before),
+			After:     auditFields(event.After),
+			CreatedAt: event.CreatedAt.AsTime(),
+		})
+	}
+
+	return res
+}
@@ -1,10 +1,14 @@
 package converter
 
 import (
+	"net/http"
+	"time"
+
 	"github.com/liriquew/control_system/api/internal/entities"
 	"github.com/liriquew/control_system/api/internal/models"
 	prdt_pb "github.com/liriquew/control_system/services_protos/predictions_service"
 	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -20,10 +24,20 @@ func ConvertTaskToProto(task *models.Task) *tsks_pb.Task {
 		PlannedTime: task.PlannedTime,
 		ActualTime:  task.ActualTime,
 		CreatedAt:   timestamppb.New(task.CreatedAt),
+
+		PercentComplete: task.PercentComplete,
+		CrashCost:       task.CrashCost,
+		CrashMinTime:    task.CrashMinTime,
 	}
 }
 
 func ConvertTaskToModel(task *tsks_pb.Task) *models.Task {
+	var completedAt *time.Time
+	if task.CompletedAt != nil {
+		t := task.CompletedAt.AsTime()
+		completedAt = &t
+	}
+
 	return &models.Task{
 		ID:          task.ID,
 		CreatedBy:   task.CreatedBy,
@@ -35,6 +49,12 @@ func ConvertTaskToModel(task *tsks_pb.Task) *models.Task {
 		PlannedTime: task.PlannedTime,
 		ActualTime:  task.ActualTime,
 		CreatedAt:   task.CreatedAt.AsTime(),
+
+		PercentComplete: task.PercentComplete,
+		CompletedAt:     completedAt,
+		CrashCost:       task.CrashCost,
+		CrashMinTime:    task.CrashMinTime,
+		Status:          taskStatusNames[task.Status],
 	}
 }
 
@@ -46,6 +66,212 @@ func ConvertTasksToModel(tasks []*tsks_pb.Task) []*models.Task {
 	return res
 }
 
+func ConvertTasksToProto(tasks []*models.Task) []*tsks_pb.Task {
+	res := make([]*tsks_pb.Task, 0, len(tasks))
+	for _, task := range tasks {
+		res = append(res, ConvertTaskToProto(task))
+	}
+	return res
+}
+
+var taskStates = map[string]tsks_pb.TaskState{
+	"":          tsks_pb.TaskState_AnyState,
+	"open":      tsks_pb.TaskState_Open,
+	"completed": tsks_pb.TaskState_Completed,
+}
+
+var taskStatusNames = map[tsks_pb.TaskStatus]string{
+	tsks_pb.TaskStatus_Backlog:    "backlog",
+	tsks_pb.TaskStatus_InProgress: "in_progress",
+	tsks_pb.TaskStatus_Blocked:    "blocked",
+	tsks_pb.TaskStatus_Review:     "review",
+	tsks_pb.TaskStatus_Done:       "done",
+	tsks_pb.TaskStatus_Cancelled:  "cancelled",
+}
+
+// ConvertTaskStatusToProto returns false for unknown status
+func ConvertTaskStatusToProto(status string) (tsks_pb.TaskStatus, bool) {
+	for s, name := range taskStatusNames {
+		if name == status {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+func ConvertTaskStatusHistoryToModel(history *tsks_pb.TaskStatusHistory) []*entities.TaskStatusChange {
+	res := make([]*entities.TaskStatusChange, 0, len(history.Changes))
+	for _, change := range history.Changes {
+		res = append(res, &entities.TaskStatusChange{
+			From:      taskStatusNames[change.From],
+			To:        taskStatusNames[change.To],
+			ChangedBy: change.ChangedBy,
+			ChangedAt: change.ChangedAt.AsTime(),
+		})
+	}
+	return res
+}
+
+func ConvertWorkSessionToModel(session *tsks_pb.WorkSession) *entities.WorkSession {
+	res := &entities.WorkSession{
+		ID:        session.ID,
+		TaskID:    session.TaskID,
+		UserID:    session.UserID,
+		StartedAt: session.StartedAt.AsTime(),
+		Time:      session.Time,
+	}
+	if session.StoppedAt != nil {
+		stoppedAt := session.StoppedAt.AsTime()
+		res.StoppedAt = &stoppedAt
+	}
+	return res
+}
+
+func ConvertCommentToProto(taskID int64, comment *models.Comment) *tsks_pb.Comment {
+	mentions := make([]*tsks_pb.Mention, 0, len(comment.Mentions))
+	for _, userID := range comment.Mentions {
+		mentions = append(mentions, &tsks_pb.Mention{UserID: userID})
+	}
+
+	return &tsks_pb.Comment{
+		TaskID:   taskID,
+		Text:     comment.Text,
+		Mentions: mentions,
+	}
+}
+
+func ConvertCommentToModel(comment *tsks_pb.Comment) *entities.Comment {
+	mentions := make([]*entities.Mention, 0, len(comment.Mentions))
+	for _, mention := range comment.Mentions {
+		mentions = append(mentions, &entities.Mention{
+			UserID:   mention.UserID,
+			Username: mention.Username,
+		})
+	}
+
+	res := &entities.Comment{
+		ID:        comment.ID,
+		TaskID:    comment.TaskID,
+		AuthorID:  comment.AuthorID,
+		Text:      comment.Text,
+		Mentions:  mentions,
+		CreatedAt: comment.CreatedAt.AsTime(),
+	}
+	if comment.UpdatedAt != nil {
+		updatedAt := comment.UpdatedAt.AsTime()
+		res.UpdatedAt = &updatedAt
+	}
+	return res
+}
+
+func ConvertTaskActivityToModel(activity *tsks_pb.TaskActivity) []*entities.ActivityItem {
+	res := make([]*entities.ActivityItem, 0, len(activity.Items))
+	for _, item := range activity.Items {
+		if item.Comment != nil {
+			res = append(res, &entities.ActivityItem{
+				Type:    "comment",
+				Comment: ConvertCommentToModel(item.Comment),
+			})
+			continue
+		}
+
+		res = append(res, &entities.ActivityItem{
+			Type: "change",
+			Change: &entities.TaskFieldChange{
+				ChangedBy: item.Change.ChangedBy,
+				ChangedAt: item.Change.ChangedAt.AsTime(),
+				Field:     item.Change.Field,
+				OldValue:  item.Change.OldValue,
+				NewValue:  item.Change.NewValue,
+			},
+		})
+	}
+	return res
+}
+
+var taskSortKeys = map[string]tsks_pb.TaskSortKey{
+	"":             tsks_pb.TaskSortKey_CreatedAt,
+	"created_at":   tsks_pb.TaskSortKey_CreatedAt,
+	"planned_time": tsks_pb.TaskSortKey_PlannedTime,
+	"title":        tsks_pb.TaskSortKey_Title,
+}
+
+// ValidTaskListRequest reports whether state, statuses and sort key of the request are known
+func ValidTaskListRequest(req *models.TaskListRequest) bool {
+	for _, status := range req.Statuses {
+		if _, ok := ConvertTaskStatusToProto(status); !ok {
+			return false
+		}
+	}
+
+	_, stateOk := taskStates[req.State]
+	_, sortOk := taskSortKeys[req.SortBy]
+	return stateOk && sortOk
+}
+
+func ConvertTaskListRequestToProto(req *models.TaskListRequest) *tsks_pb.TaskListRequest {
+	res := &tsks_pb.TaskListRequest{
+		Filter: &tsks_pb.TaskFilter{
+			GroupID:    req.GroupID,
+			AssignedTo: req.AssignedTo,
+			Tags:       req.Tags,
+			AllTags:    req.AllTags,
+			State:      taskStates[req.State],
+			Title:      req.Title,
+		},
+		SortBy: taskSortKeys[req.SortBy],
+		Asc:    req.Asc,
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	}
+	for _, status := range req.Statuses {
+		s, _ := ConvertTaskStatusToProto(status)
+		res.Filter.Statuses = append(res.Filter.Statuses, s)
+	}
+	if req.CreatedFrom != nil {
+		res.Filter.CreatedFrom = timestamppb.New(*req.CreatedFrom)
+	}
+	if req.CreatedTo != nil {
+		res.Filter.CreatedTo = timestamppb.New(*req.CreatedTo)
+	}
+	return res
+}
+
+func ConvertTaskListToModel(taskList *tsks_pb.TaskList) *entities.TaskList {
+	return &entities.TaskList{
+		Tasks:      ConvertTasksToModel(taskList.Tasks),
+		NextCursor: taskList.NextCursor,
+	}
+}
+
+// batch item grpc codes and matching http statuses
+var taskResultStatuses = map[codes.Code]int{
+	codes.OK:                 http.StatusOK,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.NotFound:           http.StatusNotFound,
+	codes.PermissionDenied:   http.StatusForbidden,
+}
+
+func ConvertTasksBatchResultToModel(result *tsks_pb.TasksBatchResult) *entities.TasksBatchResult {
+	res := &entities.TasksBatchResult{
+		Results: make([]*entities.TaskResult, 0, len(result.Results)),
+	}
+	for _, item := range result.Results {
+		httpStatus, ok := taskResultStatuses[codes.Code(item.Code)]
+		if !ok {
+			httpStatus = http.StatusInternalServerError
+		}
+
+		res.Results = append(res.Results, &entities.TaskResult{
+			ID:     item.ID,
+			Status: httpStatus,
+			Error:  item.Error,
+		})
+	}
+	return res
+}
+
 func ConvertPredictedTaskToModel(predictedTask *tsks_pb.PredictedTask) *entities.PredictedTask {
 	return &entities.PredictedTask{
 		Task:          *ConvertTaskToModel(predictedTask.Task),
@@ -69,3 +295,37 @@ func ConvertTagsToModel(tags []*prdt_pb.Tag) []*models.Tag {
 	}
 	return res
 }
+
+func ConvertTasksAuditEventsRequestToProto(req *models.AuditEventsRequest) *tsks_pb.ListAuditEventsRequest {
+	return &tsks_pb.ListAuditEventsRequest{
+		GroupID:    req.GroupID,
+		Entity:     req.Entity,
+		EntityID:   req.EntityID,
+		ActorID:    req.ActorID,
+		From:       optionalTimestamp(req.From),
+		To:         optionalTimestamp(req.To),
+		BeforeTime: optionalTimestamp(req.BeforeTime),
+		BeforeID:   req.BeforeID,
+		Limit:      req.Limit,
+	}
+}
+
+func ConvertTasksAuditEventsToModel(events []*tsks_pb.AuditEvent) []*entities.AuditEvent {
+	res := make([]*entities.AuditEvent, 0, len(events))
+	for _, event := range events {
+		res = append(res, &entities.AuditEvent{
+			Source:    "tasks",
+			ID:        event.ID,
+			GroupID:   event.GroupID,
+			ActorID:   event.ActorID,
+			Entity:    event.Entity,
+			EntityID:  event.EntityID,
+			Action:    event.Action,
+			Before:    auditFields(event.Before),
+			After:     auditFields(event.After),
+			CreatedAt: event.CreatedAt.AsTime(),
+		})
+	}
+
+	return res
+}
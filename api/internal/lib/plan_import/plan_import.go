@@ -0,0 +1,289 @@
+package planimport
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	FormatCSV   = "csv"
+	FormatMSPDI = "mspdi"
+
+	MaxPlanTasks = 1000
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown import format")
+	ErrBadPlan       = errors.New("bad plan: ")
+)
+
+// PlanTask - задача импортируемого плана, Key - идентификатор задачи внутри файла
+type PlanTask struct {
+	Key          int64
+	Title        string
+	Description  string
+	PlannedTime  float64
+	Predecessors []int64
+}
+
+type Plan struct {
+	Name  string
+	Tasks []*PlanTask
+}
+
+func Parse(r io.Reader, format string) (*Plan, error) {
+	var (
+		plan *Plan
+		err  error
+	)
+	switch format {
+	case FormatCSV:
+		plan, err = parseCSV(r)
+	case FormatMSPDI:
+		plan, err = parseMSPDI(r)
+	default:
+		return nil, ErrUnknownFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := plan.validate(); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func badPlan(format string, args ...any) error {
+	return fmt.Errorf("%w%s", ErrBadPlan, fmt.Sprintf(format, args...))
+}
+
+func (p *Plan) validate() error {
+	if len(p.Tasks) == 0 {
+		return badPlan("no tasks")
+	}
+	if len(p.Tasks) > MaxPlanTasks {
+		return badPlan("too many tasks, max %d", MaxPlanTasks)
+	}
+
+	keys := make(map[int64]struct{}, len(p.Tasks))
+	for _, task := range p.Tasks {
+		if _, ok := keys[task.Key]; ok {
+			return badPlan("duplicate task id %d", task.Key)
+		}
+		keys[task.Key] = struct{}{}
+
+		if task.Title == "" {
+			return badPlan("empty title: task %d", task.Key)
+		}
+		if task.PlannedTime <= 0 {
+			return badPlan("planned time must be greater than zero: task %d", task.Key)
+		}
+	}
+
+	for _, task := range p.Tasks {
+		for _, pred := range task.Predecessors {
+			if pred == task.Key {
+				return badPlan("task %d depends on itself", task.Key)
+			}
+			if _, ok := keys[pred]; !ok {
+				return badPlan("unknown predecessor %d: task %d", pred, task.Key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Successors - зависимости в том виде, в котором их хранит граф:
+// для каждой задачи список задач, которые от нее зависят
+func (p *Plan) Successors() map[int64][]int64 {
+	res := make(map[int64][]int64, len(p.Tasks))
+	for _, task := range p.Tasks {
+		for _, pred := range task.Predecessors {
+			res[pred] = append(res[pred], task.Key)
+		}
+	}
+
+	return res
+}
+
+// колонки CSV файла, порядок колонок задается заголовком
+const (
+	csvColumnID           = "id"
+	csvColumnTitle        = "title"
+	csvColumnDescription  = "description"
+	csvColumnPlannedTime  = "planned_time"
+	csvColumnPredecessors = "predecessors"
+)
+
+func parseCSV(r io.Reader) (*Plan, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, badPlan("can't read csv header: %v", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range []string{csvColumnID, csvColumnTitle, csvColumnPlannedTime} {
+		if _, ok := columns[column]; !ok {
+			return nil, badPlan("missing csv column %q", column)
+		}
+	}
+
+	get := func(record []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	plan := &Plan{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, badPlan("can't read csv: %v", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		key, err := strconv.ParseInt(get(record, csvColumnID), 10, 64)
+		if err != nil {
+			return nil, badPlan("bad id: line %d", line)
+		}
+		plannedTime, err := strconv.ParseFloat(get(record, csvColumnPlannedTime), 64)
+		if err != nil {
+			return nil, badPlan("bad planned_time: line %d", line)
+		}
+
+		// предшественники перечисляются через ";" или пробел
+		var predecessors []int64
+		for _, pred := range strings.FieldsFunc(get(record, csvColumnPredecessors), func(r rune) bool {
+			return r == ';' || r == ' '
+		}) {
+			predID, err := strconv.ParseInt(pred, 10, 64)
+			if err != nil {
+				return nil, badPlan("bad predecessors: line %d", line)
+			}
+			predecessors = append(predecessors, predID)
+		}
+
+		plan.Tasks = append(plan.Tasks, &PlanTask{
+			Key:          key,
+			Title:        get(record, csvColumnTitle),
+			Description:  get(record, csvColumnDescription),
+			PlannedTime:  plannedTime,
+			Predecessors: predecessors,
+		})
+	}
+
+	return plan, nil
+}
+
+type mspdiProject struct {
+	Name  string      `xml:"Name"`
+	Title string      `xml:"Title"`
+	Tasks []mspdiTask `xml:"Tasks>Task"`
+}
+
+type mspdiTask struct {
+	UID             int64  `xml:"UID"`
+	Name            string `xml:"Name"`
+	Notes           string `xml:"Notes"`
+	Duration        string `xml:"Duration"`
+	Summary         int    `xml:"Summary"`
+	PredecessorLink []struct {
+		PredecessorUID int64 `xml:"PredecessorUID"`
+	} `xml:"PredecessorLink"`
+}
+
+var mspdiDurationRe = regexp.MustCompile(`^PT(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?$`)
+
+// parseMSPDIDuration - переводит длительность вида PT8H30M0S в часы
+func parseMSPDIDuration(duration string) (float64, error) {
+	match := mspdiDurationRe.FindStringSubmatch(duration)
+	if match == nil {
+		return 0, fmt.Errorf("bad duration %q", duration)
+	}
+
+	var hours float64
+	for i, divider := range []float64{1, 60, 3600} {
+		if match[i+1] == "" {
+			continue
+		}
+		value, err := strconv.ParseFloat(match[i+1], 64)
+		if err != nil {
+			return 0, err
+		}
+		hours += value / divider
+	}
+
+	return hours, nil
+}
+
+func parseMSPDI(r io.Reader) (*Plan, error) {
+	var project mspdiProject
+	if err := xml.NewDecoder(r).Decode(&project); err != nil {
+		return nil, badPlan("can't parse xml: %v", err)
+	}
+
+	plan := &Plan{
+		Name: project.Title,
+	}
+	if plan.Name == "" {
+		plan.Name = project.Name
+	}
+
+	// суммарные задачи (в том числе задача проекта с UID 0) только группируют другие задачи,
+	// связи с ними не переносятся
+	summary := make(map[int64]struct{})
+	for _, task := range project.Tasks {
+		if task.Summary == 1 || task.UID == 0 {
+			summary[task.UID] = struct{}{}
+		}
+	}
+
+	for _, task := range project.Tasks {
+		if _, ok := summary[task.UID]; ok {
+			continue
+		}
+
+		plannedTime, err := parseMSPDIDuration(task.Duration)
+		if err != nil {
+			return nil, badPlan("%v: task %d", err, task.UID)
+		}
+
+		var predecessors []int64
+		for _, link := range task.PredecessorLink {
+			if _, ok := summary[link.PredecessorUID]; ok {
+				continue
+			}
+			predecessors = append(predecessors, link.PredecessorUID)
+		}
+
+		plan.Tasks = append(plan.Tasks, &PlanTask{
+			Key:          task.UID,
+			Title:        strings.TrimSpace(task.Name),
+			Description:  strings.TrimSpace(task.Notes),
+			PlannedTime:  plannedTime,
+			Predecessors: predecessors,
+		})
+	}
+
+	return plan, nil
+}
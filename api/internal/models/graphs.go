@@ -2,7 +2,10 @@ package models
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"time"
 )
 
 type Graph struct {
@@ -10,19 +13,174 @@ type Graph struct {
 	Name      string `json:"name" db:"name"`
 	GroupID   int64  `json:"group_id" db:"group_id"`
 	CreatedBy int64  `json:"created_by" db:"created_by"`
+	// incremented by every change of nodes or dependencies
+	Version int64 `json:"version" db:"version"`
+	// templates are copied into new graphs by instantiate
+	IsTemplate bool `json:"is_template" db:"is_template"`
+	// target finish date, nil if the graph has no deadline
+	Deadline *time.Time `json:"deadline,omitempty" db:"deadline"`
 }
 
+// GraphDeadline - new graph deadline, null removes the deadline
+type GraphDeadline struct {
+	Deadline *time.Time `json:"deadline"`
+}
+
+// CloneGraph - parameters of graph copy, empty group and name are taken from the source graph
+type CloneGraph struct {
+	GroupID    int64  `json:"group_id"`
+	Name       string `json:"name"`
+	AsTemplate bool   `json:"as_template"`
+}
+
+// link types, empty value means finish-to-start
+const (
+	LinkFinishToStart  = "FS"
+	LinkStartToStart   = "SS"
+	LinkFinishToFinish = "FF"
+	LinkStartToFinish  = "SF"
+)
+
 type Dependency struct {
 	GraphID    int64
-	FromNodeID int64 `json:"from_node_id" db:"from_node_id"`
-	ToNodeID   int64 `json:"to_node_id" db:"to_node_id"`
+	FromNodeID int64  `json:"from_node_id" db:"from_node_id"`
+	ToNodeID   int64  `json:"to_node_id" db:"to_node_id"`
+	LinkType   string `json:"link_type,omitempty"`
+	// lag in hours, negative lag is a lead
+	Lag float64 `json:"lag,omitempty"`
 }
 
+func (d *Dependency) ValidLinkType() bool {
+	switch d.LinkType {
+	case "", LinkFinishToStart, LinkStartToStart, LinkFinishToFinish, LinkStartToFinish:
+		return true
+	}
+	return false
+}
+
+// node kinds, empty value means task node
+const (
+	NodeKindTask      = "task"
+	NodeKindMilestone = "milestone"
+	NodeKindSubGraph  = "subgraph"
+)
+
 type Node struct {
-	ID                int64   `json:"id" db:"id"`
-	GraphID           int64   `json:"graph_id" db:"graph_id"`
-	TaskID            int64   `json:"task_id" db:"task_id"`
-	DependencyNodeIDs []int64 `json:"dependencies"`
+	ID                int64         `json:"id" db:"id"`
+	GraphID           int64         `json:"graph_id" db:"graph_id"`
+	TaskID            int64         `json:"task_id" db:"task_id"`
+	DependencyNodeIDs []int64       `json:"dependencies"`
+	Links             []*Dependency `json:"links,omitempty"`
+	Kind              string        `json:"kind,omitempty"`
+	// graph referenced by subgraph node
+	SubGraphID int64  `json:"subgraph_id,omitempty"`
+	Name       string `json:"name,omitempty"`
+}
+
+func (n *Node) ValidKind() bool {
+	switch n.Kind {
+	case "", NodeKindTask, NodeKindMilestone, NodeKindSubGraph:
+		return true
+	}
+	return false
+}
+
+func (n *Node) IsTask() bool {
+	return n.Kind == "" || n.Kind == NodeKindTask
+}
+
+type Baseline struct {
+	ID        int64     `json:"id"`
+	GraphID   int64     `json:"graph_id"`
+	Name      string    `json:"name"`
+	CreatedBy int64     `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	Duration  float64   `json:"duration"`
+	StartAt   time.Time `json:"start_at"`
+	FinishAt  time.Time `json:"finish_at"`
+}
+
+type TaskReassignment struct {
+	NodeID     int64 `json:"node_id"`
+	AssignedTo int64 `json:"assigned_to"`
+}
+
+type DurationOverride struct {
+	NodeID int64 `json:"node_id"`
+	// hours
+	Duration float64 `json:"duration"`
+}
+
+// Scenario - ephemeral overrides for graph prediction, nothing is stored
+type Scenario struct {
+	Reassignments      []*TaskReassignment `json:"reassignments"`
+	Durations          []*DurationOverride `json:"durations"`
+	AddDependencies    []*Dependency       `json:"add_dependencies"`
+	RemoveDependencies []*Dependency       `json:"remove_dependencies"`
+	DropNodeIDs        []int64             `json:"drop_node_ids"`
+}
+
+// patch operation types
+const (
+	PatchAddNode          = "add_node"
+	PatchRemoveNode       = "remove_node"
+	PatchAddDependency    = "add_dependency"
+	PatchRemoveDependency = "remove_dependency"
+	PatchMoveNode         = "move_node"
+)
+
+// PatchOperation - Node is set for add_node (with negative temporary id) and move_node
+// (with new dependencies), NodeID for remove_node, Dependency for dependency operations
+type PatchOperation struct {
+	Op         string      `json:"op"`
+	Node       *Node       `json:"node,omitempty"`
+	NodeID     int64       `json:"node_id,omitempty"`
+	Dependency *Dependency `json:"dependency,omitempty"`
+}
+
+// GraphPatch - operations applied atomically to the graph of the given version
+type GraphPatch struct {
+	Version    int64             `json:"version"`
+	Operations []*PatchOperation `json:"operations"`
+}
+
+// Validate checks operation types and fields required by them
+func (p *GraphPatch) Validate() error {
+	if len(p.Operations) == 0 {
+		return errors.New("empty operations")
+	}
+
+	for i, op := range p.Operations {
+		switch op.Op {
+		case PatchAddNode, PatchMoveNode:
+			if op.Node == nil {
+				return fmt.Errorf("operation %d: missing node", i)
+			}
+			if !op.Node.ValidKind() {
+				return fmt.Errorf("operation %d: unknown node kind, expected one of task, milestone, subgraph", i)
+			}
+			for _, link := range op.Node.Links {
+				if !link.ValidLinkType() {
+					return fmt.Errorf("operation %d: unknown link type, expected one of FS, SS, FF, SF", i)
+				}
+			}
+		case PatchRemoveNode:
+			if op.NodeID == 0 {
+				return fmt.Errorf("operation %d: missing node_id", i)
+			}
+		case PatchAddDependency, PatchRemoveDependency:
+			if op.Dependency == nil {
+				return fmt.Errorf("operation %d: missing dependency", i)
+			}
+			if !op.Dependency.ValidLinkType() {
+				return fmt.Errorf("operation %d: unknown link type, expected one of FS, SS, FF, SF", i)
+			}
+		default:
+			return fmt.Errorf("operation %d: unknown op %q", i, op.Op)
+		}
+	}
+
+	return nil
 }
 
 func GraphModelFromJson(jsonBody io.ReadCloser) (*Graph, error) {
@@ -42,6 +200,49 @@ func NodeModelFromJson(jsonBody io.ReadCloser) (*Node, error) {
 func DependencyModelFromJson(jsonBody io.ReadCloser) (*Dependency, error) {
 	var dependency Dependency
 	err := json.NewDecoder(jsonBody).Decode(&dependency)
+	if errors.Is(err, io.EOF) {
+		// body is optional, finish-to-start without lag by default
+		err = nil
+	}
 
 	return &dependency, err
 }
+
+func BaselineModelFromJson(jsonBody io.ReadCloser) (*Baseline, error) {
+	var baseline Baseline
+	err := json.NewDecoder(jsonBody).Decode(&baseline)
+
+	return &baseline, err
+}
+
+func CloneGraphModelFromJson(jsonBody io.ReadCloser) (*CloneGraph, error) {
+	var clone CloneGraph
+	err := json.NewDecoder(jsonBody).Decode(&clone)
+	if errors.Is(err, io.EOF) {
+		// body is optional, copy goes to the same group by default
+		err = nil
+	}
+
+	return &clone, err
+}
+
+func GraphDeadlineModelFromJson(jsonBody io.ReadCloser) (*GraphDeadline, error) {
+	var deadline GraphDeadline
+	err := json.NewDecoder(jsonBody).Decode(&deadline)
+
+	return &deadline, err
+}
+
+func GraphPatchModelFromJson(jsonBody io.ReadCloser) (*GraphPatch, error) {
+	var patch GraphPatch
+	err := json.NewDecoder(jsonBody).Decode(&patch)
+
+	return &patch, err
+}
+
+func ScenarioModelFromJson(jsonBody io.ReadCloser) (*Scenario, error) {
+	var scenario Scenario
+	err := json.NewDecoder(jsonBody).Decode(&scenario)
+
+	return &scenario, err
+}
@@ -20,6 +20,15 @@ type GroupMember struct {
 	Role    string `json:"role" db:"role"`
 }
 
+type UserCalendar struct {
+	UserID           int64       `json:"user_id"`
+	WorkStartMinute  int32       `json:"work_start_minute"`
+	WorkEndMinute    int32       `json:"work_end_minute"`
+	WorkDays         []int32     `json:"work_days"`
+	UTCOffsetMinutes int32       `json:"utc_offset_minutes"`
+	DaysOff          []time.Time `json:"days_off"`
+}
+
 func GroupModelFromJson(jsonBody io.ReadCloser) (*Group, error) {
 	var group Group
 	err := json.NewDecoder(jsonBody).Decode(&group)
@@ -36,6 +45,27 @@ func GroupMemberModelFromJson(jsonBody io.ReadCloser) (*GroupMember, error) {
 
 }
 
+func UserCalendarModelFromJson(jsonBody io.ReadCloser) (*UserCalendar, error) {
+	var calendar UserCalendar
+	err := json.NewDecoder(jsonBody).Decode(&calendar)
+
+	return &calendar, err
+}
+
 func (g *Group) Validate() bool {
 	return g.Name != "" || g.Description != ""
 }
+
+// AuditEventsRequest is a filter and a page of group audit events,
+// only events older than (BeforeTime, BeforeID) are returned if BeforeTime is set
+type AuditEventsRequest struct {
+	GroupID    int64
+	Entity     string
+	EntityID   int64
+	ActorID    int64
+	From       *time.Time
+	To         *time.Time
+	BeforeTime *time.Time
+	BeforeID   int64
+	Limit      int32
+}
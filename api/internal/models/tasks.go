@@ -24,6 +24,85 @@ type Task struct {
 	Tags        []int32   `json:"tags,omitempty" db:"tags"`
 	ActualTime  float64   `json:"actual_time,omitempty" db:"actual_time"`
 	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	// 0..100, completed task always has 100
+	PercentComplete float64    `json:"percent_complete,omitempty"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+	// cost of shortening the task by one hour and minimal time of the shortened task
+	CrashCost    float64 `json:"crash_cost,omitempty"`
+	CrashMinTime float64 `json:"crash_min_time,omitempty"`
+	// backlog, in_progress, blocked, review, done or cancelled, changed only by transition
+	Status string `json:"status,omitempty"`
+}
+
+func TasksBatchModelFromJson(jsonBody io.ReadCloser) (*TasksBatch, error) {
+	var batch TasksBatch
+	err := json.NewDecoder(jsonBody).Decode(&batch)
+
+	return &batch, err
+}
+
+// TasksBatch is a body of batch create and update requests
+type TasksBatch struct {
+	Tasks []*Task `json:"tasks"`
+}
+
+func TasksIDsModelFromJson(jsonBody io.ReadCloser) (*TasksIDs, error) {
+	var ids TasksIDs
+	err := json.NewDecoder(jsonBody).Decode(&ids)
+
+	return &ids, err
+}
+
+// TasksIDs is a body of batch delete request
+type TasksIDs struct {
+	IDs []int64 `json:"ids"`
+}
+
+// TaskListRequest is a filter, sort and page of task lists from query parameters
+type TaskListRequest struct {
+	GroupID    int64
+	AssignedTo int64
+	Tags       []int32
+	// task must have all tags, otherwise any of them
+	AllTags bool
+	// "open", "completed" or empty for any state
+	State       string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Title       string
+	// any of the statuses, empty for any status
+	Statuses []string
+	// "created_at", "planned_time" or "title"
+	SortBy string
+	Asc    bool
+	Cursor string
+	Limit  int32
+}
+
+func TaskTransitionModelFromJson(jsonBody io.ReadCloser) (*TaskTransition, error) {
+	var transition TaskTransition
+	err := json.NewDecoder(jsonBody).Decode(&transition)
+
+	return &transition, err
+}
+
+type TaskTransition struct {
+	Status string `json:"status"`
+	// required for done status
+	ActualTime float64 `json:"actual_time,omitempty"`
+}
+
+func CommentModelFromJson(jsonBody io.ReadCloser) (*Comment, error) {
+	var comment Comment
+	err := json.NewDecoder(jsonBody).Decode(&comment)
+
+	return &comment, err
+}
+
+type Comment struct {
+	Text string `json:"text"`
+	// ids of mentioned group members
+	Mentions []int64 `json:"mentions,omitempty"`
 }
 
 type Tag struct {
@@ -1,12 +1,18 @@
 package tests
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/liriquew/control_system/api/internal/entities"
 	"github.com/liriquew/control_system/api/internal/models"
@@ -70,6 +76,22 @@ func createDependency(t *testing.T, ts *suite.Suite, token string, graphID, node
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func getGraph(t *testing.T, ts *suite.Suite, token string, groupID, graphID int64) *entities.GraphWithNodes {
+	req, err := http.NewRequest("GET", ts.GetURL()+"/api/groups/"+strconv.FormatInt(groupID, 10)+"/graphs/"+strconv.FormatInt(graphID, 10), nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var graph entities.GraphWithNodes
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&graph))
+	return &graph
+}
+
 func TestCreateAndListGroupGraphs(t *testing.T) {
 	ts := suite.New(t)
 
@@ -447,6 +469,43 @@ func TestRemoveNode(t *testing.T) {
 
 		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 	})
+
+	t.Run("Restore", func(t *testing.T) {
+		// Восстанавливаем узел
+		req, _ := http.NewRequest("POST", ts.GetURL()+"/api/groups/"+strconv.FormatInt(group.ID, 10)+"/graphs/"+strconv.FormatInt(graphID, 10)+"/nodes/"+strconv.FormatInt(nodeID, 10)+"/restore", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var restored models.Node
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&restored))
+		assert.Equal(t, nodeID, restored.ID)
+		assert.Equal(t, task3.ID, restored.TaskID)
+
+		// Проверяем, что узел снова в графе
+		graph := getGraph(t, ts, token, group.ID, graphID)
+		found := false
+		for _, node := range graph.Nodes {
+			if node.ID == nodeID {
+				found = true
+			}
+		}
+		assert.True(t, found)
+
+		// Неудаленный узел восстановить нельзя
+		req, _ = http.NewRequest("POST", ts.GetURL()+"/api/groups/"+strconv.FormatInt(group.ID, 10)+"/graphs/"+strconv.FormatInt(graphID, 10)+"/nodes/"+strconv.FormatInt(nodeID, 10)+"/restore", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err = http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
 }
 
 func TestUpdateNode(t *testing.T) {
@@ -973,7 +1032,7 @@ func TestRemoveDependency(t *testing.T) {
 	assert.Len(t, dependencies.DependencyNodeIDs, 0)
 }
 
-func TestPredictGraph(t *testing.T) {
+func TestAddTypedDependency(t *testing.T) {
 	ts := suite.New(t)
 
 	user := models.User{
@@ -982,17 +1041,218 @@ func TestPredictGraph(t *testing.T) {
 	}
 	_, token := doSignUpFakeUser(t, ts, user)
 
-	user1 := models.User{
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	var taskIDs []int64
+	for range 3 {
+		task := models.Task{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.JobDescriptor(),
+			PlannedTime: 4,
+		}
+		taskIDs = append(taskIDs, createGroupTask(t, ts, token, group.ID, task))
+	}
+
+	// Разработка -> тестирование: тестирование начинается через 2 часа после начала разработки
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{
+				ID:                1,
+				TaskID:            taskIDs[0],
+				DependencyNodeIDs: []int64{2},
+				Links:             []*models.Dependency{{ToNodeID: 2, LinkType: models.LinkStartToStart, Lag: 2}},
+			},
+			{ID: 2, TaskID: taskIDs[1]},
+		},
+	}
+	graphID := createGraph(t, ts, token, group.ID, graph)
+
+	getGraph := func() *entities.GraphWithNodes {
+		req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d", group.ID, graphID), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var res entities.GraphWithNodes
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+		return &res
+	}
+
+	nodeIDs := make(map[int64]int64)
+	for _, node := range getGraph().Nodes {
+		nodeIDs[node.TaskID] = node.ID
+		if node.TaskID == taskIDs[0] {
+			require.Len(t, node.Links, 1)
+			assert.Equal(t, models.LinkStartToStart, node.Links[0].LinkType)
+			assert.Equal(t, 2.0, node.Links[0].Lag)
+		}
+	}
+
+	node3ID := createNode(t, ts, token, group.ID, graphID, models.Node{
+		GraphID: graphID,
+		TaskID:  taskIDs[2],
+	})
+
+	addDependency := func(fromNodeID, toNodeID int64, body string) int {
+		req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/nodes/%d/dependencies/%d", group.ID, graphID, fromNodeID, toNodeID), bytes.NewBufferString(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusBadRequest, addDependency(nodeIDs[taskIDs[1]], node3ID, `{"link_type": "XX"}`))
+	// Опережение: документация начинается за час до окончания тестирования
+	assert.Equal(t, http.StatusOK, addDependency(nodeIDs[taskIDs[1]], node3ID, `{"link_type": "FS", "lag": -1}`))
+
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/predict?startAt=%s", group.ID, graphID, startAt.Format(time.RFC3339)), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var predictedGraph entities.PredictedGraph
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&predictedGraph))
+
+	schedules := make(map[int64]*entities.NodeSchedule)
+	for _, node := range predictedGraph.Nodes {
+		schedules[node.Node.TaskID] = node.Schedule
+	}
+	require.Len(t, schedules, 3)
+
+	// длительности задач зависят от предсказаний, поэтому проверяем только связи
+	assert.InDelta(t, schedules[taskIDs[0]].EarlyStart+2, schedules[taskIDs[1]].EarlyStart, 1e-9)
+	assert.InDelta(t, schedules[taskIDs[1]].EarlyFinish-1, schedules[taskIDs[2]].EarlyStart, 1e-9)
+}
+
+func TestGraphCycles(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
 		Username: gofakeit.Username(),
 		Password: getSomePassword(),
 	}
-	user1, _ = doSignUpFakeUser(t, ts, user1)
+	_, token := doSignUpFakeUser(t, ts, user)
 
-	user2 := models.User{
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	var taskIDs []int64
+	for range 3 {
+		task := models.Task{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.JobDescriptor(),
+			PlannedTime: gofakeit.Float64Range(1, 10),
+		}
+		taskIDs = append(taskIDs, createGroupTask(t, ts, token, group.ID, task))
+	}
+
+	type cycleResponse struct {
+		Error string  `json:"error"`
+		Cycle []int64 `json:"cycle"`
+	}
+
+	// Граф с циклом 1 -> 2 -> 3 -> 1 не создается
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{ID: 1, TaskID: taskIDs[0], DependencyNodeIDs: []int64{2}},
+			{ID: 2, TaskID: taskIDs[1], DependencyNodeIDs: []int64{3}},
+			{ID: 3, TaskID: taskIDs[2], DependencyNodeIDs: []int64{1}},
+		},
+	}
+	body, err := json.Marshal(graph)
+	require.NoError(t, err)
+
+	req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs", group.ID), bytes.NewBuffer(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+
+	var cycle cycleResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&cycle))
+	assert.Equal(t, []int64{1, 2, 3}, cycle.Cycle)
+	assert.NotEmpty(t, cycle.Error)
+
+	// Без последнего ребра граф создается, но добавить его нельзя
+	graph.Nodes[2].DependencyNodeIDs = []int64{}
+	graphID := createGraph(t, ts, token, group.ID, graph)
+
+	req, _ = http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d", group.ID, graphID), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var created entities.GraphWithNodes
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+
+	nodeIDs := make(map[int64]int64)
+	for _, node := range created.Nodes {
+		nodeIDs[node.TaskID] = node.ID
+	}
+
+	req, _ = http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/nodes/%d/dependencies/%d", group.ID, graphID, nodeIDs[taskIDs[2]], nodeIDs[taskIDs[0]]), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+
+	cycle = cycleResponse{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&cycle))
+	require.Len(t, cycle.Cycle, 3)
+	assert.ElementsMatch(t, []int64{nodeIDs[taskIDs[0]], nodeIDs[taskIDs[1]], nodeIDs[taskIDs[2]]}, cycle.Cycle)
+
+	// Зависимость не сохранена, граф по-прежнему можно спланировать
+	req, _ = http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/predict", group.ID, graphID), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestConcurrentOppositeDependencies(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
 		Username: gofakeit.Username(),
 		Password: getSomePassword(),
 	}
-	user2, _ = doSignUpFakeUser(t, ts, user2)
+	_, token := doSignUpFakeUser(t, ts, user)
 
 	group := models.Group{
 		Name:        gofakeit.Company(),
@@ -1000,68 +1260,160 @@ func TestPredictGraph(t *testing.T) {
 	}
 	group.ID = createGroup(t, ts, token, group)
 
-	addGroupMember(t, ts, token, group.ID, models.GroupMember{
-		UserID: user1.ID,
-		Role:   "member",
-	})
-	addGroupMember(t, ts, token, group.ID, models.GroupMember{
-		UserID: user2.ID,
-		Role:   "member",
-	})
+	addDependency := func(graphID, fromNodeID, toNodeID int64) int {
+		req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/nodes/%d/dependencies/%d", group.ID, graphID, fromNodeID, toNodeID), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
 
-	task1 := models.Task{
-		Title:       gofakeit.JobTitle(),
-		Description: gofakeit.JobDescriptor(),
-		PlannedTime: 1.123,
-		AssignedTo:  user1.ID,
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return 0
+		}
+		defer resp.Body.Close()
+		return resp.StatusCode
 	}
-	task1.ID = createGroupTask(t, ts, token, group.ID, task1)
 
-	task2 := models.Task{
-		Title:       gofakeit.JobTitle(),
-		Description: gofakeit.JobDescriptor(),
-		PlannedTime: 1.123,
-		AssignedTo:  user2.ID,
+	// Зависимости A -> B и B -> A добавляются одновременно, сохраниться может только одна
+	for range 5 {
+		var taskIDs []int64
+		for range 2 {
+			task := models.Task{
+				Title:       gofakeit.JobTitle(),
+				Description: gofakeit.JobDescriptor(),
+				PlannedTime: 1,
+			}
+			taskIDs = append(taskIDs, createGroupTask(t, ts, token, group.ID, task))
+		}
+
+		graphID := createGraph(t, ts, token, group.ID, entities.GraphWithNodes{
+			GraphInfo: &models.Graph{
+				Name: gofakeit.BeerName(),
+			},
+			Nodes: []*models.Node{
+				{ID: 1, TaskID: taskIDs[0]},
+				{ID: 2, TaskID: taskIDs[1]},
+			},
+		})
+
+		req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d", group.ID, graphID), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var created entities.GraphWithNodes
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+		require.Len(t, created.Nodes, 2)
+		a, b := created.Nodes[0].ID, created.Nodes[1].ID
+
+		var wg sync.WaitGroup
+		codes := make([]int, 2)
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			codes[0] = addDependency(graphID, a, b)
+		}()
+		go func() {
+			defer wg.Done()
+			codes[1] = addDependency(graphID, b, a)
+		}()
+		wg.Wait()
+
+		// вторая зависимость отклоняется как замыкающая цикл или как конфликт версий
+		require.Contains(t, codes, http.StatusOK, "codes %v", codes)
+		rejected := codes[0]
+		if rejected == http.StatusOK {
+			rejected = codes[1]
+		}
+		assert.Contains(t, []int{http.StatusPreconditionFailed, http.StatusConflict}, rejected, "codes %v", codes)
+
+		req, _ = http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/predict", group.ID, graphID), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err = http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestAnalyzeGraph(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	_, token := doSignUpFakeUser(t, ts, user)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	var taskIDs []int64
+	for range 5 {
+		task := models.Task{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.JobDescriptor(),
+			PlannedTime: gofakeit.Float64Range(1, 10),
+		}
+		taskIDs = append(taskIDs, createGroupTask(t, ts, token, group.ID, task))
 	}
-	task2.ID = createGroupTask(t, ts, token, group.ID, task2)
 
+	// 1 -> 2, 1 -> 3, 2 -> 4, 3 -> 4, 5 не связана с остальными
 	graph := entities.GraphWithNodes{
 		GraphInfo: &models.Graph{
 			Name: gofakeit.BeerName(),
 		},
 		Nodes: []*models.Node{
-			{
-				ID:                1,
-				TaskID:            task1.ID,
-				DependencyNodeIDs: []int64{},
-			},
-			{
-				ID:                2,
-				TaskID:            task2.ID,
-				DependencyNodeIDs: []int64{1},
-			},
+			{ID: 1, TaskID: taskIDs[0], DependencyNodeIDs: []int64{2, 3}},
+			{ID: 2, TaskID: taskIDs[1], DependencyNodeIDs: []int64{4}},
+			{ID: 3, TaskID: taskIDs[2], DependencyNodeIDs: []int64{4}},
+			{ID: 4, TaskID: taskIDs[3]},
+			{ID: 5, TaskID: taskIDs[4]},
 		},
 	}
-	graph.GraphInfo.ID = createGraph(t, ts, token, group.ID, graph)
+	graphID := createGraph(t, ts, token, group.ID, graph)
 
-	req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/predict", group.ID, graph.GraphInfo.ID), nil)
+	req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/analyze", group.ID, graphID), nil)
 	req.Header.Set("Authorization", "Bearer "+token)
 
 	resp, err := http.DefaultClient.Do(req)
 	require.NoError(t, err)
 	defer resp.Body.Close()
 
-	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var analysis entities.GraphAnalysis
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&analysis))
+
+	assert.Equal(t, graphID, analysis.GraphID)
+	assert.EqualValues(t, 5, analysis.Nodes)
+	assert.EqualValues(t, 4, analysis.Dependencies)
+	assert.EqualValues(t, 2, analysis.Components)
+	assert.Len(t, analysis.LongestPath, 3)
+	assert.Equal(t, []int64{2, 2, 1}, analysis.LevelWidths)
+	assert.EqualValues(t, 2, analysis.MaxParallelism)
+	require.Len(t, analysis.FanOutHotSpots, 1)
+	assert.EqualValues(t, 2, analysis.FanOutHotSpots[0].FanOut)
+	require.Len(t, analysis.FanInHotSpots, 1)
+	assert.EqualValues(t, 2, analysis.FanInHotSpots[0].FanIn)
+	assert.Len(t, analysis.OrphanNodeIDs, 1)
+
+	req, _ = http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/analyze", group.ID, graphID+1000), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
 
-	var predictedGraph entities.PredictedGraph
-	err = json.NewDecoder(resp.Body).Decode(&predictedGraph)
+	resp, err = http.DefaultClient.Do(req)
 	require.NoError(t, err)
+	defer resp.Body.Close()
 
-	assert.NotNil(t, predictedGraph.Graph)
-	assert.NotEmpty(t, predictedGraph.Paths)
+	assert.NotEqual(t, http.StatusOK, resp.StatusCode)
 }
 
-func TestPredictGraph_BadCase(t *testing.T) {
+func TestPredictGraph(t *testing.T) {
 	ts := suite.New(t)
 
 	user := models.User{
@@ -1132,12 +1484,210 @@ func TestPredictGraph_BadCase(t *testing.T) {
 	}
 	graph.GraphInfo.ID = createGraph(t, ts, token, group.ID, graph)
 
-	// Тестируем ошибку доступа
-	t.Run("Access denied", func(t *testing.T) {
-		// Создаем другого пользователя
-		otherUser := models.User{
-			Username: gofakeit.Username(),
-			Password: getSomePassword(),
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/predict?startAt=%s", group.ID, graph.GraphInfo.ID, startAt.Format(time.RFC3339)), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var predictedGraph entities.PredictedGraph
+	err = json.NewDecoder(resp.Body).Decode(&predictedGraph)
+	require.NoError(t, err)
+
+	assert.NotNil(t, predictedGraph.Graph)
+	assert.NotEmpty(t, predictedGraph.Paths)
+	assert.Greater(t, predictedGraph.Duration, 0.0)
+	assert.True(t, startAt.Equal(predictedGraph.StartAt))
+	assert.True(t, predictedGraph.FinishAt.After(startAt))
+	for _, node := range predictedGraph.Nodes {
+		require.NotNil(t, node.Schedule)
+		assert.GreaterOrEqual(t, node.Schedule.TotalFloat, node.Schedule.FreeFloat)
+		assert.InDelta(t, node.Schedule.EarlyStart+node.Schedule.TotalFloat, node.Schedule.LateStart, 1e-9)
+		assert.False(t, node.Schedule.Start.Before(startAt))
+		assert.False(t, node.Schedule.Finish.Before(node.Schedule.Start))
+	}
+
+	// Неизвестное правило приоритета
+	req, _ = http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/predict?priority=100", group.ID, graph.GraphInfo.ID), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestPredictGraphWithProgress(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	user, token := doSignUpFakeUser(t, ts, user)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	var taskIDs []int64
+	for range 3 {
+		task := models.Task{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.JobDescriptor(),
+			PlannedTime: 4,
+			AssignedTo:  user.ID,
+		}
+		taskIDs = append(taskIDs, createGroupTask(t, ts, token, group.ID, task))
+	}
+
+	// 1 -> 2 -> 3
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{ID: 1, TaskID: taskIDs[0], DependencyNodeIDs: []int64{2}},
+			{ID: 2, TaskID: taskIDs[1], DependencyNodeIDs: []int64{3}},
+			{ID: 3, TaskID: taskIDs[2]},
+		},
+	}
+	graphID := createGraph(t, ts, token, group.ID, graph)
+
+	// Первая задача выполнена за 6 часов, вторая выполнена наполовину
+	require.Equal(t, http.StatusOK, transitionGroupTask(t, ts, token, group.ID, taskIDs[0], "in_progress"))
+	req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/tasks/%d/done", group.ID, taskIDs[0]), bytes.NewBufferString(`{"actual_time": 6}`))
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	req, _ = http.NewRequest("PATCH", ts.GetURL()+fmt.Sprintf("/api/groups/%d/tasks/%d", group.ID, taskIDs[1]), bytes.NewBufferString(`{"percent_complete": 50}`))
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	req, _ = http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/predict", group.ID, graphID), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var predictedGraph entities.PredictedGraph
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&predictedGraph))
+
+	nodes := make(map[int64]*entities.NodeWithTask)
+	for _, node := range predictedGraph.Nodes {
+		nodes[node.Task.ID] = node
+	}
+	require.Len(t, nodes, 3)
+
+	// Выполненная задача учитывается фактическим временем и не требует времени в расписании
+	done := nodes[taskIDs[0]]
+	assert.Equal(t, 6.0, done.PredictedTime)
+	assert.Zero(t, done.RemainingTime)
+	assert.Zero(t, done.Schedule.EarlyFinish)
+
+	// Оставшаяся работа планируется от текущего момента
+	inProgress := nodes[taskIDs[1]]
+	assert.InDelta(t, inProgress.PredictedTime/2, inProgress.RemainingTime, 1e-9)
+	assert.Zero(t, inProgress.Schedule.EarlyStart)
+	assert.InDelta(t, inProgress.RemainingTime+nodes[taskIDs[2]].RemainingTime, predictedGraph.Duration, 1e-9)
+}
+
+func TestPredictGraph_BadCase(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	_, token := doSignUpFakeUser(t, ts, user)
+
+	user1 := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	user1, _ = doSignUpFakeUser(t, ts, user1)
+
+	user2 := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	user2, _ = doSignUpFakeUser(t, ts, user2)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	addGroupMember(t, ts, token, group.ID, models.GroupMember{
+		UserID: user1.ID,
+		Role:   "member",
+	})
+	addGroupMember(t, ts, token, group.ID, models.GroupMember{
+		UserID: user2.ID,
+		Role:   "member",
+	})
+
+	task1 := models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 1.123,
+		AssignedTo:  user1.ID,
+	}
+	task1.ID = createGroupTask(t, ts, token, group.ID, task1)
+
+	task2 := models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 1.123,
+		AssignedTo:  user2.ID,
+	}
+	task2.ID = createGroupTask(t, ts, token, group.ID, task2)
+
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{
+				ID:                1,
+				TaskID:            task1.ID,
+				DependencyNodeIDs: []int64{},
+			},
+			{
+				ID:                2,
+				TaskID:            task2.ID,
+				DependencyNodeIDs: []int64{1},
+			},
+		},
+	}
+	graph.GraphInfo.ID = createGraph(t, ts, token, group.ID, graph)
+
+	// Тестируем ошибку доступа
+	t.Run("Access denied", func(t *testing.T) {
+		// Создаем другого пользователя
+		otherUser := models.User{
+			Username: gofakeit.Username(),
+			Password: getSomePassword(),
 		}
 		_, otherToken := doSignUpFakeUser(t, ts, otherUser)
 
@@ -1197,3 +1747,1816 @@ func TestPredictGraph_BadCase(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
 }
+
+func TestPredictScenario(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	user, token := doSignUpFakeUser(t, ts, user)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	var taskIDs []int64
+	for range 3 {
+		task := models.Task{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.JobDescriptor(),
+			PlannedTime: 4,
+		}
+		taskIDs = append(taskIDs, createGroupTask(t, ts, token, group.ID, task))
+	}
+
+	// 1 -> 2 -> 3
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{ID: 1, TaskID: taskIDs[0], DependencyNodeIDs: []int64{2}},
+			{ID: 2, TaskID: taskIDs[1], DependencyNodeIDs: []int64{3}},
+			{ID: 3, TaskID: taskIDs[2]},
+		},
+	}
+	graphID := createGraph(t, ts, token, group.ID, graph)
+
+	getNodeIDs := func() map[int64]int64 {
+		req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d", group.ID, graphID), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var res entities.GraphWithNodes
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+
+		nodeIDs := make(map[int64]int64)
+		for _, node := range res.Nodes {
+			nodeIDs[node.TaskID] = node.ID
+		}
+		return nodeIDs
+	}
+	nodeIDs := getNodeIDs()
+	require.Len(t, nodeIDs, 3)
+	first, second, third := nodeIDs[taskIDs[0]], nodeIDs[taskIDs[1]], nodeIDs[taskIDs[2]]
+
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	predictScenario := func(scenario models.Scenario) *http.Response {
+		body, err := json.Marshal(scenario)
+		require.NoError(t, err)
+
+		req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/scenario?startAt=%s", group.ID, graphID, startAt.Format(time.RFC3339)), bytes.NewBuffer(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	// Вторая задача займет 100 часов, третья задача не нужна
+	resp := predictScenario(models.Scenario{
+		Reassignments: []*models.TaskReassignment{{NodeID: first, AssignedTo: user.ID}},
+		Durations:     []*models.DurationOverride{{NodeID: second, Duration: 100}},
+		DropNodeIDs:   []int64{third},
+	})
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var predictedGraph entities.PredictedGraph
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&predictedGraph))
+
+	require.Len(t, predictedGraph.Nodes, 2)
+	for _, node := range predictedGraph.Nodes {
+		if node.Node.ID == second {
+			assert.InDelta(t, 100, node.Schedule.EarlyFinish-node.Schedule.EarlyStart, 1e-9)
+		}
+	}
+
+	require.NotNil(t, predictedGraph.Delta)
+	assert.InDelta(t, predictedGraph.Duration-predictedGraph.Delta.BaseDuration, predictedGraph.Delta.DurationDelta, 1e-9)
+	assert.Equal(t, []int64{third}, predictedGraph.Delta.RemovedNodes)
+	require.Len(t, predictedGraph.Delta.RemovedDependencies, 1)
+	assert.Equal(t, second, predictedGraph.Delta.RemovedDependencies[0].FromNodeID)
+	assert.Equal(t, third, predictedGraph.Delta.RemovedDependencies[0].ToNodeID)
+	assert.Len(t, predictedGraph.Delta.Nodes, 2)
+
+	// Сохраненный граф не изменился
+	assert.Len(t, getNodeIDs(), 3)
+
+	// Неизвестная вершина
+	resp = predictScenario(models.Scenario{
+		Durations: []*models.DurationOverride{{NodeID: third + 1000, Duration: 1}},
+	})
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// Зависимость, замыкающая цикл
+	resp = predictScenario(models.Scenario{
+		AddDependencies: []*models.Dependency{{FromNodeID: third, ToNodeID: first}},
+	})
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+}
+
+func TestPredictScenarioReassignment(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	user, token := doSignUpFakeUser(t, ts, user)
+
+	newcomer := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	newcomer, _ = doSignUpFakeUser(t, ts, newcomer)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	addGroupMember(t, ts, token, group.ID, models.GroupMember{
+		UserID: newcomer.ID,
+		Role:   "member",
+	})
+
+	// Пользователь выполняет задачи в 10 раз дольше запланированного
+	for range 3 {
+		taskID := createGroupTask(t, ts, token, group.ID, models.Task{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.JobDescriptor(),
+			PlannedTime: 1,
+			AssignedTo:  user.ID,
+		})
+		require.Equal(t, http.StatusOK, transitionGroupTask(t, ts, token, group.ID, taskID, "in_progress"))
+
+		req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/tasks/%d/done", group.ID, taskID), bytes.NewBufferString(`{"actual_time": 10}`))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	// У нового участника нет выполненных задач, его время равно запланированному
+	taskID := createGroupTask(t, ts, token, group.ID, models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 1,
+		AssignedTo:  newcomer.ID,
+	})
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{ID: 1, TaskID: taskID},
+		},
+	}
+	graphID := createGraph(t, ts, token, group.ID, graph)
+
+	req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d", group.ID, graphID), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var savedGraph entities.GraphWithNodes
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&savedGraph))
+	require.Len(t, savedGraph.Nodes, 1)
+	nodeID := savedGraph.Nodes[0].ID
+
+	body, err := json.Marshal(models.Scenario{
+		Reassignments: []*models.TaskReassignment{{NodeID: nodeID, AssignedTo: user.ID}},
+	})
+	require.NoError(t, err)
+
+	// Модель пользователя обучается на выполненных задачах асинхронно,
+	// поэтому сценарий запрашивается, пока предсказание не изменится
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	var predictedGraph entities.PredictedGraph
+	require.Eventually(t, func() bool {
+		req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/scenario?startAt=%s", group.ID, graphID, startAt.Format(time.RFC3339)), bytes.NewBuffer(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return false
+		}
+
+		predictedGraph = entities.PredictedGraph{}
+		if err := json.NewDecoder(resp.Body).Decode(&predictedGraph); err != nil {
+			return false
+		}
+		return predictedGraph.Delta != nil && predictedGraph.Delta.DurationDelta > 0
+	}, 30*time.Second, time.Second)
+
+	// Переназначение на более медленного исполнителя сдвигает окончание графа
+	assert.InDelta(t, 1, predictedGraph.Delta.BaseDuration, 1e-9)
+	assert.Greater(t, predictedGraph.Duration, predictedGraph.Delta.BaseDuration)
+	assert.True(t, predictedGraph.FinishAt.After(predictedGraph.Delta.BaseFinishAt))
+	assert.Greater(t, predictedGraph.Delta.FinishDrift, 0.0)
+	require.Len(t, predictedGraph.Nodes, 1)
+	assert.Equal(t, user.ID, predictedGraph.Nodes[0].Task.AssignedTo)
+}
+
+func TestSimulateGraph(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	_, token := doSignUpFakeUser(t, ts, user)
+
+	user1 := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	user1, _ = doSignUpFakeUser(t, ts, user1)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	addGroupMember(t, ts, token, group.ID, models.GroupMember{
+		UserID: user1.ID,
+		Role:   "member",
+	})
+
+	task1 := models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 2,
+		AssignedTo:  user1.ID,
+	}
+	task1.ID = createGroupTask(t, ts, token, group.ID, task1)
+
+	task2 := models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 3,
+		AssignedTo:  user1.ID,
+	}
+	task2.ID = createGroupTask(t, ts, token, group.ID, task2)
+
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{
+				ID:                1,
+				TaskID:            task1.ID,
+				DependencyNodeIDs: []int64{2},
+			},
+			{
+				ID:                2,
+				TaskID:            task2.ID,
+				DependencyNodeIDs: []int64{},
+			},
+		},
+	}
+	graph.GraphInfo.ID = createGraph(t, ts, token, group.ID, graph)
+
+	t.Run("Success", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/simulate?iterations=200&seed=42", group.ID, graph.GraphInfo.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var simulatedGraph entities.SimulatedGraph
+		err = json.NewDecoder(resp.Body).Decode(&simulatedGraph)
+		require.NoError(t, err)
+
+		assert.NotNil(t, simulatedGraph.Graph)
+		assert.Equal(t, int64(200), simulatedGraph.Iterations)
+		assert.LessOrEqual(t, simulatedGraph.P50, simulatedGraph.P80)
+		assert.LessOrEqual(t, simulatedGraph.P80, simulatedGraph.P95)
+		assert.Len(t, simulatedGraph.Nodes, 2)
+		for _, node := range simulatedGraph.Nodes {
+			// граф - цепочка, все вершины всегда на критическом пути
+			assert.Equal(t, 1.0, node.CriticalityIndex)
+		}
+	})
+
+	t.Run("Bad iterations", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/simulate?iterations=-1", group.ID, graph.GraphInfo.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Graph not found", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/999999/simulate", group.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Completed node", func(t *testing.T) {
+		simulate := func() entities.SimulatedGraph {
+			req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/simulate?iterations=200&seed=42", group.ID, graph.GraphInfo.ID), nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var simulatedGraph entities.SimulatedGraph
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&simulatedGraph))
+			return simulatedGraph
+		}
+		before := simulate()
+
+		// Вторая задача выполнена, моделируется только первая
+		require.Equal(t, http.StatusOK, transitionGroupTask(t, ts, token, group.ID, task2.ID, "in_progress"))
+		req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/tasks/%d/done", group.ID, task2.ID), bytes.NewBufferString(`{"actual_time": 3}`))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		after := simulate()
+		assert.Less(t, after.P95, before.P50)
+		assert.Less(t, after.Mean, before.Mean)
+		// выполненная вершина не занимает времени
+		var completed int
+		for _, node := range after.Nodes {
+			if node.MaxTime == 0 {
+				completed++
+			}
+		}
+		assert.Equal(t, 1, completed)
+	})
+}
+
+func TestGraphDeadline(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	_, token := doSignUpFakeUser(t, ts, user)
+
+	user1 := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	user1, _ = doSignUpFakeUser(t, ts, user1)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	addGroupMember(t, ts, token, group.ID, models.GroupMember{
+		UserID: user1.ID,
+		Role:   "member",
+	})
+
+	task1 := models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 2,
+		AssignedTo:  user1.ID,
+	}
+	task1.ID = createGroupTask(t, ts, token, group.ID, task1)
+
+	task2 := models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 3,
+		AssignedTo:  user1.ID,
+	}
+	task2.ID = createGroupTask(t, ts, token, group.ID, task2)
+
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{
+				ID:                1,
+				TaskID:            task1.ID,
+				DependencyNodeIDs: []int64{2},
+			},
+			{
+				ID:                2,
+				TaskID:            task2.ID,
+				DependencyNodeIDs: []int64{},
+			},
+		},
+	}
+	graph.GraphInfo.ID = createGraph(t, ts, token, group.ID, graph)
+
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+
+	setDeadline := func(t *testing.T, graphID int64, body string) int {
+		req, _ := http.NewRequest("PUT", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/deadline", group.ID, graphID), strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	predict := func(t *testing.T) *entities.PredictedGraph {
+		req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/predict?startAt=%s", group.ID, graph.GraphInfo.ID, startAt.Format(time.RFC3339)), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var predictedGraph entities.PredictedGraph
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&predictedGraph))
+		return &predictedGraph
+	}
+
+	t.Run("Deadline is met", func(t *testing.T) {
+		deadline := startAt.AddDate(1, 0, 0)
+		require.Equal(t, http.StatusOK, setDeadline(t, graph.GraphInfo.ID, fmt.Sprintf(`{"deadline": %q}`, deadline.Format(time.RFC3339))))
+
+		storedGraph := getGraph(t, ts, token, group.ID, graph.GraphInfo.ID)
+		require.NotNil(t, storedGraph.GraphInfo.Deadline)
+		assert.True(t, deadline.Equal(*storedGraph.GraphInfo.Deadline))
+
+		predictedGraph := predict(t)
+		require.NotNil(t, predictedGraph.Deadline)
+		assert.True(t, predictedGraph.Deadline.Met)
+		assert.True(t, predictedGraph.Deadline.Recoverable)
+		assert.Greater(t, predictedGraph.Deadline.BufferDays, 0.0)
+		assert.Empty(t, predictedGraph.Deadline.Shortenings)
+		assert.True(t, predictedGraph.FinishAt.Equal(predictedGraph.Deadline.FinishAt))
+	})
+
+	t.Run("Deadline is missed", func(t *testing.T) {
+		// срок раньше начала расписания нельзя выполнить никаким сокращением вершин
+		deadline := startAt.Add(-24 * time.Hour)
+		require.Equal(t, http.StatusOK, setDeadline(t, graph.GraphInfo.ID, fmt.Sprintf(`{"deadline": %q}`, deadline.Format(time.RFC3339))))
+
+		predictedGraph := predict(t)
+		require.NotNil(t, predictedGraph.Deadline)
+		assert.False(t, predictedGraph.Deadline.Met)
+		assert.False(t, predictedGraph.Deadline.Recoverable)
+		assert.Less(t, predictedGraph.Deadline.BufferDays, -1.0)
+		assert.Len(t, predictedGraph.Deadline.Shortenings, 2)
+
+		req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/simulate?iterations=200&seed=42&startAt=%s", group.ID, graph.GraphInfo.ID, startAt.Format(time.RFC3339)), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var simulatedGraph entities.SimulatedGraph
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&simulatedGraph))
+		require.NotNil(t, simulatedGraph.Deadline)
+		assert.Equal(t, 1.0, simulatedGraph.Deadline.MissProbability)
+	})
+
+	t.Run("Remove deadline", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, setDeadline(t, graph.GraphInfo.ID, `{"deadline": null}`))
+
+		assert.Nil(t, getGraph(t, ts, token, group.ID, graph.GraphInfo.ID).GraphInfo.Deadline)
+		assert.Nil(t, predict(t).Deadline)
+	})
+
+	t.Run("Bad json", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, setDeadline(t, graph.GraphInfo.ID, `{"deadline": "tomorrow"}`))
+	})
+
+	t.Run("Graph not found", func(t *testing.T) {
+		assert.Equal(t, http.StatusNotFound, setDeadline(t, 999999, `{"deadline": null}`))
+	})
+}
+
+func TestCrashGraph(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	_, token := doSignUpFakeUser(t, ts, user)
+
+	user1 := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	user1, _ = doSignUpFakeUser(t, ts, user1)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	addGroupMember(t, ts, token, group.ID, models.GroupMember{
+		UserID: user1.ID,
+		Role:   "member",
+	})
+
+	task1 := models.Task{
+		Title:        gofakeit.JobTitle(),
+		Description:  gofakeit.JobDescriptor(),
+		PlannedTime:  2,
+		AssignedTo:   user1.ID,
+		CrashCost:    10,
+		CrashMinTime: 0.1,
+	}
+	task1.ID = createGroupTask(t, ts, token, group.ID, task1)
+
+	task2 := models.Task{
+		Title:        gofakeit.JobTitle(),
+		Description:  gofakeit.JobDescriptor(),
+		PlannedTime:  3,
+		AssignedTo:   user1.ID,
+		CrashCost:    5,
+		CrashMinTime: 0.1,
+	}
+	task2.ID = createGroupTask(t, ts, token, group.ID, task2)
+
+	storedTask := getGroupTask(t, ts, token, group.ID, task1.ID)
+	assert.Equal(t, task1.CrashCost, storedTask.CrashCost)
+	assert.Equal(t, task1.CrashMinTime, storedTask.CrashMinTime)
+
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{
+				ID:                1,
+				TaskID:            task1.ID,
+				DependencyNodeIDs: []int64{2},
+			},
+			{
+				ID:                2,
+				TaskID:            task2.ID,
+				DependencyNodeIDs: []int64{},
+			},
+		},
+	}
+	graph.GraphInfo.ID = createGraph(t, ts, token, group.ID, graph)
+
+	crash := func(t *testing.T, query string) *http.Response {
+		req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/crash?%s", group.ID, graph.GraphInfo.ID, query), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("Target is already met", func(t *testing.T) {
+		resp := crash(t, "target=1000")
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var plan entities.CrashPlan
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&plan))
+
+		assert.True(t, plan.Met)
+		assert.Equal(t, plan.InitialDuration, plan.Duration)
+		assert.Empty(t, plan.Nodes)
+		assert.Zero(t, plan.Cost)
+	})
+
+	t.Run("Crash to minimal time", func(t *testing.T) {
+		// граф нельзя сократить меньше суммы минимальных времен задач
+		resp := crash(t, "target=0.1")
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var plan entities.CrashPlan
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&plan))
+
+		assert.False(t, plan.Met)
+		assert.Less(t, plan.Duration, plan.InitialDuration)
+		assert.InDelta(t, 0.2, plan.Duration, 1e-6)
+		require.Len(t, plan.Nodes, 2)
+		var cost float64
+		for _, node := range plan.Nodes {
+			assert.InDelta(t, 0.1, node.CrashedDuration, 1e-6)
+			cost += node.Cost
+		}
+		assert.InDelta(t, cost, plan.Cost, 1e-6)
+	})
+
+	t.Run("Target is required without deadline", func(t *testing.T) {
+		resp := crash(t, "")
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Bad target", func(t *testing.T) {
+		resp := crash(t, "target=soon")
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Negative crash cost", func(t *testing.T) {
+		body, _ := json.Marshal(models.Task{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.JobDescriptor(),
+			PlannedTime: 1,
+			CrashCost:   -1,
+		})
+		req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/tasks", group.ID), bytes.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestGraphBaselines(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	_, token := doSignUpFakeUser(t, ts, user)
+
+	user1 := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	user1, _ = doSignUpFakeUser(t, ts, user1)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	addGroupMember(t, ts, token, group.ID, models.GroupMember{
+		UserID: user1.ID,
+		Role:   "member",
+	})
+
+	task1 := models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 1.123,
+		AssignedTo:  user1.ID,
+	}
+	task1.ID = createGroupTask(t, ts, token, group.ID, task1)
+
+	task2 := models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 1.123,
+		AssignedTo:  user1.ID,
+	}
+	task2.ID = createGroupTask(t, ts, token, group.ID, task2)
+
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{
+				ID:                1,
+				TaskID:            task1.ID,
+				DependencyNodeIDs: []int64{},
+			},
+		},
+	}
+	graph.GraphInfo.ID = createGraph(t, ts, token, group.ID, graph)
+
+	baselinesURL := ts.GetURL() + fmt.Sprintf("/api/groups/%d/graphs/%d/baselines", group.ID, graph.GraphInfo.ID)
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+
+	var baseline models.Baseline
+	t.Run("Create", func(t *testing.T) {
+		body, err := json.Marshal(models.Baseline{Name: "initial"})
+		require.NoError(t, err)
+
+		req, _ := http.NewRequest("POST", baselinesURL+"?startAt="+startAt.Format(time.RFC3339), bytes.NewBuffer(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		err = json.NewDecoder(resp.Body).Decode(&baseline)
+		require.NoError(t, err)
+
+		assert.NotZero(t, baseline.ID)
+		assert.Equal(t, "initial", baseline.Name)
+		assert.Equal(t, graph.GraphInfo.ID, baseline.GraphID)
+		assert.True(t, startAt.Equal(baseline.StartAt))
+		assert.Greater(t, baseline.Duration, 0.0)
+	})
+
+	t.Run("Empty name", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", baselinesURL, bytes.NewBufferString(`{"name": ""}`))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", baselinesURL, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var baselines []*models.Baseline
+		err = json.NewDecoder(resp.Body).Decode(&baselines)
+		require.NoError(t, err)
+
+		require.Len(t, baselines, 1)
+		assert.Equal(t, baseline.ID, baselines[0].ID)
+	})
+
+	t.Run("Compare", func(t *testing.T) {
+		nodeID := createNode(t, ts, token, group.ID, graph.GraphInfo.ID, models.Node{
+			TaskID: task2.ID,
+		})
+
+		req, _ := http.NewRequest("GET", baselinesURL+fmt.Sprintf("/%d/compare", baseline.ID), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var comparison entities.BaselineComparison
+		err = json.NewDecoder(resp.Body).Decode(&comparison)
+		require.NoError(t, err)
+
+		require.NotNil(t, comparison.Baseline)
+		assert.Equal(t, baseline.ID, comparison.Baseline.ID)
+		assert.Equal(t, []int64{nodeID}, comparison.AddedNodes)
+		assert.Empty(t, comparison.RemovedNodes)
+		for _, node := range comparison.Nodes {
+			assert.Equal(t, node.BaselineWeight, node.CurrentWeight)
+		}
+	})
+
+	t.Run("Baseline not found", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", baselinesURL+"/999999/compare", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestEVMReport(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	user, token := doSignUpFakeUser(t, ts, user)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	var taskIDs []int64
+	for range 2 {
+		task := models.Task{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.JobDescriptor(),
+			PlannedTime: 4,
+			AssignedTo:  user.ID,
+		}
+		taskIDs = append(taskIDs, createGroupTask(t, ts, token, group.ID, task))
+	}
+
+	// 1 -> 2
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{ID: 1, TaskID: taskIDs[0], DependencyNodeIDs: []int64{2}},
+			{ID: 2, TaskID: taskIDs[1]},
+		},
+	}
+	graphID := createGraph(t, ts, token, group.ID, graph)
+
+	evmURL := ts.GetURL() + fmt.Sprintf("/api/groups/%d/graphs/%d/evm", group.ID, graphID)
+
+	t.Run("No baselines", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", evmURL, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
+	// К моменту отчета по сохраненному расписанию весь граф должен быть выполнен
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/baselines?startAt=%s", group.ID, graphID, startAt.Format(time.RFC3339)), bytes.NewBufferString(`{"name": "initial"}`))
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var baseline models.Baseline
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&baseline))
+
+	// Первая задача выполнена за 8 часов вместо 4
+	require.Equal(t, http.StatusOK, transitionGroupTask(t, ts, token, group.ID, taskIDs[0], "in_progress"))
+	req, _ = http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/tasks/%d/done", group.ID, taskIDs[0]), bytes.NewBufferString(`{"actual_time": 8}`))
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	t.Run("Report", func(t *testing.T) {
+		asOf := startAt.AddDate(0, 1, 0)
+		req, _ := http.NewRequest("GET", evmURL+"?asOf="+asOf.Format(time.RFC3339), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var report entities.EVMReport
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+
+		require.NotNil(t, report.Baseline)
+		assert.Equal(t, baseline.ID, report.Baseline.ID)
+		assert.True(t, asOf.Equal(report.AsOf))
+		assert.InDelta(t, 8.0, report.BudgetAtCompletion, 1e-9)
+		assert.InDelta(t, 8.0, report.PlannedValue, 1e-9)
+		assert.InDelta(t, 4.0, report.EarnedValue, 1e-9)
+		assert.InDelta(t, 8.0, report.ActualCost, 1e-9)
+		assert.InDelta(t, 0.5, report.SPI, 1e-9)
+		assert.InDelta(t, 0.5, report.CPI, 1e-9)
+		assert.InDelta(t, 16.0, report.EstimateAtCompletion, 1e-9)
+		assert.InDelta(t, 8.0, report.EstimateToCompletion, 1e-9)
+		assert.Len(t, report.Nodes, 2)
+		assert.Empty(t, report.UnbaselinedNodes)
+	})
+
+	t.Run("Bad asOf", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", evmURL+"?asOf=yesterday", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Baseline not found", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", evmURL+"?baselineID=999999", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+// readGraphEvent reads the next Server-Sent Event from the watch stream
+func readGraphEvent(t *testing.T, reader *bufio.Reader) *entities.GraphEvent {
+	var name string
+	var event entities.GraphEvent
+	for {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+
+		line = strings.TrimRight(line, "\n")
+		switch {
+		case line == "":
+			require.Equal(t, name, event.Type)
+			return &event
+		case strings.HasPrefix(line, "event: "):
+			name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event))
+		}
+	}
+}
+
+func TestWatchGraph(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	user, token := doSignUpFakeUser(t, ts, user)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	var taskIDs []int64
+	for range 2 {
+		task := models.Task{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.JobDescriptor(),
+			PlannedTime: 4,
+			AssignedTo:  user.ID,
+		}
+		taskIDs = append(taskIDs, createGroupTask(t, ts, token, group.ID, task))
+	}
+
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{ID: 1, TaskID: taskIDs[0]},
+		},
+	}
+	graphID := createGraph(t, ts, token, group.ID, graph)
+
+	t.Run("Graph not found", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/watch", group.ID, 999999), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/watch", group.ID, graphID), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+
+	// Первое событие содержит текущие критические пути
+	event := readGraphEvent(t, reader)
+	assert.Equal(t, "critical_path_changed", event.Type)
+	assert.Equal(t, graphID, event.GraphID)
+
+	nodeID := createNode(t, ts, token, group.ID, graphID, models.Node{
+		TaskID:            taskIDs[1],
+		DependencyNodeIDs: []int64{},
+	})
+
+	event = readGraphEvent(t, reader)
+	assert.Equal(t, "node_created", event.Type)
+	assert.Equal(t, user.ID, event.UserID)
+	require.NotNil(t, event.Node)
+	assert.Equal(t, nodeID, event.Node.ID)
+	assert.Equal(t, taskIDs[1], event.Node.TaskID)
+}
+
+func TestApplyGraphPatch(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	user, token := doSignUpFakeUser(t, ts, user)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	var taskIDs []int64
+	for range 4 {
+		task := models.Task{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.JobDescriptor(),
+			PlannedTime: 2,
+			AssignedTo:  user.ID,
+		}
+		taskIDs = append(taskIDs, createGroupTask(t, ts, token, group.ID, task))
+	}
+
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{ID: 1, TaskID: taskIDs[0]},
+		},
+	}
+	graphID := createGraph(t, ts, token, group.ID, graph)
+
+	current := getGraph(t, ts, token, group.ID, graphID)
+	require.Len(t, current.Nodes, 1)
+	firstNodeID := current.Nodes[0].ID
+	version := current.GraphInfo.Version
+
+	doPatch := func(t *testing.T, patch models.GraphPatch) *http.Response {
+		body, err := json.Marshal(patch)
+		require.NoError(t, err)
+
+		req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/patch", group.ID, graphID), bytes.NewBuffer(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	var createdNodes []*entities.CreatedNode
+	t.Run("Apply", func(t *testing.T) {
+		// Новые вершины ссылаются друг на друга по временным идентификаторам
+		resp := doPatch(t, models.GraphPatch{
+			Version: version,
+			Operations: []*models.PatchOperation{
+				{Op: models.PatchAddNode, Node: &models.Node{ID: -1, TaskID: taskIDs[1]}},
+				{Op: models.PatchAddNode, Node: &models.Node{ID: -2, TaskID: taskIDs[2], DependencyNodeIDs: []int64{-1}}},
+				{Op: models.PatchAddDependency, Dependency: &models.Dependency{FromNodeID: firstNodeID, ToNodeID: -2, LinkType: models.LinkStartToStart}},
+			},
+		})
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result entities.GraphPatchResult
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+
+		assert.Equal(t, version+1, result.Version)
+		require.Len(t, result.CreatedNodes, 2)
+		assert.Equal(t, int64(-1), result.CreatedNodes[0].TempID)
+		assert.Equal(t, int64(-2), result.CreatedNodes[1].TempID)
+
+		patched := getGraph(t, ts, token, group.ID, graphID)
+		assert.Equal(t, result.Version, patched.GraphInfo.Version)
+		require.Len(t, patched.Nodes, 3)
+		for _, node := range patched.Nodes {
+			switch node.ID {
+			case firstNodeID:
+				assert.Equal(t, []int64{result.CreatedNodes[1].NodeID}, node.DependencyNodeIDs)
+			case result.CreatedNodes[1].NodeID:
+				assert.Equal(t, []int64{result.CreatedNodes[0].NodeID}, node.DependencyNodeIDs)
+			case result.CreatedNodes[0].NodeID:
+				assert.Empty(t, node.DependencyNodeIDs)
+			default:
+				t.Errorf("unexpected node %d", node.ID)
+			}
+		}
+
+		version = result.Version
+		createdNodes = result.CreatedNodes
+	})
+
+	t.Run("Stale version", func(t *testing.T) {
+		resp := doPatch(t, models.GraphPatch{
+			Version: version - 1,
+			Operations: []*models.PatchOperation{
+				{Op: models.PatchAddNode, Node: &models.Node{ID: -1, TaskID: taskIDs[3]}},
+			},
+		})
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Single change increments version", func(t *testing.T) {
+		nodeID := createNode(t, ts, token, group.ID, graphID, models.Node{
+			TaskID:            taskIDs[3],
+			DependencyNodeIDs: []int64{},
+		})
+
+		patched := getGraph(t, ts, token, group.ID, graphID)
+		assert.Equal(t, version+1, patched.GraphInfo.Version)
+
+		resp := doPatch(t, models.GraphPatch{
+			Version: version,
+			Operations: []*models.PatchOperation{
+				{Op: models.PatchRemoveNode, NodeID: nodeID},
+			},
+		})
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		version = patched.GraphInfo.Version
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		require.Len(t, createdNodes, 2)
+
+		resp := doPatch(t, models.GraphPatch{
+			Version: version,
+			Operations: []*models.PatchOperation{
+				{Op: models.PatchAddDependency, Dependency: &models.Dependency{FromNodeID: createdNodes[0].NodeID, ToNodeID: firstNodeID}},
+			},
+		})
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
+	t.Run("Nothing applied on error", func(t *testing.T) {
+		resp := doPatch(t, models.GraphPatch{
+			Version: version,
+			Operations: []*models.PatchOperation{
+				{Op: models.PatchMoveNode, Node: &models.Node{ID: firstNodeID, DependencyNodeIDs: []int64{}}},
+				{Op: models.PatchRemoveNode, NodeID: 999999},
+			},
+		})
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		patched := getGraph(t, ts, token, group.ID, graphID)
+		assert.Equal(t, version, patched.GraphInfo.Version)
+		for _, node := range patched.Nodes {
+			if node.ID == firstNodeID {
+				assert.NotEmpty(t, node.DependencyNodeIDs)
+			}
+		}
+	})
+
+	t.Run("Unknown operation", func(t *testing.T) {
+		resp := doPatch(t, models.GraphPatch{
+			Version: version,
+			Operations: []*models.PatchOperation{
+				{Op: "rename_node", NodeID: firstNodeID},
+			},
+		})
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestNodeKinds(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	user, token := doSignUpFakeUser(t, ts, user)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	var taskIDs []int64
+	for range 3 {
+		task := models.Task{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.JobDescriptor(),
+			PlannedTime: 2,
+			AssignedTo:  user.ID,
+		}
+		taskIDs = append(taskIDs, createGroupTask(t, ts, token, group.ID, task))
+	}
+
+	subGraph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{ID: 1, TaskID: taskIDs[0]},
+			{ID: 2, TaskID: taskIDs[1], DependencyNodeIDs: []int64{1}},
+		},
+	}
+	subGraphID := createGraph(t, ts, token, group.ID, subGraph)
+
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{ID: 1, Kind: models.NodeKindMilestone, Name: "Kickoff"},
+			{ID: 2, Kind: models.NodeKindSubGraph, SubGraphID: subGraphID, DependencyNodeIDs: []int64{1}},
+			{ID: 3, TaskID: taskIDs[2], DependencyNodeIDs: []int64{2}},
+		},
+	}
+	graphID := createGraph(t, ts, token, group.ID, graph)
+
+	predict := func(t *testing.T, graphID int64) *entities.PredictedGraph {
+		req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/predict", group.ID, graphID), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var predictedGraph entities.PredictedGraph
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&predictedGraph))
+		return &predictedGraph
+	}
+
+	doCreateNode := func(t *testing.T, graphID int64, node models.Node) *http.Response {
+		body, err := json.Marshal(node)
+		require.NoError(t, err)
+
+		req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/nodes", group.ID, graphID), bytes.NewBuffer(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("Predict", func(t *testing.T) {
+		subPrediction := predict(t, subGraphID)
+		prediction := predict(t, graphID)
+		require.Len(t, prediction.Nodes, 3)
+
+		kinds := make(map[string]*entities.NodeWithTask, len(prediction.Nodes))
+		for _, node := range prediction.Nodes {
+			kinds[node.Node.Kind] = node
+		}
+
+		milestone := kinds[models.NodeKindMilestone]
+		require.NotNil(t, milestone)
+		assert.Nil(t, milestone.Task)
+		assert.Equal(t, "Kickoff", milestone.Node.Name)
+		assert.Zero(t, milestone.PredictedTime)
+
+		subGraphNode := kinds[models.NodeKindSubGraph]
+		require.NotNil(t, subGraphNode)
+		assert.Nil(t, subGraphNode.Task)
+		assert.Equal(t, subGraphID, subGraphNode.Node.SubGraphID)
+		assert.InDelta(t, subPrediction.Duration, subGraphNode.PredictedTime, 1e-6)
+
+		taskNode := kinds[models.NodeKindTask]
+		require.NotNil(t, taskNode)
+		require.NotNil(t, taskNode.Task)
+		assert.Equal(t, taskIDs[2], taskNode.Task.ID)
+
+		assert.InDelta(t, subGraphNode.PredictedTime+taskNode.PredictedTime, prediction.Duration, 1e-6)
+	})
+
+	t.Run("Milestone with task", func(t *testing.T) {
+		resp := doCreateNode(t, graphID, models.Node{Kind: models.NodeKindMilestone, TaskID: taskIDs[0]})
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Unknown kind", func(t *testing.T) {
+		resp := doCreateNode(t, graphID, models.Node{Kind: "phase"})
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Sub-graph not found", func(t *testing.T) {
+		resp := doCreateNode(t, graphID, models.Node{Kind: models.NodeKindSubGraph, SubGraphID: graphID + 1000000})
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Graph contains itself", func(t *testing.T) {
+		resp := doCreateNode(t, graphID, models.Node{Kind: models.NodeKindSubGraph, SubGraphID: graphID})
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Recursive sub-graph", func(t *testing.T) {
+		resp := doCreateNode(t, subGraphID, models.Node{Kind: models.NodeKindSubGraph, SubGraphID: graphID})
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
+	t.Run("Sub-graph of another group", func(t *testing.T) {
+		otherGroupID := createGroup(t, ts, token, models.Group{
+			Name:        gofakeit.Company(),
+			Description: gofakeit.Sentence(10),
+		})
+		otherGraphID := createGraph(t, ts, token, otherGroupID, entities.GraphWithNodes{
+			GraphInfo: &models.Graph{
+				Name: gofakeit.BeerName(),
+			},
+			Nodes: []*models.Node{
+				{ID: 1, Kind: models.NodeKindMilestone},
+			},
+		})
+
+		resp := doCreateNode(t, graphID, models.Node{Kind: models.NodeKindSubGraph, SubGraphID: otherGraphID})
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestCloneGraph(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	user, token := doSignUpFakeUser(t, ts, user)
+
+	otherUser := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	_, otherToken := doSignUpFakeUser(t, ts, otherUser)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	targetGroupID := createGroup(t, ts, token, models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	})
+	foreignGroupID := createGroup(t, ts, otherToken, models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	})
+
+	tasks := make(map[int64]models.Task)
+	var taskIDs []int64
+	for i := range 2 {
+		task := models.Task{
+			// titles are used to find task copies
+			Title:       fmt.Sprintf("%d %s", i, gofakeit.JobTitle()),
+			Description: gofakeit.JobDescriptor(),
+			PlannedTime: 2,
+			Tags:        GetRandTags(2, 10),
+			AssignedTo:  user.ID,
+		}
+		task.ID = createGroupTask(t, ts, token, group.ID, task)
+		tasks[task.ID] = task
+		taskIDs = append(taskIDs, task.ID)
+	}
+
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{ID: 1, TaskID: taskIDs[0]},
+			{ID: 2, TaskID: taskIDs[1], DependencyNodeIDs: []int64{1}},
+			{ID: 3, Kind: models.NodeKindMilestone, Name: "Release", DependencyNodeIDs: []int64{2}},
+		},
+	}
+	graphID := createGraph(t, ts, token, group.ID, graph)
+
+	doCopy := func(t *testing.T, token, action string, graphID int64, clone models.CloneGraph) *http.Response {
+		body, err := json.Marshal(clone)
+		require.NoError(t, err)
+
+		req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d/%s", group.ID, graphID, action), bytes.NewBuffer(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	// checkCopy checks that copy has the same structure and fresh tasks with copied fields
+	checkCopy := func(t *testing.T, groupID, copyID int64, sameGroup bool) *entities.GraphWithNodes {
+		copied := getGraph(t, ts, token, groupID, copyID)
+		require.Len(t, copied.Nodes, 3)
+
+		nodes := make(map[string]*models.Node, len(copied.Nodes))
+		for _, node := range copied.Nodes {
+			if node.Kind == models.NodeKindMilestone {
+				nodes["milestone"] = node
+				continue
+			}
+
+			assert.NotContains(t, taskIDs, node.TaskID)
+			task := getGroupTask(t, ts, token, groupID, node.TaskID)
+			for _, source := range tasks {
+				if source.Title != task.Title {
+					continue
+				}
+
+				assert.Equal(t, source.Description, task.Description)
+				assert.Equal(t, source.PlannedTime, task.PlannedTime)
+				assert.ElementsMatch(t, source.Tags, task.Tags)
+				if sameGroup {
+					assert.Equal(t, source.AssignedTo, task.AssignedTo)
+				} else {
+					assert.Zero(t, task.AssignedTo)
+				}
+				if source.ID == taskIDs[0] {
+					nodes["first"] = node
+				} else {
+					nodes["second"] = node
+				}
+			}
+		}
+		require.Len(t, nodes, 3)
+
+		assert.Empty(t, nodes["first"].DependencyNodeIDs)
+		assert.Equal(t, []int64{nodes["first"].ID}, nodes["second"].DependencyNodeIDs)
+		assert.Equal(t, []int64{nodes["second"].ID}, nodes["milestone"].DependencyNodeIDs)
+		assert.Equal(t, "Release", nodes["milestone"].Name)
+		return copied
+	}
+
+	var templateID int64
+	t.Run("Clone", func(t *testing.T) {
+		resp := doCopy(t, token, "clone", graphID, models.CloneGraph{Name: "Sprint copy"})
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		copied := checkCopy(t, group.ID, getID(t, resp), true)
+		assert.Equal(t, "Sprint copy", copied.GraphInfo.Name)
+		assert.False(t, copied.GraphInfo.IsTemplate)
+	})
+
+	t.Run("Save as template", func(t *testing.T) {
+		resp := doCopy(t, token, "clone", graphID, models.CloneGraph{AsTemplate: true})
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		templateID = getID(t, resp)
+		template := checkCopy(t, group.ID, templateID, true)
+		assert.Equal(t, graph.GraphInfo.Name, template.GraphInfo.Name)
+		assert.True(t, template.GraphInfo.IsTemplate)
+	})
+
+	t.Run("Instantiate not a template", func(t *testing.T) {
+		resp := doCopy(t, token, "instantiate", graphID, models.CloneGraph{})
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusPreconditionFailed, resp.StatusCode)
+	})
+
+	t.Run("Instantiate to another group", func(t *testing.T) {
+		require.NotZero(t, templateID)
+
+		resp := doCopy(t, token, "instantiate", templateID, models.CloneGraph{GroupID: targetGroupID})
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		instance := checkCopy(t, targetGroupID, getID(t, resp), false)
+		assert.Equal(t, targetGroupID, instance.GraphInfo.GroupID)
+		assert.False(t, instance.GraphInfo.IsTemplate)
+	})
+
+	t.Run("Foreign group", func(t *testing.T) {
+		resp := doCopy(t, token, "clone", graphID, models.CloneGraph{GroupID: foreignGroupID})
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("Graph not found", func(t *testing.T) {
+		resp := doCopy(t, token, "clone", graphID+1000000, models.CloneGraph{})
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestExportGraph(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	_, token := doSignUpFakeUser(t, ts, user)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	task1 := models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 1.123,
+	}
+	task1.ID = createGroupTask(t, ts, token, group.ID, task1)
+
+	task2 := models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 2,
+	}
+	task2.ID = createGroupTask(t, ts, token, group.ID, task2)
+
+	graph := entities.GraphWithNodes{
+		GraphInfo: &models.Graph{
+			Name: gofakeit.BeerName(),
+		},
+		Nodes: []*models.Node{
+			{
+				ID:                1,
+				TaskID:            task1.ID,
+				DependencyNodeIDs: []int64{2},
+			},
+			{
+				ID:                2,
+				TaskID:            task2.ID,
+				DependencyNodeIDs: []int64{},
+			},
+		},
+	}
+	graph.GraphInfo.ID = createGraph(t, ts, token, group.ID, graph)
+
+	exportURL := ts.GetURL() + fmt.Sprintf("/api/groups/%d/graphs/%d/export", group.ID, graph.GraphInfo.ID)
+
+	tests := []struct {
+		format      string
+		contentType string
+		prefix      string
+	}{
+		{format: "dot", contentType: "text/vnd.graphviz", prefix: "digraph"},
+		{format: "mermaid", contentType: "text/vnd.mermaid", prefix: "flowchart"},
+		{format: "mspdi", contentType: "application/xml", prefix: "<?xml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			req, _ := http.NewRequest("GET", exportURL+"?format="+tt.format, nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, tt.contentType, resp.Header.Get("Content-Type"))
+			assert.Contains(t, resp.Header.Get("Content-Disposition"), "attachment")
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.True(t, strings.HasPrefix(string(body), tt.prefix))
+			assert.Contains(t, string(body), task1.Title)
+			assert.Contains(t, string(body), task2.Title)
+		})
+	}
+
+	t.Run("Unknown format", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", exportURL+"?format=png", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestImportGraph(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	_, token := doSignUpFakeUser(t, ts, user)
+
+	group := models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	}
+	group.ID = createGroup(t, ts, token, group)
+
+	importURL := ts.GetURL() + fmt.Sprintf("/api/groups/%d/graphs/import", group.ID)
+
+	importPlan := func(t *testing.T, query, body string) *http.Response {
+		req, _ := http.NewRequest("POST", importURL+query, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	checkGraph := func(t *testing.T, imported entities.ImportedGraph, name string, wantDeps map[int64][]int64) {
+		req, _ := http.NewRequest("GET", ts.GetURL()+fmt.Sprintf("/api/groups/%d/graphs/%d", group.ID, imported.GraphID), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var graph entities.GraphWithNodes
+		err = json.NewDecoder(resp.Body).Decode(&graph)
+		require.NoError(t, err)
+
+		assert.Equal(t, name, graph.GraphInfo.Name)
+		require.Len(t, graph.Nodes, len(imported.Tasks))
+
+		keyByTaskID := make(map[int64]int64, len(imported.Tasks))
+		for _, task := range imported.Tasks {
+			keyByTaskID[task.TaskID] = task.Key
+		}
+		keyByNodeID := make(map[int64]int64, len(graph.Nodes))
+		for _, node := range graph.Nodes {
+			keyByNodeID[node.ID] = keyByTaskID[node.TaskID]
+		}
+
+		gotDeps := make(map[int64][]int64)
+		for _, node := range graph.Nodes {
+			for _, depNodeID := range node.DependencyNodeIDs {
+				key := keyByNodeID[node.ID]
+				gotDeps[key] = append(gotDeps[key], keyByNodeID[depNodeID])
+			}
+		}
+		for key, deps := range wantDeps {
+			assert.ElementsMatch(t, deps, gotDeps[key])
+		}
+		assert.Len(t, gotDeps, len(wantDeps))
+	}
+
+	t.Run("CSV", func(t *testing.T) {
+		plan := "id,title,description,planned_time,predecessors\n" +
+			"10,Design,\"Design, review\",2,\n" +
+			"20,Backend,,5,10\n" +
+			"30,Frontend,,3,10\n" +
+			"40,Deploy,,0.5,20;30\n"
+
+		resp := importPlan(t, "?format=csv&name=imported", plan)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var imported entities.ImportedGraph
+		err := json.NewDecoder(resp.Body).Decode(&imported)
+		require.NoError(t, err)
+
+		assert.NotZero(t, imported.GraphID)
+		require.Len(t, imported.Tasks, 4)
+
+		checkGraph(t, imported, "imported", map[int64][]int64{
+			10: {20, 30},
+			20: {40},
+			30: {40},
+		})
+	})
+
+	t.Run("MSPDI", func(t *testing.T) {
+		plan := `<?xml version="1.0" encoding="UTF-8"?>
+<Project xmlns="http://schemas.microsoft.com/project">
+  <Name>plan.xml</Name>
+  <Title>Imported project</Title>
+  <Tasks>
+    <Task><UID>0</UID><Name>Project</Name><Summary>1</Summary><Duration>PT8H0M0S</Duration></Task>
+    <Task><UID>1</UID><Name>Design</Name><Duration>PT2H30M0S</Duration></Task>
+    <Task>
+      <UID>2</UID><Name>Build</Name><Duration>PT8H0M0S</Duration>
+      <PredecessorLink><PredecessorUID>1</PredecessorUID><Type>1</Type></PredecessorLink>
+    </Task>
+  </Tasks>
+</Project>`
+
+		resp := importPlan(t, "?format=mspdi", plan)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var imported entities.ImportedGraph
+		err := json.NewDecoder(resp.Body).Decode(&imported)
+		require.NoError(t, err)
+
+		require.Len(t, imported.Tasks, 2)
+
+		checkGraph(t, imported, "Imported project", map[int64][]int64{
+			1: {2},
+		})
+	})
+
+	t.Run("Bad plan", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			query string
+			body  string
+		}{
+			{name: "Unknown format", query: "?format=xlsx&name=bad", body: "id,title,planned_time\n1,a,1\n"},
+			{name: "Unknown predecessor", query: "?name=bad", body: "id,title,planned_time,predecessors\n1,a,1,2\n"},
+			{name: "Self dependency", query: "?name=bad", body: "id,title,planned_time,predecessors\n1,a,1,1\n"},
+			{name: "Missing planned time", query: "?name=bad", body: "id,title\n1,a\n"},
+			{name: "Empty name", query: "", body: "id,title,planned_time\n1,a,1\n"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				resp := importPlan(t, tt.query, tt.body)
+				defer resp.Body.Close()
+
+				assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			})
+		}
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/brianvoe/gofakeit"
+	"github.com/liriquew/control_system/api/internal/entities"
 	"github.com/liriquew/control_system/api/internal/models"
 	"github.com/liriquew/control_system/api/tests/suite"
 	"github.com/stretchr/testify/assert"
@@ -55,6 +56,20 @@ func getGroupTask(t *testing.T, ts *suite.Suite, token string, groupID, taskID i
 	return &response
 }
 
+// transitionGroupTask moves group task to status, returns response status code
+func transitionGroupTask(t *testing.T, ts *suite.Suite, token string, groupID, taskID int64, taskStatus string) int {
+	body := fmt.Sprintf(`{"status": %q}`, taskStatus)
+	req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/tasks/%d/transition", groupID, taskID), strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	return resp.StatusCode
+}
+
 func TestCreateGroupTask(t *testing.T) {
 	ts := suite.New(t)
 
@@ -173,6 +188,83 @@ func TestGetGroupTaskPermissions(t *testing.T) {
 	})
 }
 
+func TestGetGroupTaskList(t *testing.T) {
+	ts := suite.New(t)
+
+	_, token := doSignUpFakeUser(t, ts, models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	})
+	groupID := createGroup(t, ts, token, models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	})
+
+	for i := 0; i < 3; i++ {
+		createGroupTask(t, ts, token, groupID, models.Task{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.JobDescriptor(),
+			PlannedTime: float64(i + 1),
+		})
+	}
+	// задача вне группы не попадает в список группы
+	createTask(t, ts, token, models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 1,
+	})
+
+	groupTasksURL := ts.GetURL() + "/api/groups/" + strconv.FormatInt(groupID, 10) + "/tasks"
+
+	t.Run("Success", func(t *testing.T) {
+		var collected int
+		cursor := ""
+		for {
+			req, _ := http.NewRequest("GET", groupTasksURL+"?limit=2&cursor="+cursor, nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var taskList entities.TaskList
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&taskList))
+			for _, task := range taskList.Tasks {
+				assert.Equal(t, groupID, task.GroupID)
+			}
+
+			collected += len(taskList.Tasks)
+			if taskList.NextCursor == "" {
+				break
+			}
+			cursor = taskList.NextCursor
+		}
+		assert.Equal(t, 3, collected)
+
+		// фильтр по группе в списке задач пользователя
+		page := getTaskList(t, ts, token, "groupID="+strconv.FormatInt(groupID, 10))
+		assert.Len(t, page.Tasks, 3)
+	})
+
+	t.Run("Not member", func(t *testing.T) {
+		_, otherToken := doSignUpFakeUser(t, ts, models.User{
+			Username: gofakeit.Username(),
+			Password: getSomePassword(),
+		})
+
+		req, _ := http.NewRequest("GET", groupTasksURL, nil)
+		req.Header.Set("Authorization", "Bearer "+otherToken)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+}
+
 func TestUpdateGroupTask(t *testing.T) {
 	ts := suite.New(t)
 
@@ -598,3 +690,343 @@ func TestPredictUncreatedTask(t *testing.T) {
 		assert.Zero(t, predictedTime.PredictedTime)
 	})
 }
+
+func TestTaskComments(t *testing.T) {
+	ts := suite.New(t)
+
+	owner, ownerToken := doSignUpFakeUser(t, ts, models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	})
+	member, memberToken := doSignUpFakeUser(t, ts, models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	})
+	outsider, outsiderToken := doSignUpFakeUser(t, ts, models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	})
+
+	groupID := createGroup(t, ts, ownerToken, models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	})
+	addGroupMember(t, ts, ownerToken, groupID, models.GroupMember{
+		UserID: member.ID,
+		Role:   "member",
+	})
+
+	taskID := createGroupTask(t, ts, ownerToken, groupID, models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 4,
+	})
+	taskURL := ts.GetURL() + "/api/groups/" + strconv.FormatInt(groupID, 10) + "/tasks/" + strconv.FormatInt(taskID, 10)
+
+	doComment := func(method, url, token string, comment models.Comment) (int, *entities.Comment) {
+		body, _ := json.Marshal(comment)
+		req, _ := http.NewRequest(method, url, bytes.NewBuffer(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return resp.StatusCode, nil
+		}
+
+		var created entities.Comment
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+		return resp.StatusCode, &created
+	}
+
+	getActivity := func(token string) []*entities.ActivityItem {
+		req, _ := http.NewRequest("GET", taskURL+"/activity", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var activity []*entities.ActivityItem
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&activity))
+		return activity
+	}
+
+	// Комментарий с упоминанием участника группы
+	code, comment := doComment("POST", taskURL+"/comments", memberToken, models.Comment{
+		Text:     "please check @" + owner.Username,
+		Mentions: []int64{owner.ID},
+	})
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, member.ID, comment.AuthorID)
+	require.Len(t, comment.Mentions, 1)
+	assert.Equal(t, owner.ID, comment.Mentions[0].UserID)
+	assert.Equal(t, owner.Username, comment.Mentions[0].Username)
+	assert.Nil(t, comment.UpdatedAt)
+
+	t.Run("Bad requests", func(t *testing.T) {
+		code, _ := doComment("POST", taskURL+"/comments", memberToken, models.Comment{
+			Text:     "@" + outsider.Username,
+			Mentions: []int64{outsider.ID},
+		})
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		code, _ = doComment("POST", taskURL+"/comments", memberToken, models.Comment{})
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		code, _ = doComment("POST", taskURL+"/comments", outsiderToken, models.Comment{Text: gofakeit.Sentence(5)})
+		assert.Equal(t, http.StatusForbidden, code)
+
+		// задача другой группы недоступна через эту группу
+		otherGroupID := createGroup(t, ts, outsiderToken, models.Group{
+			Name:        gofakeit.Company(),
+			Description: gofakeit.Sentence(10),
+		})
+		otherTaskID := createGroupTask(t, ts, outsiderToken, otherGroupID, models.Task{
+			Title:       gofakeit.JobTitle(),
+			Description: gofakeit.JobDescriptor(),
+			PlannedTime: 1,
+		})
+		otherTaskURL := ts.GetURL() + "/api/groups/" + strconv.FormatInt(groupID, 10) + "/tasks/" + strconv.FormatInt(otherTaskID, 10)
+		code, _ = doComment("POST", otherTaskURL+"/comments", memberToken, models.Comment{Text: gofakeit.Sentence(5)})
+		assert.Equal(t, http.StatusNotFound, code)
+	})
+
+	commentURL := taskURL + "/comments/" + strconv.FormatInt(comment.ID, 10)
+
+	// Редактировать комментарий может только автор
+	code, _ = doComment("PATCH", commentURL, ownerToken, models.Comment{Text: gofakeit.Sentence(5)})
+	assert.Equal(t, http.StatusForbidden, code)
+
+	code, updated := doComment("PATCH", commentURL, memberToken, models.Comment{Text: "updated"})
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "updated", updated.Text)
+	assert.Empty(t, updated.Mentions)
+	assert.NotNil(t, updated.UpdatedAt)
+
+	// Изменение задачи попадает в ленту активности
+	body, _ := json.Marshal(models.Task{PlannedTime: 6})
+	req, _ := http.NewRequest("PATCH", taskURL, bytes.NewBuffer(body))
+	req.Header.Set("Authorization", "Bearer "+ownerToken)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	activity := getActivity(memberToken)
+	require.Len(t, activity, 2)
+	assert.Equal(t, "comment", activity[0].Type)
+	assert.Equal(t, comment.ID, activity[0].Comment.ID)
+	assert.Equal(t, "change", activity[1].Type)
+	assert.Equal(t, "planned_time", activity[1].Change.Field)
+	assert.Equal(t, "4", activity[1].Change.OldValue)
+	assert.Equal(t, "6", activity[1].Change.NewValue)
+	assert.Equal(t, owner.ID, activity[1].Change.ChangedBy)
+
+	// Удаление комментария
+	req, _ = http.NewRequest("DELETE", commentURL, nil)
+	req.Header.Set("Authorization", "Bearer "+ownerToken)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	req, _ = http.NewRequest("DELETE", commentURL, nil)
+	req.Header.Set("Authorization", "Bearer "+memberToken)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	activity = getActivity(ownerToken)
+	require.Len(t, activity, 1)
+	assert.Equal(t, "change", activity[0].Type)
+}
+
+func TestCompleteTaskTransitions(t *testing.T) {
+	ts := suite.New(t)
+
+	user, token := doSignUpFakeUser(t, ts, models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	})
+
+	groupID := createGroup(t, ts, token, models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	})
+	tasksURL := ts.GetURL() + "/api/groups/" + strconv.FormatInt(groupID, 10) + "/tasks/"
+
+	do := func(method, url, body string) *http.Response {
+		req, _ := http.NewRequest(method, url, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	// Способы завершить задачу, возвращают код ответа
+	complete := map[string]func(taskID int64) int{
+		"done": func(taskID int64) int {
+			resp := do("POST", tasksURL+strconv.FormatInt(taskID, 10)+"/done", `{"actual_time": 5}`)
+			defer resp.Body.Close()
+			return resp.StatusCode
+		},
+		"patch": func(taskID int64) int {
+			resp := do("PATCH", tasksURL+strconv.FormatInt(taskID, 10), `{"actual_time": 5}`)
+			defer resp.Body.Close()
+			return resp.StatusCode
+		},
+		"batch": func(taskID int64) int {
+			resp := do("PATCH", tasksURL+"batch", fmt.Sprintf(`{"tasks": [{"id": %d, "actual_time": 5}]}`, taskID))
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			var result entities.TasksBatchResult
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+			require.Len(t, result.Results, 1)
+			return result.Results[0].Status
+		},
+	}
+
+	// Статусы, из которых задачу можно перевести в работу, и пути к ним
+	moves := map[string][]string{
+		"backlog": {},
+		"blocked": {"in_progress", "blocked"},
+	}
+
+	for path, completeTask := range complete {
+		for from, statuses := range moves {
+			t.Run(path+" from "+from, func(t *testing.T) {
+				taskID := createGroupTask(t, ts, token, groupID, models.Task{
+					Title:       gofakeit.JobTitle(),
+					Description: gofakeit.JobDescriptor(),
+					PlannedTime: 4,
+					AssignedTo:  user.ID,
+				})
+				for _, status := range statuses {
+					require.Equal(t, http.StatusOK, transitionGroupTask(t, ts, token, groupID, taskID, status))
+				}
+
+				// Из бэклога и блокировки задача не завершается
+				assert.Equal(t, http.StatusBadRequest, completeTask(taskID))
+
+				task := getGroupTask(t, ts, token, groupID, taskID)
+				assert.Equal(t, from, task.Status)
+				assert.Zero(t, task.ActualTime)
+
+				// Задача в работе завершается
+				require.Equal(t, http.StatusOK, transitionGroupTask(t, ts, token, groupID, taskID, "in_progress"))
+				assert.Equal(t, http.StatusOK, completeTask(taskID))
+
+				task = getGroupTask(t, ts, token, groupID, taskID)
+				assert.Equal(t, "done", task.Status)
+				assert.Equal(t, 5.0, task.ActualTime)
+			})
+		}
+	}
+}
+
+func TestGroupTaskHistoryAccess(t *testing.T) {
+	ts := suite.New(t)
+
+	_, ownerToken := doSignUpFakeUser(t, ts, models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	})
+	_, outsiderToken := doSignUpFakeUser(t, ts, models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	})
+
+	groupID := createGroup(t, ts, ownerToken, models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	})
+	otherGroupID := createGroup(t, ts, outsiderToken, models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	})
+	taskID := createGroupTask(t, ts, ownerToken, groupID, models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 4,
+	})
+
+	getHistory := func(url, token string) int {
+		req, _ := http.NewRequest("GET", url, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	// История доступна через группу задачи
+	assert.Equal(t, http.StatusOK, getHistory(ts.GetURL()+fmt.Sprintf("/api/groups/%d/tasks/%d/history", groupID, taskID), ownerToken))
+
+	// Задача другой группы недоступна через свою группу
+	assert.Equal(t, http.StatusNotFound, getHistory(ts.GetURL()+fmt.Sprintf("/api/groups/%d/tasks/%d/history", otherGroupID, taskID), outsiderToken))
+
+	// Групповая задача недоступна через личные задачи
+	assert.Equal(t, http.StatusForbidden, getHistory(ts.GetURL()+fmt.Sprintf("/api/tasks/%d/history", taskID), outsiderToken))
+	assert.Equal(t, http.StatusForbidden, getHistory(ts.GetURL()+fmt.Sprintf("/api/tasks/%d/history", taskID), ownerToken))
+}
+
+func TestGroupTaskActionsFromAnotherGroup(t *testing.T) {
+	ts := suite.New(t)
+
+	owner, ownerToken := doSignUpFakeUser(t, ts, models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	})
+
+	groupID := createGroup(t, ts, ownerToken, models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	})
+	otherGroupID := createGroup(t, ts, ownerToken, models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	})
+	taskID := createGroupTask(t, ts, ownerToken, groupID, models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 4,
+		AssignedTo:  owner.ID,
+	})
+	require.Equal(t, http.StatusOK, transitionGroupTask(t, ts, ownerToken, groupID, taskID, "in_progress"))
+
+	// Задача другой группы не изменяется через эту группу
+	requests := map[string]string{
+		"done":       `{"actual_time": 5}`,
+		"transition": `{"status": "blocked"}`,
+		"work/start": ``,
+		"work/stop":  ``,
+	}
+	for path, body := range requests {
+		req, _ := http.NewRequest("POST", ts.GetURL()+fmt.Sprintf("/api/groups/%d/tasks/%d/%s", otherGroupID, taskID, path), strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+ownerToken)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode, path)
+	}
+
+	task := getGroupTask(t, ts, ownerToken, groupID, taskID)
+	assert.Equal(t, "in_progress", task.Status)
+	assert.Zero(t, task.ActualTime)
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/liriquew/control_system/api/internal/entities"
 	"github.com/liriquew/control_system/api/internal/models"
@@ -255,6 +256,45 @@ func TestDeleteGroup(t *testing.T) {
 
 		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 	})
+
+	t.Run("Restore", func(t *testing.T) {
+		// Регистрируем пользователя, не являющегося владельцем группы
+		user := models.User{
+			Username: gofakeit.Username(),
+			Password: gofakeit.Password(true, true, true, true, false, 10),
+		}
+		_, otherToken := doSignUpFakeUser(t, ts, user)
+
+		// Восстановить группу может только владелец
+		req, _ := http.NewRequest("POST", ts.GetURL()+"/api/groups/"+strconv.FormatInt(groupID, 10)+"/restore", nil)
+		req.Header.Set("Authorization", "Bearer "+otherToken)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		// Восстанавливаем группу
+		req, _ = http.NewRequest("POST", ts.GetURL()+"/api/groups/"+strconv.FormatInt(groupID, 10)+"/restore", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err = http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// Проверяем, что группа и членство владельца восстановлены
+		req, _ = http.NewRequest("GET", ts.GetURL()+"/api/groups/"+strconv.FormatInt(groupID, 10), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err = http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
 }
 
 func TestUpdateGroup(t *testing.T) {
@@ -468,3 +508,268 @@ func TestChangeMemberRole(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+func TestUserCalendar(t *testing.T) {
+	ts := suite.New(t)
+
+	user1 := models.User{
+		Username: gofakeit.Username(),
+		Password: gofakeit.Password(true, true, true, true, false, 10),
+	}
+	user1ID, token1 := doSignUpFakeUser(t, ts, user1)
+
+	user2 := models.User{
+		Username: gofakeit.Username(),
+		Password: gofakeit.Password(true, true, true, true, false, 10),
+	}
+	_, token2 := doSignUpFakeUser(t, ts, user2)
+
+	// Календарь еще не задан
+	req, _ := http.NewRequest("GET", ts.GetURL()+"/api/calendar", nil)
+	req.Header.Set("Authorization", "Bearer "+token1)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// Некорректные рабочие часы
+	body, _ := json.Marshal(models.UserCalendar{
+		WorkStartMinute: 18 * 60,
+		WorkEndMinute:   9 * 60,
+	})
+	req, _ = http.NewRequest("PUT", ts.GetURL()+"/api/calendar", bytes.NewBuffer(body))
+	req.Header.Set("Authorization", "Bearer "+token1)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	// Задаем календарь
+	calendar := models.UserCalendar{
+		WorkStartMinute:  9 * 60,
+		WorkEndMinute:    18 * 60,
+		WorkDays:         []int32{1, 2, 3, 4, 5},
+		UTCOffsetMinutes: 3 * 60,
+		DaysOff: []time.Time{
+			time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.May, 9, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	body, _ = json.Marshal(calendar)
+	req, _ = http.NewRequest("PUT", ts.GetURL()+"/api/calendar", bytes.NewBuffer(body))
+	req.Header.Set("Authorization", "Bearer "+token1)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	req, _ = http.NewRequest("GET", ts.GetURL()+"/api/calendar", nil)
+	req.Header.Set("Authorization", "Bearer "+token1)
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var got models.UserCalendar
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+
+	assert.Equal(t, user1ID.ID, got.UserID)
+	assert.Equal(t, calendar.WorkStartMinute, got.WorkStartMinute)
+	assert.Equal(t, calendar.WorkEndMinute, got.WorkEndMinute)
+	assert.Equal(t, calendar.WorkDays, got.WorkDays)
+	assert.Equal(t, calendar.UTCOffsetMinutes, got.UTCOffsetMinutes)
+	require.Len(t, got.DaysOff, 2)
+	assert.True(t, calendar.DaysOff[0].Equal(got.DaysOff[0]))
+	assert.True(t, calendar.DaysOff[1].Equal(got.DaysOff[1]))
+
+	// Календари участников группы
+	groupID := createGroup(t, ts, token1, models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	})
+
+	req, _ = http.NewRequest("GET", ts.GetURL()+"/api/groups/"+strconv.FormatInt(groupID, 10)+"/calendars", nil)
+	req.Header.Set("Authorization", "Bearer "+token1)
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var calendars []models.UserCalendar
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&calendars))
+	require.Len(t, calendars, 1)
+	assert.Equal(t, user1ID.ID, calendars[0].UserID)
+
+	// Пользователь не из группы не может получить календари
+	req, _ = http.NewRequest("GET", ts.GetURL()+"/api/groups/"+strconv.FormatInt(groupID, 10)+"/calendars", nil)
+	req.Header.Set("Authorization", "Bearer "+token2)
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func getAuditEvents(t *testing.T, ts *suite.Suite, token string, groupID int64, query string) (int, *entities.AuditEventList) {
+	req, _ := http.NewRequest("GET", ts.GetURL()+"/api/groups/"+strconv.FormatInt(groupID, 10)+"/audit"+query, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, nil
+	}
+
+	var events entities.AuditEventList
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&events))
+	return resp.StatusCode, &events
+}
+
+func findAuditEvent(events []*entities.AuditEvent, entity, action string, entityID int64) *entities.AuditEvent {
+	for _, event := range events {
+		if event.Entity == entity && event.Action == action && event.EntityID == entityID {
+			return event
+		}
+	}
+	return nil
+}
+
+func TestGroupAuditEvents(t *testing.T) {
+	ts := suite.New(t)
+
+	user1 := models.User{
+		Username: gofakeit.Username(),
+		Password: gofakeit.Password(true, true, true, true, false, 10),
+	}
+	user1ID, token1 := doSignUpFakeUser(t, ts, user1)
+
+	user2 := models.User{
+		Username: gofakeit.Username(),
+		Password: gofakeit.Password(true, true, true, true, false, 10),
+	}
+	user2ID, token2 := doSignUpFakeUser(t, ts, user2)
+
+	groupID := createGroup(t, ts, token1, models.Group{
+		Name:        gofakeit.Company(),
+		Description: gofakeit.Sentence(10),
+	})
+	addGroupMember(t, ts, token1, groupID, models.GroupMember{
+		UserID: user2ID.ID,
+		Role:   "editor",
+	})
+
+	// Редактор изменяет задачу группы
+	taskID := createGroupTask(t, ts, token1, groupID, models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 1,
+	})
+	newTitle := gofakeit.JobTitle()
+	body, _ := json.Marshal(models.Task{Title: newTitle})
+	req, _ := http.NewRequest("PATCH", ts.GetURL()+"/api/groups/"+strconv.FormatInt(groupID, 10)+"/tasks/"+strconv.FormatInt(taskID, 10), bytes.NewBuffer(body))
+	req.Header.Set("Authorization", "Bearer "+token2)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Не администратор не может читать журнал
+	code, _ := getAuditEvents(t, ts, token2, groupID, "")
+	assert.Equal(t, http.StatusForbidden, code)
+
+	// Администратор меняет роль и удаляет участника
+	req, _ = http.NewRequest("PATCH", ts.GetURL()+"/api/groups/"+strconv.FormatInt(groupID, 10)+"/members/"+strconv.FormatInt(user2ID.ID, 10)+"/role?newRole=member", nil)
+	req.Header.Set("Authorization", "Bearer "+token1)
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	req, _ = http.NewRequest("DELETE", ts.GetURL()+"/api/groups/"+strconv.FormatInt(groupID, 10)+"/members/"+strconv.FormatInt(user2ID.ID, 10), nil)
+	req.Header.Set("Authorization", "Bearer "+token1)
+
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	code, events := getAuditEvents(t, ts, token1, groupID, "")
+	require.Equal(t, http.StatusOK, code)
+	assert.Empty(t, events.NextCursor)
+
+	taskUpdate := findAuditEvent(events.Events, "task", "update", taskID)
+	require.NotNil(t, taskUpdate)
+	assert.Equal(t, "tasks", taskUpdate.Source)
+	assert.Equal(t, user2ID.ID, taskUpdate.ActorID)
+	assert.Contains(t, string(taskUpdate.After), newTitle)
+
+	roleChange := findAuditEvent(events.Events, "member", "update", user2ID.ID)
+	require.NotNil(t, roleChange)
+	assert.Equal(t, "groups", roleChange.Source)
+	assert.Equal(t, user1ID.ID, roleChange.ActorID)
+	assert.Contains(t, string(roleChange.Before), "editor")
+	assert.Contains(t, string(roleChange.After), "member")
+
+	removal := findAuditEvent(events.Events, "member", "delete", user2ID.ID)
+	require.NotNil(t, removal)
+	assert.Equal(t, user1ID.ID, removal.ActorID)
+	assert.Empty(t, removal.After)
+
+	// События отсортированы от новых к старым
+	for i := 1; i < len(events.Events); i++ {
+		assert.False(t, events.Events[i].CreatedAt.After(events.Events[i-1].CreatedAt))
+	}
+
+	// Фильтр по исполнителю
+	code, filtered := getAuditEvents(t, ts, token1, groupID, "?actorID="+strconv.FormatInt(user2ID.ID, 10))
+	require.Equal(t, http.StatusOK, code)
+	require.NotEmpty(t, filtered.Events)
+	for _, event := range filtered.Events {
+		assert.Equal(t, user2ID.ID, event.ActorID)
+	}
+
+	// Постраничный обход возвращает все события по одному разу
+	var paged []*entities.AuditEvent
+	cursor := ""
+	for range len(events.Events) + 1 {
+		code, page := getAuditEvents(t, ts, token1, groupID, "?limit=2&cursor="+cursor)
+		require.Equal(t, http.StatusOK, code)
+		paged = append(paged, page.Events...)
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	require.Len(t, paged, len(events.Events))
+	for i := range paged {
+		assert.Equal(t, events.Events[i].Source, paged[i].Source)
+		assert.Equal(t, events.Events[i].ID, paged[i].ID)
+	}
+
+	// Некорректные параметры
+	code, _ = getAuditEvents(t, ts, token1, groupID, "?limit=101")
+	assert.Equal(t, http.StatusBadRequest, code)
+	code, _ = getAuditEvents(t, ts, token1, groupID, "?cursor=bad")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
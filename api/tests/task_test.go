@@ -3,9 +3,11 @@ package tests
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	mathrand "math/rand"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"testing"
@@ -155,31 +157,67 @@ func TestGetTaskList(t *testing.T) {
 	}
 	_, token := doSignUpFakeUser(t, ts, user)
 
-	// Создаем несколько задач
+	// Создаем несколько задач, две из них завершены
+	titles := make([]string, 0, 5)
 	for i := 0; i < 5; i++ {
 		task := models.Task{
-			Title:       gofakeit.JobTitle(),
+			Title:       fmt.Sprintf("list task %d %s", i, gofakeit.JobTitle()),
 			Description: gofakeit.JobDescriptor(),
-			PlannedTime: gofakeit.Float64(),
+			PlannedTime: float64(i + 1),
+		}
+		if i < 2 {
+			task.ActualTime = 1
 		}
 		createTask(t, ts, token, task)
+		titles = append(titles, task.Title)
 	}
 
-	// Успешное получение списка
+	// Постраничное получение списка по курсору
 	t.Run("Success", func(t *testing.T) {
-		req, _ := http.NewRequest("GET", ts.GetURL()+"/api/tasks?offset=2", nil)
-		req.Header.Set("Authorization", "Bearer "+token)
+		page := getTaskList(t, ts, token, "limit=2")
+		require.Len(t, page.Tasks, 2)
+		require.NotEmpty(t, page.NextCursor)
+		// по умолчанию задачи отсортированы от новых к старым
+		assert.Equal(t, titles[4], page.Tasks[0].Title)
+
+		collected := len(page.Tasks)
+		for page.NextCursor != "" {
+			page = getTaskList(t, ts, token, "limit=2&cursor="+page.NextCursor)
+			collected += len(page.Tasks)
+		}
+		assert.Equal(t, 5, collected)
+	})
 
-		resp, err := http.DefaultClient.Do(req)
-		require.NoError(t, err)
-		defer resp.Body.Close()
+	t.Run("Filter", func(t *testing.T) {
+		page := getTaskList(t, ts, token, "state=completed")
+		assert.Len(t, page.Tasks, 2)
+		assert.Empty(t, page.NextCursor)
 
-		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		page = getTaskList(t, ts, token, "state=open&sortBy=planned_time&order=asc")
+		require.Len(t, page.Tasks, 3)
+		assert.Equal(t, 3.0, page.Tasks[0].PlannedTime)
+		assert.Equal(t, 5.0, page.Tasks[2].PlannedTime)
+
+		page = getTaskList(t, ts, token, "title="+url.QueryEscape("LIST TASK 3"))
+		require.Len(t, page.Tasks, 1)
+		assert.Equal(t, titles[3], page.Tasks[0].Title)
+	})
+
+	// Курсор нельзя использовать с другой сортировкой
+	t.Run("Invalid cursor", func(t *testing.T) {
+		page := getTaskList(t, ts, token, "limit=2")
+		require.NotEmpty(t, page.NextCursor)
+
+		for _, query := range []string{"cursor=" + page.NextCursor + "&order=asc", "cursor=bad", "sortBy=unknown", "limit=-1"} {
+			req, _ := http.NewRequest("GET", ts.GetURL()+"/api/tasks?"+query, nil)
+			req.Header.Set("Authorization", "Bearer "+token)
 
-		var tasks []models.Task
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
 
-		json.NewDecoder(resp.Body).Decode(&tasks)
-		assert.Len(t, tasks, 3)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode, query)
+		}
 	})
 }
 
@@ -313,6 +351,384 @@ func TestDeleteTask(t *testing.T) {
 
 		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
 	})
+
+	// Восстановление удаленной задачи
+	t.Run("Restore", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", ts.GetURL()+"/api/tasks/"+strconv.FormatInt(taskID, 10)+"/restore", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// Проверяем, что задача восстановлена
+		req, _ = http.NewRequest("GET", ts.GetURL()+"/api/tasks/"+strconv.FormatInt(taskID, 10), nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err = http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// Неудаленную задачу восстановить нельзя
+		req, _ = http.NewRequest("POST", ts.GetURL()+"/api/tasks/"+strconv.FormatInt(taskID, 10)+"/restore", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err = http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
+func TestBatchTasks(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	_, token := doSignUpFakeUser(t, ts, user)
+
+	var taskIDs []int64
+
+	// Создание задач, невалидная задача не мешает созданию остальных
+	t.Run("Create", func(t *testing.T) {
+		batch := models.TasksBatch{
+			Tasks: []*models.Task{
+				{Title: gofakeit.JobTitle(), Description: gofakeit.JobDescriptor(), PlannedTime: 2},
+				{Title: "", Description: gofakeit.JobDescriptor(), PlannedTime: 2},
+				{Title: gofakeit.JobTitle(), Description: gofakeit.JobDescriptor(), PlannedTime: 3, ActualTime: 4},
+			},
+		}
+
+		result := doTasksBatch(t, ts, token, "POST", batch)
+		require.Len(t, result.Results, 3)
+		assert.Equal(t, http.StatusOK, result.Results[0].Status)
+		assert.Equal(t, http.StatusBadRequest, result.Results[1].Status)
+		assert.Zero(t, result.Results[1].ID)
+		assert.Equal(t, http.StatusOK, result.Results[2].Status)
+
+		taskIDs = []int64{result.Results[0].ID, result.Results[2].ID}
+		assert.Equal(t, batch.Tasks[0].Title, getTask(t, ts, token, taskIDs[0]).Title)
+		assert.Equal(t, 4.0, getTask(t, ts, token, taskIDs[1]).ActualTime)
+	})
+
+	// Обновление задач, завершенной задаче нельзя изменить время
+	t.Run("Update", func(t *testing.T) {
+		require.Len(t, taskIDs, 2)
+
+		batch := models.TasksBatch{
+			Tasks: []*models.Task{
+				{ID: taskIDs[0], PlannedTime: 5},
+				{ID: taskIDs[1], PlannedTime: 5},
+				{ID: 999999, PlannedTime: 5},
+			},
+		}
+
+		result := doTasksBatch(t, ts, token, "PATCH", batch)
+		require.Len(t, result.Results, 3)
+		assert.Equal(t, http.StatusOK, result.Results[0].Status)
+		assert.Equal(t, http.StatusBadRequest, result.Results[1].Status)
+		assert.Equal(t, http.StatusNotFound, result.Results[2].Status)
+
+		assert.Equal(t, 5.0, getTask(t, ts, token, taskIDs[0]).PlannedTime)
+		assert.Equal(t, 3.0, getTask(t, ts, token, taskIDs[1]).PlannedTime)
+	})
+
+	// Чужие задачи не удаляются
+	t.Run("Delete", func(t *testing.T) {
+		require.Len(t, taskIDs, 2)
+
+		_, otherToken := doSignUpFakeUser(t, ts, models.User{
+			Username: gofakeit.Username(),
+			Password: getSomePassword(),
+		})
+		result := doTasksBatch(t, ts, otherToken, "DELETE", models.TasksIDs{IDs: taskIDs})
+		require.Len(t, result.Results, 2)
+		assert.Equal(t, http.StatusNotFound, result.Results[0].Status)
+		assert.Equal(t, http.StatusNotFound, result.Results[1].Status)
+
+		result = doTasksBatch(t, ts, token, "DELETE", models.TasksIDs{IDs: taskIDs})
+		require.Len(t, result.Results, 2)
+		for _, item := range result.Results {
+			assert.Equal(t, http.StatusOK, item.Status)
+
+			req, _ := http.NewRequest("GET", ts.GetURL()+"/api/tasks/"+strconv.FormatInt(item.ID, 10), nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		}
+	})
+
+	t.Run("Empty batch", func(t *testing.T) {
+		body, _ := json.Marshal(models.TasksBatch{})
+		req, _ := http.NewRequest("POST", ts.GetURL()+"/api/tasks/batch", bytes.NewBuffer(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+func TestTaskDone(t *testing.T) {
+	ts := suite.New(t)
+
+	user := models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	}
+	_, token := doSignUpFakeUser(t, ts, user)
+
+	task := models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 8,
+	}
+	taskID := createTask(t, ts, token, task)
+
+	patchTask := func(body string) int {
+		req, _ := http.NewRequest("PATCH", ts.GetURL()+"/api/tasks/"+strconv.FormatInt(taskID, 10), strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		return resp.StatusCode
+	}
+	taskDone := func(body string) int {
+		req, _ := http.NewRequest("POST", ts.GetURL()+"/api/tasks/"+strconv.FormatInt(taskID, 10)+"/done", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		return resp.StatusCode
+	}
+	transition := func(body string) int {
+		req, _ := http.NewRequest("POST", ts.GetURL()+"/api/tasks/"+strconv.FormatInt(taskID, 10)+"/transition", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	// Задача выполнена на 40%
+	assert.Equal(t, http.StatusBadRequest, patchTask(`{"percent_complete": 100}`))
+	assert.Equal(t, http.StatusOK, patchTask(`{"percent_complete": 40}`))
+	assert.Equal(t, 40.0, getTask(t, ts, token, taskID).PercentComplete)
+
+	// Задачу из бэклога нельзя сразу завершить
+	assert.Equal(t, http.StatusBadRequest, taskDone(`{"actual_time": 7.5}`))
+	assert.Equal(t, "backlog", getTask(t, ts, token, taskID).Status)
+	assert.Equal(t, http.StatusOK, transition(`{"status": "in_progress"}`))
+
+	assert.Equal(t, http.StatusBadRequest, taskDone(`{}`))
+	assert.Equal(t, http.StatusOK, taskDone(`{"actual_time": 7.5}`))
+
+	completed := getTask(t, ts, token, taskID)
+	assert.Equal(t, 7.5, completed.ActualTime)
+	assert.Equal(t, 100.0, completed.PercentComplete)
+	require.NotNil(t, completed.CompletedAt)
+
+	// Повторно завершить задачу и изменить прогресс нельзя
+	assert.Equal(t, http.StatusBadRequest, taskDone(`{"actual_time": 3}`))
+	assert.Equal(t, http.StatusBadRequest, patchTask(`{"percent_complete": 10}`))
+}
+
+func TestTransitionTask(t *testing.T) {
+	ts := suite.New(t)
+
+	_, token := doSignUpFakeUser(t, ts, models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	})
+	_, otherToken := doSignUpFakeUser(t, ts, models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	})
+
+	taskID := createTask(t, ts, token, models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 8,
+	})
+	assert.Equal(t, "backlog", getTask(t, ts, token, taskID).Status)
+
+	transition := func(token, body string) int {
+		req, _ := http.NewRequest("POST", ts.GetURL()+"/api/tasks/"+strconv.FormatInt(taskID, 10)+"/transition", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	// Недопустимые переходы и чужая задача
+	assert.Equal(t, http.StatusBadRequest, transition(token, `{"status": "review"}`))
+	assert.Equal(t, http.StatusBadRequest, transition(token, `{"status": "unknown"}`))
+	assert.Equal(t, http.StatusForbidden, transition(otherToken, `{"status": "in_progress"}`))
+
+	assert.Equal(t, http.StatusOK, transition(token, `{"status": "in_progress"}`))
+	assert.Equal(t, http.StatusOK, transition(token, `{"status": "blocked"}`))
+	assert.Equal(t, http.StatusOK, transition(token, `{"status": "in_progress"}`))
+
+	// Завершение требует фактическое время
+	assert.Equal(t, http.StatusBadRequest, transition(token, `{"status": "done"}`))
+	assert.Equal(t, http.StatusOK, transition(token, `{"status": "done", "actual_time": 5}`))
+
+	completed := getTask(t, ts, token, taskID)
+	assert.Equal(t, "done", completed.Status)
+	assert.Equal(t, 5.0, completed.ActualTime)
+	assert.Equal(t, 100.0, completed.PercentComplete)
+
+	// Выполненная задача не меняет статус
+	assert.Equal(t, http.StatusBadRequest, transition(token, `{"status": "in_progress"}`))
+
+	page := getTaskList(t, ts, token, "status=done")
+	require.Len(t, page.Tasks, 1)
+	assert.Equal(t, taskID, page.Tasks[0].ID)
+
+	t.Run("History", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", ts.GetURL()+"/api/tasks/"+strconv.FormatInt(taskID, 10)+"/history", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var history []entities.TaskStatusChange
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&history))
+		require.Len(t, history, 4)
+
+		expected := [][2]string{
+			{"backlog", "in_progress"},
+			{"in_progress", "blocked"},
+			{"blocked", "in_progress"},
+			{"in_progress", "done"},
+		}
+		for i, change := range history {
+			assert.Equal(t, expected[i][0], change.From)
+			assert.Equal(t, expected[i][1], change.To)
+			assert.NotZero(t, change.ChangedBy)
+		}
+	})
+}
+
+func TestWorkSessions(t *testing.T) {
+	ts := suite.New(t)
+
+	_, token := doSignUpFakeUser(t, ts, models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	})
+	_, otherToken := doSignUpFakeUser(t, ts, models.User{
+		Username: gofakeit.Username(),
+		Password: getSomePassword(),
+	})
+
+	taskID := createTask(t, ts, token, models.Task{
+		Title:       gofakeit.JobTitle(),
+		Description: gofakeit.JobDescriptor(),
+		PlannedTime: 8,
+	})
+	taskURL := ts.GetURL() + "/api/tasks/" + strconv.FormatInt(taskID, 10)
+
+	work := func(token, action string) (int, *entities.WorkSession) {
+		req, _ := http.NewRequest("POST", taskURL+"/work/"+action, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return resp.StatusCode, nil
+		}
+
+		var session entities.WorkSession
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&session))
+		return resp.StatusCode, &session
+	}
+
+	code, _ := work(otherToken, "start")
+	assert.Equal(t, http.StatusForbidden, code)
+	code, _ = work(otherToken, "stop")
+	assert.Equal(t, http.StatusForbidden, code)
+	code, _ = work(token, "stop")
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	// Остановка работы над несуществующей задачей
+	missingReq, _ := http.NewRequest("POST", ts.GetURL()+"/api/tasks/"+strconv.FormatInt(taskID+1000000, 10)+"/work/stop", nil)
+	missingReq.Header.Set("Authorization", "Bearer "+token)
+	missingResp, err := http.DefaultClient.Do(missingReq)
+	require.NoError(t, err)
+	missingResp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, missingResp.StatusCode)
+
+	// Начало работы переводит задачу в работу
+	code, session := work(token, "start")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, taskID, session.TaskID)
+	assert.Nil(t, session.StoppedAt)
+	assert.Equal(t, "in_progress", getTask(t, ts, token, taskID).Status)
+
+	code, _ = work(token, "start")
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, stopped := work(token, "stop")
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, session.ID, stopped.ID)
+	require.NotNil(t, stopped.StoppedAt)
+	assert.Greater(t, stopped.Time, 0.0)
+
+	code, _ = work(token, "start")
+	require.Equal(t, http.StatusOK, code)
+
+	// Фактическое время считается по сессиям, активная сессия закрывается
+	req, _ := http.NewRequest("POST", taskURL+"/done", strings.NewReader(`{"actual_time": 100}`))
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	completed := getTask(t, ts, token, taskID)
+	assert.Equal(t, "done", completed.Status)
+	assert.Greater(t, completed.ActualTime, 0.0)
+	assert.Less(t, completed.ActualTime, 1.0)
+
+	code, _ = work(token, "stop")
+	assert.Equal(t, http.StatusBadRequest, code)
+	code, _ = work(token, "start")
+	assert.Equal(t, http.StatusBadRequest, code)
 }
 
 func TestPredicTask(t *testing.T) {
@@ -559,6 +975,38 @@ func createTask(t *testing.T, ts *suite.Suite, token string, task models.Task) i
 	return id
 }
 
+func doTasksBatch(t *testing.T, ts *suite.Suite, token, method string, batch any) *entities.TasksBatchResult {
+	body, _ := json.Marshal(batch)
+	req, _ := http.NewRequest(method, ts.GetURL()+"/api/tasks/batch", bytes.NewBuffer(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var result entities.TasksBatchResult
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	return &result
+}
+
+func getTaskList(t *testing.T, ts *suite.Suite, token, query string) *entities.TaskList {
+	req, _ := http.NewRequest("GET", ts.GetURL()+"/api/tasks?"+query, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var taskList entities.TaskList
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&taskList))
+	return &taskList
+}
+
 func getTask(t *testing.T, ts *suite.Suite, token string, taskID int64) *models.Task {
 	req, _ := http.NewRequest("GET", ts.GetURL()+"/api/tasks/"+strconv.FormatInt(taskID, 10), nil)
 	req.Header.Set("Authorization", "Bearer "+token)
@@ -5,9 +5,11 @@ import (
 	"log/slog"
 
 	"github.com/liriquew/control_system/graphs_service/internal/lib/config"
+	"github.com/liriquew/control_system/graphs_service/internal/purge"
 	graphs_service "github.com/liriquew/control_system/graphs_service/internal/service/graphs"
 	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
 
+	groups_client "github.com/liriquew/control_system/graphs_service/internal/grpc/clients/groups"
 	tasks_client "github.com/liriquew/control_system/graphs_service/internal/grpc/clients/tasks"
 
 	grpcapp "github.com/liriquew/control_system/graphs_service/internal/app/grpc_app"
@@ -15,9 +17,10 @@ import (
 )
 
 type App struct {
-	GRPCServer *grpcapp.App
-	closers    []func() error
-	log        *slog.Logger
+	GRPCServer  *grpcapp.App
+	PurgeWorker *purge.Worker
+	closers     []func() error
+	log         *slog.Logger
 }
 
 func New(log *slog.Logger, cfg config.AppConfig) *App {
@@ -31,12 +34,19 @@ func New(log *slog.Logger, cfg config.AppConfig) *App {
 		panic(err)
 	}
 
-	service := graphs_service.New(log, storage, tasksClient)
+	groupsClient, err := groups_client.New(log, cfg.GroupsClient)
+	if err != nil {
+		panic(err)
+	}
+
+	purgeWorker := purge.New(log, storage, cfg.Purge)
+
+	service := graphs_service.New(log, storage, tasksClient, groupsClient)
 
 	app := grpcapp.New(log, service, cfg.ServiceConfig.Port)
 
-	mainApp := &App{GRPCServer: app, log: log}
-	mainApp.closers = append(mainApp.closers, storage.Close)
+	mainApp := &App{GRPCServer: app, log: log, PurgeWorker: purgeWorker}
+	mainApp.closers = append(mainApp.closers, purgeWorker.Close, storage.Close)
 	return mainApp
 }
 
@@ -37,16 +37,12 @@ func (nt *NodeWithTask) GetWeight() float64 {
 	return nt.Node.Weight
 }
 
-func (nt *NodeWithTask) GetAssignedTo() int64 {
-	return *nt.Node.AssignedTo
-}
-
-func (nt *NodeWithTask) GetAdditionalDependencies() []int64 {
-	return nt.AdditionalDependencies
+func (nt *NodeWithTask) GetLink(depNodeID int64) (int, float64) {
+	return nt.Node.GetLink(depNodeID)
 }
 
-func (nt *NodeWithTask) AddAdditionalDependency(ids ...int64) {
-	nt.AdditionalDependencies = append(nt.AdditionalDependencies, ids...)
+func (nt *NodeWithTask) GetAssignedTo() int64 {
+	return *nt.Node.AssignedTo
 }
 
 type PredictedNodes struct {
@@ -0,0 +1,74 @@
+package groupsclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	grpclog "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+
+	"github.com/liriquew/control_system/graphs_service/internal/lib/config"
+	grps_pb "github.com/liriquew/control_system/services_protos/groups_service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Client struct {
+	client grps_pb.GroupsClient
+	log    *slog.Logger
+}
+
+func New(log *slog.Logger, cfg config.ClientConfig) (*Client, error) {
+	const op = "groupsclient.New"
+
+	retryOpts := []grpcretry.CallOption{
+		grpcretry.WithCodes(codes.Aborted, codes.DeadlineExceeded),
+		grpcretry.WithMax(uint(cfg.Retries)),
+		grpcretry.WithPerRetryTimeout(cfg.Timeout),
+	}
+
+	logOpts := []grpclog.Option{
+		grpclog.WithLogOnEvents(grpclog.PayloadReceived, grpclog.PayloadSent),
+	}
+
+	address := fmt.Sprintf("%s:%s", cfg.Host, cfg.Port)
+	cc, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(
+			grpclog.UnaryClientInterceptor(InterceptorLogger(log), logOpts...),
+			grpcretry.UnaryClientInterceptor(retryOpts...),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &Client{
+		client: grps_pb.NewGroupsClient(cc),
+		log:    log,
+	}, nil
+}
+
+func InterceptorLogger(log *slog.Logger) grpclog.Logger {
+	return grpclog.LoggerFunc(func(ctx context.Context, level grpclog.Level, msg string, fields ...any) {
+		log.Log(ctx, slog.Level(level), msg, fields...)
+	})
+}
+
+var (
+	ErrInternal = errors.New("internal")
+)
+
+func (gc *Client) GetGroupCalendars(ctx context.Context, groupID int64) ([]*grps_pb.UserCalendar, error) {
+	resp, err := gc.client.GetGroupCalendars(ctx, &grps_pb.GroupID{
+		ID: groupID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternal, err)
+	}
+
+	return resp.Calendars, nil
+}
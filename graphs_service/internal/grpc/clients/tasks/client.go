@@ -14,6 +14,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -82,6 +83,34 @@ func (tc *Client) GetPredictedTasks(ctx context.Context, taskIDs []int64) ([]*ts
 	return resp.Tasks, resp.UnpredictedUIDs, nil
 }
 
+// PredictReassignedTasks - предсказывает время задач так, как если бы они были назначены
+// другим исполнителям, assignees - исполнитель для каждой задачи, сами задачи не изменяются
+func (tc *Client) PredictReassignedTasks(ctx context.Context, assignees map[int64]int64) ([]*tsks_pb.PredictedTask, []int64, error) {
+	tasks := make([]*tsks_pb.TaskAssignee, 0, len(assignees))
+	for taskID, assignedTo := range assignees {
+		tasks = append(tasks, &tsks_pb.TaskAssignee{
+			TaskID:     taskID,
+			AssignedTo: assignedTo,
+		})
+	}
+
+	resp, err := tc.client.PredictReassignedTasks(ctx, &tsks_pb.ReassignedTasks{
+		Tasks: tasks,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return nil, nil, ErrNotFound
+			}
+		}
+
+		return nil, nil, fmt.Errorf("%w: %w", ErrInternal, err)
+	}
+
+	return resp.Tasks, resp.UnpredictedUIDs, nil
+}
+
 func (tc *Client) TaskExists(ctx context.Context, taskID, groupID int64) error {
 	_, err := tc.client.TaskExists(ctx, &tsks_pb.TaskExistsRequest{
 		TaskID:  taskID,
@@ -100,3 +129,56 @@ func (tc *Client) TaskExists(ctx context.Context, taskID, groupID int64) error {
 
 	return nil
 }
+
+// outgoingContext - передает идентификатор пользователя из входящего запроса в сервис задач
+func outgoingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	if ids := md.Get("user-id"); len(ids) != 0 {
+		return metadata.AppendToOutgoingContext(ctx, "user-id", ids[0])
+	}
+	return ctx
+}
+
+func (tc *Client) GetGroupTasks(ctx context.Context, groupID int64) ([]*tsks_pb.Task, error) {
+	resp, err := tc.client.GetGroupTasks(ctx, &tsks_pb.GroupID{
+		ID: groupID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInternal, err)
+	}
+
+	return resp.Tasks, nil
+}
+
+// CreateTask - создает задачу от имени пользователя из входящего запроса,
+// повторные попытки отключены, чтобы не создать задачу дважды
+func (tc *Client) CreateTask(ctx context.Context, task *tsks_pb.Task) (int64, error) {
+	resp, err := tc.client.CreateTask(outgoingContext(ctx), task, grpcretry.Disable())
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInternal, err)
+	}
+
+	return resp.ID, nil
+}
+
+func (tc *Client) DeleteTask(ctx context.Context, taskID, groupID int64) error {
+	_, err := tc.client.DeleteTask(outgoingContext(ctx), &tsks_pb.TaskID{
+		ID:      taskID,
+		GroupID: groupID,
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return ErrNotFound
+			}
+		}
+
+		return fmt.Errorf("%w: %w", ErrInternal, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,307 @@
+package broker
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+)
+
+// размер буфера событий одного наблюдателя, если наблюдатель не успевает
+// читать события, его подписка закрывается
+const subscriberBuffer = 64
+
+// Subscription - подписка на события графа, канал Events закрывается
+// при отмене подписки или переполнении буфера
+type Subscription struct {
+	Events <-chan *grph_pb.GraphEvent
+
+	events  chan *grph_pb.GraphEvent
+	graphID int64
+	// подписка закрыта из-за переполнения буфера
+	overflowed bool
+}
+
+// Overflowed - true, если подписка закрыта из-за того, что наблюдатель не успевал читать события
+func (s *Subscription) Overflowed() bool {
+	return s.overflowed
+}
+
+type graphWatchers struct {
+	subscribers map[*Subscription]struct{}
+	// последние отправленные критические пути графа
+	paths [][]int64
+	// последнее отправленное событие срока сообщало об угрозе срыва срока
+	deadlineAtRisk bool
+
+	// сигнал воркеру графа о том, что граф изменился, буфер на одно событие,
+	// поэтому изменения, которые воркер еще не обработал, объединяются
+	changed chan struct{}
+	// закрывается, когда у графа не остается наблюдателей
+	stop chan struct{}
+}
+
+// ScheduleFunc - пересчитывает расписание графа и отправляет наблюдателям
+// события изменения критических путей и срока
+type ScheduleFunc func(graphID int64)
+
+// Broker - рассылает события изменения графов наблюдателям внутри процесса
+type Broker struct {
+	mu     sync.Mutex
+	graphs map[int64]*graphWatchers
+
+	schedule ScheduleFunc
+	debounce time.Duration
+}
+
+// New - создает брокер, schedule вызывается воркером графа не раньше, чем через debounce
+// после изменения графа, пока у графа есть наблюдатели, nil отключает пересчет расписания
+func New(schedule ScheduleFunc, debounce time.Duration) *Broker {
+	return &Broker{
+		graphs:   make(map[int64]*graphWatchers),
+		schedule: schedule,
+		debounce: debounce,
+	}
+}
+
+// Subscribe - подписывает на события графа graphID
+func (b *Broker) Subscribe(graphID int64) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	watchers, ok := b.graphs[graphID]
+	if !ok {
+		watchers = &graphWatchers{
+			subscribers: make(map[*Subscription]struct{}),
+			changed:     make(chan struct{}, 1),
+			stop:        make(chan struct{}),
+		}
+		b.graphs[graphID] = watchers
+
+		if b.schedule != nil {
+			go b.watch(graphID, watchers)
+		}
+	}
+
+	events := make(chan *grph_pb.GraphEvent, subscriberBuffer)
+	sub := &Subscription{
+		Events:  events,
+		events:  events,
+		graphID: graphID,
+	}
+	watchers.subscribers[sub] = struct{}{}
+
+	return sub
+}
+
+// Unsubscribe - отменяет подписку, повторная отмена ничего не делает
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.remove(sub)
+}
+
+func (b *Broker) remove(sub *Subscription) {
+	watchers, ok := b.graphs[sub.graphID]
+	if !ok {
+		return
+	}
+	if _, ok := watchers.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(watchers.subscribers, sub)
+	close(sub.events)
+	if len(watchers.subscribers) == 0 {
+		delete(b.graphs, sub.graphID)
+		close(watchers.stop)
+	}
+}
+
+// watch - воркер графа, пересчитывает расписание после изменений графа,
+// изменения, пришедшие за debounce после первого из них, объединяются в один пересчет,
+// воркер завершается, когда у графа не остается наблюдателей
+func (b *Broker) watch(graphID int64, watchers *graphWatchers) {
+	for {
+		select {
+		case <-watchers.stop:
+			return
+		case <-watchers.changed:
+		}
+
+		timer := time.NewTimer(b.debounce)
+		select {
+		case <-watchers.stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		// изменения, пришедшие во время ожидания, учтены этим пересчетом
+		select {
+		case <-watchers.changed:
+		default:
+		}
+
+		b.schedule(graphID)
+	}
+}
+
+// Reschedule - сообщает воркеру графа, что расписание графа нужно пересчитать,
+// не ждет пересчета
+func (b *Broker) Reschedule(graphID int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.reschedule(graphID)
+}
+
+func (b *Broker) reschedule(graphID int64) {
+	watchers, ok := b.graphs[graphID]
+	if !ok {
+		return
+	}
+
+	select {
+	case watchers.changed <- struct{}{}:
+	default:
+	}
+}
+
+// HasWatchers - true, если у графа есть наблюдатели
+func (b *Broker) HasWatchers(graphID int64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, ok := b.graphs[graphID]
+	return ok
+}
+
+// Publish - отправляет событие изменения графа всем наблюдателям графа event.GraphID
+// и сообщает воркеру графа, что расписание нужно пересчитать
+func (b *Broker) Publish(event *grph_pb.GraphEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.publish(event)
+	b.reschedule(event.GraphID)
+}
+
+func (b *Broker) publish(event *grph_pb.GraphEvent) {
+	watchers, ok := b.graphs[event.GraphID]
+	if !ok {
+		return
+	}
+
+	for sub := range watchers.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			sub.overflowed = true
+			b.remove(sub)
+		}
+	}
+}
+
+// PublishPaths - отправляет событие изменения критических путей, если пути
+// отличаются от последних отправленных, возвращает true, если событие отправлено
+func (b *Broker) PublishPaths(event *grph_pb.GraphEvent) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	watchers, ok := b.graphs[event.GraphID]
+	if !ok {
+		return false
+	}
+
+	paths := make([][]int64, 0, len(event.Paths))
+	for _, path := range event.Paths {
+		paths = append(paths, path.NodeIDs)
+	}
+	if watchers.paths != nil && slices.EqualFunc(watchers.paths, paths, slices.Equal) {
+		return false
+	}
+
+	watchers.paths = paths
+	b.publish(event)
+	return true
+}
+
+// SendPaths - отправляет текущие критические пути одному наблюдателю,
+// используется для первого события подписки
+func (b *Broker) SendPaths(sub *Subscription, event *grph_pb.GraphEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	watchers, ok := b.graphs[sub.graphID]
+	if !ok {
+		return
+	}
+	if _, ok := watchers.subscribers[sub]; !ok {
+		return
+	}
+
+	if watchers.paths == nil {
+		watchers.paths = make([][]int64, 0, len(event.Paths))
+		for _, path := range event.Paths {
+			watchers.paths = append(watchers.paths, path.NodeIDs)
+		}
+	}
+
+	select {
+	case sub.events <- event:
+	default:
+		sub.overflowed = true
+		b.remove(sub)
+	}
+}
+
+// PublishDeadline - отправляет событие DeadlineAtRisk или DeadlineRecovered, если срок графа
+// оказался под угрозой или перестал быть под угрозой с последнего отправленного события,
+// возвращает true, если событие отправлено
+func (b *Broker) PublishDeadline(event *grph_pb.GraphEvent) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	watchers, ok := b.graphs[event.GraphID]
+	if !ok {
+		return false
+	}
+
+	atRisk := event.Type == grph_pb.GraphEventType_DeadlineAtRisk
+	if watchers.deadlineAtRisk == atRisk {
+		return false
+	}
+
+	watchers.deadlineAtRisk = atRisk
+	b.publish(event)
+	return true
+}
+
+// SendDeadline - отправляет одному наблюдателю событие DeadlineAtRisk,
+// используется после первого события подписки, событие DeadlineRecovered не отправляется
+func (b *Broker) SendDeadline(sub *Subscription, event *grph_pb.GraphEvent) {
+	if event.Type != grph_pb.GraphEventType_DeadlineAtRisk {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	watchers, ok := b.graphs[sub.graphID]
+	if !ok {
+		return
+	}
+	if _, ok := watchers.subscribers[sub]; !ok {
+		return
+	}
+
+	select {
+	case sub.events <- event:
+	default:
+		sub.overflowed = true
+		b.remove(sub)
+	}
+}
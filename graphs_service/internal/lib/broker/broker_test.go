@@ -0,0 +1,194 @@
+package broker
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+)
+
+func pathsEvent(graphID int64, paths ...[]int64) *grph_pb.GraphEvent {
+	event := &grph_pb.GraphEvent{
+		Type:    grph_pb.GraphEventType_CriticalPathChanged,
+		GraphID: graphID,
+	}
+	for _, path := range paths {
+		event.Paths = append(event.Paths, &grph_pb.Path{NodeIDs: path})
+	}
+	return event
+}
+
+func TestBrokerPublish(t *testing.T) {
+	b := New(nil, 0)
+
+	sub1 := b.Subscribe(1)
+	sub2 := b.Subscribe(2)
+	if !b.HasWatchers(1) {
+		t.Fatal("graph 1 must have watchers")
+	}
+
+	event := &grph_pb.GraphEvent{Type: grph_pb.GraphEventType_NodeCreated, GraphID: 1}
+	b.Publish(event)
+
+	if len(sub1.Events) != 1 || <-sub1.Events != event {
+		t.Fatal("event must be sent to graph 1 watcher")
+	}
+	if len(sub2.Events) != 0 {
+		t.Fatal("event must not be sent to graph 2 watcher")
+	}
+
+	b.Unsubscribe(sub1)
+	b.Unsubscribe(sub1)
+	if b.HasWatchers(1) {
+		t.Fatal("graph 1 must not have watchers")
+	}
+	if _, ok := <-sub1.Events; ok {
+		t.Fatal("events channel must be closed")
+	}
+	if sub1.Overflowed() {
+		t.Fatal("subscription must not be overflowed")
+	}
+
+	// события графа без наблюдателей никуда не отправляются
+	b.Publish(event)
+	if len(sub2.Events) != 0 {
+		t.Fatal("event must not be sent to graph 2 watcher")
+	}
+}
+
+func TestBrokerPublishPaths(t *testing.T) {
+	b := New(nil, 0)
+
+	if b.PublishPaths(pathsEvent(1, []int64{1, 2})) {
+		t.Fatal("graph without watchers must not get events")
+	}
+
+	sub := b.Subscribe(1)
+	b.SendPaths(sub, pathsEvent(1, []int64{1, 2}))
+	if len(sub.Events) != 1 {
+		t.Fatal("initial paths must be sent")
+	}
+	<-sub.Events
+
+	// пути не изменились
+	if b.PublishPaths(pathsEvent(1, []int64{1, 2})) || len(sub.Events) != 0 {
+		t.Fatal("same paths must not be sent")
+	}
+
+	if !b.PublishPaths(pathsEvent(1, []int64{1, 3})) || len(sub.Events) != 1 {
+		t.Fatal("changed paths must be sent")
+	}
+	event := <-sub.Events
+	if !slices.Equal(event.Paths[0].NodeIDs, []int64{1, 3}) {
+		t.Errorf("expected path [1 3], got %v", event.Paths[0].NodeIDs)
+	}
+}
+
+func TestBrokerPublishDeadline(t *testing.T) {
+	b := New(nil, 0)
+
+	atRisk := &grph_pb.GraphEvent{Type: grph_pb.GraphEventType_DeadlineAtRisk, GraphID: 1}
+	recovered := &grph_pb.GraphEvent{Type: grph_pb.GraphEventType_DeadlineRecovered, GraphID: 1}
+
+	sub := b.Subscribe(1)
+	b.SendDeadline(sub, recovered)
+	if len(sub.Events) != 0 {
+		t.Fatal("initial recovered event must not be sent")
+	}
+	b.SendDeadline(sub, atRisk)
+	if len(sub.Events) != 1 {
+		t.Fatal("initial at risk event must be sent")
+	}
+	<-sub.Events
+
+	// срок не был под угрозой для остальных наблюдателей
+	if b.PublishDeadline(recovered) || len(sub.Events) != 0 {
+		t.Fatal("recovered event must not be sent before at risk event")
+	}
+	if !b.PublishDeadline(atRisk) || len(sub.Events) != 1 {
+		t.Fatal("at risk event must be sent")
+	}
+	<-sub.Events
+
+	if b.PublishDeadline(atRisk) || len(sub.Events) != 0 {
+		t.Fatal("same deadline state must not be sent")
+	}
+	if !b.PublishDeadline(recovered) || len(sub.Events) != 1 {
+		t.Fatal("recovered event must be sent")
+	}
+	if event := <-sub.Events; event != recovered {
+		t.Errorf("expected recovered event, got %v", event.Type)
+	}
+}
+
+func TestBrokerOverflow(t *testing.T) {
+	b := New(nil, 0)
+
+	sub := b.Subscribe(1)
+	for range subscriberBuffer + 1 {
+		b.Publish(&grph_pb.GraphEvent{GraphID: 1})
+	}
+
+	received := 0
+	for range sub.Events {
+		received++
+	}
+	if received != subscriberBuffer {
+		t.Errorf("expected %d events, got %d", subscriberBuffer, received)
+	}
+	if !sub.Overflowed() {
+		t.Error("subscription must be overflowed")
+	}
+	if b.HasWatchers(1) {
+		t.Error("overflowed watcher must be removed")
+	}
+}
+
+func TestBrokerReschedule(t *testing.T) {
+	const debounce = 20 * time.Millisecond
+
+	scheduled := make(chan int64, 10)
+	b := New(func(graphID int64) {
+		scheduled <- graphID
+	}, debounce)
+
+	// у графа без наблюдателей расписание не пересчитывается
+	b.Reschedule(1)
+
+	sub := b.Subscribe(1)
+	for range 5 {
+		b.Publish(&grph_pb.GraphEvent{Type: grph_pb.GraphEventType_NodeUpdated, GraphID: 1})
+	}
+
+	// изменения подряд объединяются в один пересчет
+	select {
+	case graphID := <-scheduled:
+		if graphID != 1 {
+			t.Fatalf("expected graph 1 to be scheduled, got %d", graphID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("graph must be scheduled after change")
+	}
+	select {
+	case <-scheduled:
+		t.Fatal("changes must be scheduled once")
+	case <-time.After(5 * debounce):
+	}
+
+	b.Reschedule(1)
+	select {
+	case <-scheduled:
+	case <-time.After(time.Second):
+		t.Fatal("graph must be scheduled after reschedule")
+	}
+
+	// после отмены подписки воркер графа завершается
+	b.Unsubscribe(sub)
+	b.Reschedule(1)
+	select {
+	case <-scheduled:
+		t.Fatal("graph without watchers must not be scheduled")
+	case <-time.After(5 * debounce):
+	}
+}
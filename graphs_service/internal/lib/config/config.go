@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"time"
 
@@ -10,7 +11,9 @@ import (
 type AppConfig struct {
 	ServiceConfig ServiceConfig `yaml:"service_config" env-required:"true"`
 	TasksClient   ClientConfig  `yaml:"tasks_service" env-required:"true"`
+	GroupsClient  ClientConfig  `yaml:"groups_service" env-required:"true"`
 	Storage       StorageConfig `yaml:"postgres" env-required:"true"`
+	Purge         PurgeConfig   `yaml:"purge"`
 }
 
 type ServiceConfig struct {
@@ -32,6 +35,25 @@ type StorageConfig struct {
 	DBName   string `yaml:"db_name" env-required:"true"`
 }
 
+// PurgeConfig - сколько хранятся удаленные вершины графов
+// и как часто они удаляются окончательно
+type PurgeConfig struct {
+	Retention time.Duration `yaml:"retention" env-default:"720h"`
+	Interval  time.Duration `yaml:"interval" env-default:"1h"`
+}
+
+// Validate - проверяет, что период удаления и срок хранения положительны
+func (c PurgeConfig) Validate() error {
+	if c.Interval <= 0 {
+		return fmt.Errorf("purge interval must be positive, got %s", c.Interval)
+	}
+	if c.Retention <= 0 {
+		return fmt.Errorf("purge retention must be positive, got %s", c.Retention)
+	}
+
+	return nil
+}
+
 func MustLoad() AppConfig {
 	path := fetchConfigPath()
 
@@ -57,5 +79,9 @@ func MustLoadPath(path string) AppConfig {
 		panic("error while reading config" + err.Error())
 	}
 
+	if err := cfg.Purge.Validate(); err != nil {
+		panic("invalid config: " + err.Error())
+	}
+
 	return cfg
 }
@@ -0,0 +1,170 @@
+package graphtools
+
+import (
+	"cmp"
+	"slices"
+
+	graphinterface "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/graph_interface"
+)
+
+// максимальное количество вершин в списках узких мест
+const maxHotSpots = 5
+
+// NodeDegree - количество входящих (FanIn) и исходящих (FanOut) ребер вершины
+type NodeDegree struct {
+	NodeID int64
+	FanIn  int
+	FanOut int
+}
+
+// GraphAnalysis - структурные характеристики графа
+type GraphAnalysis struct {
+	Nodes        int
+	Dependencies int
+	// количество компонент слабой связности
+	Components int
+	// самый длинный по количеству вершин путь
+	LongestPath []int64
+	// количество вершин на каждом уровне, уровень вершины -
+	// количество вершин в самом длинном пути, который в нее ведет, не считая ее саму
+	LevelWidths []int
+	// максимальное количество вершин, которые могут выполняться параллельно
+	MaxParallelism int
+	// вершины, от которых зависит больше всего вершин, и вершины,
+	// которые зависят от наибольшего количества вершин
+	FanOutHotSpots []NodeDegree
+	FanInHotSpots  []NodeDegree
+	// вершины, не связанные ни с одной другой вершиной
+	Orphans []int64
+}
+
+// AnalyzeGraph - вычисляет структурные характеристики графа,
+// граф не должен содержать циклов
+func AnalyzeGraph(graph graphinterface.GraphWithNodes) (*GraphAnalysis, error) {
+	components, err := CountConnectedComponents(graph)
+	if err != nil {
+		return nil, err
+	}
+
+	if cycle, has := HasCycle(graph); has {
+		return nil, &CycleError{Cycle: cycle}
+	}
+
+	s := newSolver(graph, make(map[int64]float64, graph.Len()), ScheduleOptions{})
+
+	res := &GraphAnalysis{
+		Nodes:      graph.Len(),
+		Components: components,
+	}
+
+	degrees := make([]NodeDegree, 0, graph.Len())
+	for _, node := range graph.GetNodes() {
+		degree := NodeDegree{
+			NodeID: node.GetID(),
+			FanIn:  len(s.predecessors(node.GetID())),
+			FanOut: len(s.successorsOf(node.GetID())),
+		}
+		res.Dependencies += degree.FanOut
+
+		if degree.FanIn == 0 && degree.FanOut == 0 {
+			res.Orphans = append(res.Orphans, node.GetID())
+		}
+		degrees = append(degrees, degree)
+	}
+	slices.Sort(res.Orphans)
+
+	res.FanOutHotSpots = hotSpots(degrees, func(d NodeDegree) int { return d.FanOut })
+	res.FanInHotSpots = hotSpots(degrees, func(d NodeDegree) int { return d.FanIn })
+
+	res.LevelWidths, res.LongestPath = s.levels()
+	for _, width := range res.LevelWidths {
+		res.MaxParallelism = max(res.MaxParallelism, width)
+	}
+
+	return res, nil
+}
+
+// predecessors - вершины графа, от которых зависит вершина, без последней вершины
+func (s *solver) predecessors(nodeID int64) []int64 {
+	return slices.DeleteFunc(slices.Clone(s.adjacencyListInversed[nodeID]), func(id int64) bool {
+		return id == dummyNodeID
+	})
+}
+
+// successorsOf - вершины графа, которые зависят от вершины, без последней вершины
+func (s *solver) successorsOf(nodeID int64) []int64 {
+	return slices.DeleteFunc(slices.Clone(s.adjacencyList[nodeID]), func(id int64) bool {
+		return id == dummyNodeID
+	})
+}
+
+// levels - возвращает количество вершин на каждом уровне графа и самый длинный путь
+func (s *solver) levels() ([]int, []int64) {
+	level := make(map[int64]int, len(s.adjacencyList))
+	prev := make(map[int64]int64, len(s.adjacencyList))
+
+	var (
+		widths   []int
+		lastNode int64
+		found    bool
+	)
+	for _, nodeID := range s.topologicalOrder() {
+		if nodeID == dummyNodeID {
+			continue
+		}
+
+		currLevel := level[nodeID]
+		for len(widths) <= currLevel {
+			widths = append(widths, 0)
+		}
+		widths[currLevel]++
+
+		// путь заканчивается в вершине с наибольшим уровнем,
+		// из нескольких таких вершин выбирается вершина с наименьшим идентификатором
+		if !found || currLevel > level[lastNode] || (currLevel == level[lastNode] && nodeID < lastNode) {
+			lastNode, found = nodeID, true
+		}
+
+		for _, nextNodeID := range s.successorsOf(nodeID) {
+			if nextLevel, ok := level[nextNodeID]; !ok || currLevel+1 > nextLevel {
+				level[nextNodeID] = currLevel + 1
+				prev[nextNodeID] = nodeID
+			}
+		}
+	}
+
+	if !found {
+		return widths, nil
+	}
+
+	path := []int64{lastNode}
+	for nodeID, ok := prev[lastNode]; ok; nodeID, ok = prev[nodeID] {
+		path = append(path, nodeID)
+	}
+	slices.Reverse(path)
+
+	return widths, path
+}
+
+// hotSpots - вершины с наибольшим значением degree, вершины со значением меньше 2 не учитываются
+func hotSpots(degrees []NodeDegree, degree func(NodeDegree) int) []NodeDegree {
+	var res []NodeDegree
+	for _, d := range degrees {
+		if degree(d) > 1 {
+			res = append(res, d)
+		}
+	}
+
+	slices.SortFunc(res, func(a, b NodeDegree) int {
+		if c := cmp.Compare(degree(b), degree(a)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.NodeID, b.NodeID)
+	})
+
+	if len(res) > maxHotSpots {
+		res = res[:maxHotSpots]
+	}
+
+	return res
+}
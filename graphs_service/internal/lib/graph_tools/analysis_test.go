@@ -0,0 +1,123 @@
+package graphtools
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/liriquew/control_system/graphs_service/internal/entities"
+	graph_wrapper "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/wrapper"
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+)
+
+func TestAnalyzeGraph(t *testing.T) {
+	//       1
+	//     / | \
+	//    2  3  4
+	//     \ | /
+	//       5 -> 6
+	//
+	//    7 -> 8     9
+	graph := &entities.GraphWithNodes{
+		Nodes: []*models.Node{
+			{ID: 1, DependencyNodeIDs: []int64{2, 3, 4}},
+			{ID: 2, DependencyNodeIDs: []int64{5}},
+			{ID: 3, DependencyNodeIDs: []int64{5}},
+			{ID: 4, DependencyNodeIDs: []int64{5}},
+			{ID: 5, DependencyNodeIDs: []int64{6}},
+			{ID: 6, DependencyNodeIDs: []int64{}},
+			{ID: 7, DependencyNodeIDs: []int64{8}},
+			{ID: 8, DependencyNodeIDs: []int64{}},
+			{ID: 9, DependencyNodeIDs: []int64{}},
+		},
+	}
+
+	analysis, err := AnalyzeGraph(graph_wrapper.WrapGraphWithNodes(graph))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if analysis.Nodes != 9 || analysis.Dependencies != 8 {
+		t.Errorf("Expected 9 nodes and 8 dependencies, got %d and %d", analysis.Nodes, analysis.Dependencies)
+	}
+	if analysis.Components != 3 {
+		t.Errorf("Expected 3 components, got %d", analysis.Components)
+	}
+	if want := []int64{1, 2, 5, 6}; !slices.Equal(analysis.LongestPath, want) {
+		t.Errorf("Expected longest path %v, got %v", want, analysis.LongestPath)
+	}
+	if want := []int{3, 4, 1, 1}; !slices.Equal(analysis.LevelWidths, want) {
+		t.Errorf("Expected level widths %v, got %v", want, analysis.LevelWidths)
+	}
+	if analysis.MaxParallelism != 4 {
+		t.Errorf("Expected max parallelism 4, got %d", analysis.MaxParallelism)
+	}
+	if want := []NodeDegree{{NodeID: 1, FanIn: 0, FanOut: 3}}; !slices.Equal(analysis.FanOutHotSpots, want) {
+		t.Errorf("Expected fan-out hot spots %v, got %v", want, analysis.FanOutHotSpots)
+	}
+	if want := []NodeDegree{{NodeID: 5, FanIn: 3, FanOut: 1}}; !slices.Equal(analysis.FanInHotSpots, want) {
+		t.Errorf("Expected fan-in hot spots %v, got %v", want, analysis.FanInHotSpots)
+	}
+	if want := []int64{9}; !slices.Equal(analysis.Orphans, want) {
+		t.Errorf("Expected orphans %v, got %v", want, analysis.Orphans)
+	}
+}
+
+func TestAnalyzeGraphHotSpotsLimit(t *testing.T) {
+	// вершина 100 зависит от всех остальных вершин
+	graph := &entities.GraphWithNodes{
+		Nodes: []*models.Node{{ID: 100, DependencyNodeIDs: []int64{}}},
+	}
+	for i := range int64(maxHotSpots + 2) {
+		graph.Nodes = append(graph.Nodes,
+			&models.Node{ID: i * 2, DependencyNodeIDs: []int64{i*2 + 1, 100}},
+			&models.Node{ID: i*2 + 1, DependencyNodeIDs: []int64{100}},
+		)
+	}
+
+	analysis, err := AnalyzeGraph(graph_wrapper.WrapGraphWithNodes(graph))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(analysis.FanOutHotSpots) != maxHotSpots {
+		t.Fatalf("Expected %d fan-out hot spots, got %v", maxHotSpots, analysis.FanOutHotSpots)
+	}
+	for i, hotSpot := range analysis.FanOutHotSpots {
+		if hotSpot.NodeID != int64(i*2) || hotSpot.FanOut != 2 {
+			t.Errorf("Unexpected hot spot %d: %+v", i, hotSpot)
+		}
+	}
+	if len(analysis.FanInHotSpots) != 1 || analysis.FanInHotSpots[0].NodeID != 100 {
+		t.Errorf("Expected node 100 as the only fan-in hot spot, got %v", analysis.FanInHotSpots)
+	}
+}
+
+func TestAnalyzeGraphErrors(t *testing.T) {
+	cycled := &entities.GraphWithNodes{
+		Nodes: []*models.Node{
+			{ID: 1, DependencyNodeIDs: []int64{2}},
+			{ID: 2, DependencyNodeIDs: []int64{1}},
+		},
+	}
+	if _, err := AnalyzeGraph(graph_wrapper.WrapGraphWithNodes(cycled)); !errors.Is(err, ErrCycleInGraph) {
+		t.Errorf("Expected ErrCycleInGraph, got %v", err)
+	}
+
+	unknown := &entities.GraphWithNodes{
+		Nodes: []*models.Node{
+			{ID: 1, DependencyNodeIDs: []int64{2}},
+		},
+	}
+	if _, err := AnalyzeGraph(graph_wrapper.WrapGraphWithNodes(unknown)); !errors.Is(err, ErrUnexpectedNodeInDeps) {
+		t.Errorf("Expected ErrUnexpectedNodeInDeps, got %v", err)
+	}
+
+	empty, err := AnalyzeGraph(graph_wrapper.WrapGraphWithNodes(&entities.GraphWithNodes{}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if empty.Components != 0 || len(empty.LongestPath) != 0 || empty.MaxParallelism != 0 {
+		t.Errorf("Unexpected analysis of empty graph: %+v", empty)
+	}
+}
@@ -0,0 +1,155 @@
+package graphtools
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// SnapshotNode - состояние вершины в сохраненном расписании
+type SnapshotNode struct {
+	NodeID       int64   `json:"node_id"`
+	TaskID       int64   `json:"task_id"`
+	Dependencies []int64 `json:"dependencies"`
+	// вес вершины, по которому строилось расписание
+	Weight float64 `json:"weight"`
+
+	EarlyStart  float64   `json:"early_start"`
+	EarlyFinish float64   `json:"early_finish"`
+	LateStart   float64   `json:"late_start"`
+	LateFinish  float64   `json:"late_finish"`
+	TotalFloat  float64   `json:"total_float"`
+	FreeFloat   float64   `json:"free_float"`
+	Start       time.Time `json:"start"`
+	Finish      time.Time `json:"finish"`
+}
+
+// Snapshot - сохраненное расписание графа
+type Snapshot struct {
+	StartAt  time.Time      `json:"start_at"`
+	FinishAt time.Time      `json:"finish_at"`
+	Duration float64        `json:"duration"`
+	Nodes    []SnapshotNode `json:"nodes"`
+	Paths    [][]int64      `json:"paths"`
+}
+
+// NodeDrift - отклонение вершины от сохраненного расписания
+type NodeDrift struct {
+	NodeID         int64
+	BaselineWeight float64
+	CurrentWeight  float64
+	// сдвиг начала и окончания вершины в часах,
+	// положительное значение означает задержку
+	StartDrift  float64
+	FinishDrift float64
+	// изменение полного резерва времени
+	TotalFloatDelta float64
+	// лежала ли вершина на критическом пути в сохраненном и текущем расписании
+	WasCritical bool
+	IsCritical  bool
+}
+
+// SnapshotDiff - результат сравнения текущего расписания с сохраненным
+type SnapshotDiff struct {
+	// изменение времени выполнения графа в часах
+	DurationDelta float64
+	// сдвиг окончания выполнения графа в часах
+	FinishDrift float64
+	// отклонения вершин, которые есть в обоих расписаниях
+	Nodes []NodeDrift
+
+	AddedNodes   []int64
+	RemovedNodes []int64
+	// зависимости в виде пар (from, to)
+	AddedDependencies   [][2]int64
+	RemovedDependencies [][2]int64
+}
+
+// CompareSnapshots - сравнивает текущее расписание current с сохраненным baseline
+// результаты упорядочены по идентификаторам вершин
+func CompareSnapshots(baseline, current *Snapshot) *SnapshotDiff {
+	diff := &SnapshotDiff{
+		DurationDelta: current.Duration - baseline.Duration,
+		FinishDrift:   current.FinishAt.Sub(baseline.FinishAt).Hours(),
+	}
+
+	baselineNodes := snapshotNodesMap(baseline)
+	currentNodes := snapshotNodesMap(current)
+
+	for _, node := range current.Nodes {
+		baseNode, ok := baselineNodes[node.NodeID]
+		if !ok {
+			diff.AddedNodes = append(diff.AddedNodes, node.NodeID)
+			continue
+		}
+
+		diff.Nodes = append(diff.Nodes, NodeDrift{
+			NodeID:          node.NodeID,
+			BaselineWeight:  baseNode.Weight,
+			CurrentWeight:   node.Weight,
+			StartDrift:      node.Start.Sub(baseNode.Start).Hours(),
+			FinishDrift:     node.Finish.Sub(baseNode.Finish).Hours(),
+			TotalFloatDelta: node.TotalFloat - baseNode.TotalFloat,
+			WasCritical:     baseNode.TotalFloat == 0,
+			IsCritical:      node.TotalFloat == 0,
+		})
+	}
+
+	for _, node := range baseline.Nodes {
+		if _, ok := currentNodes[node.NodeID]; !ok {
+			diff.RemovedNodes = append(diff.RemovedNodes, node.NodeID)
+		}
+	}
+
+	baselineDeps := snapshotDependencies(baseline)
+	currentDeps := snapshotDependencies(current)
+
+	for dep := range currentDeps {
+		if _, ok := baselineDeps[dep]; !ok {
+			diff.AddedDependencies = append(diff.AddedDependencies, dep)
+		}
+	}
+	for dep := range baselineDeps {
+		if _, ok := currentDeps[dep]; !ok {
+			diff.RemovedDependencies = append(diff.RemovedDependencies, dep)
+		}
+	}
+
+	slices.SortFunc(diff.Nodes, func(a, b NodeDrift) int {
+		return cmp.Compare(a.NodeID, b.NodeID)
+	})
+	slices.Sort(diff.AddedNodes)
+	slices.Sort(diff.RemovedNodes)
+	slices.SortFunc(diff.AddedDependencies, compareDependencies)
+	slices.SortFunc(diff.RemovedDependencies, compareDependencies)
+
+	return diff
+}
+
+func snapshotNodesMap(snapshot *Snapshot) map[int64]SnapshotNode {
+	res := make(map[int64]SnapshotNode, len(snapshot.Nodes))
+	for _, node := range snapshot.Nodes {
+		res[node.NodeID] = node
+	}
+
+	return res
+}
+
+func snapshotDependencies(snapshot *Snapshot) map[[2]int64]struct{} {
+	res := make(map[[2]int64]struct{})
+	for _, node := range snapshot.Nodes {
+		for _, depNodeID := range node.Dependencies {
+			res[[2]int64{node.NodeID, depNodeID}] = struct{}{}
+		}
+	}
+
+	return res
+}
+
+func compareDependencies(a, b [2]int64) int {
+	if a[0] != b[0] {
+		return cmp.Compare(a[0], b[0])
+	}
+
+	return cmp.Compare(a[1], b[1])
+}
@@ -0,0 +1,75 @@
+package graphtools
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCompareSnapshots(t *testing.T) {
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	at := func(hours float64) time.Time { return startAt.Add(hoursToDuration(hours)) }
+
+	// 1 -> 2 -> 3
+	baseline := &Snapshot{
+		StartAt:  startAt,
+		FinishAt: at(6),
+		Duration: 6,
+		Nodes: []SnapshotNode{
+			{NodeID: 1, Dependencies: []int64{2}, Weight: 1, Start: at(0), Finish: at(1)},
+			{NodeID: 2, Dependencies: []int64{3}, Weight: 2, Start: at(1), Finish: at(3)},
+			{NodeID: 3, Dependencies: []int64{}, Weight: 3, Start: at(3), Finish: at(6)},
+		},
+	}
+
+	// задача 2 стала дольше, вершина 3 удалена, добавлена вершина 4 параллельно вершине 2
+	current := &Snapshot{
+		StartAt:  startAt,
+		FinishAt: at(5),
+		Duration: 5,
+		Nodes: []SnapshotNode{
+			{NodeID: 1, Dependencies: []int64{2, 4}, Weight: 1, Start: at(0), Finish: at(1)},
+			{NodeID: 2, Dependencies: []int64{}, Weight: 4, Start: at(1), Finish: at(5)},
+			{NodeID: 4, Dependencies: []int64{}, Weight: 1, Start: at(1), Finish: at(2), TotalFloat: 3, FreeFloat: 3},
+		},
+	}
+
+	want := &SnapshotDiff{
+		DurationDelta: -1,
+		FinishDrift:   -1,
+		Nodes: []NodeDrift{
+			{NodeID: 1, BaselineWeight: 1, CurrentWeight: 1, WasCritical: true, IsCritical: true},
+			{NodeID: 2, BaselineWeight: 2, CurrentWeight: 4, FinishDrift: 2, WasCritical: true, IsCritical: true},
+		},
+		AddedNodes:          []int64{4},
+		RemovedNodes:        []int64{3},
+		AddedDependencies:   [][2]int64{{1, 4}},
+		RemovedDependencies: [][2]int64{{2, 3}},
+	}
+
+	got := CompareSnapshots(baseline, current)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CompareSnapshots() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCompareSnapshotsUnchanged(t *testing.T) {
+	snapshot := &Snapshot{
+		Duration: 2,
+		Nodes: []SnapshotNode{
+			{NodeID: 1, Dependencies: []int64{2}, Weight: 1},
+			{NodeID: 2, Dependencies: []int64{}, Weight: 1},
+		},
+	}
+
+	got := CompareSnapshots(snapshot, snapshot)
+	if got.DurationDelta != 0 || len(got.AddedNodes) != 0 || len(got.RemovedNodes) != 0 ||
+		len(got.AddedDependencies) != 0 || len(got.RemovedDependencies) != 0 {
+		t.Errorf("Expected no changes, got %+v", got)
+	}
+	for _, node := range got.Nodes {
+		if node.StartDrift != 0 || node.FinishDrift != 0 || node.BaselineWeight != node.CurrentWeight {
+			t.Errorf("Expected no drift for node %d, got %+v", node.NodeID, node)
+		}
+	}
+}
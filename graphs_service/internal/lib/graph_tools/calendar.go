@@ -0,0 +1,154 @@
+package graphtools
+
+import (
+	"time"
+)
+
+const (
+	// максимальное количество дней, которое просматривается при поиске рабочего времени,
+	// защищает от бесконечного поиска в календаре без рабочих дней
+	maxCalendarSearchDays = 366 * 5
+
+	dayOffLayout = "2006-01-02"
+)
+
+// Calendar - рабочий календарь исполнителя
+type Calendar struct {
+	// начало и конец рабочего дня, смещение от полуночи
+	workStart, workEnd time.Duration
+	// рабочие дни недели
+	workDays map[time.Weekday]struct{}
+	// выходные дни, ключ - дата в формате dayOffLayout
+	daysOff map[string]struct{}
+	// часовой пояс исполнителя
+	location *time.Location
+}
+
+// NewCalendar - создает календарь исполнителя
+// workStart, workEnd - начало и конец рабочего дня, смещение от полуночи,
+// рабочий день должен заканчиваться до полуночи
+// workDays - рабочие дни недели
+// daysOff - выходные дни, время внутри дня не учитывается
+// location - часовой пояс, в котором заданы рабочие часы и выходные дни
+func NewCalendar(workStart, workEnd time.Duration, workDays []time.Weekday, daysOff []time.Time, location *time.Location) *Calendar {
+	if location == nil {
+		location = time.UTC
+	}
+
+	c := &Calendar{
+		workStart: workStart,
+		workEnd:   workEnd,
+		workDays:  make(map[time.Weekday]struct{}, len(workDays)),
+		daysOff:   make(map[string]struct{}, len(daysOff)),
+		location:  location,
+	}
+
+	for _, day := range workDays {
+		c.workDays[day] = struct{}{}
+	}
+	for _, day := range daysOff {
+		c.daysOff[day.Format(dayOffLayout)] = struct{}{}
+	}
+
+	return c
+}
+
+// isWorkingDay - проверяет, является ли день рабочим
+func (c *Calendar) isWorkingDay(day time.Time) bool {
+	if _, ok := c.workDays[day.Weekday()]; !ok {
+		return false
+	}
+	_, dayOff := c.daysOff[day.Format(dayOffLayout)]
+
+	return !dayOff
+}
+
+// midnight - возвращает начало дня, в котором находится t
+func (c *Calendar) midnight(t time.Time) time.Time {
+	t = t.In(c.location)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, c.location)
+}
+
+// nextWorkingTime - возвращает ближайший рабочий момент, не раньше t
+func (c *Calendar) nextWorkingTime(t time.Time) time.Time {
+	day := c.midnight(t)
+	for range maxCalendarSearchDays {
+		if c.isWorkingDay(day) {
+			workStart, workEnd := day.Add(c.workStart), day.Add(c.workEnd)
+			if t.Before(workEnd) {
+				if t.Before(workStart) {
+					return workStart
+				}
+				return t
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+
+	// рабочего времени не нашлось, считаем исполнителя доступным
+	return t
+}
+
+// prevWorkingTime - возвращает ближайший рабочий момент, не позже t
+func (c *Calendar) prevWorkingTime(t time.Time) time.Time {
+	day := c.midnight(t)
+	for range maxCalendarSearchDays {
+		if c.isWorkingDay(day) {
+			workStart, workEnd := day.Add(c.workStart), day.Add(c.workEnd)
+			if t.After(workStart) {
+				if t.After(workEnd) {
+					return workEnd
+				}
+				return t
+			}
+		}
+		day = day.AddDate(0, 0, -1)
+	}
+
+	return t
+}
+
+// addWorkingTime - возвращает момент окончания работы длительностью d,
+// начатой не раньше t, работа прерывается на нерабочее время
+func (c *Calendar) addWorkingTime(t time.Time, d time.Duration) time.Time {
+	t = c.nextWorkingTime(t)
+	for range maxCalendarSearchDays {
+		workEnd := c.midnight(t).Add(c.workEnd)
+		if !t.Before(workEnd) {
+			// рабочего времени не нашлось
+			break
+		}
+
+		available := workEnd.Sub(t)
+		if d <= available {
+			return t.Add(d)
+		}
+
+		d -= available
+		t = c.nextWorkingTime(workEnd)
+	}
+
+	return t.Add(d)
+}
+
+// subtractWorkingTime - возвращает самый поздний момент начала работы длительностью d,
+// которая должна быть закончена не позже t
+func (c *Calendar) subtractWorkingTime(t time.Time, d time.Duration) time.Time {
+	t = c.prevWorkingTime(t)
+	for range maxCalendarSearchDays {
+		workStart := c.midnight(t).Add(c.workStart)
+		if !t.After(workStart) {
+			break
+		}
+
+		available := t.Sub(workStart)
+		if d <= available {
+			return t.Add(-d)
+		}
+
+		d -= available
+		t = c.prevWorkingTime(workStart)
+	}
+
+	return t.Add(-d)
+}
@@ -0,0 +1,99 @@
+package graphtools
+
+import (
+	"testing"
+	"time"
+)
+
+func newWeekCalendar(daysOff ...time.Time) *Calendar {
+	return NewCalendar(
+		9*time.Hour, 18*time.Hour,
+		[]time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		daysOff,
+		time.UTC,
+	)
+}
+
+func TestCalendarAddWorkingTime(t *testing.T) {
+	// 2025-03-07 - пятница
+	friday := func(hour int) time.Time { return time.Date(2025, time.March, 7, hour, 0, 0, 0, time.UTC) }
+	monday := func(hour int) time.Time { return time.Date(2025, time.March, 10, hour, 0, 0, 0, time.UTC) }
+	tuesday := func(hour int) time.Time { return time.Date(2025, time.March, 11, hour, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name     string
+		calendar *Calendar
+		start    time.Time
+		duration time.Duration
+		want     time.Time
+	}{
+		{
+			name:     "Within working day",
+			calendar: newWeekCalendar(),
+			start:    friday(10),
+			duration: 3 * time.Hour,
+			want:     friday(13),
+		},
+		{
+			name:     "Start before working day",
+			calendar: newWeekCalendar(),
+			start:    friday(6),
+			duration: 2 * time.Hour,
+			want:     friday(11),
+		},
+		{
+			name:     "Over the weekend",
+			calendar: newWeekCalendar(),
+			start:    friday(16),
+			duration: 4 * time.Hour,
+			want:     monday(11),
+		},
+		{
+			name:     "Over the weekend and day off",
+			calendar: newWeekCalendar(monday(0)),
+			start:    friday(16),
+			duration: 4 * time.Hour,
+			want:     tuesday(11),
+		},
+		{
+			name:     "Start at the end of working day",
+			calendar: newWeekCalendar(),
+			start:    friday(18),
+			duration: time.Hour,
+			want:     monday(10),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.calendar.addWorkingTime(tt.start, tt.duration)
+			if !got.Equal(tt.want) {
+				t.Errorf("addWorkingTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarSubtractWorkingTime(t *testing.T) {
+	friday := func(hour int) time.Time { return time.Date(2025, time.March, 7, hour, 0, 0, 0, time.UTC) }
+	monday := func(hour int) time.Time { return time.Date(2025, time.March, 10, hour, 0, 0, 0, time.UTC) }
+
+	calendar := newWeekCalendar()
+
+	if got := calendar.subtractWorkingTime(monday(11), 4*time.Hour); !got.Equal(friday(16)) {
+		t.Errorf("subtractWorkingTime() = %v, want %v", got, friday(16))
+	}
+	if got := calendar.subtractWorkingTime(friday(20), 2*time.Hour); !got.Equal(friday(16)) {
+		t.Errorf("subtractWorkingTime() = %v, want %v", got, friday(16))
+	}
+}
+
+func TestCalendarWithoutWorkingDays(t *testing.T) {
+	calendar := NewCalendar(9*time.Hour, 18*time.Hour, nil, nil, time.UTC)
+	start := time.Date(2025, time.March, 7, 10, 0, 0, 0, time.UTC)
+
+	// рабочего времени нет, календарь не должен зависать
+	if got := calendar.addWorkingTime(start, time.Hour); !got.Equal(start.Add(time.Hour)) {
+		t.Errorf("addWorkingTime() = %v, want %v", got, start.Add(time.Hour))
+	}
+}
@@ -0,0 +1,238 @@
+package graphtools
+
+import (
+	"maps"
+	"math"
+	"slices"
+
+	graphinterface "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/graph_interface"
+)
+
+// CrashOption - возможность сокращения вершины за счет дополнительных затрат
+type CrashOption struct {
+	// время, меньше которого вершину сократить нельзя
+	MinDuration float64
+	// стоимость сокращения вершины на один час
+	CostPerHour float64
+}
+
+// NodeCrash - сокращение времени одной вершины
+type NodeCrash struct {
+	NodeID int64
+	// время вершины до сокращения
+	Duration float64
+	// время вершины после сокращения
+	CrashedDuration float64
+	Cost            float64
+}
+
+// CrashPlan - результат сокращения расписания графа
+type CrashPlan struct {
+	// время выполнения графа до и после сокращения
+	InitialDuration float64
+	Duration        float64
+	// true, если время выполнения графа не превышает целевое
+	Met   bool
+	Cost  float64
+	Nodes []NodeCrash
+}
+
+// CrashSchedule - подбирает самые дешевые сокращения вершин, после которых граф
+// выполняется не дольше target часов, вершины без crashOptions не сокращаются
+// на каждом шаге выбирается набор вершин минимальной стоимости, который пересекает
+// все критические пути (минимальный разрез сети критических путей), и вершины набора
+// сокращаются, пока не закончится резерв вершин, резерв некритических вершин
+// или не будет достигнута цель, после чего расписание строится заново
+func CrashSchedule[T graphinterface.GraphWithNodes](graph T, nodesValueMap map[int64]float64, crashOptions map[int64]CrashOption, opts ScheduleOptions, target float64) (*CrashPlan, error) {
+	if _, ok := priorityRules[opts.Priority]; !ok {
+		return nil, ErrUnknownPriority
+	}
+
+	// в случае, если в графе есть цикл, вернем ошибку
+	if cycle, has := HasCycle(graph); has {
+		return nil, &CycleError{Cycle: cycle}
+	}
+
+	weights := maps.Clone(nodesValueMap)
+	solver := newSolver(graph, maps.Clone(weights), opts)
+	paths := solver.solve()
+
+	res := &CrashPlan{
+		InitialDuration: solver.duration(),
+		Duration:        solver.duration(),
+	}
+
+	// резерв сокращения вершины в часах
+	room := func(nodeID int64) float64 {
+		option, ok := crashOptions[nodeID]
+		if !ok {
+			return 0
+		}
+		return max(weights[nodeID]-option.MinDuration, 0)
+	}
+
+	// каждый шаг либо достигает цели, либо исчерпывает резерв вершины,
+	// либо делает критической еще одну вершину
+	for range 4*graph.Len() + 1 {
+		if !floatLess(target, res.Duration) {
+			break
+		}
+
+		cut, ok := minCostCut(paths, func(nodeID int64) float64 {
+			if !floatLess(0, room(nodeID)) {
+				return math.Inf(1)
+			}
+			return crashOptions[nodeID].CostPerHour
+		})
+		if !ok {
+			break
+		}
+
+		step := res.Duration - target
+		for _, nodeID := range cut {
+			step = min(step, room(nodeID))
+		}
+		// некритические вершины становятся критическими после сокращения на их полный резерв
+		for _, nodeSchedule := range solver.schedule() {
+			if floatLess(0, nodeSchedule.TotalFloat) {
+				step = min(step, nodeSchedule.TotalFloat)
+			}
+		}
+
+		for _, nodeID := range cut {
+			weights[nodeID] -= step
+		}
+
+		nodes := make([]graphinterface.Node, 0, graph.Len())
+		for _, node := range graph.GetNodes() {
+			nodes = append(nodes, &sampledNode{Node: node, weight: weights[node.GetID()]})
+		}
+
+		solver = newSolver(&sampledGraph{nodes: nodes}, maps.Clone(weights), opts)
+		paths = solver.solve()
+		res.Duration = solver.duration()
+	}
+
+	res.Met = !floatLess(target, res.Duration)
+	for _, nodeID := range slices.Sorted(maps.Keys(weights)) {
+		crashed := nodesValueMap[nodeID] - weights[nodeID]
+		if !floatLess(0, crashed) {
+			continue
+		}
+
+		nodeCrash := NodeCrash{
+			NodeID:          nodeID,
+			Duration:        nodesValueMap[nodeID],
+			CrashedDuration: weights[nodeID],
+			Cost:            crashed * crashOptions[nodeID].CostPerHour,
+		}
+		res.Cost += nodeCrash.Cost
+		res.Nodes = append(res.Nodes, nodeCrash)
+	}
+
+	return res, nil
+}
+
+// minCostCut - находит набор вершин минимальной стоимости, который пересекает
+// все пути сети, составленной из путей paths, стоимость вершины задает cost,
+// бесконечная стоимость означает, что вершину нельзя включить в набор,
+// false, если такого набора нет
+// разрез ищется как минимальный разрез сети, в которой каждая вершина разделена
+// на вход и выход, соединенные ребром с пропускной способностью, равной стоимости вершины
+func minCostCut(paths [][]int64, cost func(int64) float64) ([]int64, bool) {
+	// индексы входа и выхода вершины в сети, 0 - исток, 1 - сток
+	const source, sink = 0, 1
+	index := make(map[int64]int)
+	var nodeIDs []int64
+	in := func(nodeID int64) int {
+		if i, ok := index[nodeID]; ok {
+			return i
+		}
+		index[nodeID] = 2 + 2*len(nodeIDs)
+		nodeIDs = append(nodeIDs, nodeID)
+		return index[nodeID]
+	}
+
+	capacity := make(map[[2]int]float64)
+	adjacency := make(map[int][]int)
+	addEdge := func(from, to int, c float64) {
+		if _, ok := capacity[[2]int{from, to}]; !ok {
+			adjacency[from] = append(adjacency[from], to)
+			adjacency[to] = append(adjacency[to], from)
+		}
+		capacity[[2]int{from, to}] += c
+	}
+
+	for _, path := range paths {
+		for i, nodeID := range path {
+			if i == 0 {
+				addEdge(source, in(nodeID), math.Inf(1))
+			} else {
+				addEdge(in(path[i-1])+1, in(nodeID), math.Inf(1))
+			}
+		}
+		addEdge(in(path[len(path)-1])+1, sink, math.Inf(1))
+	}
+	if len(nodeIDs) == 0 {
+		return nil, false
+	}
+	for _, nodeID := range nodeIDs {
+		addEdge(index[nodeID], index[nodeID]+1, cost(nodeID))
+	}
+
+	// поиск увеличивающих путей в ширину (алгоритм Эдмондса-Карпа),
+	// возвращает вершины сети, достижимые из истока в остаточной сети
+	bfs := func() (map[int]int, bool) {
+		prev := map[int]int{source: source}
+		queue := []int{source}
+		for len(queue) > 0 {
+			curr := queue[0]
+			queue = queue[1:]
+			for _, next := range adjacency[curr] {
+				if _, ok := prev[next]; ok || capacity[[2]int{curr, next}] <= 0 {
+					continue
+				}
+				prev[next] = curr
+				if next == sink {
+					return prev, true
+				}
+				queue = append(queue, next)
+			}
+		}
+		return prev, false
+	}
+
+	for {
+		prev, found := bfs()
+		if !found {
+			break
+		}
+
+		flow := math.Inf(1)
+		for v := sink; v != source; v = prev[v] {
+			flow = min(flow, capacity[[2]int{prev[v], v}])
+		}
+		// путь из вершин, которые нельзя сократить
+		if math.IsInf(flow, 1) {
+			return nil, false
+		}
+		for v := sink; v != source; v = prev[v] {
+			capacity[[2]int{prev[v], v}] -= flow
+			capacity[[2]int{v, prev[v]}] += flow
+		}
+	}
+
+	// в разрез входят вершины, вход которых достижим из истока, а выход - нет
+	reachable, _ := bfs()
+	var cut []int64
+	for _, nodeID := range nodeIDs {
+		_, inReachable := reachable[index[nodeID]]
+		_, outReachable := reachable[index[nodeID]+1]
+		if inReachable && !outReachable {
+			cut = append(cut, nodeID)
+		}
+	}
+	slices.Sort(cut)
+
+	return cut, true
+}
@@ -0,0 +1,179 @@
+package graphtools
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/liriquew/control_system/graphs_service/internal/entities"
+	graph_wrapper "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/wrapper"
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+)
+
+func TestCrashSchedule(t *testing.T) {
+	// 1 -> 2 -> 3
+	chain := entities.GraphWithTasks{
+		Nodes: []*entities.NodeWithTask{
+			{Node: &models.Node{ID: 1, DependencyNodeIDs: []int64{2}, AssignedTo: ptrInt64(1)}},
+			{Node: &models.Node{ID: 2, DependencyNodeIDs: []int64{3}, AssignedTo: ptrInt64(2)}},
+			{Node: &models.Node{ID: 3, DependencyNodeIDs: []int64{}, AssignedTo: ptrInt64(3)}},
+		},
+	}
+	chainValues := map[int64]float64{1: 2, 2: 5, 3: 1}
+	chainOptions := map[int64]CrashOption{
+		1: {MinDuration: 1, CostPerHour: 10},
+		2: {MinDuration: 2, CostPerHour: 3},
+		3: {MinDuration: 0.5, CostPerHour: 1},
+	}
+
+	// 1 -> 2 -> 4
+	// 1 -> 3 -> 4
+	diamond := entities.GraphWithTasks{
+		Nodes: []*entities.NodeWithTask{
+			{Node: &models.Node{ID: 1, DependencyNodeIDs: []int64{2, 3}, AssignedTo: ptrInt64(1)}},
+			{Node: &models.Node{ID: 2, DependencyNodeIDs: []int64{4}, AssignedTo: ptrInt64(2)}},
+			{Node: &models.Node{ID: 3, DependencyNodeIDs: []int64{4}, AssignedTo: ptrInt64(3)}},
+			{Node: &models.Node{ID: 4, DependencyNodeIDs: []int64{}, AssignedTo: ptrInt64(4)}},
+		},
+	}
+	diamondValues := map[int64]float64{1: 1, 2: 5, 3: 4, 4: 1}
+	diamondOptions := map[int64]CrashOption{
+		1: {MinDuration: 0.5, CostPerHour: 10},
+		2: {MinDuration: 1, CostPerHour: 2},
+		3: {MinDuration: 1, CostPerHour: 3},
+	}
+
+	tests := []struct {
+		name          string
+		graph         entities.GraphWithTasks
+		nodesValueMap map[int64]float64
+		crashOptions  map[int64]CrashOption
+		target        float64
+		want          CrashPlan
+	}{
+		{
+			name:          "Target is already met",
+			graph:         chain,
+			nodesValueMap: chainValues,
+			crashOptions:  chainOptions,
+			target:        10,
+			want:          CrashPlan{InitialDuration: 8, Duration: 8, Met: true},
+		},
+		{
+			name:          "Cheapest nodes are crashed first",
+			graph:         chain,
+			nodesValueMap: chainValues,
+			crashOptions:  chainOptions,
+			target:        5,
+			want: CrashPlan{
+				InitialDuration: 8,
+				Duration:        5,
+				Met:             true,
+				Cost:            8,
+				Nodes: []NodeCrash{
+					{NodeID: 2, Duration: 5, CrashedDuration: 2.5, Cost: 7.5},
+					{NodeID: 3, Duration: 1, CrashedDuration: 0.5, Cost: 0.5},
+				},
+			},
+		},
+		{
+			// после сокращения вершины 2 на 1 час оба пути становятся критическими,
+			// сокращать вершины 2 и 3 вместе дешевле, чем общую вершину 1
+			name:          "Parallel critical paths",
+			graph:         diamond,
+			nodesValueMap: diamondValues,
+			crashOptions:  diamondOptions,
+			target:        3,
+			want: CrashPlan{
+				InitialDuration: 7,
+				Duration:        3,
+				Met:             true,
+				Cost:            17,
+				Nodes: []NodeCrash{
+					{NodeID: 2, Duration: 5, CrashedDuration: 1, Cost: 8},
+					{NodeID: 3, Duration: 4, CrashedDuration: 1, Cost: 9},
+				},
+			},
+		},
+		{
+			name:          "Target can't be met",
+			graph:         chain,
+			nodesValueMap: chainValues,
+			crashOptions:  chainOptions,
+			target:        1,
+			want: CrashPlan{
+				InitialDuration: 8,
+				Duration:        3.5,
+				Cost:            19.5,
+				Nodes: []NodeCrash{
+					{NodeID: 1, Duration: 2, CrashedDuration: 1, Cost: 10},
+					{NodeID: 2, Duration: 5, CrashedDuration: 2, Cost: 9},
+					{NodeID: 3, Duration: 1, CrashedDuration: 0.5, Cost: 0.5},
+				},
+			},
+		},
+		{
+			name:          "Nodes without options are not crashed",
+			graph:         chain,
+			nodesValueMap: chainValues,
+			target:        5,
+			want:          CrashPlan{InitialDuration: 8, Duration: 8},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := CrashSchedule(graph_wrapper.WrapGraphWithTasks(&tt.graph), tt.nodesValueMap, tt.crashOptions, ScheduleOptions{}, tt.target)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if math.Abs(res.InitialDuration-tt.want.InitialDuration) > 1e-9 || math.Abs(res.Duration-tt.want.Duration) > 1e-9 {
+				t.Errorf("Expected durations %v -> %v, got %v -> %v", tt.want.InitialDuration, tt.want.Duration, res.InitialDuration, res.Duration)
+			}
+			if res.Met != tt.want.Met {
+				t.Errorf("Expected met %v, got %v", tt.want.Met, res.Met)
+			}
+			if math.Abs(res.Cost-tt.want.Cost) > 1e-9 {
+				t.Errorf("Expected cost %v, got %v", tt.want.Cost, res.Cost)
+			}
+			if !slices.Equal(res.Nodes, tt.want.Nodes) {
+				t.Errorf("Expected nodes %v, got %v", tt.want.Nodes, res.Nodes)
+			}
+		})
+	}
+
+	t.Run("Cycle", func(t *testing.T) {
+		cycled := entities.GraphWithTasks{
+			Nodes: []*entities.NodeWithTask{
+				{Node: &models.Node{ID: 1, DependencyNodeIDs: []int64{2}, AssignedTo: ptrInt64(1)}},
+				{Node: &models.Node{ID: 2, DependencyNodeIDs: []int64{1}, AssignedTo: ptrInt64(2)}},
+			},
+		}
+
+		if _, err := CrashSchedule(graph_wrapper.WrapGraphWithTasks(&cycled), nil, nil, ScheduleOptions{}, 1); !errors.Is(err, ErrCycleInGraph) {
+			t.Errorf("Expected %v, got %v", ErrCycleInGraph, err)
+		}
+	})
+}
+
+func TestMinCostCut(t *testing.T) {
+	costs := map[int64]float64{1: 10, 2: 2, 3: 3, 4: math.Inf(1)}
+	cost := func(nodeID int64) float64 { return costs[nodeID] }
+
+	cut, ok := minCostCut([][]int64{{1, 2, 4}, {1, 3, 4}}, cost)
+	if !ok || !slices.Equal(cut, []int64{2, 3}) {
+		t.Errorf("Expected cut [2 3], got %v, %v", cut, ok)
+	}
+
+	costs[3] = 9
+	cut, ok = minCostCut([][]int64{{1, 2, 4}, {1, 3, 4}}, cost)
+	if !ok || !slices.Equal(cut, []int64{1}) {
+		t.Errorf("Expected cut [1], got %v, %v", cut, ok)
+	}
+
+	if _, ok := minCostCut([][]int64{{4}}, cost); ok {
+		t.Error("Path of uncrashable nodes must not be cut")
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"errors"
 	"math"
 	"slices"
-	"sort"
+	"time"
 
 	"github.com/liriquew/control_system/graphs_service/internal/entities"
 	graphinterface "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/graph_interface"
@@ -13,55 +13,72 @@ import (
 
 // Хранит состояние текущей вершины
 type nodeAmount struct {
-	min                 float64 // Раннее начало (ES - early start)
-	max                 float64 // Позднее начало (LS - late start)
+	min                 float64 // Позднее начало (LS - late start)
+	max                 float64 // Раннее начало (ES - early start)
+	earlyFinish         float64 // Раннее окончание (EF - early finish)
+	lateFinish          float64 // Позднее окончание (LF - late finish)
 	graphinterface.Node         // сама вершина
 }
 
-// Обновляет ES вершины
+// Обновляет LS вершины
 func (n *nodeAmount) SetMin(val float64) {
 	if n.min > val {
 		n.min = val
 	}
 }
 
-// Обновляет LS вершины
+// Обновляет ES вершины
 func (n *nodeAmount) SetMax(val float64) {
 	if n.max < val {
 		n.max = val
 	}
 }
 
-type less func(graphinterface.Node, graphinterface.Node) bool
+// isCritical - вершина лежит на критическом пути, если ее ES == LS
+func (n *nodeAmount) isCritical() bool {
+	return floatEqual(n.min, n.max)
+}
 
-const (
-	// Константы для выбора приоритета
-	MinTimePriority = iota
-	MaxTimePriority
+// floatEqual - сравнивает времена с учетом погрешности вычислений,
+// так как при вычислении LS время вершин вычитается в обратном порядке
+func floatEqual(a, b float64) bool {
+	return math.Abs(a-b) <= timeEpsilon*max(1, math.Abs(a), math.Abs(b))
+}
+
+// floatLess - строгое сравнение времен с учетом погрешности вычислений
+func floatLess(a, b float64) bool {
+	return a < b && !floatEqual(a, b)
+}
 
+const (
 	// В случае, если есть несколько вершин, которые не имеют исходящих ребер,
 	// то есть несколько финальных вершин
 	// необходимо добавить вершину, которая будет аккумулировать состояния предыдущих
 	dummyNodeID int64 = -1
-)
 
-// Приоритеты
-var lessFuncMap map[int]less = map[int]less{
-	MinTimePriority: func(n1, n2 graphinterface.Node) bool {
-		// вершины с меньшим весом приоритетнее
-		return n1.GetWeight() < n2.GetWeight()
-	},
-	MaxTimePriority: func(n1, n2 graphinterface.Node) bool {
-		// вершины с большим весом приоритетнее
-		return n1.GetWeight() > n2.GetWeight()
-	},
-}
+	// допустимая относительная погрешность при сравнении времен
+	timeEpsilon = 1e-9
+)
 
 var (
 	// Будет возвращено, в случае, если граф содержит цикл
 	ErrCycleInGraph = errors.New("cycle detected")
+	// Будет возвращено, в случае, если правило приоритета не существует
+	ErrUnknownPriority = errors.New("unknown priority")
 )
 
+// ScheduleOptions - параметры построения расписания
+type ScheduleOptions struct {
+	// правило приоритета, определяет порядок, в котором
+	// вершины претендуют на время исполнителя
+	Priority int
+	// момент начала выполнения графа, от него отсчитываются времена вершин
+	StartAt time.Time
+	// рабочие календари исполнителей, ключ - идентификатор исполнителя,
+	// исполнитель без календаря доступен в любое время
+	Calendars map[int64]*Calendar
+}
+
 // Возвращает список индентификаторов вершин, которые не имеют исходящих ребер
 func getLastNodes(adjacencyList map[int64][]int64) []int64 {
 	res := []int64{}
@@ -88,19 +105,35 @@ type solver struct {
 
 	graph graphinterface.GraphWithNodes
 
-	// функция, задающая приоритет задач
-	// в случае, если выполнение каких-то задач накладывается
-	priorityFunc less
+	// правило, задающее приоритет задач
+	// в случае, если на исполнителя претендуют несколько задач
+	priorityRule priorityRule
+
+	// момент начала выполнения графа
+	startAt time.Time
+	// рабочие календари исполнителей
+	calendars map[int64]*Calendar
+	// признак того, что расписание построено с учетом исполнителей,
+	// после этого обратный ход учитывает календари
+	leveled bool
+
+	// все вершины, достижимые из вершины, заполняется по мере необходимости
+	// правилами приоритета
+	successors map[int64][]int64
+
+	// зависимости, добавленные из-за того, что задачи выполняет один исполнитель
+	// ключ - вершина, которая должна быть выполнена раньше
+	resourceDependencies map[int64][]int64
 }
 
 // конструктор для структуры, которая хранит состояние алгоритма
-func newSolver(graph graphinterface.GraphWithNodes, nodesValueMap map[int64]float64, priority int) solver {
+func newSolver(graph graphinterface.GraphWithNodes, nodesValueMap map[int64]float64, opts ScheduleOptions) solver {
 	adjacencyList := make(map[int64][]int64, graph.Len())
 	adjacencyListInversed := make(map[int64][]int64, graph.Len())
 
 	// построение списков
 	for _, node := range graph.GetNodes() {
-		adjacencyList[node.GetID()] = append(node.GetDependencies(), node.GetAdditionalDependencies()...)
+		adjacencyList[node.GetID()] = slices.Clone(node.GetDependencies())
 
 		if _, ok := adjacencyListInversed[node.GetID()]; !ok {
 			adjacencyListInversed[node.GetID()] = []int64{}
@@ -115,7 +148,11 @@ func newSolver(graph graphinterface.GraphWithNodes, nodesValueMap map[int64]floa
 		adjacencyListInversed: adjacencyListInversed,
 		nodesValueMap:         nodesValueMap,
 		graph:                 graph,
-		priorityFunc:          lessFuncMap[priority],
+		priorityRule:          priorityRules[opts.Priority],
+		startAt:               opts.StartAt,
+		calendars:             opts.Calendars,
+		successors:            make(map[int64][]int64),
+		resourceDependencies:  make(map[int64][]int64),
 	}
 
 	s.dropState()
@@ -131,7 +168,7 @@ func (s *solver) dropState() {
 		s.nodeAmountMap[node.GetID()] = &nodeAmount{
 			Node: node,
 			min:  math.MaxFloat64,
-			max:  math.SmallestNonzeroFloat64,
+			max:  0,
 		}
 	}
 
@@ -151,7 +188,7 @@ func (s *solver) createLastNode() {
 			Node: &models.Node{ID: dummyNodeID, AssignedTo: &dummyNodeAssignedToID},
 		},
 		min: math.MaxFloat64,
-		max: math.SmallestNonzeroFloat64,
+		max: 0,
 	}
 
 	if len(lastNodes) == 1 && lastNodes[0] == -1 {
@@ -173,162 +210,117 @@ func (s *solver) createLastNode() {
 	}
 }
 
-// forward - выполняет прямой ход алгоритма
-func (s *solver) forvard() {
-	// сбрасываем состояние, добавляем последнюю вершниу
-	s.dropState()
-
-	// очередь рассматриваемых вершин
+// topologicalOrder - возвращает вершины графа в таком порядке,
+// что каждая вершина следует после всех своих предшественников
+func (s *solver) topologicalOrder() []int64 {
+	inDegree := make(map[int64]int, len(s.nodeAmountMap))
 	var queue []int64
-	// множество вершин, которые выполнены
-	doneNodesSet := make(map[int64]struct{}, len(s.nodesValueMap))
-
-	// Определение начальных вершин
-	for nodeID, inversedNodeDependencies := range s.adjacencyListInversed {
-		if len(inversedNodeDependencies) == 0 {
+	for nodeID := range s.nodeAmountMap {
+		inDegree[nodeID] = len(s.adjacencyListInversed[nodeID])
+		if inDegree[nodeID] == 0 {
 			queue = append(queue, nodeID)
-			s.nodeAmountMap[nodeID].min = math.MaxFloat64
-			s.nodeAmountMap[nodeID].max = 0
 		}
 	}
+	slices.Sort(queue)
 
-	// использоваием алгоритма обхода графа в ширину,
-	// обходим все вершины, при этом обновляя ES и EF рассматриваемых вершин
+	order := make([]int64, 0, len(s.nodeAmountMap))
 	for len(queue) > 0 {
-		var nextQueue []int64
-
-		for _, currNodeID := range queue {
-			// проверка, готова ли текущая вершина к выполнению
-			// для этого все предыдущие вершины должны быть выполнены
-			readyToDone := true
-			for _, prevNodeID := range s.adjacencyListInversed[currNodeID] {
-				if _, ok := doneNodesSet[prevNodeID]; !ok {
-					readyToDone = false
-					break
-				}
+		currNodeID := queue[0]
+		queue = queue[1:]
+		order = append(order, currNodeID)
+
+		for _, nextNodeID := range s.adjacencyList[currNodeID] {
+			inDegree[nextNodeID]--
+			if inDegree[nextNodeID] == 0 {
+				queue = append(queue, nextNodeID)
 			}
+		}
+	}
 
-			// если вершина не готова к выполнению
-			// рассмотрим ее на следующей интерации
-			if !readyToDone {
-				nextQueue = append(nextQueue, currNodeID)
-				continue
-			}
+	return order
+}
 
-			// текущая вершина выполненена (так как все предыдущие вершины выполнены)
-			doneNodesSet[currNodeID] = struct{}{}
+// forward - выполняет прямой ход алгоритма без учета исполнителей
+func (s *solver) forvard() {
+	// вершины рассматриваются в топологическом порядке,
+	// поэтому к моменту рассмотрения вершины все предыдущие уже рассмотрены
+	for _, currNodeID := range s.topologicalOrder() {
+		currNodeAmount := s.nodeAmountMap[currNodeID]
+		if currNodeID == dummyNodeID {
+			s.finishAllNodes()
+		}
+		currNodeAmount.earlyFinish = currNodeAmount.max + s.nodesValueMap[currNodeID]
 
-			// обновляем состояние текущей вершины
-			currNodeAmount := s.nodeAmountMap[currNodeID]
-			currNodeTime := currNodeAmount.max + s.nodesValueMap[currNodeID]
+		// обходим вершины, в которые можно перейти из текущей вершины
+		for _, nextNodeID := range s.adjacencyList[currNodeID] {
+			// ES следующей вершины = max(ограничений связей с предыдущими),
+			// для связи окончание-начало ограничение - EF предыдущей вершины с учетом задержки
+			s.nodeAmountMap[nextNodeID].SetMax(s.earliestStart(currNodeID, nextNodeID, currNodeAmount.max, currNodeAmount.earlyFinish))
+		}
+	}
+}
 
-			// обходим вершины, в которые можно перейти из текущей вершины
-			for _, nextNodeID := range s.adjacencyList[currNodeID] {
-				// обновляем состоние вершины
-				s.nodeAmountMap[nextNodeID].SetMax(currNodeTime)
-				// добавляем в очередь
-				nextQueue = append(nextQueue, nextNodeID)
-			}
+// finishAllNodes - последняя вершина начинается после окончания всех вершин графа,
+// а не только тех, у которых нет исходящих ребер,
+// так как при связях начало-начало и начало-окончание вершина
+// может закончиться позже вершин, которые от нее зависят
+// последняя вершина рассматривается после всех остальных вершин
+func (s *solver) finishAllNodes() {
+	dummyNodeAmount := s.nodeAmountMap[dummyNodeID]
+	for nodeID, amount := range s.nodeAmountMap {
+		if nodeID != dummyNodeID {
+			dummyNodeAmount.SetMax(amount.earlyFinish)
 		}
-		// обновляем очередь для следующей итерации
-		queue = nextQueue
 	}
 }
 
-// forward - выполняет прямой ход алгоритма
+// backward - выполняет обратный ход алгоритма
 func (s *solver) backward() {
-	// очередь рассматриваемых вершин,
-	// изначально в очереди аккумулирующая вершина
-	queue := []int64{dummyNodeID}
+	order := s.topologicalOrder()
 
 	// обновляем состояние акуккумулирующей вершины,
-	// ее LS должен быть равен ES
-	s.nodeAmountMap[dummyNodeID].SetMin(s.nodeAmountMap[dummyNodeID].max)
-
-	// использоваием алгоритма обхода графа в ширину,
-	// обходим все вершины, при этом обновляя ES и EF рассматриваемых вершин
-	for len(queue) != 0 {
-		// очередь для следующей итерации
-		var nextQueue []int64
-		for _, currNodeID := range queue {
-			// получаем время текущей вершины (ee LS)
-			currNodeTime := s.nodeAmountMap[currNodeID].min
-
-			for _, nextNodeID := range s.adjacencyListInversed[currNodeID] {
-				// для каждой предшествующей вершины
-				// LS = min(LS текущей - время выполнения текущей)
-				s.nodeAmountMap[nextNodeID].SetMin(currNodeTime - s.nodesValueMap[nextNodeID])
-
-				// добавляем вершину в очередь
-				nextQueue = append(nextQueue, nextNodeID)
+	// ее LF должен быть равен EF
+	dummyNodeAmount := s.nodeAmountMap[dummyNodeID]
+	dummyNodeAmount.lateFinish = dummyNodeAmount.earlyFinish
+
+	// вершины рассматриваются в обратном топологическом порядке,
+	// поэтому к моменту рассмотрения вершины все следующие уже рассмотрены
+	for _, currNodeID := range slices.Backward(order) {
+		currNodeAmount := s.nodeAmountMap[currNodeID]
+
+		if currNodeID != dummyNodeID {
+			// LF = min(ограничений связей со следующими), но не позже окончания графа,
+			// для связи окончание-начало ограничение - LS следующей вершины с учетом задержки
+			currNodeAmount.lateFinish = dummyNodeAmount.lateFinish
+			for _, nextNodeID := range s.adjacencyList[currNodeID] {
+				currNodeAmount.lateFinish = min(currNodeAmount.lateFinish, s.latestFinish(currNodeID, nextNodeID))
 			}
 		}
-		// обновляем очередь для следующей итерации
-		queue = nextQueue
-	}
-}
-
-// correctIntervals - выполняет корректировку в графе, добавляя ребра (зависимости)
-// в случае, если для какого-то исполнителя выявились накладывающиеся задачи
-func (s *solver) correctIntervals() (addedNewDependency bool) {
-	// Interval - хранит информацию о временном интервале, в котором исполнитель выполнял задачу
-	type Interval struct {
-		// начало и конец интервала
-		start, end float64
-		// вершина обозначающая задачу, которая выполняется в этом интервале
-		graphinterface.Node
-	}
 
-	workersTimeLine := make(map[int64][]Interval, len(s.nodesValueMap))
-
-	// группируем интервалы по исполнителям
-	for _, node := range s.nodeAmountMap {
-		workersTimeLine[node.GetAssignedTo()] = append(
-			workersTimeLine[node.GetAssignedTo()],
-			Interval{
-				start: node.max,
-				end:   node.max + node.GetWeight(),
-				Node:  node,
-			},
-		)
+		currNodeAmount.min = s.lateStart(currNodeID, currNodeAmount.lateFinish)
 	}
+}
 
-	for _, intervals := range workersTimeLine {
-		// сортируем интервалы исполнителя
-		// по возрастанию времени начала интервала
-		sort.Slice(intervals, func(i, j int) bool {
-			// в случае, если начало интервалов совпадают,
-			// меньше тот, который раньше заканчивается
-			if intervals[i].start == intervals[j].start {
-				return intervals[i].end < intervals[j].end
-			}
-
-			return intervals[i].start < intervals[j].start
-		})
-
-		for i := range len(intervals) - 1 {
-			// в случае если конец текщуего интервала превосходит начало следующего
-			// добавляем в зависимости (i+1)-й задачи i-ю
-			if intervals[i].end > intervals[i+1].start {
-				s.addDependency(
-					intervals[i].Node.GetID(),
-					intervals[i+1].Node.GetID(),
-				)
+// lateStart - возвращает LS вершины по ее LF
+func (s *solver) lateStart(nodeID int64, lateFinish float64) float64 {
+	duration := s.nodesValueMap[nodeID]
 
-				// отмечаем, что структура графа была изменена
-				addedNewDependency = true
-			}
-		}
+	calendar := s.calendar(nodeID)
+	if !s.leveled || calendar == nil || duration == 0 {
+		return lateFinish - duration
 	}
 
-	return
+	// исполнитель работает по календарю,
+	// поэтому работа заканчивается в последний рабочий момент до LF
+	return s.toHours(calendar.subtractWorkingTime(s.toTime(lateFinish), hoursToDuration(duration)))
 }
 
-// addDependency - добавляет зависимость
-// toId будет зависеть от выполнения вершины с индетификатором fromId
-func (s *solver) addDependency(fromId, toId int64) {
-	s.adjacencyList[fromId] = append(s.adjacencyList[fromId], toId)
-	s.adjacencyListInversed[toId] = append(s.adjacencyListInversed[toId], fromId)
+// drives - возвращает true, если критическая вершина prevNodeID задерживает вершину nodeID,
+// то есть ограничение связи между ними определяет LF вершины prevNodeID
+// для связи окончание-начало это означает, что LF предыдущей == LS текущей
+func (s *solver) drives(prevNodeID, nodeID int64) bool {
+	amount := s.nodeAmountMap[prevNodeID]
+	return amount.isCritical() && floatEqual(amount.lateFinish, s.latestFinish(prevNodeID, nodeID))
 }
 
 // collectPaths - собирает критические пути графа
@@ -336,57 +328,199 @@ func (s *solver) collectPaths() [][]int64 {
 	var res [][]int64
 
 	// рекурсивно, с помощью алгоритма поиска в грубину обходим граф
-	// и собираем вершины у которых ES == EF
+	// и собираем вершины у которых ES == LS
 	var dfs func(int64, []int64)
 	dfs = func(node int64, curr []int64) {
-		if amount := s.nodeAmountMap[node]; amount.max == 0 && amount.min == amount.max {
+		found := false
+
+		for _, prevNode := range s.adjacencyListInversed[node] {
+			// рассматриваем только те пути, которые содержат вершины ES == LS,
+			// при этом предыдущая вершина должна задерживать текущую
+			// прочие вершины не формируют критический путь
+			if s.drives(prevNode, node) {
+				found = true
+				dfs(prevNode, append(curr, prevNode))
+			}
+		}
+
+		if !found && len(curr) != 0 {
 			path := make([]int64, len(curr))
 			copy(path, curr)
 			slices.Reverse(path)
 			res = append(res, path)
-			return
 		}
+	}
 
-		for _, nextNode := range s.adjacencyListInversed[node] {
-			// рассматриваем только те пути, которые содержат вершины ES == EF
-			// прочие вершины не формируют критический путь
-			if amount := s.nodeAmountMap[nextNode]; amount.min == amount.max {
-				dfs(nextNode, append(curr, nextNode))
+	// критический путь заканчивается вершиной, которая задерживает окончание графа
+	// и не задерживает другие критические вершины
+	// при связях окончание-начало это вершины без исходящих ребер
+	for _, nodeID := range s.topologicalOrder() {
+		if nodeID == dummyNodeID || !s.drives(nodeID, dummyNodeID) {
+			continue
+		}
+
+		last := true
+		for _, nextNodeID := range s.adjacencyList[nodeID] {
+			if nextNodeID != dummyNodeID && s.nodeAmountMap[nextNodeID].isCritical() && s.drives(nodeID, nextNodeID) {
+				last = false
+				break
 			}
 		}
-	}
 
-	dfs(dummyNodeID, []int64{})
+		if last {
+			dfs(nodeID, []int64{nodeID})
+		}
+	}
 
 	return res
 }
 
+// NodeSchedule - временные параметры вершины
+type NodeSchedule struct {
+	EarlyStart  float64 // Раннее начало (ES)
+	EarlyFinish float64 // Раннее окончание (EF)
+	LateStart   float64 // Позднее начало (LS)
+	LateFinish  float64 // Позднее окончание (LF)
+	TotalFloat  float64 // Полный резерв времени, LS - ES
+	FreeFloat   float64 // Свободный резерв времени, min(ES следующих) - EF
+
+	// Моменты начала и окончания выполнения вершины по расписанию
+	Start, Finish time.Time
+}
+
+// Schedule - результат расчета графа
+type Schedule struct {
+	// критические пути графа
+	Paths [][]int64
+	// временные параметры вершин, ключ - идентификатор вершины
+	Nodes map[int64]NodeSchedule
+	// время выполнения всего графа
+	Duration float64
+	// момент окончания выполнения всего графа
+	FinishAt time.Time
+	// зависимости, добавленные из-за того, что задачи выполняет один исполнитель,
+	// ключ - вершина, которая должна быть выполнена раньше
+	ResourceDependencies map[int64][]int64
+}
+
 // FindCriticalPath - находит критические пути графа
 // graph - граф
 // nodesValueMap - определяет веса вершин
 func FindCriticalPath[T graphinterface.GraphWithNodes](graph T, nodesValueMap map[int64]float64, priority int) ([][]int64, error) {
-	// в случае, если в графе есть цикл, вернем ошибку
-	if HasCycle(graph) {
-		return nil, ErrCycleInGraph
+	schedule, err := FindSchedule(graph, nodesValueMap, ScheduleOptions{Priority: priority})
+	if err != nil {
+		return nil, err
 	}
 
-	solver := newSolver(graph, nodesValueMap, priority)
+	return schedule.Paths, nil
+}
 
-	// выполняем рассчет прямого хода
-	solver.forvard()
+// FindSchedule - строит расписание выполнения графа с учетом занятости исполнителей,
+// находит критические пути, а также ранние и поздние сроки и резервы времени каждой вершины
+// времена вершин отсчитываются в часах от opts.StartAt,
+// граф не изменяется, зависимости из-за занятости исполнителей возвращаются в ResourceDependencies
+func FindSchedule[T graphinterface.GraphWithNodes](graph T, nodesValueMap map[int64]float64, opts ScheduleOptions) (*Schedule, error) {
+	if _, ok := priorityRules[opts.Priority]; !ok {
+		return nil, ErrUnknownPriority
+	}
 
-	// проверяем наличие накладывающихся задач
-	if graphChanged := solver.correctIntervals(); graphChanged {
-		// если граф был изменен (были накладывающиеся задачи)
-		// необходимо вычислить прямой ход повторно
-		solver.forvard()
+	// в случае, если в графе есть цикл, вернем ошибку
+	if cycle, has := HasCycle(graph); has {
+		return nil, &CycleError{Cycle: cycle}
 	}
 
-	// рассчет обратного хода
-	solver.backward()
+	solver := newSolver(graph, nodesValueMap, opts)
+	paths := solver.solve()
+
+	return &Schedule{
+		Paths:                paths,
+		Nodes:                solver.schedule(),
+		Duration:             solver.duration(),
+		FinishAt:             solver.toTime(solver.duration()),
+		ResourceDependencies: solver.resourceDependencies,
+	}, nil
+}
+
+// solve - выполняет один проход алгоритма поиска критических путей
+// и возвращает найденные пути, проверка графа на наличие циклов
+// должна быть выполнена заранее
+func (s *solver) solve() [][]int64 {
+	// расчет без учета исполнителей, его результат
+	// используется правилами приоритета
+	s.forvard()
+	s.backward()
+
+	// построение расписания с учетом занятости исполнителей
+	s.levelResources()
+
+	// рассчет обратного хода по построенному расписанию
+	s.backward()
 
 	// сбор критических путей
-	res := solver.collectPaths()
+	return s.collectPaths()
+}
+
+// duration - возвращает время выполнения всего графа,
+// доступно после выполнения прямого хода
+func (s *solver) duration() float64 {
+	return s.nodeAmountMap[dummyNodeID].max
+}
+
+// schedule - собирает временные параметры вершин,
+// доступно после выполнения прямого и обратного хода
+func (s *solver) schedule() map[int64]NodeSchedule {
+	res := make(map[int64]NodeSchedule, len(s.nodeAmountMap))
+
+	for nodeID, amount := range s.nodeAmountMap {
+		if nodeID == dummyNodeID {
+			continue
+		}
+
+		// свободный резерв - насколько можно задержать вершину,
+		// не сдвигая раннее начало ни одной из следующих вершин и окончание графа
+		freeFloat := s.duration() - amount.earlyFinish
+		for _, nextNodeID := range s.adjacencyList[nodeID] {
+			bound := s.earliestStart(nodeID, nextNodeID, amount.max, amount.earlyFinish)
+			freeFloat = min(freeFloat, s.nodeAmountMap[nextNodeID].max-bound)
+		}
+
+		nodeSchedule := NodeSchedule{
+			EarlyStart:  amount.max,
+			EarlyFinish: amount.earlyFinish,
+			LateStart:   amount.min,
+			LateFinish:  amount.lateFinish,
+			Start:       s.toTime(amount.max),
+			Finish:      s.toTime(amount.earlyFinish),
+		}
+		if !amount.isCritical() {
+			nodeSchedule.TotalFloat = amount.min - amount.max
+		}
+		if floatLess(amount.earlyFinish, amount.earlyFinish+freeFloat) {
+			nodeSchedule.FreeFloat = freeFloat
+		}
+
+		res[nodeID] = nodeSchedule
+	}
+
+	return res
+}
+
+// calendar - возвращает календарь исполнителя вершины,
+// nil, если исполнитель работает без календаря
+func (s *solver) calendar(nodeID int64) *Calendar {
+	return s.calendars[s.nodeAmountMap[nodeID].GetAssignedTo()]
+}
+
+// toTime - переводит время, отсчитанное в часах от начала выполнения графа, в момент времени
+func (s *solver) toTime(hours float64) time.Time {
+	return s.startAt.Add(hoursToDuration(hours))
+}
+
+// toHours - переводит момент времени в часы от начала выполнения графа
+func (s *solver) toHours(t time.Time) float64 {
+	return t.Sub(s.startAt).Hours()
+}
 
-	return res, nil
+func hoursToDuration(hours float64) time.Duration {
+	return time.Duration(hours * float64(time.Hour))
 }
@@ -67,7 +67,7 @@ func TestFindCriticalPath(t *testing.T) {
 					},
 				},
 			},
-			criticalPathNodesIDs: [][]int64{{1, 3, 5, 6}, {1, 3, 6}},
+			criticalPathNodesIDs: [][]int64{{1, 3, 5, 6}},
 			nodesValueMap: map[int64]float64{
 				1: 1, 2: 2, 3: 3, 4: 4, 5: 5, 6: 6,
 			},
@@ -102,7 +102,8 @@ func TestFindCriticalPath(t *testing.T) {
 					},
 				},
 			},
-			criticalPathNodesIDs: [][]int64{{1, 2}, {1, 3}, {1, 2, 3}},
+			// исполнитель 2 сначала выполняет более короткую задачу 2, затем 3
+			criticalPathNodesIDs: [][]int64{{1, 2, 3}},
 			nodesValueMap: map[int64]float64{
 				1: 1, 2: 2, 3: 3,
 			},
@@ -280,7 +281,8 @@ func TestFindCriticalPath(t *testing.T) {
 					},
 				},
 			},
-			criticalPathNodesIDs: [][]int64{{1, 2, 5, 6}, {1, 3, 5, 6}, {1, 2, 3, 5, 6}},
+			// исполнитель 2 сначала выполняет более короткую задачу 3, затем 2
+			criticalPathNodesIDs: [][]int64{{1, 3, 2, 4, 5, 6}},
 			nodesValueMap: map[int64]float64{
 				1: 1, 2: 4, 3: 3, 4: 1, 5: 2, 6: 3,
 			},
@@ -331,7 +333,9 @@ func TestFindCriticalPath(t *testing.T) {
 					},
 				},
 			},
-			criticalPathNodesIDs: [][]int64{{1, 3, 4, 5}},
+			// задачи 2 и 4 исполнителя 2 не пересекаются,
+			// задача 4 ждет одновременно задачу 3 и освобождения исполнителя
+			criticalPathNodesIDs: [][]int64{{1, 3, 4, 5}, {1, 2, 4, 5}},
 			nodesValueMap: map[int64]float64{
 				1: 1, 2: 1, 3: 1, 4: 1, 5: 1,
 			},
@@ -386,7 +390,8 @@ func TestFindCriticalPath(t *testing.T) {
 					},
 				},
 			},
-			criticalPathNodesIDs: [][]int64{{1, 2}, {1, 2, 4, 5}},
+			// исполнитель 2 сначала выполняет короткую задачу 4, затем 2
+			criticalPathNodesIDs: [][]int64{{1, 3, 4, 2}},
 			nodesValueMap: map[int64]float64{
 				1: 1, 2: 10, 3: 1, 4: 1, 5: 1,
 			},
@@ -438,3 +443,55 @@ func comparePaths(a [][]int64, b [][]int64) bool {
 
 	return true
 }
+
+func TestFindSchedule(t *testing.T) {
+	// 1 -> 2 -> 4
+	// 1 -> 3 -> 5 -> 4
+	graph := entities.GraphWithTasks{
+		Nodes: []*entities.NodeWithTask{
+			{Node: &models.Node{ID: 1, DependencyNodeIDs: []int64{2, 3}, AssignedTo: ptrInt64(1), Weight: 2}},
+			{Node: &models.Node{ID: 2, DependencyNodeIDs: []int64{4}, AssignedTo: ptrInt64(2), Weight: 3}},
+			{Node: &models.Node{ID: 3, DependencyNodeIDs: []int64{5}, AssignedTo: ptrInt64(3), Weight: 1}},
+			{Node: &models.Node{ID: 4, DependencyNodeIDs: []int64{}, AssignedTo: ptrInt64(4), Weight: 2}},
+			{Node: &models.Node{ID: 5, DependencyNodeIDs: []int64{4}, AssignedTo: ptrInt64(5), Weight: 1}},
+		},
+	}
+	nodesValueMap := map[int64]float64{
+		1: 2, 2: 3, 3: 1, 4: 2, 5: 1,
+	}
+
+	want := map[int64]NodeSchedule{
+		1: {EarlyStart: 0, EarlyFinish: 2, LateStart: 0, LateFinish: 2},
+		2: {EarlyStart: 2, EarlyFinish: 5, LateStart: 2, LateFinish: 5},
+		3: {EarlyStart: 2, EarlyFinish: 3, LateStart: 3, LateFinish: 4, TotalFloat: 1},
+		4: {EarlyStart: 5, EarlyFinish: 7, LateStart: 5, LateFinish: 7},
+		5: {EarlyStart: 3, EarlyFinish: 4, LateStart: 4, LateFinish: 5, TotalFloat: 1, FreeFloat: 1},
+	}
+
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	for nodeID, nodeSchedule := range want {
+		nodeSchedule.Start = startAt.Add(time.Duration(nodeSchedule.EarlyStart) * time.Hour)
+		nodeSchedule.Finish = startAt.Add(time.Duration(nodeSchedule.EarlyFinish) * time.Hour)
+		want[nodeID] = nodeSchedule
+	}
+
+	schedule, err := FindSchedule(graph_wrapper.WrapGraphWithTasks(&graph), nodesValueMap, ScheduleOptions{StartAt: startAt})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if schedule.Duration != 7 {
+		t.Errorf("Expected duration 7, got %v", schedule.Duration)
+	}
+	if !comparePaths(schedule.Paths, [][]int64{{1, 2, 4}}) {
+		t.Errorf("Expected %v, got %v", [][]int64{{1, 2, 4}}, schedule.Paths)
+	}
+	if len(schedule.Nodes) != len(want) {
+		t.Fatalf("Expected %d nodes, got %d", len(want), len(schedule.Nodes))
+	}
+	for nodeID, nodeSchedule := range want {
+		if schedule.Nodes[nodeID] != nodeSchedule {
+			t.Errorf("Node %d: expected %+v, got %+v", nodeID, nodeSchedule, schedule.Nodes[nodeID])
+		}
+	}
+}
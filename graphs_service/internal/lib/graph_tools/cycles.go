@@ -1,43 +1,90 @@
 package graphtools
 
-import graphinterface "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/graph_interface"
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
 
-// HasCycle - возвращает true, если граф содержит цикл, false в противном случае
-// для обнаружения цикла использует топологическую сортировку
-func HasCycle(graph graphinterface.GraphWithNodes) (has bool) {
-	visited := make(map[int64]struct{}, graph.Len())
+	graphinterface "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/graph_interface"
+)
 
+// CycleError - ошибка, содержащая найденный в графе цикл
+type CycleError struct {
+	// идентификаторы вершин цикла, каждая следующая вершина зависит от предыдущей,
+	// а первая - от последней
+	Cycle []int64
+}
+
+func (e *CycleError) Error() string {
+	ids := make([]string, 0, len(e.Cycle)+1)
+	for _, nodeID := range e.Cycle {
+		ids = append(ids, strconv.FormatInt(nodeID, 10))
+	}
+	if len(e.Cycle) != 0 {
+		ids = append(ids, ids[0])
+	}
+
+	return fmt.Sprintf("%s: %s", ErrCycleInGraph, strings.Join(ids, " -> "))
+}
+
+func (e *CycleError) Unwrap() error {
+	return ErrCycleInGraph
+}
+
+// HasCycle - возвращает найденный цикл и true, если граф содержит цикл, nil и false в противном случае
+// для обнаружения цикла использует обход в глубину, вершины обходятся в порядке возрастания
+// идентификаторов, поэтому для одного и того же графа всегда возвращается один и тот же цикл
+func HasCycle(graph graphinterface.GraphWithNodes) (cycle []int64, has bool) {
 	g := make(map[int64][]int64, graph.Len())
 	for _, node := range graph.GetNodes() {
-		g[node.GetID()] = node.GetDependencies()
+		g[node.GetID()] = slices.Sorted(slices.Values(node.GetDependencies()))
 	}
 
+	// состояния вершин: не посещена, находится в текущем пути обхода, обход завершен
+	const (
+		notVisited = iota
+		inPath
+		visited
+	)
+	state := make(map[int64]int, len(g))
+	path := make([]int64, 0, len(g))
+
 	var dfs func(int64) bool
 	dfs = func(node int64) bool {
-		if len(g[node]) == 0 {
-			return false
-		}
-		if _, ok := visited[node]; ok {
-			return true
-		}
+		state[node] = inPath
+		path = append(path, node)
 
-		visited[node] = struct{}{}
-
-		for _, v := range g[node] {
-			if dfs(v) {
+		for _, next := range g[node] {
+			switch state[next] {
+			case inPath:
+				// цикл - часть текущего пути, начиная с вершины next
+				cycle = slices.Clone(path[slices.Index(path, next):])
 				return true
+			case notVisited:
+				if dfs(next) {
+					return true
+				}
 			}
 		}
 
-		g[node] = []int64{}
+		path = path[:len(path)-1]
+		state[node] = visited
 		return false
 	}
 
-	for nodeID := range g {
-		if dfs(nodeID) {
-			return true
+	for _, nodeID := range slices.Sorted(maps.Keys(g)) {
+		if state[nodeID] == notVisited && dfs(nodeID) {
+			return rotateCycle(cycle), true
 		}
 	}
 
-	return false
+	return nil, false
+}
+
+// rotateCycle - сдвигает цикл так, чтобы он начинался с вершины с наименьшим идентификатором
+func rotateCycle(cycle []int64) []int64 {
+	start := slices.Index(cycle, slices.Min(cycle))
+	return slices.Concat(cycle[start:], cycle[:start])
 }
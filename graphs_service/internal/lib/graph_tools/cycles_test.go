@@ -1,6 +1,8 @@
 package graphtools
 
 import (
+	"errors"
+	"slices"
 	"testing"
 
 	"github.com/liriquew/control_system/graphs_service/internal/entities"
@@ -13,6 +15,7 @@ func TestHasCycle(t *testing.T) {
 		name  string
 		graph *entities.GraphWithNodes
 		want  bool
+		cycle []int64
 	}{
 		{
 			name: "No cycle",
@@ -36,7 +39,8 @@ func TestHasCycle(t *testing.T) {
 					{ID: 3, DependencyNodeIDs: []int64{1}},
 				},
 			},
-			want: true,
+			want:  true,
+			cycle: []int64{1, 2, 3},
 		},
 		{
 			name: "Disconnected graph with cycle",
@@ -50,7 +54,8 @@ func TestHasCycle(t *testing.T) {
 					{ID: 4, DependencyNodeIDs: []int64{3}},
 				},
 			},
-			want: true,
+			want:  true,
+			cycle: []int64{3, 4},
 		},
 		{
 			name: "Big graph with cycle",
@@ -72,7 +77,8 @@ func TestHasCycle(t *testing.T) {
 					{ID: 6, DependencyNodeIDs: []int64{3, 5}},
 				},
 			},
-			want: true,
+			want:  true,
+			cycle: []int64{1, 3, 2},
 		},
 		{
 			name: "Big graph without cycle",
@@ -100,9 +106,52 @@ func TestHasCycle(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := HasCycle(graph_wrapper.WrapGraphWithNodes(tt.graph)); got != tt.want {
+			cycle, got := HasCycle(graph_wrapper.WrapGraphWithNodes(tt.graph))
+			if got != tt.want {
 				t.Errorf("HasCycle() = %v, want %v", got, tt.want)
 			}
+			if !slices.Equal(cycle, tt.cycle) {
+				t.Errorf("HasCycle() cycle = %v, want %v", cycle, tt.cycle)
+			}
 		})
 	}
 }
+
+func TestHasCycleSelfDependency(t *testing.T) {
+	graph := &entities.GraphWithNodes{
+		Nodes: []*models.Node{
+			{ID: 1, DependencyNodeIDs: []int64{2}},
+			{ID: 2, DependencyNodeIDs: []int64{2}},
+		},
+	}
+
+	cycle, has := HasCycle(graph_wrapper.WrapGraphWithNodes(graph))
+	if !has || !slices.Equal(cycle, []int64{2}) {
+		t.Errorf("Expected cycle [2], got %v", cycle)
+	}
+}
+
+func TestFindScheduleReturnsCycle(t *testing.T) {
+	// 1 -> 2 -> 3 -> 4 -> 2
+	graph := &entities.GraphWithNodes{
+		Nodes: []*models.Node{
+			{ID: 1, DependencyNodeIDs: []int64{2}, AssignedTo: ptrInt64(1)},
+			{ID: 2, DependencyNodeIDs: []int64{3}, AssignedTo: ptrInt64(1)},
+			{ID: 3, DependencyNodeIDs: []int64{4}, AssignedTo: ptrInt64(1)},
+			{ID: 4, DependencyNodeIDs: []int64{2}, AssignedTo: ptrInt64(1)},
+		},
+	}
+
+	_, err := FindSchedule(graph_wrapper.WrapGraphWithNodes(graph), map[int64]float64{1: 1, 2: 1, 3: 1, 4: 1}, ScheduleOptions{})
+	if !errors.Is(err, ErrCycleInGraph) {
+		t.Fatalf("Expected ErrCycleInGraph, got %v", err)
+	}
+
+	var cycleErr *CycleError
+	if !errors.As(err, &cycleErr) || !slices.Equal(cycleErr.Cycle, []int64{2, 3, 4}) {
+		t.Errorf("Expected cycle [2 3 4], got %v", err)
+	}
+	if want := "cycle detected: 2 -> 3 -> 4 -> 2"; err.Error() != want {
+		t.Errorf("Expected %q, got %q", want, err.Error())
+	}
+}
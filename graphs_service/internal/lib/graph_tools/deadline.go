@@ -0,0 +1,132 @@
+package graphtools
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	graphinterface "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/graph_interface"
+)
+
+// NodeShortening - сокращение времени вершины, необходимое для выполнения графа в срок
+type NodeShortening struct {
+	NodeID int64
+	// оставшееся время вершины в часах
+	Duration float64
+	// на сколько часов нужно сократить вершину
+	Shortening float64
+}
+
+// DeadlineStatus - результат проверки срока выполнения графа
+type DeadlineStatus struct {
+	Deadline time.Time
+	// момент окончания выполнения графа по расписанию
+	FinishAt time.Time
+	// граф выполняется в срок
+	Met bool
+	// резерв времени в днях, отрицательный, если срок сорван
+	BufferDays float64
+	// вершины критических путей, сокращение которых позволит выполнить граф в срок
+	Shortenings []NodeShortening
+	// false, если граф не укладывается в срок даже после сокращения вершин
+	Recoverable bool
+}
+
+// CheckDeadline - проверяет, выполняется ли граф к сроку deadline,
+// если нет - подбирает вершины критических путей, которые нужно сократить:
+// на каждом шаге сокращается вершина, лежащая на наибольшем количестве критических путей
+// (при равенстве - самая длинная), после чего расписание строится заново, так как
+// критическими могут стать другие пути, вершина не может быть сокращена больше, чем до нуля
+func CheckDeadline[T graphinterface.GraphWithNodes](graph T, nodesValueMap map[int64]float64, opts ScheduleOptions, deadline time.Time) (*DeadlineStatus, error) {
+	if _, ok := priorityRules[opts.Priority]; !ok {
+		return nil, ErrUnknownPriority
+	}
+
+	// в случае, если в графе есть цикл, вернем ошибку
+	if cycle, has := HasCycle(graph); has {
+		return nil, &CycleError{Cycle: cycle}
+	}
+
+	solver := newSolver(graph, nodesValueMap, opts)
+	paths := solver.solve()
+	finishAt := solver.toTime(solver.duration())
+
+	res := &DeadlineStatus{
+		Deadline:    deadline,
+		FinishAt:    finishAt,
+		Met:         !finishAt.After(deadline),
+		BufferDays:  deadline.Sub(finishAt).Hours() / 24,
+		Recoverable: true,
+	}
+	if res.Met {
+		return res, nil
+	}
+
+	weights := maps.Clone(nodesValueMap)
+	shortenings := make(map[int64]float64)
+
+	// каждый шаг либо устраняет текущее опоздание, либо сокращает вершину до нуля
+	for range 2*graph.Len() + 1 {
+		late := finishAt.Sub(deadline).Hours()
+		if late <= 0 {
+			break
+		}
+
+		nodeID, ok := shorteningCandidate(paths, weights)
+		if !ok {
+			break
+		}
+
+		cut := min(late, weights[nodeID])
+		weights[nodeID] -= cut
+		shortenings[nodeID] += cut
+
+		nodes := make([]graphinterface.Node, 0, graph.Len())
+		for _, node := range graph.GetNodes() {
+			nodes = append(nodes, &sampledNode{Node: node, weight: weights[node.GetID()]})
+		}
+
+		solver = newSolver(&sampledGraph{nodes: nodes}, weights, opts)
+		paths = solver.solve()
+		finishAt = solver.toTime(solver.duration())
+	}
+
+	res.Recoverable = !finishAt.After(deadline)
+	for _, nodeID := range slices.Sorted(maps.Keys(shortenings)) {
+		res.Shortenings = append(res.Shortenings, NodeShortening{
+			NodeID:     nodeID,
+			Duration:   nodesValueMap[nodeID],
+			Shortening: shortenings[nodeID],
+		})
+	}
+
+	return res, nil
+}
+
+// shorteningCandidate - выбирает вершину критических путей для сокращения,
+// false, если все вершины критических путей уже имеют нулевое время
+func shorteningCandidate(paths [][]int64, weights map[int64]float64) (int64, bool) {
+	pathsCount := make(map[int64]int)
+	for _, path := range paths {
+		for _, nodeID := range path {
+			if nodeID != dummyNodeID && weights[nodeID] > 0 {
+				pathsCount[nodeID]++
+			}
+		}
+	}
+
+	var (
+		candidate int64
+		found     bool
+	)
+	for _, nodeID := range slices.Sorted(maps.Keys(pathsCount)) {
+		if !found ||
+			pathsCount[nodeID] > pathsCount[candidate] ||
+			pathsCount[nodeID] == pathsCount[candidate] && weights[nodeID] > weights[candidate] {
+			candidate = nodeID
+			found = true
+		}
+	}
+
+	return candidate, found
+}
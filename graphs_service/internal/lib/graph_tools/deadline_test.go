@@ -0,0 +1,130 @@
+package graphtools
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/liriquew/control_system/graphs_service/internal/entities"
+	graph_wrapper "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/wrapper"
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+)
+
+func TestCheckDeadline(t *testing.T) {
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+
+	// 1 -> 2 -> 3
+	chain := entities.GraphWithTasks{
+		Nodes: []*entities.NodeWithTask{
+			{Node: &models.Node{ID: 1, DependencyNodeIDs: []int64{2}, AssignedTo: ptrInt64(1)}},
+			{Node: &models.Node{ID: 2, DependencyNodeIDs: []int64{3}, AssignedTo: ptrInt64(2)}},
+			{Node: &models.Node{ID: 3, DependencyNodeIDs: []int64{}, AssignedTo: ptrInt64(3)}},
+		},
+	}
+	chainValues := map[int64]float64{1: 2, 2: 5, 3: 1}
+
+	// 1 -> 2 -> 4
+	// 1 -> 3 -> 4
+	diamond := entities.GraphWithTasks{
+		Nodes: []*entities.NodeWithTask{
+			{Node: &models.Node{ID: 1, DependencyNodeIDs: []int64{2, 3}, AssignedTo: ptrInt64(1)}},
+			{Node: &models.Node{ID: 2, DependencyNodeIDs: []int64{4}, AssignedTo: ptrInt64(2)}},
+			{Node: &models.Node{ID: 3, DependencyNodeIDs: []int64{4}, AssignedTo: ptrInt64(3)}},
+			{Node: &models.Node{ID: 4, DependencyNodeIDs: []int64{}, AssignedTo: ptrInt64(4)}},
+		},
+	}
+	diamondValues := map[int64]float64{1: 1, 2: 5, 3: 4, 4: 1}
+
+	tests := []struct {
+		name            string
+		graph           entities.GraphWithTasks
+		nodesValueMap   map[int64]float64
+		deadline        time.Time
+		wantMet         bool
+		wantBufferDays  float64
+		wantShortenings []NodeShortening
+		wantRecoverable bool
+	}{
+		{
+			name:            "Deadline is met",
+			graph:           chain,
+			nodesValueMap:   chainValues,
+			deadline:        startAt.Add(10 * time.Hour),
+			wantMet:         true,
+			wantBufferDays:  2.0 / 24,
+			wantRecoverable: true,
+		},
+		{
+			name:           "Longest critical node is shortened",
+			graph:          chain,
+			nodesValueMap:  chainValues,
+			deadline:       startAt.Add(6 * time.Hour),
+			wantBufferDays: -2.0 / 24,
+			wantShortenings: []NodeShortening{
+				{NodeID: 2, Duration: 5, Shortening: 2},
+			},
+			wantRecoverable: true,
+		},
+		{
+			name:           "Path becomes critical after shortening",
+			graph:          diamond,
+			nodesValueMap:  diamondValues,
+			deadline:       startAt.Add(4 * time.Hour),
+			wantBufferDays: -3.0 / 24,
+			wantShortenings: []NodeShortening{
+				{NodeID: 2, Duration: 5, Shortening: 3},
+				{NodeID: 3, Duration: 4, Shortening: 2},
+			},
+			wantRecoverable: true,
+		},
+		{
+			name:           "Deadline can't be met",
+			graph:          chain,
+			nodesValueMap:  chainValues,
+			deadline:       startAt.Add(-time.Hour),
+			wantBufferDays: -9.0 / 24,
+			wantShortenings: []NodeShortening{
+				{NodeID: 1, Duration: 2, Shortening: 2},
+				{NodeID: 2, Duration: 5, Shortening: 5},
+				{NodeID: 3, Duration: 1, Shortening: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := CheckDeadline(graph_wrapper.WrapGraphWithTasks(&tt.graph), tt.nodesValueMap, ScheduleOptions{StartAt: startAt}, tt.deadline)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if res.Met != tt.wantMet {
+				t.Errorf("Expected met %v, got %v", tt.wantMet, res.Met)
+			}
+			if math.Abs(res.BufferDays-tt.wantBufferDays) > 1e-9 {
+				t.Errorf("Expected buffer %v days, got %v", tt.wantBufferDays, res.BufferDays)
+			}
+			if !slices.Equal(res.Shortenings, tt.wantShortenings) {
+				t.Errorf("Expected shortenings %v, got %v", tt.wantShortenings, res.Shortenings)
+			}
+			if res.Recoverable != tt.wantRecoverable {
+				t.Errorf("Expected recoverable %v, got %v", tt.wantRecoverable, res.Recoverable)
+			}
+		})
+	}
+
+	t.Run("Cycle", func(t *testing.T) {
+		cycled := entities.GraphWithTasks{
+			Nodes: []*entities.NodeWithTask{
+				{Node: &models.Node{ID: 1, DependencyNodeIDs: []int64{2}, AssignedTo: ptrInt64(1)}},
+				{Node: &models.Node{ID: 2, DependencyNodeIDs: []int64{1}, AssignedTo: ptrInt64(2)}},
+			},
+		}
+
+		if _, err := CheckDeadline(graph_wrapper.WrapGraphWithTasks(&cycled), nil, ScheduleOptions{}, startAt); !errors.Is(err, ErrCycleInGraph) {
+			t.Errorf("Expected %v, got %v", ErrCycleInGraph, err)
+		}
+	})
+}
@@ -11,27 +11,34 @@ var (
 	ErrUnexpectedNodeInDeps = errors.New("got unexpected node in dependencies")
 )
 
+// CountConnectedComponents - возвращает количество компонент слабой связности графа,
+// направление ребер при подсчете не учитывается
 func CountConnectedComponents(graph graphinterface.GraphWithNodes) (int, error) {
 	n := len(graph.GetNodes())
 	visited := make(map[int64]struct{}, n)
 
 	g := make(map[int64][]int64, n)
 	for _, node := range graph.GetNodes() {
-		g[node.GetID()] = node.GetDependencies()
+		g[node.GetID()] = append(g[node.GetID()], node.GetDependencies()...)
 	}
 
-	var err error
-	var dfs func(int64)
-	dfs = func(node int64) {
-		if _, ok := g[node]; !ok {
-			err = fmt.Errorf(
-				"error while counting components, error node: %d error: %w",
-				node,
-				ErrUnexpectedNodeInDeps,
-			)
-			return
+	// ребра добавляются в обе стороны, иначе результат обхода
+	// зависит от того, с какой вершины он начат
+	for _, node := range graph.GetNodes() {
+		for _, nodeID := range node.GetDependencies() {
+			if _, ok := g[nodeID]; !ok {
+				return 0, fmt.Errorf(
+					"error while counting components, error node: %d error: %w",
+					nodeID,
+					ErrUnexpectedNodeInDeps,
+				)
+			}
+			g[nodeID] = append(g[nodeID], node.GetID())
 		}
+	}
 
+	var dfs func(int64)
+	dfs = func(node int64) {
 		visited[node] = struct{}{}
 
 		for _, nodeID := range g[node] {
@@ -46,9 +53,6 @@ func CountConnectedComponents(graph graphinterface.GraphWithNodes) (int, error)
 		if _, ok := visited[node]; !ok {
 			count++
 			dfs(node)
-			if err != nil {
-				return 0, err
-			}
 		}
 	}
 
@@ -86,3 +86,27 @@ func TestCountConnectedComponents(t *testing.T) {
 		})
 	}
 }
+
+func TestCountConnectedComponentsIgnoresDirection(t *testing.T) {
+	// 1 -> 2 <- 3, 4 -> 5
+	graph := &entities.GraphWithNodes{
+		Nodes: []*models.Node{
+			{ID: 1, DependencyNodeIDs: []int64{2}},
+			{ID: 2, DependencyNodeIDs: []int64{}},
+			{ID: 3, DependencyNodeIDs: []int64{2}},
+			{ID: 4, DependencyNodeIDs: []int64{5}},
+			{ID: 5, DependencyNodeIDs: []int64{}},
+		},
+	}
+
+	// порядок обхода вершин в map случаен, поэтому проверяем несколько раз
+	for range 100 {
+		count, err := CountConnectedComponents(graph_wrapper.WrapGraphWithNodes(graph))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if count != 2 {
+			t.Fatalf("CountConnectedComponents() = %v, want 2", count)
+		}
+	}
+}
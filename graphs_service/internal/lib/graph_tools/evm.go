@@ -0,0 +1,129 @@
+package graphtools
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// EVMNode - состояние задачи вершины для расчета освоенного объема,
+// объемы измеряются в часах
+type EVMNode struct {
+	NodeID int64
+	// бюджет задачи - планируемое время выполнения
+	PlannedTime float64
+	// фактическое время выполнения, известно только для выполненных задач
+	ActualTime float64
+	// процент выполнения от 0 до 100
+	PercentComplete float64
+	Done            bool
+}
+
+// NodeEarnedValue - показатели освоенного объема вершины
+type NodeEarnedValue struct {
+	NodeID       int64
+	PlannedValue float64
+	EarnedValue  float64
+	ActualCost   float64
+}
+
+// EVMReport - показатели освоенного объема графа на момент AsOf
+type EVMReport struct {
+	AsOf time.Time
+	// BAC - бюджет графа
+	BudgetAtCompletion float64
+	// PV - плановый объем работ по сохраненному расписанию
+	PlannedValue float64
+	// EV - освоенный объем
+	EarnedValue float64
+	// AC - фактические затраты, учитываются только выполненные задачи,
+	// так как для начатых задач фактическое время неизвестно
+	ActualCost float64
+	// SV = EV - PV, CV = EV(выполненных задач) - AC
+	ScheduleVariance float64
+	CostVariance     float64
+	// SPI = EV / PV, CPI = EV(выполненных задач) / AC,
+	// индексы равны нулю, если знаменатель равен нулю
+	SPI float64
+	CPI float64
+	// EAC = BAC / CPI, ETC = EAC - AC, если CPI неизвестен, то EAC = BAC
+	EstimateAtCompletion float64
+	EstimateToCompletion float64
+
+	Nodes []NodeEarnedValue
+	// вершины, которых нет в сохраненном расписании, они не учитываются в PV
+	UnbaselinedNodes []int64
+}
+
+// EarnedValue - рассчитывает показатели освоенного объема графа на момент asOf,
+// плановый объем вершины распределяется равномерно между ее началом и окончанием
+// в сохраненном расписании baseline
+// результаты по вершинам упорядочены по идентификаторам вершин
+func EarnedValue(baseline *Snapshot, nodes []EVMNode, asOf time.Time) *EVMReport {
+	baselineNodes := snapshotNodesMap(baseline)
+
+	res := &EVMReport{
+		AsOf:  asOf,
+		Nodes: make([]NodeEarnedValue, 0, len(nodes)),
+	}
+
+	// освоенный объем выполненных задач, по нему считается CPI
+	var doneEarnedValue float64
+	for _, node := range nodes {
+		value := NodeEarnedValue{NodeID: node.NodeID}
+
+		if baseNode, ok := baselineNodes[node.NodeID]; ok {
+			value.PlannedValue = node.PlannedTime * plannedFraction(baseNode.Start, baseNode.Finish, asOf)
+		} else {
+			res.UnbaselinedNodes = append(res.UnbaselinedNodes, node.NodeID)
+		}
+
+		if node.Done {
+			value.EarnedValue = node.PlannedTime
+			value.ActualCost = node.ActualTime
+			doneEarnedValue += value.EarnedValue
+		} else {
+			value.EarnedValue = node.PlannedTime * min(max(node.PercentComplete, 0), 100) / 100
+		}
+
+		res.BudgetAtCompletion += node.PlannedTime
+		res.PlannedValue += value.PlannedValue
+		res.EarnedValue += value.EarnedValue
+		res.ActualCost += value.ActualCost
+		res.Nodes = append(res.Nodes, value)
+	}
+
+	slices.SortFunc(res.Nodes, func(a, b NodeEarnedValue) int {
+		return cmp.Compare(a.NodeID, b.NodeID)
+	})
+	slices.Sort(res.UnbaselinedNodes)
+
+	res.ScheduleVariance = res.EarnedValue - res.PlannedValue
+	res.CostVariance = doneEarnedValue - res.ActualCost
+	if res.PlannedValue > 0 {
+		res.SPI = res.EarnedValue / res.PlannedValue
+	}
+	if res.ActualCost > 0 {
+		res.CPI = doneEarnedValue / res.ActualCost
+	}
+
+	res.EstimateAtCompletion = res.BudgetAtCompletion
+	if res.CPI > 0 {
+		res.EstimateAtCompletion = res.BudgetAtCompletion / res.CPI
+	}
+	res.EstimateToCompletion = max(res.EstimateAtCompletion-res.ActualCost, 0)
+
+	return res
+}
+
+// plannedFraction - доля работы вершины, которая должна быть выполнена к моменту asOf
+func plannedFraction(start, finish, asOf time.Time) float64 {
+	if !asOf.After(start) {
+		return 0
+	}
+	if !asOf.Before(finish) {
+		return 1
+	}
+
+	return float64(asOf.Sub(start)) / float64(finish.Sub(start))
+}
@@ -0,0 +1,89 @@
+package graphtools
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func evmBaseline(startAt time.Time) *Snapshot {
+	hours := func(h float64) time.Time { return startAt.Add(hoursToDuration(h)) }
+	return &Snapshot{
+		StartAt:  startAt,
+		FinishAt: hours(16),
+		Duration: 16,
+		Nodes: []SnapshotNode{
+			{NodeID: 1, Dependencies: []int64{2}, Start: hours(0), Finish: hours(4)},
+			{NodeID: 2, Dependencies: []int64{3}, Start: hours(4), Finish: hours(8)},
+			{NodeID: 3, Dependencies: []int64{}, Start: hours(8), Finish: hours(16)},
+		},
+	}
+}
+
+func TestEarnedValue(t *testing.T) {
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	nodes := []EVMNode{
+		{NodeID: 3, PlannedTime: 8},
+		{NodeID: 1, PlannedTime: 4, ActualTime: 5, PercentComplete: 100, Done: true},
+		{NodeID: 4, PlannedTime: 2},
+		{NodeID: 2, PlannedTime: 4, PercentComplete: 50},
+	}
+
+	report := EarnedValue(evmBaseline(startAt), nodes, startAt.Add(6*time.Hour))
+
+	want := map[string][2]float64{
+		"BAC": {18, report.BudgetAtCompletion},
+		"PV":  {6, report.PlannedValue},
+		"EV":  {6, report.EarnedValue},
+		"AC":  {5, report.ActualCost},
+		"SV":  {0, report.ScheduleVariance},
+		"CV":  {-1, report.CostVariance},
+		"SPI": {1, report.SPI},
+		"CPI": {0.8, report.CPI},
+		"EAC": {22.5, report.EstimateAtCompletion},
+		"ETC": {17.5, report.EstimateToCompletion},
+	}
+	for name, values := range want {
+		if math.Abs(values[0]-values[1]) > 1e-9 {
+			t.Errorf("%s: expected %v, got %v", name, values[0], values[1])
+		}
+	}
+
+	wantNodes := []NodeEarnedValue{
+		{NodeID: 1, PlannedValue: 4, EarnedValue: 4, ActualCost: 5},
+		{NodeID: 2, PlannedValue: 2, EarnedValue: 2},
+		{NodeID: 3},
+		{NodeID: 4},
+	}
+	if !slices.Equal(report.Nodes, wantNodes) {
+		t.Errorf("Expected nodes %v, got %v", wantNodes, report.Nodes)
+	}
+	if !slices.Equal(report.UnbaselinedNodes, []int64{4}) {
+		t.Errorf("Expected unbaselined nodes [4], got %v", report.UnbaselinedNodes)
+	}
+}
+
+func TestEarnedValueWithoutProgress(t *testing.T) {
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	nodes := []EVMNode{
+		{NodeID: 1, PlannedTime: 4},
+		{NodeID: 2, PlannedTime: 4},
+		{NodeID: 3, PlannedTime: 8},
+	}
+
+	// до начала графа плановый объем равен нулю, индексы не определены
+	report := EarnedValue(evmBaseline(startAt), nodes, startAt.Add(-time.Hour))
+	if report.PlannedValue != 0 || report.SPI != 0 || report.CPI != 0 {
+		t.Errorf("Unexpected report before start: %+v", report)
+	}
+	if report.EstimateAtCompletion != 16 || report.EstimateToCompletion != 16 {
+		t.Errorf("Expected EAC and ETC equal to BAC, got %v and %v", report.EstimateAtCompletion, report.EstimateToCompletion)
+	}
+
+	// после окончания графа плановый объем равен бюджету
+	report = EarnedValue(evmBaseline(startAt), nodes, startAt.Add(20*time.Hour))
+	if report.PlannedValue != 16 || report.SPI != 0 || report.ScheduleVariance != -16 {
+		t.Errorf("Unexpected report after finish: %+v", report)
+	}
+}
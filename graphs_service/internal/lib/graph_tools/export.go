@@ -0,0 +1,326 @@
+package graphtools
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown export format")
+)
+
+// форматы экспорта графа
+const (
+	FormatDOT = iota
+	FormatMermaid
+	FormatMSPDI
+)
+
+// ExportNode - вершина графа для экспорта
+type ExportNode struct {
+	ID     int64
+	TaskID int64
+	Title  string
+	// идентификаторы вершин, которые зависят от данной
+	Dependencies []int64
+	// связи с зависимыми вершинами, ключ - идентификатор зависимой вершины,
+	// отсутствующая связь - окончание-начало без задержки
+	Links map[int64]ExportLink
+	// время выполнения задачи в часах
+	Duration      float64
+	Start, Finish time.Time
+}
+
+// ExportLink - тип связи и задержка в часах
+type ExportLink struct {
+	Type int
+	Lag  float64
+}
+
+// ExportGraph - граф с расписанием для экспорта
+type ExportGraph struct {
+	Name              string
+	StartAt, FinishAt time.Time
+	Nodes             []ExportNode
+	// критические пути, вершины и ребра которых выделяются
+	Paths [][]int64
+}
+
+// Export - представляет граф в одном из форматов:
+// Graphviz DOT, Mermaid flowchart или MS Project XML (MSPDI)
+func Export(graph *ExportGraph, format int) ([]byte, error) {
+	switch format {
+	case FormatDOT:
+		return exportDOT(graph), nil
+	case FormatMermaid:
+		return exportMermaid(graph), nil
+	case FormatMSPDI:
+		return exportMSPDI(graph)
+	}
+
+	return nil, ErrUnknownFormat
+}
+
+// criticalSets - собирает вершины и ребра, лежащие на критических путях
+func (g *ExportGraph) criticalSets() (map[int64]struct{}, map[[2]int64]struct{}) {
+	nodes := make(map[int64]struct{})
+	edges := make(map[[2]int64]struct{})
+	for _, path := range g.Paths {
+		for i, nodeID := range path {
+			nodes[nodeID] = struct{}{}
+			if i > 0 {
+				edges[[2]int64{path[i-1], nodeID}] = struct{}{}
+			}
+		}
+	}
+
+	return nodes, edges
+}
+
+// sortedNodes - вершины и их зависимости, упорядоченные по идентификаторам,
+// чтобы результат экспорта не зависел от порядка вершин в графе
+func (g *ExportGraph) sortedNodes() []ExportNode {
+	nodes := make([]ExportNode, len(g.Nodes))
+	for i, node := range g.Nodes {
+		node.Dependencies = slices.Sorted(slices.Values(node.Dependencies))
+		nodes[i] = node
+	}
+	slices.SortFunc(nodes, func(a, b ExportNode) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return nodes
+}
+
+func formatHours(hours float64) string {
+	return strconv.FormatFloat(math.Round(hours*100)/100, 'f', -1, 64) + "h"
+}
+
+var linkNames = map[int]string{
+	LinkFinishToStart:  "FS",
+	LinkStartToStart:   "SS",
+	LinkFinishToFinish: "FF",
+	LinkStartToFinish:  "SF",
+}
+
+// linkLabel - подпись ребра, например SS+2h,
+// для связи окончание-начало без задержки подпись не нужна
+func (n *ExportNode) linkLabel(depNodeID int64) string {
+	link := n.Links[depNodeID]
+	if link.Type == LinkFinishToStart && link.Lag == 0 {
+		return ""
+	}
+
+	label := linkNames[link.Type]
+	if link.Lag > 0 {
+		label += "+" + formatHours(link.Lag)
+	} else if link.Lag < 0 {
+		label += formatHours(link.Lag)
+	}
+
+	return label
+}
+
+func exportDOT(graph *ExportGraph) []byte {
+	criticalNodes, criticalEdges := graph.criticalSets()
+	nodes := graph.sortedNodes()
+
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "digraph \"%s\" {\n", escape.Replace(graph.Name))
+	buf.WriteString("\trankdir=LR;\n")
+	buf.WriteString("\tnode [shape=box];\n")
+
+	for _, node := range nodes {
+		fmt.Fprintf(&buf, "\tn%d [label=\"%s\\n%s\"", node.ID, escape.Replace(node.Title), formatHours(node.Duration))
+		if _, ok := criticalNodes[node.ID]; ok {
+			buf.WriteString(", color=red, penwidth=2")
+		}
+		buf.WriteString("];\n")
+	}
+
+	for _, node := range nodes {
+		for _, depNodeID := range node.Dependencies {
+			fmt.Fprintf(&buf, "\tn%d -> n%d", node.ID, depNodeID)
+
+			var attrs []string
+			if label := node.linkLabel(depNodeID); label != "" {
+				attrs = append(attrs, fmt.Sprintf("label=\"%s\"", label))
+			}
+			if _, ok := criticalEdges[[2]int64{node.ID, depNodeID}]; ok {
+				attrs = append(attrs, "color=red", "penwidth=2")
+			}
+			if len(attrs) != 0 {
+				fmt.Fprintf(&buf, " [%s]", strings.Join(attrs, ", "))
+			}
+			buf.WriteString(";\n")
+		}
+	}
+
+	buf.WriteString("}\n")
+
+	return buf.Bytes()
+}
+
+func exportMermaid(graph *ExportGraph) []byte {
+	criticalNodes, criticalEdges := graph.criticalSets()
+	nodes := graph.sortedNodes()
+
+	// в mermaid кавычки внутри подписи задаются html-сущностями
+	escape := strings.NewReplacer(`"`, "#quot;", "\n", " ")
+
+	var buf bytes.Buffer
+	buf.WriteString("flowchart LR\n")
+
+	for _, node := range nodes {
+		fmt.Fprintf(&buf, "    n%d[\"%s<br/>%s\"]\n", node.ID, escape.Replace(node.Title), formatHours(node.Duration))
+	}
+
+	// стиль ребер задается по их порядковому номеру
+	var criticalLinks []string
+	edgeIdx := 0
+	for _, node := range nodes {
+		for _, depNodeID := range node.Dependencies {
+			if label := node.linkLabel(depNodeID); label != "" {
+				fmt.Fprintf(&buf, "    n%d -->|%s| n%d\n", node.ID, label, depNodeID)
+			} else {
+				fmt.Fprintf(&buf, "    n%d --> n%d\n", node.ID, depNodeID)
+			}
+			if _, ok := criticalEdges[[2]int64{node.ID, depNodeID}]; ok {
+				criticalLinks = append(criticalLinks, strconv.Itoa(edgeIdx))
+			}
+			edgeIdx++
+		}
+	}
+
+	var critical []string
+	for _, node := range nodes {
+		if _, ok := criticalNodes[node.ID]; ok {
+			critical = append(critical, "n"+strconv.FormatInt(node.ID, 10))
+		}
+	}
+	if len(critical) != 0 {
+		buf.WriteString("    classDef critical stroke:#d00,stroke-width:2px;\n")
+		fmt.Fprintf(&buf, "    class %s critical;\n", strings.Join(critical, ","))
+	}
+	if len(criticalLinks) != 0 {
+		fmt.Fprintf(&buf, "    linkStyle %s stroke:#d00,stroke-width:2px;\n", strings.Join(criticalLinks, ","))
+	}
+
+	return buf.Bytes()
+}
+
+const (
+	mspdiNamespace  = "http://schemas.microsoft.com/project"
+	mspdiTimeLayout = "2006-01-02T15:04:05"
+	// задержка связи задается в часах
+	mspdiLagFormatHours = 5
+)
+
+// типы связей в MSPDI
+var mspdiLinkTypes = map[int]int{
+	LinkFinishToFinish: 0,
+	LinkFinishToStart:  1,
+	LinkStartToFinish:  2,
+	LinkStartToStart:   3,
+}
+
+type mspdiProject struct {
+	XMLName    xml.Name    `xml:"Project"`
+	Xmlns      string      `xml:"xmlns,attr"`
+	Name       string      `xml:"Name"`
+	StartDate  string      `xml:"StartDate"`
+	FinishDate string      `xml:"FinishDate"`
+	Tasks      []mspdiTask `xml:"Tasks>Task"`
+}
+
+type mspdiTask struct {
+	UID             int64                  `xml:"UID"`
+	ID              int                    `xml:"ID"`
+	Name            string                 `xml:"Name"`
+	Start           string                 `xml:"Start"`
+	Finish          string                 `xml:"Finish"`
+	Duration        string                 `xml:"Duration"`
+	Critical        int                    `xml:"Critical"`
+	PredecessorLink []mspdiPredecessorLink `xml:"PredecessorLink,omitempty"`
+}
+
+type mspdiPredecessorLink struct {
+	PredecessorUID int64 `xml:"PredecessorUID"`
+	Type           int   `xml:"Type"`
+	// задержка в десятых долях минуты
+	LinkLag   int64 `xml:"LinkLag,omitempty"`
+	LagFormat int   `xml:"LagFormat,omitempty"`
+}
+
+// formatMSPDIDuration - длительность в формате ISO 8601, например PT8H30M0S
+func formatMSPDIDuration(hours float64) string {
+	d := hoursToDuration(hours).Round(time.Second)
+	h := int64(d / time.Hour)
+	m := int64(d % time.Hour / time.Minute)
+	s := int64(d % time.Minute / time.Second)
+
+	return fmt.Sprintf("PT%dH%dM%dS", h, m, s)
+}
+
+func exportMSPDI(graph *ExportGraph) ([]byte, error) {
+	criticalNodes, _ := graph.criticalSets()
+	nodes := graph.sortedNodes()
+
+	predecessors := make(map[int64][]mspdiPredecessorLink, len(nodes))
+	for _, node := range nodes {
+		for _, depNodeID := range node.Dependencies {
+			link := node.Links[depNodeID]
+			predecessorLink := mspdiPredecessorLink{
+				PredecessorUID: node.ID,
+				Type:           mspdiLinkTypes[link.Type],
+			}
+			if link.Lag != 0 {
+				predecessorLink.LinkLag = int64(math.Round(link.Lag * 600))
+				predecessorLink.LagFormat = mspdiLagFormatHours
+			}
+			predecessors[depNodeID] = append(predecessors[depNodeID], predecessorLink)
+		}
+	}
+
+	project := mspdiProject{
+		Xmlns:      mspdiNamespace,
+		Name:       graph.Name,
+		StartDate:  graph.StartAt.Format(mspdiTimeLayout),
+		FinishDate: graph.FinishAt.Format(mspdiTimeLayout),
+		Tasks:      make([]mspdiTask, 0, len(nodes)),
+	}
+
+	for i, node := range nodes {
+		task := mspdiTask{
+			UID:      node.ID,
+			ID:       i + 1,
+			Name:     node.Title,
+			Start:    node.Start.Format(mspdiTimeLayout),
+			Finish:   node.Finish.Format(mspdiTimeLayout),
+			Duration: formatMSPDIDuration(node.Duration),
+		}
+		if _, ok := criticalNodes[node.ID]; ok {
+			task.Critical = 1
+		}
+		task.PredecessorLink = predecessors[node.ID]
+
+		project.Tasks = append(project.Tasks, task)
+	}
+
+	res, err := xml.MarshalIndent(project, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), append(res, '\n')...), nil
+}
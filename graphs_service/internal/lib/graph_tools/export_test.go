@@ -0,0 +1,170 @@
+package graphtools
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func exportTestGraph() *ExportGraph {
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	at := func(hours float64) time.Time { return startAt.Add(hoursToDuration(hours)) }
+
+	// 1 -> 2 -> 4
+	// 1 -> 3 -> 4
+	// критический путь 1 -> 2 -> 4
+	return &ExportGraph{
+		Name:     `Release "v2"`,
+		StartAt:  startAt,
+		FinishAt: at(6.5),
+		Nodes: []ExportNode{
+			{ID: 4, Title: "Deploy", Dependencies: []int64{}, Duration: 0.5, Start: at(6), Finish: at(6.5)},
+			{ID: 1, Title: "Design", Dependencies: []int64{3, 2}, Duration: 1, Start: at(0), Finish: at(1)},
+			{ID: 2, Title: "Backend", Dependencies: []int64{4}, Duration: 5, Start: at(1), Finish: at(6)},
+			{ID: 3, Title: `"Frontend"`, Dependencies: []int64{4}, Duration: 2.25, Start: at(1), Finish: at(3.25)},
+		},
+		Paths: [][]int64{{1, 2, 4}},
+	}
+}
+
+func TestExportDOT(t *testing.T) {
+	want := `digraph "Release \"v2\"" {
+	rankdir=LR;
+	node [shape=box];
+	n1 [label="Design\n1h", color=red, penwidth=2];
+	n2 [label="Backend\n5h", color=red, penwidth=2];
+	n3 [label="\"Frontend\"\n2.25h"];
+	n4 [label="Deploy\n0.5h", color=red, penwidth=2];
+	n1 -> n2 [color=red, penwidth=2];
+	n1 -> n3;
+	n2 -> n4 [color=red, penwidth=2];
+	n3 -> n4;
+}
+`
+
+	got, err := Export(exportTestGraph(), FormatDOT)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestExportMermaid(t *testing.T) {
+	want := `flowchart LR
+    n1["Design<br/>1h"]
+    n2["Backend<br/>5h"]
+    n3["#quot;Frontend#quot;<br/>2.25h"]
+    n4["Deploy<br/>0.5h"]
+    n1 --> n2
+    n1 --> n3
+    n2 --> n4
+    n3 --> n4
+    classDef critical stroke:#d00,stroke-width:2px;
+    class n1,n2,n4 critical;
+    linkStyle 0,2 stroke:#d00,stroke-width:2px;
+`
+
+	got, err := Export(exportTestGraph(), FormatMermaid)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestExportMSPDI(t *testing.T) {
+	got, err := Export(exportTestGraph(), FormatMSPDI)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var project mspdiProject
+	if err := xml.Unmarshal(got, &project); err != nil {
+		t.Fatalf("Invalid xml: %v", err)
+	}
+
+	if project.Name != `Release "v2"` || project.StartDate != "2025-03-03T09:00:00" || project.FinishDate != "2025-03-03T15:30:00" {
+		t.Errorf("Unexpected project header: %+v", project)
+	}
+	if len(project.Tasks) != 4 {
+		t.Fatalf("Expected 4 tasks, got %d", len(project.Tasks))
+	}
+
+	frontend := project.Tasks[2]
+	if frontend.UID != 3 || frontend.ID != 3 || frontend.Duration != "PT2H15M0S" || frontend.Critical != 0 {
+		t.Errorf("Unexpected task: %+v", frontend)
+	}
+
+	deploy := project.Tasks[3]
+	if deploy.Critical != 1 || len(deploy.PredecessorLink) != 2 ||
+		deploy.PredecessorLink[0].PredecessorUID != 2 || deploy.PredecessorLink[1].PredecessorUID != 3 {
+		t.Errorf("Unexpected task: %+v", deploy)
+	}
+	if deploy.Start != "2025-03-03T15:00:00" || deploy.Finish != "2025-03-03T15:30:00" {
+		t.Errorf("Unexpected task dates: %+v", deploy)
+	}
+}
+
+func TestExportUnknownFormat(t *testing.T) {
+	_, err := Export(exportTestGraph(), 100)
+	if !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("Expected ErrUnknownFormat, got %v", err)
+	}
+}
+
+func TestExportLinks(t *testing.T) {
+	graph := exportTestGraph()
+	graph.Nodes[1].Links = map[int64]ExportLink{
+		3: {Type: LinkStartToStart, Lag: 0.5},
+	}
+	graph.Nodes[2].Links = map[int64]ExportLink{
+		4: {Type: LinkFinishToStart, Lag: -1},
+	}
+
+	dot, err := Export(graph, FormatDOT)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, edge := range []string{
+		"\tn1 -> n3 [label=\"SS+0.5h\"];\n",
+		"\tn2 -> n4 [label=\"FS-1h\", color=red, penwidth=2];\n",
+		"\tn3 -> n4;\n",
+	} {
+		if !strings.Contains(string(dot), edge) {
+			t.Errorf("Expected edge %q in:\n%s", edge, dot)
+		}
+	}
+
+	mermaid, err := Export(graph, FormatMermaid)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(string(mermaid), "    n1 -->|SS+0.5h| n3\n") {
+		t.Errorf("Expected labeled edge in:\n%s", mermaid)
+	}
+
+	content, err := Export(graph, FormatMSPDI)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var project mspdiProject
+	if err := xml.Unmarshal(content, &project); err != nil {
+		t.Fatalf("Invalid xml: %v", err)
+	}
+
+	frontend := project.Tasks[2]
+	if len(frontend.PredecessorLink) != 1 || frontend.PredecessorLink[0].Type != 3 ||
+		frontend.PredecessorLink[0].LinkLag != 300 || frontend.PredecessorLink[0].LagFormat != mspdiLagFormatHours {
+		t.Errorf("Unexpected task: %+v", frontend)
+	}
+	deploy := project.Tasks[3]
+	if deploy.PredecessorLink[0].Type != 1 || deploy.PredecessorLink[0].LinkLag != -600 ||
+		deploy.PredecessorLink[1].LinkLag != 0 {
+		t.Errorf("Unexpected task: %+v", deploy)
+	}
+}
@@ -10,6 +10,6 @@ type Node interface {
 	GetID() int64
 	GetWeight() float64
 	GetAssignedTo() int64
-	GetAdditionalDependencies() []int64
-	AddAdditionalDependency(...int64)
+	// тип связи с зависимой вершиной и задержка в часах
+	GetLink(depNodeID int64) (linkType int, lag float64)
 }
@@ -0,0 +1,112 @@
+package graphtools
+
+import (
+	"errors"
+	"slices"
+)
+
+// Типы связей между вершинами, from - вершина, от которой зависит вершина to
+const (
+	// to начинается после окончания from
+	LinkFinishToStart = iota
+	// to начинается после начала from
+	LinkStartToStart
+	// to заканчивается после окончания from
+	LinkFinishToFinish
+	// to заканчивается после начала from
+	LinkStartToFinish
+)
+
+var (
+	// Будет возвращено, в случае, если тип связи не существует
+	ErrUnknownLinkType = errors.New("unknown link type")
+)
+
+// ValidateLinkType - проверяет, что тип связи существует
+func ValidateLinkType(linkType int) error {
+	if linkType < LinkFinishToStart || linkType > LinkStartToFinish {
+		return ErrUnknownLinkType
+	}
+
+	return nil
+}
+
+// link - возвращает тип связи fromID -> toID и задержку в часах,
+// отрицательная задержка означает опережение
+// связи с последней вершиной имеют тип окончание-начало без задержки
+func (s *solver) link(fromID, toID int64) (int, float64) {
+	if toID == dummyNodeID {
+		return LinkFinishToStart, 0
+	}
+
+	return s.nodeAmountMap[fromID].GetLink(toID)
+}
+
+// isResourceDependency - зависимость добавлена из-за занятости исполнителя,
+// такая зависимость всегда имеет тип окончание-начало
+func (s *solver) isResourceDependency(fromID, toID int64) bool {
+	return slices.Contains(s.resourceDependencies[fromID], toID)
+}
+
+// earliestStart - возвращает самое раннее начало вершины toID,
+// которое допускает связь с вершиной fromID, выполняемой в интервале [start, finish]
+func (s *solver) earliestStart(fromID, toID int64, start, finish float64) float64 {
+	linkType, lag := s.link(fromID, toID)
+
+	var res float64
+	switch linkType {
+	case LinkStartToStart:
+		res = start + lag
+	case LinkFinishToFinish:
+		res = s.lateStart(toID, finish+lag)
+	case LinkStartToFinish:
+		res = s.lateStart(toID, start+lag)
+	default:
+		res = finish + lag
+	}
+
+	if s.isResourceDependency(fromID, toID) {
+		res = max(res, finish)
+	}
+
+	return res
+}
+
+// latestFinish - возвращает самое позднее окончание вершины fromID,
+// которое допускает связь с вершиной toID при ее поздних сроках,
+// доступно после расчета поздних сроков вершины toID
+func (s *solver) latestFinish(fromID, toID int64) float64 {
+	linkType, lag := s.link(fromID, toID)
+	next := s.nodeAmountMap[toID]
+
+	var res float64
+	switch linkType {
+	case LinkStartToStart:
+		res = s.earlyFinishAt(fromID, next.min-lag)
+	case LinkFinishToFinish:
+		res = next.lateFinish - lag
+	case LinkStartToFinish:
+		res = s.earlyFinishAt(fromID, next.lateFinish-lag)
+	default:
+		res = next.min - lag
+	}
+
+	if s.isResourceDependency(fromID, toID) {
+		res = min(res, next.min)
+	}
+
+	return res
+}
+
+// earlyFinishAt - возвращает окончание вершины, если начать ее в момент start
+func (s *solver) earlyFinishAt(nodeID int64, start float64) float64 {
+	_, finish := s.place(nodeID, start)
+	return finish
+}
+
+// isPlainLink - связь окончание-начало без опережения,
+// такая связь уже гарантирует последовательное выполнение вершин
+func (s *solver) isPlainLink(fromID, toID int64) bool {
+	linkType, lag := s.link(fromID, toID)
+	return linkType == LinkFinishToStart && lag >= 0
+}
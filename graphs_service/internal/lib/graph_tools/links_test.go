@@ -0,0 +1,153 @@
+package graphtools
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/liriquew/control_system/graphs_service/internal/entities"
+	graph_wrapper "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/wrapper"
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+)
+
+// linkedGraph - граф из двух вершин 1 -> 2, связанных связью заданного типа
+func linkedGraph(linkType int32, lag float64) entities.GraphWithTasks {
+	return entities.GraphWithTasks{
+		Nodes: []*entities.NodeWithTask{
+			{Node: &models.Node{
+				ID:                1,
+				DependencyNodeIDs: []int64{2},
+				Links:             []*models.Dependency{{FromNodeID: 1, ToNodeID: 2, LinkType: linkType, Lag: lag}},
+				AssignedTo:        ptrInt64(1),
+			}},
+			{Node: &models.Node{ID: 2, DependencyNodeIDs: []int64{}, AssignedTo: ptrInt64(2)}},
+		},
+	}
+}
+
+func TestFindScheduleWithLinks(t *testing.T) {
+	tests := []struct {
+		name          string
+		graph         entities.GraphWithTasks
+		nodesValueMap map[int64]float64
+		duration      float64
+		paths         [][]int64
+		want          map[int64]NodeSchedule
+	}{
+		{
+			name:          "Start to start with lag",
+			graph:         linkedGraph(LinkStartToStart, 2),
+			nodesValueMap: map[int64]float64{1: 10, 2: 5},
+			duration:      10,
+			paths:         [][]int64{{1}},
+			want: map[int64]NodeSchedule{
+				1: {EarlyStart: 0, EarlyFinish: 10, LateStart: 0, LateFinish: 10},
+				2: {EarlyStart: 2, EarlyFinish: 7, LateStart: 5, LateFinish: 10, TotalFloat: 3, FreeFloat: 3},
+			},
+		},
+		{
+			name:          "Finish to finish with lag",
+			graph:         linkedGraph(LinkFinishToFinish, 1),
+			nodesValueMap: map[int64]float64{1: 4, 2: 2},
+			duration:      5,
+			paths:         [][]int64{{1, 2}},
+			want: map[int64]NodeSchedule{
+				1: {EarlyStart: 0, EarlyFinish: 4, LateStart: 0, LateFinish: 4},
+				2: {EarlyStart: 3, EarlyFinish: 5, LateStart: 3, LateFinish: 5},
+			},
+		},
+		{
+			name:          "Start to finish with lag",
+			graph:         linkedGraph(LinkStartToFinish, 3),
+			nodesValueMap: map[int64]float64{1: 4, 2: 2},
+			duration:      4,
+			paths:         [][]int64{{1}},
+			want: map[int64]NodeSchedule{
+				1: {EarlyStart: 0, EarlyFinish: 4, LateStart: 0, LateFinish: 4},
+				2: {EarlyStart: 1, EarlyFinish: 3, LateStart: 2, LateFinish: 4, TotalFloat: 1, FreeFloat: 1},
+			},
+		},
+		{
+			name:          "Finish to start with lead",
+			graph:         linkedGraph(LinkFinishToStart, -2),
+			nodesValueMap: map[int64]float64{1: 5, 2: 3},
+			duration:      6,
+			paths:         [][]int64{{1, 2}},
+			want: map[int64]NodeSchedule{
+				1: {EarlyStart: 0, EarlyFinish: 5, LateStart: 0, LateFinish: 5},
+				2: {EarlyStart: 3, EarlyFinish: 6, LateStart: 3, LateFinish: 6},
+			},
+		},
+		{
+			name:          "Finish to start with lag",
+			graph:         linkedGraph(LinkFinishToStart, 4),
+			nodesValueMap: map[int64]float64{1: 1, 2: 1},
+			duration:      6,
+			paths:         [][]int64{{1, 2}},
+			want: map[int64]NodeSchedule{
+				1: {EarlyStart: 0, EarlyFinish: 1, LateStart: 0, LateFinish: 1},
+				2: {EarlyStart: 5, EarlyFinish: 6, LateStart: 5, LateFinish: 6},
+			},
+		},
+	}
+
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedule, err := FindSchedule(graph_wrapper.WrapGraphWithTasks(&test.graph), test.nodesValueMap, ScheduleOptions{StartAt: startAt})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if schedule.Duration != test.duration {
+				t.Errorf("Expected duration %v, got %v", test.duration, schedule.Duration)
+			}
+			if !comparePaths(schedule.Paths, test.paths) {
+				t.Errorf("Expected %v, got %v", test.paths, schedule.Paths)
+			}
+			for nodeID, nodeSchedule := range test.want {
+				nodeSchedule.Start = startAt.Add(time.Duration(nodeSchedule.EarlyStart) * time.Hour)
+				nodeSchedule.Finish = startAt.Add(time.Duration(nodeSchedule.EarlyFinish) * time.Hour)
+				if schedule.Nodes[nodeID] != nodeSchedule {
+					t.Errorf("Node %d: expected %+v, got %+v", nodeID, nodeSchedule, schedule.Nodes[nodeID])
+				}
+			}
+		})
+	}
+}
+
+func TestResourceLevelingWithStartToStartLink(t *testing.T) {
+	// обе вершины выполняет один исполнитель,
+	// поэтому связь начало-начало не позволяет выполнять их параллельно
+	graph := linkedGraph(LinkStartToStart, 1)
+	graph.Nodes[1].Node.AssignedTo = ptrInt64(1)
+
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	schedule, err := FindSchedule(graph_wrapper.WrapGraphWithTasks(&graph), map[int64]float64{1: 3, 2: 2}, ScheduleOptions{StartAt: startAt})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if schedule.Duration != 5 {
+		t.Errorf("Expected duration 5, got %v", schedule.Duration)
+	}
+	if node := schedule.Nodes[2]; node.EarlyStart != 3 || node.EarlyFinish != 5 {
+		t.Errorf("Expected node 2 in [3, 5], got %+v", node)
+	}
+	if !comparePaths(schedule.Paths, [][]int64{{1, 2}}) {
+		t.Errorf("Expected %v, got %v", [][]int64{{1, 2}}, schedule.Paths)
+	}
+}
+
+func TestValidateLinkType(t *testing.T) {
+	for _, linkType := range []int{LinkFinishToStart, LinkStartToStart, LinkFinishToFinish, LinkStartToFinish} {
+		if err := ValidateLinkType(linkType); err != nil {
+			t.Errorf("Unexpected error for link type %d: %v", linkType, err)
+		}
+	}
+	for _, linkType := range []int{-1, 4} {
+		if err := ValidateLinkType(linkType); !errors.Is(err, ErrUnknownLinkType) {
+			t.Errorf("Expected ErrUnknownLinkType for link type %d, got %v", linkType, err)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package graphtools
+
+// RemainingWeight - оставшееся время выполнения вершины в часах,
+// выполненная вершина больше не занимает времени, для начатой вершины
+// учитывается только невыполненная часть, percentComplete - процент выполнения от 0 до 100
+func RemainingWeight(weight, percentComplete float64, done bool) float64 {
+	if done {
+		return 0
+	}
+
+	percentComplete = min(max(percentComplete, 0), 100)
+	return weight * (100 - percentComplete) / 100
+}
+
+// Remaining - распределение оставшегося времени выполнения вершины,
+// все оценки сокращаются так же, как в RemainingWeight
+func (d Distribution) Remaining(percentComplete float64, done bool) Distribution {
+	return Distribution{
+		Min:  RemainingWeight(d.Min, percentComplete, done),
+		Mode: RemainingWeight(d.Mode, percentComplete, done),
+		Max:  RemainingWeight(d.Max, percentComplete, done),
+	}
+}
@@ -0,0 +1,85 @@
+package graphtools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/liriquew/control_system/graphs_service/internal/entities"
+	graph_wrapper "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/wrapper"
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+)
+
+func TestRemainingWeight(t *testing.T) {
+	tests := []struct {
+		name            string
+		weight          float64
+		percentComplete float64
+		done            bool
+		want            float64
+	}{
+		{name: "Not started", weight: 8, want: 8},
+		{name: "In progress", weight: 8, percentComplete: 25, want: 6},
+		{name: "Done", weight: 8, percentComplete: 100, done: true, want: 0},
+		{name: "Done without progress", weight: 8, done: true, want: 0},
+		{name: "Progress above 100", weight: 8, percentComplete: 150, want: 0},
+		{name: "Negative progress", weight: 8, percentComplete: -10, want: 8},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := RemainingWeight(test.weight, test.percentComplete, test.done); got != test.want {
+				t.Errorf("Expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestDistributionRemaining(t *testing.T) {
+	dist := Distribution{Min: 4, Mode: 8, Max: 16}
+
+	if got, want := dist.Remaining(25, false), (Distribution{Min: 3, Mode: 6, Max: 12}); got != want {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if got := dist.Remaining(25, true); got != (Distribution{}) {
+		t.Errorf("Expected zero distribution, got %v", got)
+	}
+}
+
+func TestFindScheduleWithProgress(t *testing.T) {
+	// 1 -> 2 -> 3, вершина 1 выполнена, вершина 2 выполнена наполовину,
+	// оставшаяся часть графа планируется от момента startAt
+	graph := entities.GraphWithTasks{
+		Nodes: []*entities.NodeWithTask{
+			{Node: &models.Node{ID: 1, DependencyNodeIDs: []int64{2}, AssignedTo: ptrInt64(1)}},
+			{Node: &models.Node{ID: 2, DependencyNodeIDs: []int64{3}, AssignedTo: ptrInt64(1)}},
+			{Node: &models.Node{ID: 3, DependencyNodeIDs: []int64{}, AssignedTo: ptrInt64(2)}},
+		},
+	}
+	nodesValueMap := map[int64]float64{
+		1: RemainingWeight(5, 100, true),
+		2: RemainingWeight(4, 50, false),
+		3: RemainingWeight(6, 0, false),
+	}
+
+	startAt := time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)
+	schedule, err := FindSchedule(graph_wrapper.WrapGraphWithTasks(&graph), nodesValueMap, ScheduleOptions{StartAt: startAt})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if schedule.Duration != 8 {
+		t.Errorf("Expected duration 8, got %v", schedule.Duration)
+	}
+	if !schedule.FinishAt.Equal(startAt.Add(8 * time.Hour)) {
+		t.Errorf("Expected finish at %v, got %v", startAt.Add(8*time.Hour), schedule.FinishAt)
+	}
+	if node := schedule.Nodes[1]; node.EarlyStart != 0 || node.EarlyFinish != 0 {
+		t.Errorf("Expected completed node 1 in [0, 0], got %+v", node)
+	}
+	if node := schedule.Nodes[2]; node.EarlyStart != 0 || node.EarlyFinish != 2 {
+		t.Errorf("Expected node 2 in [0, 2], got %+v", node)
+	}
+	if node := schedule.Nodes[3]; node.EarlyStart != 2 || node.EarlyFinish != 8 {
+		t.Errorf("Expected node 3 in [2, 8], got %+v", node)
+	}
+}
@@ -0,0 +1,254 @@
+package graphtools
+
+import (
+	"cmp"
+	"slices"
+)
+
+const (
+	// Константы для выбора приоритета
+	MinTimePriority = iota
+	MaxTimePriority
+	LatestStartPriority
+	MostSuccessorsPriority
+	RankPositionalWeightPriority
+)
+
+// priorityRule - возвращает true, если вершина a приоритетнее вершины b,
+// то есть должна получить время исполнителя раньше
+type priorityRule func(s *solver, a, b int64) bool
+
+// Приоритеты, для добавления нового правила
+// достаточно добавить его в этот список
+var priorityRules = map[int]priorityRule{
+	MinTimePriority: func(s *solver, a, b int64) bool {
+		// вершины с меньшим весом приоритетнее
+		return s.nodesValueMap[a] < s.nodesValueMap[b]
+	},
+	MaxTimePriority: func(s *solver, a, b int64) bool {
+		// вершины с большим весом приоритетнее
+		return s.nodesValueMap[a] > s.nodesValueMap[b]
+	},
+	LatestStartPriority: func(s *solver, a, b int64) bool {
+		// вершины с меньшим поздним началом приоритетнее,
+		// используется LS, рассчитанный без учета исполнителей
+		return s.nodeAmountMap[a].min < s.nodeAmountMap[b].min
+	},
+	MostSuccessorsPriority: func(s *solver, a, b int64) bool {
+		// вершины, от которых зависит больше вершин, приоритетнее
+		return len(s.allSuccessors(a)) > len(s.allSuccessors(b))
+	},
+	RankPositionalWeightPriority: func(s *solver, a, b int64) bool {
+		// вершины с большим весом вместе с весом следующих вершин приоритетнее
+		return s.positionalWeight(a) > s.positionalWeight(b)
+	},
+}
+
+// allSuccessors - возвращает все вершины, которые зависят от вершины
+// напрямую или через другие вершины, последняя вершина не учитывается
+func (s *solver) allSuccessors(nodeID int64) []int64 {
+	if successors, ok := s.successors[nodeID]; ok {
+		return successors
+	}
+
+	visited := make(map[int64]struct{})
+	stack := slices.Clone(s.adjacencyList[nodeID])
+	for len(stack) > 0 {
+		currNodeID := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if _, ok := visited[currNodeID]; ok || currNodeID == dummyNodeID {
+			continue
+		}
+		visited[currNodeID] = struct{}{}
+		stack = append(stack, s.adjacencyList[currNodeID]...)
+	}
+
+	successors := make([]int64, 0, len(visited))
+	for successorID := range visited {
+		successors = append(successors, successorID)
+	}
+	s.successors[nodeID] = successors
+
+	return successors
+}
+
+// positionalWeight - возвращает вес вершины вместе с весом всех следующих за ней вершин
+func (s *solver) positionalWeight(nodeID int64) float64 {
+	weight := s.nodesValueMap[nodeID]
+	for _, nextNodeID := range s.allSuccessors(nodeID) {
+		weight += s.nodesValueMap[nextNodeID]
+	}
+
+	return weight
+}
+
+// higherPriority - возвращает true, если вершина a должна быть запланирована раньше b
+// при равном приоритете раньше планируется вершина с меньшим идентификатором
+func (s *solver) higherPriority(a, b int64) bool {
+	if s.priorityRule(s, a, b) {
+		return true
+	}
+	if s.priorityRule(s, b, a) {
+		return false
+	}
+
+	return a < b
+}
+
+// interval - временной интервал, в котором исполнитель выполняет задачу
+type interval struct {
+	start, finish float64
+	nodeID        int64
+	// порядковый номер вершины в расписании
+	seq int
+}
+
+// levelResources - строит расписание с учетом занятости исполнителей
+// (последовательная схема генерации расписания)
+// на каждом шаге из вершин, все предыдущие вершины которых уже запланированы,
+// выбирается самая приоритетная и ставится на самое раннее время,
+// в которое исполнитель свободен и работает по своему календарю
+// вершины без исполнителя не ограничены по времени
+func (s *solver) levelResources() {
+	// количество незапланированных предыдущих вершин
+	inDegree := make(map[int64]int, len(s.nodeAmountMap))
+	var eligible []int64
+	for nodeID := range s.nodeAmountMap {
+		inDegree[nodeID] = len(s.adjacencyListInversed[nodeID])
+		if inDegree[nodeID] == 0 {
+			eligible = append(eligible, nodeID)
+		}
+	}
+
+	// далее времена вершин рассчитываются с учетом календарей исполнителей
+	s.leveled = true
+
+	// занятость исполнителей, ключ - идентификатор исполнителя
+	workersTimeLine := make(map[int64][]interval, len(s.nodeAmountMap))
+	scheduled := make(map[int64]*nodeAmount, len(s.nodeAmountMap))
+
+	for seq := 0; len(eligible) > 0; seq++ {
+		// выбираем самую приоритетную вершину
+		best := 0
+		for i := range eligible {
+			if s.higherPriority(eligible[i], eligible[best]) {
+				best = i
+			}
+		}
+		currNodeID := eligible[best]
+		eligible = slices.Delete(eligible, best, best+1)
+
+		// вершина не может начаться раньше, чем допускают связи с предыдущими
+		var earliest float64
+		for _, prevNodeID := range s.adjacencyListInversed[currNodeID] {
+			prev := scheduled[prevNodeID]
+			earliest = max(earliest, s.earliestStart(prevNodeID, currNodeID, prev.max, prev.earlyFinish))
+		}
+		if currNodeID == dummyNodeID {
+			// к этому моменту запланированы все вершины графа
+			for _, amount := range scheduled {
+				earliest = max(earliest, amount.earlyFinish)
+			}
+		}
+
+		worker := s.nodeAmountMap[currNodeID].GetAssignedTo()
+		start, finish := s.findSlot(currNodeID, earliest, workersTimeLine[worker])
+
+		currNodeAmount := s.nodeAmountMap[currNodeID]
+		currNodeAmount.max = start
+		currNodeAmount.earlyFinish = finish
+		currNodeAmount.min = 0
+		scheduled[currNodeID] = currNodeAmount
+
+		if worker > 0 {
+			workersTimeLine[worker] = append(workersTimeLine[worker], interval{
+				start:  start,
+				finish: finish,
+				nodeID: currNodeID,
+				seq:    seq,
+			})
+		}
+
+		for _, nextNodeID := range s.adjacencyList[currNodeID] {
+			inDegree[nextNodeID]--
+			if inDegree[nextNodeID] == 0 {
+				eligible = append(eligible, nextNodeID)
+			}
+		}
+	}
+
+	// задачи одного исполнителя выполняются последовательно,
+	// поэтому добавляем зависимости между соседними задачами исполнителя,
+	// иначе обратный ход не учтет, что задержка одной задачи сдвигает следующую
+	for _, intervals := range workersTimeLine {
+		slices.SortFunc(intervals, func(a, b interval) int {
+			if !floatEqual(a.start, b.start) {
+				return cmp.Compare(a.start, b.start)
+			}
+			return a.seq - b.seq
+		})
+
+		for i := range len(intervals) - 1 {
+			s.addDependency(intervals[i].nodeID, intervals[i+1].nodeID)
+		}
+	}
+}
+
+// findSlot - находит самый ранний интервал, не раньше earliest,
+// в который исполнитель может выполнить вершину, не пересекаясь с другими его задачами
+func (s *solver) findSlot(nodeID int64, earliest float64, busy []interval) (start, finish float64) {
+	for {
+		start, finish = s.place(nodeID, earliest)
+
+		overlapped := false
+		for _, iv := range busy {
+			if floatLess(iv.start, finish) && floatLess(start, iv.finish) {
+				// интервал занят, пробуем начать после его окончания
+				earliest = max(earliest, iv.finish)
+				overlapped = true
+				break
+			}
+		}
+
+		if !overlapped {
+			return start, finish
+		}
+	}
+}
+
+// place - возвращает время начала и окончания вершины,
+// если начать ее не раньше earliest, с учетом календаря исполнителя
+func (s *solver) place(nodeID int64, earliest float64) (start, finish float64) {
+	duration := s.nodesValueMap[nodeID]
+
+	calendar := s.calendar(nodeID)
+	if !s.leveled || calendar == nil || duration == 0 {
+		return earliest, earliest + duration
+	}
+
+	startTime := calendar.nextWorkingTime(s.toTime(earliest))
+	finishTime := calendar.addWorkingTime(startTime, hoursToDuration(duration))
+
+	return s.toHours(startTime), s.toHours(finishTime)
+}
+
+// addDependency - добавляет зависимость, возникшую из-за занятости исполнителя
+// toId будет зависеть от выполнения вершины с индетификатором fromId
+// если вершины уже связаны, но связь допускает их параллельное выполнение,
+// зависимость дополняет существующую связь
+func (s *solver) addDependency(fromId, toId int64) {
+	if s.isResourceDependency(fromId, toId) {
+		return
+	}
+
+	if slices.Contains(s.adjacencyList[fromId], toId) {
+		if s.isPlainLink(fromId, toId) {
+			return
+		}
+	} else {
+		s.adjacencyList[fromId] = append(s.adjacencyList[fromId], toId)
+		s.adjacencyListInversed[toId] = append(s.adjacencyListInversed[toId], fromId)
+	}
+	s.resourceDependencies[fromId] = append(s.resourceDependencies[fromId], toId)
+}
@@ -0,0 +1,151 @@
+package graphtools
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/liriquew/control_system/graphs_service/internal/entities"
+	graph_wrapper "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/wrapper"
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+)
+
+func TestLevelResourcesPriority(t *testing.T) {
+	// 1 -> 2, 1 -> 3 -> 4, задачи 2 и 3 выполняет один исполнитель,
+	// граф общий для всех случаев, так как FindSchedule не изменяет граф
+	graph := entities.GraphWithTasks{
+		Nodes: []*entities.NodeWithTask{
+			{Node: &models.Node{ID: 1, DependencyNodeIDs: []int64{2, 3}, AssignedTo: ptrInt64(1)}},
+			{Node: &models.Node{ID: 2, DependencyNodeIDs: []int64{}, AssignedTo: ptrInt64(2)}},
+			{Node: &models.Node{ID: 3, DependencyNodeIDs: []int64{4}, AssignedTo: ptrInt64(2)}},
+			{Node: &models.Node{ID: 4, DependencyNodeIDs: []int64{}, AssignedTo: ptrInt64(3)}},
+		},
+	}
+	nodesValueMap := map[int64]float64{
+		1: 1, 2: 2, 3: 3, 4: 4,
+	}
+
+	tests := []struct {
+		name     string
+		priority int
+		duration float64
+		// зависимость, добавленная из-за занятости исполнителя
+		resourceDependency [2]int64
+	}{
+		{name: "Min time", priority: MinTimePriority, duration: 10, resourceDependency: [2]int64{2, 3}},
+		{name: "Max time", priority: MaxTimePriority, duration: 8, resourceDependency: [2]int64{3, 2}},
+		{name: "Latest start", priority: LatestStartPriority, duration: 8, resourceDependency: [2]int64{3, 2}},
+		{name: "Most successors", priority: MostSuccessorsPriority, duration: 8, resourceDependency: [2]int64{3, 2}},
+		{name: "Rank positional weight", priority: RankPositionalWeightPriority, duration: 8, resourceDependency: [2]int64{3, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := FindSchedule(graph_wrapper.WrapGraphWithTasks(&graph), nodesValueMap, ScheduleOptions{Priority: tt.priority})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if schedule.Duration != tt.duration {
+				t.Errorf("Expected duration %v, got %v", tt.duration, schedule.Duration)
+			}
+
+			from, to := tt.resourceDependency[0], tt.resourceDependency[1]
+			if len(schedule.ResourceDependencies) != 1 || len(schedule.ResourceDependencies[from]) != 1 || schedule.ResourceDependencies[from][0] != to {
+				t.Errorf("Expected resource dependency %d -> %d, got %v", from, to, schedule.ResourceDependencies)
+			}
+		})
+	}
+}
+
+func TestLevelResourcesKeepsGraph(t *testing.T) {
+	// 1 -> 2, 1 -> 3, задачи 2 и 3 выполняет один исполнитель
+	graph := entities.GraphWithTasks{
+		Nodes: []*entities.NodeWithTask{
+			{Node: &models.Node{ID: 1, DependencyNodeIDs: []int64{2, 3}, AssignedTo: ptrInt64(1)}},
+			{Node: &models.Node{ID: 2, DependencyNodeIDs: []int64{}, AssignedTo: ptrInt64(2)}},
+			{Node: &models.Node{ID: 3, DependencyNodeIDs: []int64{}, AssignedTo: ptrInt64(2)}},
+		},
+	}
+	nodesValueMap := map[int64]float64{1: 1, 2: 2, 3: 3}
+
+	first, err := FindSchedule(graph_wrapper.WrapGraphWithTasks(&graph), nodesValueMap, ScheduleOptions{Priority: MinTimePriority})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(first.ResourceDependencies) != 1 {
+		t.Fatalf("Expected one resource dependency, got %v", first.ResourceDependencies)
+	}
+
+	for _, node := range graph.Nodes {
+		if len(node.AdditionalDependencies) != 0 {
+			t.Errorf("Expected node %d without additional dependencies, got %v", node.Node.ID, node.AdditionalDependencies)
+		}
+	}
+	if deps := graph.Nodes[0].Node.DependencyNodeIDs; len(deps) != 2 {
+		t.Errorf("Expected node 1 dependencies unchanged, got %v", deps)
+	}
+
+	second, err := FindSchedule(graph_wrapper.WrapGraphWithTasks(&graph), nodesValueMap, ScheduleOptions{Priority: MinTimePriority})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if second.Duration != first.Duration {
+		t.Errorf("Expected duration %v on repeated call, got %v", first.Duration, second.Duration)
+	}
+}
+
+func TestLevelResourcesUnknownPriority(t *testing.T) {
+	graph := entities.GraphWithTasks{
+		Nodes: []*entities.NodeWithTask{
+			{Node: &models.Node{ID: 1, DependencyNodeIDs: []int64{}, AssignedTo: ptrInt64(1)}},
+		},
+	}
+
+	_, err := FindSchedule(graph_wrapper.WrapGraphWithTasks(&graph), map[int64]float64{1: 1}, ScheduleOptions{Priority: 100})
+	if !errors.Is(err, ErrUnknownPriority) {
+		t.Errorf("Expected ErrUnknownPriority, got %v", err)
+	}
+}
+
+func TestLevelResourcesWithCalendars(t *testing.T) {
+	// 1 -> 2, задачи выполняют разные исполнители,
+	// исполнитель 2 работает с 9 до 18 по будням
+	graph := entities.GraphWithTasks{
+		Nodes: []*entities.NodeWithTask{
+			{Node: &models.Node{ID: 1, DependencyNodeIDs: []int64{2}, AssignedTo: ptrInt64(1)}},
+			{Node: &models.Node{ID: 2, DependencyNodeIDs: []int64{}, AssignedTo: ptrInt64(2)}},
+		},
+	}
+	nodesValueMap := map[int64]float64{
+		1: 7, 2: 4,
+	}
+
+	// пятница, 9:00
+	startAt := time.Date(2025, time.March, 7, 9, 0, 0, 0, time.UTC)
+	schedule, err := FindSchedule(graph_wrapper.WrapGraphWithTasks(&graph), nodesValueMap, ScheduleOptions{
+		StartAt: startAt,
+		Calendars: map[int64]*Calendar{
+			2: newWeekCalendar(),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// у исполнителя 1 нет календаря, задача 1 заканчивается в 16:00,
+	// задача 2 начинается в 16:00 и прерывается на выходные
+	wantStart := time.Date(2025, time.March, 7, 16, 0, 0, 0, time.UTC)
+	wantFinish := time.Date(2025, time.March, 10, 11, 0, 0, 0, time.UTC)
+
+	node := schedule.Nodes[2]
+	if !node.Start.Equal(wantStart) || !node.Finish.Equal(wantFinish) {
+		t.Errorf("Expected node 2 in [%v, %v], got [%v, %v]", wantStart, wantFinish, node.Start, node.Finish)
+	}
+	if node.TotalFloat != 0 {
+		t.Errorf("Expected node 2 to be critical, got total float %v", node.TotalFloat)
+	}
+	if !comparePaths(schedule.Paths, [][]int64{{1, 2}}) {
+		t.Errorf("Expected %v, got %v", [][]int64{{1, 2}}, schedule.Paths)
+	}
+}
@@ -0,0 +1,205 @@
+package graphtools
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+
+	graphinterface "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/graph_interface"
+)
+
+const (
+	// минимальный относительный разброс времени выполнения задачи
+	minDurationSpread = 0.1
+	// во сколько раз пессимистичная оценка дальше от наиболее вероятной, чем оптимистичная
+	pessimisticSpreadFactor = 2
+)
+
+// Distribution - треугольное распределение времени выполнения задачи
+type Distribution struct {
+	Min  float64 // оптимистичная оценка
+	Mode float64 // наиболее вероятная оценка
+	Max  float64 // пессимистичная оценка
+}
+
+// NewTaskDistribution - строит распределение времени выполнения задачи
+// если задача уже выполнена (actualTime > 0), ее время фиксировано
+// в качестве наиболее вероятного времени берется предсказанное время,
+// а если его нет - запланированное, разброс определяется расхождением
+// между запланированным и предсказанным временем
+func NewTaskDistribution(plannedTime, actualTime, predictedTime float64) Distribution {
+	if actualTime > 0 {
+		return Distribution{Min: actualTime, Mode: actualTime, Max: actualTime}
+	}
+
+	mode := predictedTime
+	if mode <= 0 {
+		mode = plannedTime
+	}
+	if mode <= 0 {
+		return Distribution{}
+	}
+
+	spread := minDurationSpread
+	if predictedTime > 0 && plannedTime > 0 {
+		spread = max(spread, math.Abs(predictedTime-plannedTime)/mode)
+	}
+
+	return Distribution{
+		Min:  max(0, mode*(1-spread)),
+		Mode: mode,
+		Max:  mode * (1 + pessimisticSpreadFactor*spread),
+	}
+}
+
+// Sample - возвращает случайное значение из распределения
+func (d Distribution) Sample(rnd *rand.Rand) float64 {
+	if d.Max <= d.Min {
+		return d.Mode
+	}
+
+	u := rnd.Float64()
+	width := d.Max - d.Min
+	if u < (d.Mode-d.Min)/width {
+		return d.Min + math.Sqrt(u*width*(d.Mode-d.Min))
+	}
+
+	return d.Max - math.Sqrt((1-u)*width*(d.Max-d.Mode))
+}
+
+// SimulationResult - результат моделирования выполнения графа
+type SimulationResult struct {
+	// количество выполненных итераций
+	Iterations int
+	// время выполнения графа в каждой итерации, отсортировано по возрастанию
+	Durations []float64
+	// среднее время выполнения графа
+	Mean float64
+	// доля итераций, в которых вершина лежала на критическом пути
+	Criticality map[int64]float64
+}
+
+// Percentile - возвращает p-й процентиль времени выполнения графа
+func (r *SimulationResult) Percentile(p float64) float64 {
+	if len(r.Durations) == 0 {
+		return 0
+	}
+
+	rank := int(math.Ceil(p/100*float64(len(r.Durations)))) - 1
+
+	return r.Durations[min(max(rank, 0), len(r.Durations)-1)]
+}
+
+// ExceedProbability - возвращает долю итераций, в которых время выполнения графа превысило hours
+func (r *SimulationResult) ExceedProbability(hours float64) float64 {
+	if len(r.Durations) == 0 {
+		return 0
+	}
+
+	// количество итераций, уложившихся в hours
+	n, _ := slices.BinarySearchFunc(r.Durations, hours, func(duration, hours float64) int {
+		if duration <= hours {
+			return -1
+		}
+		return 1
+	})
+
+	return float64(len(r.Durations)-n) / float64(len(r.Durations))
+}
+
+// sampledNode - вершина, вес которой заменен на случайное значение
+type sampledNode struct {
+	graphinterface.Node
+	weight float64
+}
+
+func (n *sampledNode) GetWeight() float64 {
+	return n.weight
+}
+
+// sampledGraph - граф, составленный из вершин со случайными весами
+type sampledGraph struct {
+	nodes []graphinterface.Node
+}
+
+func (g *sampledGraph) Len() int {
+	return len(g.nodes)
+}
+
+func (g *sampledGraph) GetNodes() []graphinterface.Node {
+	return g.nodes
+}
+
+// SimulateCriticalPath - моделирует выполнение графа методом Монте-Карло
+// на каждой итерации веса вершин выбираются из распределений distributions,
+// после чего выполняется поиск критических путей
+// вершины, для которых распределение не задано, имеют вес GetWeight()
+func SimulateCriticalPath[T graphinterface.GraphWithNodes](graph T, distributions map[int64]Distribution, opts ScheduleOptions, iterations int, rnd *rand.Rand) (*SimulationResult, error) {
+	if _, ok := priorityRules[opts.Priority]; !ok {
+		return nil, ErrUnknownPriority
+	}
+
+	// в случае, если в графе есть цикл, вернем ошибку
+	if cycle, has := HasCycle(graph); has {
+		return nil, &CycleError{Cycle: cycle}
+	}
+
+	res := &SimulationResult{
+		Iterations:  iterations,
+		Durations:   make([]float64, 0, iterations),
+		Criticality: make(map[int64]float64, graph.Len()),
+	}
+
+	for _, node := range graph.GetNodes() {
+		res.Criticality[node.GetID()] = 0
+	}
+
+	for range iterations {
+		nodes := make([]graphinterface.Node, 0, graph.Len())
+		nodesValueMap := make(map[int64]float64, graph.Len()+1)
+		for _, node := range graph.GetNodes() {
+			weight := node.GetWeight()
+			if dist, ok := distributions[node.GetID()]; ok {
+				weight = dist.Sample(rnd)
+			}
+
+			nodes = append(nodes, &sampledNode{Node: node, weight: weight})
+			nodesValueMap[node.GetID()] = weight
+		}
+
+		solver := newSolver(&sampledGraph{nodes: nodes}, nodesValueMap, opts)
+		paths := solver.solve()
+
+		res.Durations = append(res.Durations, solver.duration())
+
+		// вершина учитывается один раз за итерацию,
+		// даже если лежит на нескольких критических путях
+		criticalNodes := make(map[int64]struct{}, len(nodes))
+		for _, path := range paths {
+			for _, nodeID := range path {
+				criticalNodes[nodeID] = struct{}{}
+			}
+		}
+		for nodeID := range criticalNodes {
+			res.Criticality[nodeID]++
+		}
+	}
+
+	if iterations == 0 {
+		return res, nil
+	}
+
+	var sum float64
+	for _, duration := range res.Durations {
+		sum += duration
+	}
+	res.Mean = sum / float64(iterations)
+
+	for nodeID := range res.Criticality {
+		res.Criticality[nodeID] /= float64(iterations)
+	}
+
+	slices.Sort(res.Durations)
+
+	return res, nil
+}
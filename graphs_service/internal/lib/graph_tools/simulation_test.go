@@ -0,0 +1,151 @@
+package graphtools
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/liriquew/control_system/graphs_service/internal/entities"
+	graph_wrapper "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/wrapper"
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+)
+
+func TestNewTaskDistribution(t *testing.T) {
+	tests := []struct {
+		name                               string
+		plannedTime, actualTime, predicted float64
+		want                               Distribution
+	}{
+		{
+			name:        "Done task has fixed duration",
+			plannedTime: 5, actualTime: 7, predicted: 6,
+			want: Distribution{Min: 7, Mode: 7, Max: 7},
+		},
+		{
+			name:        "Unpredicted task uses planned time with min spread",
+			plannedTime: 10,
+			want:        Distribution{Min: 9, Mode: 10, Max: 12},
+		},
+		{
+			name:        "Predicted task spread depends on planned time",
+			plannedTime: 5, predicted: 10,
+			want: Distribution{Min: 5, Mode: 10, Max: 20},
+		},
+		{
+			name: "Empty task",
+			want: Distribution{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewTaskDistribution(tt.plannedTime, tt.actualTime, tt.predicted)
+			if got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSimulateCriticalPath(t *testing.T) {
+	// 1 -> 2 -> 4
+	// 1 -> 3 -> 4
+	graph := entities.GraphWithTasks{
+		Nodes: []*entities.NodeWithTask{
+			{Node: &models.Node{ID: 1, DependencyNodeIDs: []int64{2, 3}, AssignedTo: ptrInt64(1), Weight: 1}},
+			{Node: &models.Node{ID: 2, DependencyNodeIDs: []int64{4}, AssignedTo: ptrInt64(2), Weight: 5}},
+			{Node: &models.Node{ID: 3, DependencyNodeIDs: []int64{4}, AssignedTo: ptrInt64(3), Weight: 1}},
+			{Node: &models.Node{ID: 4, DependencyNodeIDs: []int64{}, AssignedTo: ptrInt64(4), Weight: 1}},
+		},
+	}
+
+	t.Run("Fixed durations", func(t *testing.T) {
+		res, err := SimulateCriticalPath(graph_wrapper.WrapGraphWithTasks(&graph), nil, ScheduleOptions{}, 10, rand.New(rand.NewPCG(1, 1)))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		for _, p := range []float64{50, 80, 95} {
+			if got := res.Percentile(p); got != 7 {
+				t.Errorf("P%v: expected 7, got %v", p, got)
+			}
+		}
+
+		want := map[int64]float64{1: 1, 2: 1, 3: 0, 4: 1}
+		for nodeID, criticality := range want {
+			if res.Criticality[nodeID] != criticality {
+				t.Errorf("Node %d: expected criticality %v, got %v", nodeID, criticality, res.Criticality[nodeID])
+			}
+		}
+	})
+
+	t.Run("Random durations", func(t *testing.T) {
+		dists := map[int64]Distribution{
+			2: {Min: 1, Mode: 2, Max: 3},
+			3: {Min: 1, Mode: 2, Max: 3},
+		}
+
+		res, err := SimulateCriticalPath(graph_wrapper.WrapGraphWithTasks(&graph), dists, ScheduleOptions{}, 1000, rand.New(rand.NewPCG(1, 1)))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(res.Durations) != 1000 {
+			t.Fatalf("Expected 1000 durations, got %d", len(res.Durations))
+		}
+
+		p50, p80, p95 := res.Percentile(50), res.Percentile(80), res.Percentile(95)
+		if !(p50 <= p80 && p80 <= p95) {
+			t.Errorf("Expected P50 <= P80 <= P95, got %v, %v, %v", p50, p80, p95)
+		}
+		if p50 < 3 || p95 > 5 {
+			t.Errorf("Percentiles out of bounds: P50 = %v, P95 = %v", p50, p95)
+		}
+
+		// вершины 2 и 3 симметричны, каждая должна быть критической примерно в половине итераций
+		for _, nodeID := range []int64{2, 3} {
+			if c := res.Criticality[nodeID]; c < 0.4 || c > 0.6 {
+				t.Errorf("Node %d: expected criticality about 0.5, got %v", nodeID, c)
+			}
+		}
+		if res.Criticality[1] != 1 || res.Criticality[4] != 1 {
+			t.Errorf("Expected nodes 1 and 4 to be always critical, got %v", res.Criticality)
+		}
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		cycled := entities.GraphWithTasks{
+			Nodes: []*entities.NodeWithTask{
+				{Node: &models.Node{ID: 1, DependencyNodeIDs: []int64{2}, AssignedTo: ptrInt64(1)}},
+				{Node: &models.Node{ID: 2, DependencyNodeIDs: []int64{1}, AssignedTo: ptrInt64(2)}},
+			},
+		}
+
+		if _, err := SimulateCriticalPath(graph_wrapper.WrapGraphWithTasks(&cycled), nil, ScheduleOptions{}, 10, rand.New(rand.NewPCG(1, 1))); !errors.Is(err, ErrCycleInGraph) {
+			t.Errorf("Expected %v, got %v", ErrCycleInGraph, err)
+		}
+	})
+}
+
+func TestExceedProbability(t *testing.T) {
+	res := &SimulationResult{Durations: []float64{1, 2, 3, 4}}
+
+	tests := []struct {
+		hours float64
+		want  float64
+	}{
+		{hours: 0, want: 1},
+		{hours: 2, want: 0.5},
+		{hours: 2.5, want: 0.5},
+		{hours: 4, want: 0},
+	}
+	for _, tt := range tests {
+		if got := res.ExceedProbability(tt.hours); got != tt.want {
+			t.Errorf("%v hours: expected %v, got %v", tt.hours, tt.want, got)
+		}
+	}
+
+	if got := (&SimulationResult{}).ExceedProbability(1); got != 0 {
+		t.Errorf("Empty result: expected 0, got %v", got)
+	}
+}
@@ -31,21 +31,22 @@ func (wn *WrappedNode) GetID() int64 {
 
 func (wn *WrappedNode) GetWeight() float64 {
 	if wn.Node.PredictedTime == 0 {
-		return wn.Node.Task.PlannedTime
+		return wn.Node.Task.GetPlannedTime()
 	}
 	return wn.Node.PredictedTime
 }
 
-func (wn *WrappedNode) GetAssignedTo() int64 {
-	return wn.Node.Task.AssignedTo
-}
-
-func (wn *WrappedNode) GetAdditionalDependencies() []int64 {
-	return wn.Node.AdditionalDependencies
+func (wn *WrappedNode) GetLink(depNodeID int64) (int, float64) {
+	for _, link := range wn.Node.Node.Links {
+		if link.ToNodeID == depNodeID {
+			return int(link.Type), link.Lag
+		}
+	}
+	return 0, 0
 }
 
-func (wn *WrappedNode) AddAdditionalDependency(ids ...int64) {
-	wn.Node.AdditionalDependencies = append(wn.Node.AdditionalDependencies, ids...)
+func (wn *WrappedNode) GetAssignedTo() int64 {
+	return wn.Node.Task.GetAssignedTo()
 }
 
 func WrapNode(node *grph_pb.NodeWithTask) graphtools.Node {
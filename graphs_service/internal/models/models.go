@@ -1,7 +1,11 @@
 package models
 
 import (
+	"encoding/json"
+	"time"
+
 	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Graph struct {
@@ -9,26 +13,45 @@ type Graph struct {
 	Name      string `json:"name" db:"name"`
 	GroupID   int64  `json:"group_id" db:"group_id"`
 	CreatedBy int64  `json:"created_by" db:"created_by"`
+	// версия графа, увеличивается при каждом изменении вершин и зависимостей
+	Version int64 `json:"version" db:"version"`
+	// шаблон графа, из него создаются новые графы
+	IsTemplate bool `json:"is_template" db:"is_template"`
+	// срок выполнения графа, nil, если срок не задан
+	Deadline *time.Time `json:"deadline" db:"deadline"`
 }
 
 func ConvertGraphToProto(graph *Graph) *grph_pb.Graph {
-	return &grph_pb.Graph{
-		ID:        graph.ID,
-		Name:      graph.Name,
-		GroupID:   graph.GroupID,
-		CreatedBy: graph.CreatedBy,
+	res := &grph_pb.Graph{
+		ID:         graph.ID,
+		Name:       graph.Name,
+		GroupID:    graph.GroupID,
+		CreatedBy:  graph.CreatedBy,
+		Version:    graph.Version,
+		IsTemplate: graph.IsTemplate,
+	}
+	if graph.Deadline != nil {
+		res.Deadline = timestamppb.New(*graph.Deadline)
 	}
+
+	return res
 }
 
 type Dependency struct {
 	FromNodeID int64 `json:"from_node_id" db:"from_node_id"`
 	ToNodeID   int64 `json:"to_node_id" db:"to_node_id"`
+	// тип связи, значения совпадают с grph_pb.LinkType
+	LinkType int32 `json:"link_type" db:"link_type"`
+	// задержка в часах, отрицательное значение - опережение
+	Lag float64 `json:"lag" db:"lag"`
 }
 
 func ConvertDependencyToProto(dep *Dependency) *grph_pb.Dependency {
 	return &grph_pb.Dependency{
 		FromNodeID: dep.FromNodeID,
 		ToNodeID:   dep.ToNodeID,
+		Type:       grph_pb.LinkType(dep.LinkType),
+		Lag:        dep.Lag,
 	}
 }
 
@@ -41,13 +64,29 @@ func ConvertDependenciesToProto(deps []*Dependency) []*grph_pb.Dependency {
 }
 
 type Node struct {
-	ID                     int64   `json:"id" db:"id"`
-	GraphID                int64   `json:"graph_id" db:"graph_id"`
-	TaskID                 int64   `json:"task_id" db:"task_id"`
-	AssignedTo             *int64  `json:"assigned_to" db:"assigned_to"`
-	DependencyNodeIDs      []int64 `json:"dependencies"`
+	ID                     int64         `json:"id" db:"id"`
+	GraphID                int64         `json:"graph_id" db:"graph_id"`
+	TaskID                 int64         `json:"task_id" db:"task_id"`
+	AssignedTo             *int64        `json:"assigned_to" db:"assigned_to"`
+	DependencyNodeIDs      []int64       `json:"dependencies"`
+	Links                  []*Dependency `json:"links"`
 	Weight                 float64
 	AdditionalDependencies []int64
+
+	// вид вершины, значения совпадают с grph_pb.NodeKind,
+	// TaskID задан только для задач, SubGraphID - только для подграфов
+	Kind       int32  `json:"kind" db:"kind"`
+	SubGraphID int64  `json:"subgraph_id" db:"subgraph_id"`
+	Name       string `json:"name" db:"name"`
+}
+
+// SetLinks - устанавливает связи вершины и идентификаторы зависимых вершин
+func (n *Node) SetLinks(links []*Dependency) {
+	n.Links = links
+	n.DependencyNodeIDs = make([]int64, 0, len(links))
+	for _, link := range links {
+		n.DependencyNodeIDs = append(n.DependencyNodeIDs, link.ToNodeID)
+	}
 }
 
 func (n *Node) GetDependencies() []int64 {
@@ -65,12 +104,14 @@ func (n *Node) GetID() int64 {
 func (n *Node) GetAssignedTo() int64 {
 	return *n.AssignedTo
 }
-func (n *Node) GetAdditionalDependencies() []int64 {
-	return n.AdditionalDependencies
-}
 
-func (n *Node) AddAdditionalDependency(ids ...int64) {
-	n.AdditionalDependencies = append(n.AdditionalDependencies, ids...)
+func (n *Node) GetLink(depNodeID int64) (int, float64) {
+	for _, link := range n.Links {
+		if link.ToNodeID == depNodeID {
+			return int(link.LinkType), link.Lag
+		}
+	}
+	return 0, 0
 }
 
 func ConvertNodeToProto(node *Node) *grph_pb.Node {
@@ -78,7 +119,11 @@ func ConvertNodeToProto(node *Node) *grph_pb.Node {
 		ID:                node.ID,
 		GraphID:           node.GraphID,
 		TaskID:            node.TaskID,
+		Kind:              grph_pb.NodeKind(node.Kind),
+		SubGraphID:        node.SubGraphID,
+		Name:              node.Name,
 		DependencyNodeIDs: node.DependencyNodeIDs,
+		Links:             ConvertDependenciesToProto(node.Links),
 	}
 }
 
@@ -94,3 +139,55 @@ type UserWithTime struct {
 	UID  int64
 	Time float64
 }
+
+type Baseline struct {
+	ID        int64     `json:"id" db:"id"`
+	GraphID   int64     `json:"graph_id" db:"graph_id"`
+	Name      string    `json:"name" db:"name"`
+	CreatedBy int64     `json:"created_by" db:"created_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	Duration  float64   `json:"duration" db:"duration"`
+	StartAt   time.Time `json:"start_at" db:"start_at"`
+	FinishAt  time.Time `json:"finish_at" db:"finish_at"`
+	// сохраненное расписание графа в формате JSON
+	Snapshot json.RawMessage `json:"snapshot" db:"snapshot"`
+}
+
+func ConvertBaselineToProto(baseline *Baseline) *grph_pb.Baseline {
+	return &grph_pb.Baseline{
+		ID:        baseline.ID,
+		GraphID:   baseline.GraphID,
+		Name:      baseline.Name,
+		CreatedBy: baseline.CreatedBy,
+		CreatedAt: timestamppb.New(baseline.CreatedAt),
+		Duration:  baseline.Duration,
+		StartAt:   timestamppb.New(baseline.StartAt),
+		FinishAt:  timestamppb.New(baseline.FinishAt),
+	}
+}
+
+type AuditEvent struct {
+	ID        int64     `db:"id"`
+	GroupID   int64     `db:"group_id"`
+	ActorID   int64     `db:"actor_id"`
+	Entity    string    `db:"entity"`
+	EntityID  int64     `db:"entity_id"`
+	Action    string    `db:"action"`
+	Before    string    `db:"before"`
+	After     string    `db:"after"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func ConvertAuditEventToProto(event *AuditEvent) *grph_pb.AuditEvent {
+	return &grph_pb.AuditEvent{
+		ID:        event.ID,
+		GroupID:   event.GroupID,
+		ActorID:   event.ActorID,
+		Entity:    event.Entity,
+		EntityID:  event.EntityID,
+		Action:    event.Action,
+		Before:    event.Before,
+		After:     event.After,
+		CreatedAt: timestamppb.New(event.CreatedAt),
+	}
+}
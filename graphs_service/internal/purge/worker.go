@@ -0,0 +1,94 @@
+package purge
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/liriquew/control_system/graphs_service/internal/lib/config"
+	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
+)
+
+var (
+	ErrTimeoutLimitExceeded = errors.New("worker shutdown take too much time")
+)
+
+type NodesRepository interface {
+	PurgeNodes(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// Worker - окончательно удаляет вершины графов,
+// удаленные раньше, чем retention назад
+type Worker struct {
+	repository NodesRepository
+	retention  time.Duration
+	interval   time.Duration
+	// cancel останавливает Run, done закрывается после остановки
+	cancel context.CancelFunc
+	ctx    context.Context
+	done   chan struct{}
+	log    *slog.Logger
+}
+
+// New - конструктор, возвращает экземпляр Worker
+func New(log *slog.Logger, repository NodesRepository, cfg config.PurgeConfig) *Worker {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Worker{
+		repository: repository,
+		retention:  cfg.Retention,
+		interval:   cfg.Interval,
+		ctx:        ctx,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+		log:        log,
+	}
+}
+
+func (w *Worker) MustRun() {
+	defer close(w.done)
+
+	w.Run(w.ctx)
+}
+
+func (w *Worker) Close() error {
+	w.cancel()
+
+	select {
+	case <-w.done:
+	case <-time.After(time.Second):
+		return ErrTimeoutLimitExceeded
+	}
+
+	return nil
+}
+
+// Run - удаляет вершины каждые interval, пока ctx не отменен
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) purge(ctx context.Context) {
+	purged, err := w.repository.PurgeNodes(ctx, w.retention)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			w.log.Error("error while purging deleted nodes", sl.Err(err))
+		}
+		return
+	}
+
+	if purged != 0 {
+		w.log.Info("deleted nodes purged", slog.Int64("count", purged))
+	}
+}
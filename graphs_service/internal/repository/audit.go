@@ -0,0 +1,347 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+)
+
+const (
+	auditEntityGraph      = "graph"
+	auditEntityNode       = "node"
+	auditEntityDependency = "dependency"
+	auditEntityBaseline   = "baseline"
+
+	auditActionRestore = "restore"
+
+	listAuditEventsBatchSize = 50
+)
+
+// auditRecord - изменение одной сущности, Before пустой
+// для созданной сущности, After - для удаленной,
+// Action заменяет действие, определяемое по Before и After
+type auditRecord struct {
+	GroupID  int64
+	ActorID  int64
+	Entity   string
+	EntityID int64
+	Action   string
+	Before   map[string]any
+	After    map[string]any
+}
+
+// auditTime - время для журнала, nil, если время не задано
+func auditTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
+
+func graphSnapshot(graph *models.Graph) map[string]any {
+	return map[string]any{
+		"name":        graph.Name,
+		"created_by":  graph.CreatedBy,
+		"is_template": graph.IsTemplate,
+		"deadline":    auditTime(graph.Deadline),
+	}
+}
+
+// nodeModel - вершина запроса в графе graphID
+func nodeModel(graphID int64, node *grph_pb.Node) *models.Node {
+	return &models.Node{
+		ID:         node.ID,
+		GraphID:    graphID,
+		TaskID:     node.TaskID,
+		Kind:       int32(node.Kind),
+		SubGraphID: node.SubGraphID,
+		Name:       node.Name,
+	}
+}
+
+func nodeSnapshot(node *models.Node) map[string]any {
+	snapshot := map[string]any{
+		"graph_id":    node.GraphID,
+		"task_id":     node.TaskID,
+		"kind":        node.Kind,
+		"subgraph_id": node.SubGraphID,
+		"name":        node.Name,
+	}
+	// связи записываются только при их изменении
+	if node.Links != nil {
+		snapshot["links"] = node.Links
+	}
+
+	return snapshot
+}
+
+func dependencySnapshot(dep *models.Dependency) map[string]any {
+	return map[string]any{
+		"from_node_id": dep.FromNodeID,
+		"to_node_id":   dep.ToNodeID,
+		"link_type":    dep.LinkType,
+		"lag":          dep.Lag,
+	}
+}
+
+func baselineSnapshot(baseline *models.Baseline) map[string]any {
+	return map[string]any{
+		"graph_id":  baseline.GraphID,
+		"name":      baseline.Name,
+		"duration":  baseline.Duration,
+		"start_at":  baseline.StartAt.UTC(),
+		"finish_at": baseline.FinishAt.UTC(),
+	}
+}
+
+func auditJSON(fields map[string]any) (any, error) {
+	if fields == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+// recordAudit - добавляет изменение в журнал, для изменения записываются
+// только измененные поля, изменение без измененных полей не записывается
+func recordAudit(ctx context.Context, txn *sql.Tx, rec auditRecord) error {
+	action := "update"
+	switch {
+	case rec.Action != "":
+		action = rec.Action
+	case rec.Before == nil:
+		action = "create"
+	case rec.After == nil:
+		action = "delete"
+	default:
+		before := make(map[string]any)
+		after := make(map[string]any)
+		for field, value := range rec.After {
+			if !reflect.DeepEqual(rec.Before[field], value) {
+				before[field] = rec.Before[field]
+				after[field] = value
+			}
+		}
+		if len(after) == 0 {
+			return nil
+		}
+		rec.Before, rec.After = before, after
+	}
+
+	before, err := auditJSON(rec.Before)
+	if err != nil {
+		return err
+	}
+	after, err := auditJSON(rec.After)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO audit_events (group_id, actor_id, entity, entity_id, action, before, after)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err = txn.ExecContext(ctx, query, rec.GroupID, rec.ActorID, rec.Entity, rec.EntityID, action, before, after)
+	return err
+}
+
+// auditNode - записывает изменение вершины, prev пустой для созданной вершины, node - для удаленной
+func auditNode(ctx context.Context, txn *sql.Tx, actorID, groupID int64, prev, node *models.Node) error {
+	rec := auditRecord{
+		GroupID: groupID,
+		ActorID: actorID,
+		Entity:  auditEntityNode,
+	}
+	if prev != nil {
+		rec.EntityID = prev.ID
+		rec.Before = nodeSnapshot(prev)
+	}
+	if node != nil {
+		rec.EntityID = node.ID
+		rec.After = nodeSnapshot(node)
+	}
+
+	return recordAudit(ctx, txn, rec)
+}
+
+// auditDependency - записывает изменение зависимости, идентификатор зависимости - ее начальная вершина,
+// prev пустой для созданной зависимости, dep - для удаленной
+func auditDependency(ctx context.Context, txn *sql.Tx, actorID, groupID int64, prev, dep *models.Dependency) error {
+	rec := auditRecord{
+		GroupID: groupID,
+		ActorID: actorID,
+		Entity:  auditEntityDependency,
+	}
+	if prev != nil {
+		rec.EntityID = prev.FromNodeID
+		rec.Before = dependencySnapshot(prev)
+	}
+	if dep != nil {
+		rec.EntityID = dep.FromNodeID
+		rec.After = dependencySnapshot(dep)
+	}
+
+	return recordAudit(ctx, txn, rec)
+}
+
+// graphGroup - возвращает группу графа в транзакции
+func graphGroup(ctx context.Context, txn *sql.Tx, graphID int64) (int64, error) {
+	query := "SELECT group_id FROM graphs WHERE id=$1"
+
+	var groupID int64
+	if err := txn.QueryRowContext(ctx, query, graphID).Scan(&groupID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrNotFound
+		}
+		return 0, err
+	}
+
+	return groupID, nil
+}
+
+// lockNode - возвращает вершину и группу ее графа, вершина блокируется до конца транзакции
+func lockNode(ctx context.Context, txn *sql.Tx, nodeID int64) (*models.Node, int64, error) {
+	query := `
+		SELECT n.id, n.graph_id, COALESCE(n.task_id, 0), n.kind, COALESCE(n.subgraph_id, 0), n.name, g.group_id
+		FROM nodes n JOIN graphs g ON g.id = n.graph_id
+		WHERE n.id=$1 AND n.deleted_at IS NULL
+		FOR UPDATE OF n
+	`
+
+	var node models.Node
+	var groupID int64
+	err := txn.QueryRowContext(ctx, query, nodeID).Scan(
+		&node.ID, &node.GraphID, &node.TaskID, &node.Kind, &node.SubGraphID, &node.Name, &groupID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, 0, ErrNotFound
+		}
+		return nil, 0, err
+	}
+
+	return &node, groupID, nil
+}
+
+// nodeLinks - возвращает связи вершины, отсортированные по зависимой вершине
+func nodeLinks(ctx context.Context, txn *sql.Tx, graphID, nodeID int64) ([]*models.Dependency, error) {
+	query := "SELECT from_node_id, to_node_id, link_type, lag FROM dependencies WHERE from_node_id=$1 AND graph_id=$2 AND deleted_at IS NULL"
+
+	rows, err := txn.QueryContext(ctx, query, nodeID, graphID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	links := make([]*models.Dependency, 0)
+	for rows.Next() {
+		var link models.Dependency
+		if err := rows.Scan(&link.FromNodeID, &link.ToNodeID, &link.LinkType, &link.Lag); err != nil {
+			return nil, err
+		}
+		links = append(links, &link)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(links, func(a, b *models.Dependency) int {
+		return cmp.Compare(a.ToNodeID, b.ToNodeID)
+	})
+	return links, nil
+}
+
+// lockDependency - возвращает зависимость и группу ее графа, зависимость блокируется до конца транзакции
+func lockDependency(ctx context.Context, txn *sql.Tx, fromNodeID, toNodeID int64) (*models.Dependency, int64, error) {
+	query := `
+		SELECT d.from_node_id, d.to_node_id, d.link_type, d.lag, g.group_id
+		FROM dependencies d JOIN graphs g ON g.id = d.graph_id
+		WHERE d.from_node_id=$1 AND d.to_node_id=$2 AND d.deleted_at IS NULL
+		FOR UPDATE OF d
+	`
+
+	var dep models.Dependency
+	var groupID int64
+	err := txn.QueryRowContext(ctx, query, fromNodeID, toNodeID).Scan(&dep.FromNodeID, &dep.ToNodeID, &dep.LinkType, &dep.Lag, &groupID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, 0, ErrNotFound
+		}
+		return nil, 0, err
+	}
+
+	return &dep, groupID, nil
+}
+
+// ListAuditEvents - возвращает события журнала группы от новых к старым
+func (r *GraphsRepository) ListAuditEvents(ctx context.Context, req *grph_pb.ListAuditEventsRequest) ([]*models.AuditEvent, error) {
+	query := `
+		SELECT
+			id, group_id, actor_id, entity, entity_id, action,
+			COALESCE(before::text, '') AS before, COALESCE(after::text, '') AS after, created_at
+		FROM audit_events
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d
+	`
+
+	conditions := []string{"group_id=$1"}
+	args := []any{req.GroupID}
+
+	if req.Entity != "" {
+		args = append(args, req.Entity)
+		conditions = append(conditions, fmt.Sprintf("entity=$%d", len(args)))
+	}
+	if req.EntityID != 0 {
+		args = append(args, req.EntityID)
+		conditions = append(conditions, fmt.Sprintf("entity_id=$%d", len(args)))
+	}
+	if req.ActorID != 0 {
+		args = append(args, req.ActorID)
+		conditions = append(conditions, fmt.Sprintf("actor_id=$%d", len(args)))
+	}
+	if req.From != nil {
+		args = append(args, req.From.AsTime())
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if req.To != nil {
+		args = append(args, req.To.AsTime())
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+	if req.BeforeTime != nil {
+		args = append(args, req.BeforeTime.AsTime(), req.BeforeID)
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = listAuditEventsBatchSize
+	}
+	args = append(args, limit)
+
+	query = fmt.Sprintf(query, strings.Join(conditions, " AND "), len(args))
+
+	var events []*models.AuditEvent
+	if err := r.db.SelectContext(ctx, &events, query, args...); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+
+	"github.com/lib/pq"
+)
+
+// insertDependency - добавляет зависимость в транзакции патча
+func insertDependency(ctx context.Context, txn *sql.Tx, graphID, fromNodeID, toNodeID int64, linkType grph_pb.LinkType, lag float64) error {
+	if err := checkNodesAlive(ctx, txn, fromNodeID, toNodeID); err != nil {
+		return err
+	}
+
+	query := "INSERT INTO dependencies (from_node_id, to_node_id, graph_id, link_type, lag) VALUES ($1, $2, $3, $4, $5)"
+	if _, err := txn.ExecContext(ctx, query, fromNodeID, toNodeID, graphID, linkType, lag); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23503": // Код ошибки для FOREIGN KEY violation
+				switch pqErr.Constraint {
+				case "fk_from_node_dependency":
+					return fmt.Errorf("node with id %d does not exist%w", fromNodeID, ErrNotExists)
+				case "fk_to_node_dependency":
+					return fmt.Errorf("node with id %d does not exist%w", toNodeID, ErrNotExists)
+				}
+			case "23505":
+				return ErrAlreadyExists
+			case "23514": // Код ошибки для CHECK violation
+				switch pqErr.Constraint {
+				case "no_self_dependency":
+					return ErrSelfDependencyRejected
+				case "dependency_link_type":
+					return ErrUnknownLinkType
+				}
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ApplyGraphPatch - применяет операции изменения графа в одной транзакции, если версия графа
+// совпадает с version, новые вершины создаются вместо временных идентификаторов,
+// возвращает новую версию графа и идентификаторы созданных вершин по временным идентификаторам
+func (r *GraphsRepository) ApplyGraphPatch(ctx context.Context, actorID, graphID, version int64, ops []*grph_pb.PatchOperation) (int64, map[int64]int64, error) {
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer txn.Rollback()
+
+	// блокировка строки графа не дает другим изменениям пройти до конца транзакции
+	var currentVersion, groupID int64
+	query := "SELECT version, group_id FROM graphs WHERE id=$1 FOR UPDATE"
+	if err := txn.QueryRowContext(ctx, query, graphID).Scan(&currentVersion, &groupID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil, ErrNotFound
+		}
+		return 0, nil, err
+	}
+	if currentVersion != version {
+		return 0, nil, ErrVersionConflict
+	}
+
+	createdNodes := make(map[int64]int64)
+	resolve := func(nodeID int64) int64 {
+		if createdID, ok := createdNodes[nodeID]; ok {
+			return createdID
+		}
+		return nodeID
+	}
+	addDependency := func(fromNodeID, toNodeID int64, linkType grph_pb.LinkType, lag float64) error {
+		if err := insertDependency(ctx, txn, graphID, fromNodeID, toNodeID, linkType, lag); err != nil {
+			return err
+		}
+		return auditDependency(ctx, txn, actorID, groupID, nil, &models.Dependency{
+			FromNodeID: fromNodeID,
+			ToNodeID:   toNodeID,
+			LinkType:   int32(linkType),
+			Lag:        lag,
+		})
+	}
+
+	for _, op := range ops {
+		switch op.Type {
+		case grph_pb.PatchOperationType_AddNode:
+			var nodeID int64
+			if err := txn.QueryRowContext(ctx, insertNodeQuery, graphID, op.Node.TaskID, op.Node.Kind, op.Node.SubGraphID, op.Node.Name).Scan(&nodeID); err != nil {
+				if kindErr := nodeKindError(err, op.Node); kindErr != nil {
+					return 0, nil, kindErr
+				}
+				if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" && pqErr.Constraint == "uq_task_id" {
+					return 0, nil, fmt.Errorf("task with id %d alredy in some node: %w", op.Node.TaskID, ErrTaskAlreadyInNode)
+				}
+				return 0, nil, fmt.Errorf("error while creating node: %w", err)
+			}
+			createdNodes[op.Node.ID] = nodeID
+
+			if err := auditNode(ctx, txn, actorID, groupID, nil, nodeModel(graphID, &grph_pb.Node{
+				ID:         nodeID,
+				TaskID:     op.Node.TaskID,
+				Kind:       op.Node.Kind,
+				SubGraphID: op.Node.SubGraphID,
+				Name:       op.Node.Name,
+			})); err != nil {
+				return 0, nil, err
+			}
+
+			for _, depNodeID := range op.Node.DependencyNodeIDs {
+				linkType, lag := linkTo(op.Node, depNodeID)
+				if err := addDependency(nodeID, resolve(depNodeID), linkType, lag); err != nil {
+					return 0, nil, err
+				}
+			}
+
+		case grph_pb.PatchOperationType_RemoveNode:
+			prev, _, err := lockNode(ctx, txn, resolve(op.NodeID))
+			if err != nil && !errors.Is(err, ErrNotFound) {
+				return 0, nil, err
+			}
+			// вершина другого графа не удаляется
+			if prev == nil || prev.GraphID != graphID {
+				continue
+			}
+
+			if err := softDeleteNode(ctx, txn, prev.ID); err != nil {
+				return 0, nil, fmt.Errorf("error while removing node: %w", err)
+			}
+			if err := auditNode(ctx, txn, actorID, groupID, prev, nil); err != nil {
+				return 0, nil, err
+			}
+
+		case grph_pb.PatchOperationType_AddDependency:
+			dep := op.Dependency
+			if err := addDependency(resolve(dep.FromNodeID), resolve(dep.ToNodeID), dep.Type, dep.Lag); err != nil {
+				return 0, nil, err
+			}
+
+		case grph_pb.PatchOperationType_RemoveDependency:
+			query := `
+				DELETE FROM dependencies WHERE from_node_id=$1 AND to_node_id=$2 AND graph_id=$3 AND deleted_at IS NULL
+				RETURNING from_node_id, to_node_id, link_type, lag
+			`
+			var prev models.Dependency
+			err := txn.QueryRowContext(ctx, query, resolve(op.Dependency.FromNodeID), resolve(op.Dependency.ToNodeID), graphID).Scan(
+				&prev.FromNodeID, &prev.ToNodeID, &prev.LinkType, &prev.Lag,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					continue
+				}
+				return 0, nil, fmt.Errorf("error while removing dependency: %w", err)
+			}
+			if err := auditDependency(ctx, txn, actorID, groupID, &prev, nil); err != nil {
+				return 0, nil, err
+			}
+
+		case grph_pb.PatchOperationType_MoveNode:
+			// перемещение записывается в журнал как изменение связей вершины
+			nodeID := resolve(op.Node.ID)
+			prev, _, err := lockNode(ctx, txn, nodeID)
+			if err != nil && !errors.Is(err, ErrNotFound) {
+				return 0, nil, err
+			}
+			if prev != nil && prev.GraphID == graphID {
+				if prev.Links, err = nodeLinks(ctx, txn, graphID, nodeID); err != nil {
+					return 0, nil, err
+				}
+			}
+
+			query := "DELETE FROM dependencies WHERE from_node_id=$1 AND graph_id=$2 AND deleted_at IS NULL"
+			if _, err := txn.ExecContext(ctx, query, nodeID, graphID); err != nil {
+				return 0, nil, fmt.Errorf("error while removing dependencies: %w", err)
+			}
+
+			for _, depNodeID := range op.Node.DependencyNodeIDs {
+				linkType, lag := linkTo(op.Node, depNodeID)
+				if err := insertDependency(ctx, txn, graphID, nodeID, resolve(depNodeID), linkType, lag); err != nil {
+					return 0, nil, err
+				}
+			}
+
+			if prev != nil && prev.Links != nil {
+				node := *prev
+				if node.Links, err = nodeLinks(ctx, txn, graphID, nodeID); err != nil {
+					return 0, nil, err
+				}
+				if err := auditNode(ctx, txn, actorID, groupID, prev, &node); err != nil {
+					return 0, nil, err
+				}
+			}
+		}
+	}
+
+	query = "UPDATE graphs SET version = version + 1 WHERE id=$1 RETURNING version"
+	if err := txn.QueryRowContext(ctx, query, graphID).Scan(&currentVersion); err != nil {
+		return 0, nil, err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return 0, nil, err
+	}
+
+	return currentVersion, createdNodes, nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/liriquew/control_system/graphs_service/internal/entities"
 	"github.com/liriquew/control_system/graphs_service/internal/lib/config"
@@ -62,8 +63,83 @@ var (
 	ErrNodeNotFound           = errors.New("node not found")
 	ErrDependencyNotFound     = errors.New("dependency not found")
 	ErrTaskAlreadyInNode      = errors.New("task already in node")
+	ErrUnknownLinkType        = errors.New("unknown link type")
+	ErrVersionConflict        = errors.New("graph version conflict")
+	ErrBadNodeKind            = errors.New("node fields don't match node kind")
 )
 
+const (
+	// nodeColumns - столбцы вершины, пустые ссылки на задачу и подграф читаются как ноль
+	nodeColumns = "id, graph_id, COALESCE(task_id, 0) AS task_id, kind, COALESCE(subgraph_id, 0) AS subgraph_id, name"
+	// insertNodeQuery - добавляет вершину, нулевые ссылки на задачу и подграф записываются как NULL
+	insertNodeQuery = "INSERT INTO nodes (graph_id, task_id, kind, subgraph_id, name) VALUES ($1, NULLIF($2, 0), $3, NULLIF($4, 0), $5) RETURNING id"
+)
+
+// nodeKindError - возвращает ошибку нарушения ограничений вида вершины
+// или nil, если ошибка с ними не связана
+func nodeKindError(err error, node *grph_pb.Node) error {
+	pqErr, ok := err.(*pq.Error)
+	if !ok {
+		return nil
+	}
+
+	switch pqErr.Constraint {
+	case "node_kind":
+		return ErrBadNodeKind
+	case "fk_subgraph_node":
+		return fmt.Errorf("graph with id %d does not exist%w", node.SubGraphID, ErrNotExists)
+	}
+	return nil
+}
+
+// withVersionBump - дополняет запрос изменения вершин или зависимостей увеличением
+// версии графа, запрос должен изменять строки, содержащие graph_id
+func withVersionBump(query string) string {
+	return "WITH changed AS (" + query + " RETURNING graph_id) " +
+		"UPDATE graphs SET version = version + 1 WHERE id IN (SELECT graph_id FROM changed)"
+}
+
+// lockGraphVersion - блокирует строку графа до конца транзакции и возвращает ErrVersionConflict,
+// если версия графа отличается от version, все изменения вершин и зависимостей увеличивают
+// версию, поэтому проверка, выполненная по графу версии version, остается верной при записи
+func lockGraphVersion(ctx context.Context, txn *sql.Tx, graphID, version int64) error {
+	var currentVersion int64
+	query := "SELECT version FROM graphs WHERE id=$1 FOR UPDATE"
+	if err := txn.QueryRowContext(ctx, query, graphID).Scan(&currentVersion); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+	if currentVersion != version {
+		return ErrVersionConflict
+	}
+
+	return nil
+}
+
+// linkTo - возвращает тип связи вершины с вершиной toNodeID и задержку,
+// если связь не описана, используется окончание-начало без задержки
+func linkTo(node *grph_pb.Node, toNodeID int64) (grph_pb.LinkType, float64) {
+	for _, link := range node.Links {
+		if link.ToNodeID == toNodeID {
+			return link.Type, link.Lag
+		}
+	}
+	return grph_pb.LinkType_FinishToStart, 0
+}
+
+// selectLinks - загружает связи вершины и заполняет идентификаторы зависимых вершин
+func (r *GraphsRepository) selectLinks(ctx context.Context, node *models.Node, query string, args ...any) error {
+	var links []*models.Dependency
+	if err := r.db.SelectContext(ctx, &links, query, args...); err != nil {
+		return err
+	}
+	node.SetLinks(links)
+
+	return nil
+}
+
 func (r *GraphsRepository) GetGraphGroup(ctx context.Context, graphID int64) (int64, error) {
 	query := `SELECT group_id FROM graphs WHERE id=$1`
 
@@ -86,19 +162,43 @@ func (r *GraphsRepository) CreateGraph(ctx context.Context, graph *grph_pb.Graph
 	}
 	defer txn.Rollback()
 
-	graphQuery := `INSERT INTO graphs (group_id, created_by, name) VALUES ($1, $2, $3) RETURNING id`
-	err = txn.QueryRowContext(ctx, graphQuery, graph.GroupID, graph.CreatedBy, graph.Name).Scan(&graph.ID)
+	var deadline *time.Time
+	if graph.Deadline != nil {
+		t := graph.Deadline.AsTime()
+		deadline = &t
+	}
+
+	graphQuery := `INSERT INTO graphs (group_id, created_by, name, is_template, deadline) VALUES ($1, $2, $3, $4, $5) RETURNING id`
+	err = txn.QueryRowContext(ctx, graphQuery, graph.GroupID, graph.CreatedBy, graph.Name, graph.IsTemplate, deadline).Scan(&graph.ID)
 	if err != nil {
 		return 0, fmt.Errorf("error while creating graph: %w", err)
 	}
 
+	err = recordAudit(ctx, txn, auditRecord{
+		GroupID:  graph.GroupID,
+		ActorID:  graph.CreatedBy,
+		Entity:   auditEntityGraph,
+		EntityID: graph.ID,
+		After: graphSnapshot(&models.Graph{
+			Name:       graph.Name,
+			CreatedBy:  graph.CreatedBy,
+			IsTemplate: graph.IsTemplate,
+			Deadline:   deadline,
+		}),
+	})
+	if err != nil {
+		return 0, err
+	}
+
 	// Узлы
-	nodeQuery := `INSERT INTO nodes (graph_id, task_id) VALUES ($1, $2) RETURNING id`
 	nodeIDMap := make(map[int64]int64, len(nodes))
 	for _, node := range nodes {
 		var nodeID int64
-		err = txn.QueryRowContext(ctx, nodeQuery, graph.ID, node.TaskID).Scan(&nodeID)
+		err = txn.QueryRowContext(ctx, insertNodeQuery, graph.ID, node.TaskID, node.Kind, node.SubGraphID, node.Name).Scan(&nodeID)
 		if err != nil {
+			if kindErr := nodeKindError(err, node); kindErr != nil {
+				return 0, kindErr
+			}
 			if pqErr, ok := err.(*pq.Error); ok {
 				switch pqErr.Code {
 				case "23505":
@@ -113,10 +213,14 @@ func (r *GraphsRepository) CreateGraph(ctx context.Context, graph *grph_pb.Graph
 		}
 		nodeIDMap[node.ID] = nodeID
 		node.ID = nodeID
+
+		if err := auditNode(ctx, txn, graph.CreatedBy, graph.GroupID, nil, nodeModel(graph.ID, node)); err != nil {
+			return 0, err
+		}
 	}
 
 	// Зависимости
-	dependencyQuery := `INSERT INTO dependencies (from_node_id, to_node_id, graph_id) VALUES ($1, $2, $3)`
+	dependencyQuery := `INSERT INTO dependencies (from_node_id, to_node_id, graph_id, link_type, lag) VALUES ($1, $2, $3, $4, $5)`
 	stmt, err := txn.PrepareContext(ctx, dependencyQuery)
 	if err != nil {
 		return 0, err
@@ -126,7 +230,8 @@ func (r *GraphsRepository) CreateGraph(ctx context.Context, graph *grph_pb.Graph
 		fromNodeID := node.ID
 		for _, dependencyNodeDummyID := range node.DependencyNodeIDs {
 			toNodeID := nodeIDMap[dependencyNodeDummyID]
-			_, err = stmt.ExecContext(ctx, fromNodeID, toNodeID, graph.ID)
+			linkType, lag := linkTo(node, dependencyNodeDummyID)
+			_, err = stmt.ExecContext(ctx, fromNodeID, toNodeID, graph.ID, linkType, lag)
 			if err != nil {
 				if pqErr, ok := err.(*pq.Error); ok {
 					switch pqErr.Code {
@@ -140,6 +245,15 @@ func (r *GraphsRepository) CreateGraph(ctx context.Context, graph *grph_pb.Graph
 				}
 				return 0, fmt.Errorf("error while creating dependency: %w", err)
 			}
+			err = auditDependency(ctx, txn, graph.CreatedBy, graph.GroupID, nil, &models.Dependency{
+				FromNodeID: fromNodeID,
+				ToNodeID:   toNodeID,
+				LinkType:   int32(linkType),
+				Lag:        lag,
+			})
+			if err != nil {
+				return 0, err
+			}
 			node.DependencyNodeIDs = append(node.DependencyNodeIDs, toNodeID)
 		}
 	}
@@ -170,8 +284,8 @@ func (r *GraphsRepository) ListGroupGraphs(ctx context.Context, groupID, offset
 		}
 	}
 
-	queryNodes := "SELECT * FROM nodes WHERE graph_id=$1"
-	queryDependencies := "SELECT to_node_id FROM dependencies WHERE from_node_id=$1"
+	queryNodes := "SELECT " + nodeColumns + " FROM nodes WHERE graph_id=$1 AND deleted_at IS NULL"
+	queryDependencies := "SELECT from_node_id, to_node_id, link_type, lag FROM dependencies WHERE from_node_id=$1 AND deleted_at IS NULL"
 	for i, graph := range graphs {
 		if err := r.db.SelectContext(ctx, &graphsWithNodes[i].Nodes, queryNodes, graph.ID); err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
@@ -182,7 +296,7 @@ func (r *GraphsRepository) ListGroupGraphs(ctx context.Context, groupID, offset
 		}
 
 		for _, node := range graphsWithNodes[i].Nodes {
-			if err := r.db.SelectContext(ctx, &node.DependencyNodeIDs, queryDependencies, node.ID); err != nil {
+			if err := r.selectLinks(ctx, node, queryDependencies, node.ID); err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
 					continue
 				}
@@ -206,7 +320,7 @@ func (r *GraphsRepository) GetGraph(ctx context.Context, graphID int64) (*entiti
 		return nil, fmt.Errorf("failed to get graph: %w", err)
 	}
 
-	query = "SELECT * FROM nodes WHERE graph_id=$1"
+	query = "SELECT " + nodeColumns + " FROM nodes WHERE graph_id=$1 AND deleted_at IS NULL"
 
 	var nodes []*models.Node
 	if err := r.db.SelectContext(ctx, &nodes, query, graphID); err != nil {
@@ -216,10 +330,10 @@ func (r *GraphsRepository) GetGraph(ctx context.Context, graphID int64) (*entiti
 		// Если узлов нет, оставляем nodes пустым
 	}
 
-	query = "SELECT to_node_id FROM dependencies WHERE graph_id=$1 AND from_node_id=$2"
+	query = "SELECT from_node_id, to_node_id, link_type, lag FROM dependencies WHERE graph_id=$1 AND from_node_id=$2 AND deleted_at IS NULL"
 
 	for _, node := range nodes {
-		if err := r.db.SelectContext(ctx, &node.DependencyNodeIDs, query, graphID, node.ID); err != nil {
+		if err := r.selectLinks(ctx, node, query, graphID, node.ID); err != nil {
 			if !errors.Is(err, sql.ErrNoRows) {
 				return nil, fmt.Errorf("failed to get dependencies: %w", err)
 			}
@@ -233,15 +347,18 @@ func (r *GraphsRepository) GetGraph(ctx context.Context, graphID int64) (*entiti
 	}, nil
 }
 
-func (r *GraphsRepository) CreateNode(ctx context.Context, node *grph_pb.Node) (int64, error) {
+func (r *GraphsRepository) CreateNode(ctx context.Context, actorID int64, node *grph_pb.Node) (int64, error) {
 	txn, err := r.db.Begin()
 	if err != nil {
 		return 0, err
 	}
 	defer txn.Rollback()
 
-	query := "INSERT INTO nodes (graph_id, task_id) VALUES ($1, $2) RETURNING id"
-	if err := txn.QueryRowContext(ctx, query, node.GraphID, node.TaskID).Scan(&node.ID); err != nil {
+	query := insertNodeQuery
+	if err := txn.QueryRowContext(ctx, query, node.GraphID, node.TaskID, node.Kind, node.SubGraphID, node.Name).Scan(&node.ID); err != nil {
+		if kindErr := nodeKindError(err, node); kindErr != nil {
+			return 0, kindErr
+		}
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code {
 			case "23503": // Код ошибки для FOREIGN KEY violation
@@ -260,7 +377,19 @@ func (r *GraphsRepository) CreateNode(ctx context.Context, node *grph_pb.Node) (
 		return 0, err
 	}
 
-	query = "INSERT INTO dependencies (graph_id, from_node_id, to_node_id) VALUES ($1, $2, $3)"
+	groupID, err := graphGroup(ctx, txn, node.GraphID)
+	if err != nil {
+		return 0, err
+	}
+	if err := auditNode(ctx, txn, actorID, groupID, nil, nodeModel(node.GraphID, node)); err != nil {
+		return 0, err
+	}
+
+	if err := checkNodesAlive(ctx, txn, node.DependencyNodeIDs...); err != nil {
+		return 0, err
+	}
+
+	query = "INSERT INTO dependencies (graph_id, from_node_id, to_node_id, link_type, lag) VALUES ($1, $2, $3, $4, $5)"
 	// TOOD: check is nodes in one graph (write postgres constraint)
 	nodeIDs := make(map[int64]any, 0)
 	for _, depNodeID := range node.DependencyNodeIDs {
@@ -268,7 +397,8 @@ func (r *GraphsRepository) CreateNode(ctx context.Context, node *grph_pb.Node) (
 			continue
 		}
 		nodeIDs[depNodeID] = struct{}{}
-		if _, err := txn.ExecContext(ctx, query, node.GraphID, node.ID, depNodeID); err != nil {
+		linkType, lag := linkTo(node, depNodeID)
+		if _, err := txn.ExecContext(ctx, query, node.GraphID, node.ID, depNodeID, linkType, lag); err != nil {
 			if pqErr, ok := err.(*pq.Error); ok {
 				switch pqErr.Code {
 				case "23503": // Код ошибки для FOREIGN KEY violation
@@ -290,6 +420,21 @@ func (r *GraphsRepository) CreateNode(ctx context.Context, node *grph_pb.Node) (
 			}
 			return 0, err
 		}
+
+		err = auditDependency(ctx, txn, actorID, groupID, nil, &models.Dependency{
+			FromNodeID: node.ID,
+			ToNodeID:   depNodeID,
+			LinkType:   int32(linkType),
+			Lag:        lag,
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	query = "UPDATE graphs SET version = version + 1 WHERE id=$1"
+	if _, err := txn.ExecContext(ctx, query, node.GraphID); err != nil {
+		return 0, err
 	}
 
 	if err := txn.Commit(); err != nil {
@@ -300,7 +445,7 @@ func (r *GraphsRepository) CreateNode(ctx context.Context, node *grph_pb.Node) (
 }
 
 func (r *GraphsRepository) GetNode(ctx context.Context, graphID, nodeID int64) (*models.Node, error) {
-	query := `SELECT * FROM nodes WHERE id=$1`
+	query := "SELECT " + nodeColumns + " FROM nodes WHERE id=$1 AND deleted_at IS NULL"
 
 	var node models.Node
 	if err := r.db.GetContext(ctx, &node, query, nodeID); err != nil {
@@ -310,9 +455,9 @@ func (r *GraphsRepository) GetNode(ctx context.Context, graphID, nodeID int64) (
 		return nil, fmt.Errorf("repository: error while getting node: %w", err)
 	}
 
-	query = `SELECT to_node_id FROM dependencies WHERE graph_id=$1 AND from_node_id=$2`
+	query = `SELECT from_node_id, to_node_id, link_type, lag FROM dependencies WHERE graph_id=$1 AND from_node_id=$2 AND deleted_at IS NULL`
 
-	if err := r.db.SelectContext(ctx, &node.DependencyNodeIDs, query, graphID, nodeID); err != nil {
+	if err := r.selectLinks(ctx, &node, query, graphID, nodeID); err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("repository: error while getting dependencies: %w", err)
 		}
@@ -322,43 +467,252 @@ func (r *GraphsRepository) GetNode(ctx context.Context, graphID, nodeID int64) (
 	return &node, nil
 }
 
-func (r *GraphsRepository) UpdateNode(ctx context.Context, node *grph_pb.Node) error {
-	query := "UPDATE nodes SET task_id=$1 WHERE id=$2"
+func (r *GraphsRepository) UpdateNode(ctx context.Context, actorID int64, node *grph_pb.Node) error {
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
 
-	result, err := r.db.ExecContext(ctx, query, node.TaskID, node.ID)
+	prev, groupID, err := lockNode(ctx, txn, node.ID)
 	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return ErrNotExists
+		}
 		return err
 	}
 
-	if rowsAffected, err := result.RowsAffected(); err != nil {
+	query := withVersionBump("UPDATE nodes SET task_id=NULLIF($1, 0), kind=$3, subgraph_id=NULLIF($4, 0), name=$5 WHERE id=$2")
+
+	if _, err := txn.ExecContext(ctx, query, node.TaskID, node.ID, node.Kind, node.SubGraphID, node.Name); err != nil {
+		if kindErr := nodeKindError(err, node); kindErr != nil {
+			return kindErr
+		}
 		return err
-	} else if rowsAffected == 0 {
-		return ErrNotExists
 	}
 
-	return nil
+	if err := auditNode(ctx, txn, actorID, groupID, prev, nodeModel(prev.GraphID, node)); err != nil {
+		return err
+	}
+
+	return txn.Commit()
 }
 
-func (r *GraphsRepository) RemoveNode(ctx context.Context, nodeID int64) error {
-	query := "DELETE FROM nodes WHERE id=$1"
+func (r *GraphsRepository) RemoveNode(ctx context.Context, actorID, nodeID int64) error {
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
 
-	result, err := r.db.ExecContext(ctx, query, nodeID)
+	prev, groupID, err := lockNode(ctx, txn, nodeID)
 	if err != nil {
-		return nil
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
 	}
 
-	if rowsAffected, err := result.RowsAffected(); err != nil {
+	if err := softDeleteNode(ctx, txn, nodeID); err != nil {
+		return err
+	}
+
+	query := "UPDATE graphs SET version = version + 1 WHERE id=$1"
+	if _, err := txn.ExecContext(ctx, query, prev.GraphID); err != nil {
+		return err
+	}
+
+	if err := auditNode(ctx, txn, actorID, groupID, prev, nil); err != nil {
+		return err
+	}
+
+	return txn.Commit()
+}
+
+// softDeleteNode - помечает вершину и ее зависимости удаленными,
+// они хранятся до очистки и могут быть восстановлены
+func softDeleteNode(ctx context.Context, txn *sql.Tx, nodeID int64) error {
+	query := "UPDATE nodes SET deleted_at=NOW() WHERE id=$1"
+	if _, err := txn.ExecContext(ctx, query, nodeID); err != nil {
+		return err
+	}
+
+	query = "UPDATE dependencies SET deleted_at=NOW() WHERE (from_node_id=$1 OR to_node_id=$1) AND deleted_at IS NULL"
+	if _, err := txn.ExecContext(ctx, query, nodeID); err != nil {
 		return err
-	} else if rowsAffected == 0 {
-		return nil
 	}
 
 	return nil
 }
 
+// checkNodesAlive - возвращает ErrNotExists, если какая-то из вершин удалена
+func checkNodesAlive(ctx context.Context, txn *sql.Tx, nodeIDs ...int64) error {
+	query := "SELECT id FROM nodes WHERE id = ANY($1) AND deleted_at IS NOT NULL LIMIT 1"
+
+	var nodeID int64
+	if err := txn.QueryRowContext(ctx, query, pq.Array(nodeIDs)).Scan(&nodeID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+
+	return fmt.Errorf("node with id %d does not exist%w", nodeID, ErrNotExists)
+}
+
+// GetDeletedNode - возвращает удаленную вершину графа и зависимости,
+// которые будут восстановлены вместе с ней
+func (r *GraphsRepository) GetDeletedNode(ctx context.Context, graphID, nodeID int64) (*models.Node, []*models.Dependency, error) {
+	query := "SELECT " + nodeColumns + " FROM nodes WHERE id=$1 AND graph_id=$2 AND deleted_at IS NOT NULL"
+
+	var node models.Node
+	if err := r.db.GetContext(ctx, &node, query, nodeID, graphID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrNotFound
+		}
+		return nil, nil, err
+	}
+
+	// зависимость восстанавливается, если вторая ее вершина не удалена
+	query = `
+		SELECT d.from_node_id, d.to_node_id, d.link_type, d.lag
+		FROM dependencies d
+		JOIN nodes f ON f.id = d.from_node_id
+		JOIN nodes t ON t.id = d.to_node_id
+		WHERE (d.from_node_id=$1 OR d.to_node_id=$1) AND d.deleted_at IS NOT NULL
+			AND (f.id=$1 OR f.deleted_at IS NULL) AND (t.id=$1 OR t.deleted_at IS NULL)
+	`
+
+	var deps []*models.Dependency
+	if err := r.db.SelectContext(ctx, &deps, query, nodeID); err != nil {
+		return nil, nil, err
+	}
+
+	return &node, deps, nil
+}
+
+// RestoreNode - восстанавливает удаленную вершину графа и ее зависимости с неудаленными вершинами,
+// если версия графа равна version, возвращает вершину и восстановленные зависимости
+func (r *GraphsRepository) RestoreNode(ctx context.Context, actorID, graphID, nodeID, version int64) (*models.Node, []*models.Dependency, error) {
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer txn.Rollback()
+
+	if err := lockGraphVersion(ctx, txn, graphID, version); err != nil {
+		return nil, nil, err
+	}
+
+	query := `
+		SELECT n.id, n.graph_id, COALESCE(n.task_id, 0), n.kind, COALESCE(n.subgraph_id, 0), n.name, g.group_id
+		FROM nodes n JOIN graphs g ON g.id = n.graph_id
+		WHERE n.id=$1 AND n.graph_id=$2 AND n.deleted_at IS NOT NULL
+		FOR UPDATE OF n
+	`
+
+	var node models.Node
+	var groupID int64
+	err = txn.QueryRowContext(ctx, query, nodeID, graphID).Scan(
+		&node.ID, &node.GraphID, &node.TaskID, &node.Kind, &node.SubGraphID, &node.Name, &groupID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrNotFound
+		}
+		return nil, nil, err
+	}
+
+	query = withVersionBump("UPDATE nodes SET deleted_at=NULL WHERE id=$1")
+	if _, err := txn.ExecContext(ctx, query, nodeID); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" && pqErr.Constraint == "uq_task_id" {
+			return nil, nil, fmt.Errorf("task with id %d alredy in some node: %w", node.TaskID, ErrTaskAlreadyInNode)
+		}
+		return nil, nil, err
+	}
+
+	err = recordAudit(ctx, txn, auditRecord{
+		GroupID:  groupID,
+		ActorID:  actorID,
+		Entity:   auditEntityNode,
+		EntityID: nodeID,
+		Action:   auditActionRestore,
+		After:    nodeSnapshot(&node),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	query = `
+		UPDATE dependencies d SET deleted_at=NULL
+		FROM nodes f, nodes t
+		WHERE f.id = d.from_node_id AND t.id = d.to_node_id
+			AND (d.from_node_id=$1 OR d.to_node_id=$1) AND d.deleted_at IS NOT NULL
+			AND f.deleted_at IS NULL AND t.deleted_at IS NULL
+		RETURNING d.from_node_id, d.to_node_id, d.link_type, d.lag
+	`
+
+	rows, err := txn.QueryContext(ctx, query, nodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var deps []*models.Dependency
+	for rows.Next() {
+		var dep models.Dependency
+		if err := rows.Scan(&dep.FromNodeID, &dep.ToNodeID, &dep.LinkType, &dep.Lag); err != nil {
+			return nil, nil, err
+		}
+		deps = append(deps, &dep)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	var links []*models.Dependency
+	for _, dep := range deps {
+		err := recordAudit(ctx, txn, auditRecord{
+			GroupID:  groupID,
+			ActorID:  actorID,
+			Entity:   auditEntityDependency,
+			EntityID: dep.FromNodeID,
+			Action:   auditActionRestore,
+			After:    dependencySnapshot(dep),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if dep.FromNodeID == nodeID {
+			links = append(links, dep)
+		}
+	}
+	node.SetLinks(links)
+
+	if err := txn.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return &node, deps, nil
+}
+
+// PurgeNodes - окончательно удаляет вершины, удаленные раньше, чем retention назад,
+// вместе с их зависимостями
+func (r *GraphsRepository) PurgeNodes(ctx context.Context, retention time.Duration) (int64, error) {
+	query := "DELETE FROM nodes WHERE deleted_at < NOW() - make_interval(secs => $1)"
+
+	res, err := r.db.ExecContext(ctx, query, retention.Seconds())
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
 func (r *GraphsRepository) GetDependencies(ctx context.Context, nodeID int64) (*models.Node, error) {
 	nodeWithDeps := models.Node{}
-	query := "SELECT * FROM nodes WHERE id=$1"
+	query := "SELECT " + nodeColumns + " FROM nodes WHERE id=$1 AND deleted_at IS NULL"
 	if err := r.db.GetContext(ctx, &nodeWithDeps, query, nodeID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrNodeNotFound
@@ -366,8 +720,8 @@ func (r *GraphsRepository) GetDependencies(ctx context.Context, nodeID int64) (*
 		return nil, err
 	}
 
-	query = "SELECT to_node_id FROM dependencies WHERE from_node_id=$1"
-	if err := r.db.SelectContext(ctx, &nodeWithDeps.DependencyNodeIDs, query, nodeID); err != nil {
+	query = "SELECT from_node_id, to_node_id, link_type, lag FROM dependencies WHERE from_node_id=$1 AND deleted_at IS NULL"
+	if err := r.selectLinks(ctx, &nodeWithDeps, query, nodeID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrDependencyNotFound
 		}
@@ -377,9 +731,24 @@ func (r *GraphsRepository) GetDependencies(ctx context.Context, nodeID int64) (*
 	return &nodeWithDeps, nil
 }
 
-func (r *GraphsRepository) AddDependency(ctx context.Context, graphID int64, dependency *grph_pb.Dependency) error {
-	query := "INSERT INTO dependencies (from_node_id, to_node_id, graph_id) VALUES ($1, $2, $3)"
-	if _, err := r.db.ExecContext(ctx, query, dependency.FromNodeID, dependency.ToNodeID, graphID); err != nil {
+// AddDependency - добавляет зависимость, если версия графа равна version
+func (r *GraphsRepository) AddDependency(ctx context.Context, actorID, graphID, version int64, dependency *grph_pb.Dependency) error {
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	if err := lockGraphVersion(ctx, txn, graphID, version); err != nil {
+		return err
+	}
+
+	if err := checkNodesAlive(ctx, txn, dependency.FromNodeID, dependency.ToNodeID); err != nil {
+		return err
+	}
+
+	query := withVersionBump("INSERT INTO dependencies (from_node_id, to_node_id, graph_id, link_type, lag) VALUES ($1, $2, $3, $4, $5)")
+	if _, err := txn.ExecContext(ctx, query, dependency.FromNodeID, dependency.ToNodeID, graphID, dependency.Type, dependency.Lag); err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code {
 			case "23503": // Код ошибки для FOREIGN KEY violation
@@ -395,36 +764,113 @@ func (r *GraphsRepository) AddDependency(ctx context.Context, graphID int64, dep
 					return ErrAlreadyExists
 				}
 			case "23514": // Код ошибки для CHECK violation
-				if pqErr.Constraint == "no_self_dependency" {
+				switch pqErr.Constraint {
+				case "no_self_dependency":
 					return ErrSelfDependencyRejected
+				case "dependency_link_type":
+					return ErrUnknownLinkType
 				}
 			}
 		}
 		return err
 	}
 
-	return nil
+	groupID, err := graphGroup(ctx, txn, graphID)
+	if err != nil {
+		return err
+	}
+	err = auditDependency(ctx, txn, actorID, groupID, nil, &models.Dependency{
+		FromNodeID: dependency.FromNodeID,
+		ToNodeID:   dependency.ToNodeID,
+		LinkType:   int32(dependency.Type),
+		Lag:        dependency.Lag,
+	})
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit()
 }
 
-func (r *GraphsRepository) RemoveDependensy(ctx context.Context, dependency *grph_pb.Dependency) error {
-	query := "DELETE FROM dependencies WHERE from_node_id=$1 AND to_node_id=$2"
+func (r *GraphsRepository) RemoveDependensy(ctx context.Context, actorID int64, dependency *grph_pb.Dependency) error {
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
 
-	result, err := r.db.ExecContext(ctx, query, dependency.FromNodeID, dependency.ToNodeID)
+	prev, groupID, err := lockDependency(ctx, txn, dependency.FromNodeID, dependency.ToNodeID)
 	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
 		return err
 	}
 
-	if rowsAffected, err := result.RowsAffected(); err != nil {
+	query := withVersionBump("DELETE FROM dependencies WHERE from_node_id=$1 AND to_node_id=$2")
+	if _, err := txn.ExecContext(ctx, query, dependency.FromNodeID, dependency.ToNodeID); err != nil {
 		return err
-	} else if rowsAffected == 0 {
+	}
+
+	if err := auditDependency(ctx, txn, actorID, groupID, prev, nil); err != nil {
 		return err
 	}
 
-	return nil
+	return txn.Commit()
+}
+
+// SetGraphDeadline - задает срок выполнения графа, nil убирает срок
+func (r *GraphsRepository) SetGraphDeadline(ctx context.Context, actorID, graphID int64, deadline *time.Time) error {
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	var groupID int64
+	var prev *time.Time
+	query := "SELECT group_id, deadline FROM graphs WHERE id=$1 FOR UPDATE"
+	if err := txn.QueryRowContext(ctx, query, graphID).Scan(&groupID, &prev); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	query = "UPDATE graphs SET deadline=$1 WHERE id=$2"
+	if _, err := txn.ExecContext(ctx, query, deadline, graphID); err != nil {
+		return fmt.Errorf("error while setting graph deadline: %w", err)
+	}
+
+	err = recordAudit(ctx, txn, auditRecord{
+		GroupID:  groupID,
+		ActorID:  actorID,
+		Entity:   auditEntityGraph,
+		EntityID: graphID,
+		Before:   map[string]any{"deadline": auditTime(prev)},
+		After:    map[string]any{"deadline": auditTime(deadline)},
+	})
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit()
+}
+
+// GetSubGraphIDs - возвращает идентификаторы графов, на которые ссылаются вершины-подграфы графа
+func (r *GraphsRepository) GetSubGraphIDs(ctx context.Context, graphID int64) ([]int64, error) {
+	query := "SELECT DISTINCT subgraph_id FROM nodes WHERE graph_id=$1 AND subgraph_id IS NOT NULL AND deleted_at IS NULL"
+
+	var subGraphIDs []int64
+	if err := r.db.SelectContext(ctx, &subGraphIDs, query, graphID); err != nil {
+		return nil, fmt.Errorf("error while getting sub-graphs: %w", err)
+	}
+
+	return subGraphIDs, nil
 }
 
 func (r *GraphsRepository) TaskInNode(ctx context.Context, taskID int64) (int64, error) {
-	query := "SELECT id FROM nodes WHERE task_id=$1"
+	query := "SELECT id FROM nodes WHERE task_id=$1 AND deleted_at IS NULL"
 
 	var nodeID int64
 	if err := r.db.QueryRowContext(ctx, query, taskID).Scan(&nodeID); err != nil {
@@ -437,3 +883,91 @@ func (r *GraphsRepository) TaskInNode(ctx context.Context, taskID int64) (int64,
 
 	return nodeID, nil
 }
+
+func (r *GraphsRepository) CreateBaseline(ctx context.Context, baseline *models.Baseline) (*models.Baseline, error) {
+	query := `
+		INSERT INTO graph_baselines (graph_id, name, created_by, duration, start_at, finish_at, snapshot)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	err = txn.QueryRowContext(ctx, query,
+		baseline.GraphID,
+		baseline.Name,
+		baseline.CreatedBy,
+		baseline.Duration,
+		baseline.StartAt,
+		baseline.FinishAt,
+		[]byte(baseline.Snapshot),
+	).Scan(&baseline.ID, &baseline.CreatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23503": // Код ошибки для FOREIGN KEY violation
+				if pqErr.Constraint == "fk_graph_baseline" {
+					return nil, fmt.Errorf("graph with id %d does not exist%w", baseline.GraphID, ErrNotExists)
+				}
+			default:
+				return nil, fmt.Errorf("database error: %s", pqErr.Message)
+			}
+		}
+		return nil, err
+	}
+
+	groupID, err := graphGroup(ctx, txn, baseline.GraphID)
+	if err != nil {
+		return nil, err
+	}
+	err = recordAudit(ctx, txn, auditRecord{
+		GroupID:  groupID,
+		ActorID:  baseline.CreatedBy,
+		Entity:   auditEntityBaseline,
+		EntityID: baseline.ID,
+		After:    baselineSnapshot(baseline),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+
+	return baseline, nil
+}
+
+func (r *GraphsRepository) ListBaselines(ctx context.Context, graphID int64) ([]*models.Baseline, error) {
+	query := `
+		SELECT id, graph_id, name, created_by, created_at, duration, start_at, finish_at
+		FROM graph_baselines
+		WHERE graph_id=$1
+		ORDER BY created_at DESC, id DESC
+	`
+
+	var baselines []*models.Baseline
+	if err := r.db.SelectContext(ctx, &baselines, query, graphID); err != nil {
+		return nil, fmt.Errorf("error while getting baselines: %w", err)
+	}
+
+	return baselines, nil
+}
+
+func (r *GraphsRepository) GetBaseline(ctx context.Context, graphID, baselineID int64) (*models.Baseline, error) {
+	query := `SELECT * FROM graph_baselines WHERE id=$1 AND graph_id=$2`
+
+	var baseline models.Baseline
+	if err := r.db.GetContext(ctx, &baseline, query, baselineID, graphID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("error while getting baseline: %w", err)
+	}
+
+	return &baseline, nil
+}
@@ -0,0 +1,66 @@
+package graphsservice
+
+import (
+	"context"
+	"errors"
+
+	graphtools "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools"
+	graph_wrapper "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/wrapper"
+	"github.com/liriquew/control_system/graphs_service/internal/repository"
+	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func convertNodeDegreesToProto(degrees []graphtools.NodeDegree) []*grph_pb.NodeDegree {
+	res := make([]*grph_pb.NodeDegree, 0, len(degrees))
+	for _, degree := range degrees {
+		res = append(res, &grph_pb.NodeDegree{
+			NodeID: degree.NodeID,
+			FanIn:  int64(degree.FanIn),
+			FanOut: int64(degree.FanOut),
+		})
+	}
+	return res
+}
+
+func (s *Service) AnalyzeGraph(ctx context.Context, req *grph_pb.AnalyzeGraphRequest) (*grph_pb.GraphAnalysisResponse, error) {
+	graph, err := s.repository.GetGraph(ctx, req.GraphID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "graph not found")
+		}
+
+		s.log.Error("error while getting graph", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	analysis, err := graphtools.AnalyzeGraph(graph_wrapper.WrapGraphWithNodes(graph))
+	if err != nil {
+		if errors.Is(err, graphtools.ErrCycleInGraph) {
+			return nil, cycleStatus(err)
+		}
+
+		s.log.Error("error while analyzing graph", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	levelWidths := make([]int64, 0, len(analysis.LevelWidths))
+	for _, width := range analysis.LevelWidths {
+		levelWidths = append(levelWidths, int64(width))
+	}
+
+	return &grph_pb.GraphAnalysisResponse{
+		GraphID:        req.GraphID,
+		Nodes:          int64(analysis.Nodes),
+		Dependencies:   int64(analysis.Dependencies),
+		Components:     int64(analysis.Components),
+		LongestPath:    analysis.LongestPath,
+		LevelWidths:    levelWidths,
+		MaxParallelism: int64(analysis.MaxParallelism),
+		FanOutHotSpots: convertNodeDegreesToProto(analysis.FanOutHotSpots),
+		FanInHotSpots:  convertNodeDegreesToProto(analysis.FanInHotSpots),
+		OrphanNodeIDs:  analysis.Orphans,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package graphsservice
+
+import (
+	"context"
+
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// максимальный размер страницы журнала
+const maxAuditEventsLimit = 100
+
+// ListAuditEvents - возвращает журнал изменений графов группы,
+// права администратора группы проверяются в шлюзе
+func (s *Service) ListAuditEvents(ctx context.Context, req *grph_pb.ListAuditEventsRequest) (*grph_pb.AuditEventList, error) {
+	if req.GroupID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "group id required")
+	}
+	if req.Limit < 0 || req.Limit > maxAuditEventsLimit {
+		return nil, status.Error(codes.InvalidArgument, "limit must be between 0 and 100")
+	}
+
+	events, err := s.repository.ListAuditEvents(ctx, req)
+	if err != nil {
+		s.log.Error("error while listing audit events", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	resp := make([]*grph_pb.AuditEvent, 0, len(events))
+	for _, event := range events {
+		resp = append(resp, models.ConvertAuditEventToProto(event))
+	}
+
+	return &grph_pb.AuditEventList{
+		Events: resp,
+	}, nil
+}
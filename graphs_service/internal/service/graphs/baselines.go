@@ -0,0 +1,200 @@
+package graphsservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	graphtools "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools"
+	graph_wrapper "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/wrapper"
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+	"github.com/liriquew/control_system/graphs_service/internal/repository"
+	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxBaselineNameLength - ограничение длины названия в таблице graph_baselines
+const maxBaselineNameLength = 128
+
+// snapshotFromPrediction - строит снимок расписания по результату PredictGraph
+func snapshotFromPrediction(predictedGraph *grph_pb.PredictedGraphResponse) *graphtools.Snapshot {
+	snapshot := &graphtools.Snapshot{
+		StartAt:  predictedGraph.StartAt.AsTime(),
+		FinishAt: predictedGraph.FinishAt.AsTime(),
+		Duration: predictedGraph.Duration,
+		Nodes:    make([]graphtools.SnapshotNode, 0, len(predictedGraph.Nodes)),
+		Paths:    make([][]int64, 0, len(predictedGraph.Paths)),
+	}
+
+	for _, node := range predictedGraph.Nodes {
+		schedule := node.Schedule
+		snapshot.Nodes = append(snapshot.Nodes, graphtools.SnapshotNode{
+			NodeID:       node.Node.ID,
+			TaskID:       node.Node.TaskID,
+			Dependencies: node.Node.DependencyNodeIDs,
+			Weight:       graph_wrapper.WrapNode(node).GetWeight(),
+			EarlyStart:   schedule.EarlyStart,
+			EarlyFinish:  schedule.EarlyFinish,
+			LateStart:    schedule.LateStart,
+			LateFinish:   schedule.LateFinish,
+			TotalFloat:   schedule.TotalFloat,
+			FreeFloat:    schedule.FreeFloat,
+			Start:        schedule.Start.AsTime(),
+			Finish:       schedule.Finish.AsTime(),
+		})
+	}
+
+	for _, path := range predictedGraph.Paths {
+		snapshot.Paths = append(snapshot.Paths, path.NodeIDs)
+	}
+
+	return snapshot
+}
+
+func convertPathsToProto(paths [][]int64) []*grph_pb.Path {
+	res := make([]*grph_pb.Path, 0, len(paths))
+	for _, path := range paths {
+		res = append(res, &grph_pb.Path{NodeIDs: path})
+	}
+	return res
+}
+
+func convertNodeDriftsToProto(drifts []graphtools.NodeDrift) []*grph_pb.NodeDrift {
+	res := make([]*grph_pb.NodeDrift, 0, len(drifts))
+	for _, node := range drifts {
+		res = append(res, &grph_pb.NodeDrift{
+			NodeID:          node.NodeID,
+			BaselineWeight:  node.BaselineWeight,
+			CurrentWeight:   node.CurrentWeight,
+			StartDrift:      node.StartDrift,
+			FinishDrift:     node.FinishDrift,
+			TotalFloatDelta: node.TotalFloatDelta,
+			WasCritical:     node.WasCritical,
+			IsCritical:      node.IsCritical,
+		})
+	}
+	return res
+}
+
+func convertDependencyPairsToProto(deps [][2]int64) []*grph_pb.Dependency {
+	res := make([]*grph_pb.Dependency, 0, len(deps))
+	for _, dep := range deps {
+		res = append(res, &grph_pb.Dependency{FromNodeID: dep[0], ToNodeID: dep[1]})
+	}
+	return res
+}
+
+func (s *Service) CreateBaseline(ctx context.Context, req *grph_pb.CreateBaselineRequest) (*grph_pb.BaselineResponse, error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	if req.Name == "" || len(req.Name) > maxBaselineNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "baseline name must be from 1 to %d characters", maxBaselineNameLength)
+	}
+
+	predictedGraph, err := s.predictGraph(ctx, req.GraphID, req.Priority, req.StartAt)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := snapshotFromPrediction(predictedGraph)
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		s.log.Error("error while marshaling baseline snapshot", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	baseline, err := s.repository.CreateBaseline(ctx, &models.Baseline{
+		GraphID:   req.GraphID,
+		Name:      req.Name,
+		CreatedBy: userID,
+		Duration:  snapshot.Duration,
+		StartAt:   snapshot.StartAt,
+		FinishAt:  snapshot.FinishAt,
+		Snapshot:  data,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotExists) {
+			return nil, status.Error(codes.NotFound, "graph not found")
+		}
+
+		s.log.Error("error while creating baseline", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return &grph_pb.BaselineResponse{
+		Baseline: models.ConvertBaselineToProto(baseline),
+	}, nil
+}
+
+func (s *Service) ListBaselines(ctx context.Context, req *grph_pb.ListBaselinesRequest) (*grph_pb.BaselineListResponse, error) {
+	baselines, err := s.repository.ListBaselines(ctx, req.GraphID)
+	if err != nil {
+		s.log.Error("error while listing baselines", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	resp := make([]*grph_pb.Baseline, 0, len(baselines))
+	for _, baseline := range baselines {
+		resp = append(resp, models.ConvertBaselineToProto(baseline))
+	}
+
+	return &grph_pb.BaselineListResponse{
+		Baselines: resp,
+	}, nil
+}
+
+func (s *Service) CompareBaseline(ctx context.Context, req *grph_pb.CompareBaselineRequest) (*grph_pb.BaselineComparisonResponse, error) {
+	baseline, err := s.repository.GetBaseline(ctx, req.GraphID, req.BaselineID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "baseline not found")
+		}
+
+		s.log.Error("error while getting baseline", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	var baselineSnapshot graphtools.Snapshot
+	if err := json.Unmarshal(baseline.Snapshot, &baselineSnapshot); err != nil {
+		s.log.Error("error while unmarshaling baseline snapshot", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	// по умолчанию текущее расписание строится от того же момента, что и сохраненное,
+	// тогда отклонения показывают только изменения структуры графа и оценок задач
+	startAt := req.StartAt
+	if startAt == nil {
+		startAt = timestamppb.New(baseline.StartAt)
+	}
+
+	predictedGraph, err := s.predictGraph(ctx, req.GraphID, req.Priority, startAt)
+	if err != nil {
+		return nil, err
+	}
+
+	currentSnapshot := snapshotFromPrediction(predictedGraph)
+	diff := graphtools.CompareSnapshots(&baselineSnapshot, currentSnapshot)
+
+	return &grph_pb.BaselineComparisonResponse{
+		Baseline:            models.ConvertBaselineToProto(baseline),
+		CurrentDuration:     currentSnapshot.Duration,
+		DurationDelta:       diff.DurationDelta,
+		CurrentFinishAt:     predictedGraph.FinishAt,
+		FinishDrift:         diff.FinishDrift,
+		Nodes:               convertNodeDriftsToProto(diff.Nodes),
+		AddedNodes:          diff.AddedNodes,
+		RemovedNodes:        diff.RemovedNodes,
+		AddedDependencies:   convertDependencyPairsToProto(diff.AddedDependencies),
+		RemovedDependencies: convertDependencyPairsToProto(diff.RemovedDependencies),
+		BaselinePaths:       convertPathsToProto(baselineSnapshot.Paths),
+		CurrentPaths:        predictedGraph.Paths,
+		UnpredictedUIDs:     predictedGraph.UnpredictedUIDs,
+	}, nil
+}
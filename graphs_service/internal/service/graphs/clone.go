@@ -0,0 +1,173 @@
+package graphsservice
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	tasksclient "github.com/liriquew/control_system/graphs_service/internal/grpc/clients/tasks"
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+	"github.com/liriquew/control_system/graphs_service/internal/repository"
+	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// cloneParams - параметры копирования графа
+type cloneParams struct {
+	graphID int64
+	// группа нового графа, если не задана - группа исходного графа
+	groupID int64
+	name    string
+	// новый граф является шаблоном
+	asTemplate bool
+	// исходный граф должен быть шаблоном
+	fromTemplate bool
+}
+
+// cloneGraph - копирует вершины и зависимости графа в новый граф,
+// для каждой вершины-задачи создается новая задача с теми же названием, описанием, тегами
+// и запланированным временем, исполнитель сохраняется только в пределах той же группы,
+// если граф не удалось создать, созданные задачи удаляются
+func (s *Service) cloneGraph(ctx context.Context, params cloneParams) (_ int64, err error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return 0, err
+	}
+
+	source, err := s.repository.GetGraph(ctx, params.graphID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return 0, status.Error(codes.NotFound, "graph not found")
+		}
+
+		s.log.Error("error while getting graph", sl.Err(err))
+		return 0, status.Error(codes.Internal, "internal")
+	}
+	if params.fromTemplate && !source.GraphInfo.IsTemplate {
+		return 0, status.Errorf(codes.FailedPrecondition, "graph %d is not a template", params.graphID)
+	}
+
+	sourceGroupID := source.GraphInfo.GroupID
+	graphInfo := &grph_pb.Graph{
+		Name:       params.name,
+		GroupID:    params.groupID,
+		CreatedBy:  userID,
+		IsTemplate: params.asTemplate,
+	}
+	if graphInfo.Name == "" {
+		graphInfo.Name = source.GraphInfo.Name
+	}
+	if graphInfo.GroupID == 0 {
+		graphInfo.GroupID = sourceGroupID
+	}
+	sameGroup := graphInfo.GroupID == sourceGroupID
+
+	nodes := models.ConvertNodesToProto(source.Nodes)
+	for _, node := range nodes {
+		// подграф может ссылаться только на граф своей группы
+		if node.Kind == grph_pb.NodeKind_SubGraph && !sameGroup {
+			return 0, status.Errorf(codes.InvalidArgument, "sub-graph node %d can't be copied to another group", node.ID)
+		}
+	}
+
+	sourceTasks, err := s.tasksClient.GetGroupTasks(ctx, sourceGroupID)
+	if err != nil {
+		s.log.Error("error while getting group tasks", sl.Err(err))
+		return 0, status.Error(codes.Internal, "internal")
+	}
+	tasks := make(map[int64]*tsks_pb.Task, len(sourceTasks))
+	for _, task := range sourceTasks {
+		tasks[task.ID] = task
+	}
+
+	createdTaskIDs := make([]int64, 0, len(nodes))
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		// компенсация должна завершиться, даже если клиент уже отключился
+		ctx := context.WithoutCancel(ctx)
+		for _, taskID := range createdTaskIDs {
+			if err := s.tasksClient.DeleteTask(ctx, taskID, graphInfo.GroupID); err != nil && !errors.Is(err, tasksclient.ErrNotFound) {
+				s.log.Error("error while deleting cloned task", slog.Int64("taskID", taskID), sl.Err(err))
+			}
+		}
+	}()
+
+	for _, node := range nodes {
+		if node.Kind != grph_pb.NodeKind_TaskNode {
+			continue
+		}
+
+		task, ok := tasks[node.TaskID]
+		if !ok {
+			s.log.Error("node task not found in graph group", slog.Int64("nodeID", node.ID), slog.Int64("taskID", node.TaskID))
+			return 0, status.Error(codes.Internal, "internal")
+		}
+
+		newTask := &tsks_pb.Task{
+			GroupID:      graphInfo.GroupID,
+			Title:        task.Title,
+			Description:  task.Description,
+			Tags:         task.Tags,
+			PlannedTime:  task.PlannedTime,
+			CrashCost:    task.CrashCost,
+			CrashMinTime: task.CrashMinTime,
+		}
+		if sameGroup {
+			newTask.AssignedTo = task.AssignedTo
+		}
+
+		node.TaskID, err = s.tasksClient.CreateTask(ctx, newTask)
+		if err != nil {
+			s.log.Error("error while creating task", sl.Err(err))
+			return 0, status.Error(codes.Internal, "internal")
+		}
+		createdTaskIDs = append(createdTaskIDs, node.TaskID)
+	}
+
+	graphID, err := s.repository.CreateGraph(ctx, graphInfo, nodes)
+	if err != nil {
+		s.log.Error("error while creating graph copy", sl.Err(err))
+		return 0, status.Error(codes.Internal, "internal")
+	}
+
+	return graphID, nil
+}
+
+func (s *Service) CloneGraph(ctx context.Context, req *grph_pb.CloneGraphRequest) (*grph_pb.GraphResponse, error) {
+	graphID, err := s.cloneGraph(ctx, cloneParams{
+		graphID:    req.GraphID,
+		groupID:    req.GroupID,
+		name:       req.Name,
+		asTemplate: req.AsTemplate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &grph_pb.GraphResponse{
+		GraphID: graphID,
+	}, nil
+}
+
+func (s *Service) InstantiateTemplate(ctx context.Context, req *grph_pb.InstantiateTemplateRequest) (*grph_pb.GraphResponse, error) {
+	graphID, err := s.cloneGraph(ctx, cloneParams{
+		graphID:      req.TemplateID,
+		groupID:      req.GroupID,
+		name:         req.Name,
+		fromTemplate: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &grph_pb.GraphResponse{
+		GraphID: graphID,
+	}, nil
+}
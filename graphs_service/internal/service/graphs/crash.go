@@ -0,0 +1,91 @@
+package graphsservice
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	graphtools "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools"
+	graph_wrapper "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/wrapper"
+	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) CrashGraph(ctx context.Context, req *grph_pb.CrashGraphRequest) (*grph_pb.CrashGraphResponse, error) {
+	if req.TargetDuration < 0 {
+		return nil, status.Error(codes.InvalidArgument, "target duration can't be negative")
+	}
+
+	predictableGraph, err := s.loadPredictedGraph(ctx, req.GraphID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := s.scheduleOptions(ctx, predictableGraph.Graph.GroupID, req.Priority, req.StartAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// без целевого времени граф сокращается до своего срока
+	target := req.TargetDuration
+	if target == 0 {
+		deadline := predictableGraph.Graph.GetDeadline()
+		if deadline == nil {
+			return nil, status.Error(codes.InvalidArgument, "target duration is required for graph without deadline")
+		}
+		target = deadline.AsTime().Sub(opts.StartAt).Hours()
+	}
+
+	// сокращается только оставшаяся часть задач, поэтому минимальное время
+	// начатой задачи уменьшается так же, как ее оставшееся время
+	nodesValuesMap := remainingValues(predictableGraph)
+	crashOptions := make(map[int64]graphtools.CrashOption, len(predictableGraph.Nodes))
+	for _, node := range predictableGraph.Nodes {
+		if node.Task.GetCrashMinTime() <= 0 {
+			continue
+		}
+
+		crashOptions[node.Node.ID] = graphtools.CrashOption{
+			MinDuration: graphtools.RemainingWeight(node.Task.GetCrashMinTime(), node.Task.GetPercentComplete(), node.Task.GetActualTime() != 0),
+			CostPerHour: node.Task.GetCrashCost(),
+		}
+	}
+
+	plan, err := graphtools.CrashSchedule(graph_wrapper.WrapPredictedGraph(predictableGraph), nodesValuesMap, crashOptions, opts, target)
+	if err != nil {
+		if errors.Is(err, graphtools.ErrCycleInGraph) {
+			return nil, cycleStatus(err)
+		}
+		if errors.Is(err, graphtools.ErrUnknownPriority) {
+			return nil, status.Error(codes.InvalidArgument, "unknown priority")
+		}
+
+		s.log.Error("error while crashing graph", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	nodes := make([]*grph_pb.NodeCrash, 0, len(plan.Nodes))
+	for _, node := range plan.Nodes {
+		nodes = append(nodes, &grph_pb.NodeCrash{
+			NodeID:          node.NodeID,
+			Duration:        node.Duration,
+			CrashedDuration: node.CrashedDuration,
+			Cost:            node.Cost,
+		})
+	}
+
+	return &grph_pb.CrashGraphResponse{
+		Graph:           predictableGraph.Graph,
+		TargetDuration:  target,
+		InitialDuration: plan.InitialDuration,
+		Duration:        plan.Duration,
+		FinishAt:        timestamppb.New(opts.StartAt.Add(time.Duration(plan.Duration * float64(time.Hour)))),
+		Met:             plan.Met,
+		Cost:            plan.Cost,
+		Nodes:           nodes,
+		UnpredictedUIDs: predictableGraph.UnpredictedUIDs,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package graphsservice
+
+import (
+	"errors"
+
+	"github.com/liriquew/control_system/graphs_service/internal/entities"
+	graphtools "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools"
+	graph_wrapper "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/wrapper"
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+	"github.com/liriquew/control_system/graphs_service/internal/repository"
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// количество попыток проверить граф на циклы и записать изменение,
+// если граф изменился между проверкой и записью
+const maxVersionConflictAttempts = 3
+
+// retryOnVersionConflict - повторяет проверку и запись write, пока она возвращает
+// repository.ErrVersionConflict, после последней попытки возвращает ошибку Aborted
+func retryOnVersionConflict(write func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := write()
+		if !errors.Is(err, repository.ErrVersionConflict) {
+			return err
+		}
+		if attempt == maxVersionConflictAttempts {
+			return status.Error(codes.Aborted, "graph was changed, reload it and retry")
+		}
+	}
+}
+
+// cycleStatus - ошибка FailedPrecondition, найденный цикл передается в деталях ошибки
+func cycleStatus(err error) error {
+	var cycleErr *graphtools.CycleError
+	if !errors.As(err, &cycleErr) {
+		return status.Error(codes.FailedPrecondition, "cycle found in graph")
+	}
+
+	st := status.New(codes.FailedPrecondition, "cycle found in graph: "+cycleErr.Error())
+	if withDetails, err := st.WithDetails(&grph_pb.CycleDetails{NodeIDs: cycleErr.Cycle}); err == nil {
+		st = withDetails
+	}
+
+	return st.Err()
+}
+
+// checkCycle - возвращает ошибку со статусом FailedPrecondition, если граф содержит цикл
+func checkCycle(graph *entities.GraphWithNodes) error {
+	if cycle, has := graphtools.HasCycle(graph_wrapper.WrapGraphWithNodes(graph)); has {
+		return cycleStatus(&graphtools.CycleError{Cycle: cycle})
+	}
+
+	return nil
+}
+
+// checkNodesCycle - проверяет на наличие цикла вершины создаваемого графа
+func checkNodesCycle(nodes []*grph_pb.Node) error {
+	graph := &entities.GraphWithNodes{
+		Nodes: make([]*models.Node, 0, len(nodes)),
+	}
+	for _, node := range nodes {
+		graph.Nodes = append(graph.Nodes, &models.Node{
+			ID:                node.ID,
+			DependencyNodeIDs: node.DependencyNodeIDs,
+		})
+	}
+
+	return checkCycle(graph)
+}
@@ -0,0 +1,158 @@
+package graphsservice
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	graphtools "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools"
+	graph_wrapper "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/wrapper"
+	"github.com/liriquew/control_system/graphs_service/internal/repository"
+	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// количество итераций моделирования при оценке риска срыва срока для наблюдателей графа
+	deadlineRiskIterations = 200
+	// наименьшее количество итераций оценки риска для больших графов
+	minDeadlineRiskIterations = 20
+	// количество длительностей вершин, которые разыгрываются при одной оценке риска,
+	// ограничивает количество итераций для больших графов
+	deadlineRiskSamples = 20000
+	// вероятность срыва срока, начиная с которой срок считается под угрозой
+	deadlineRiskProbability = 0.2
+)
+
+// deadlineRiskIterationsFor - возвращает количество итераций оценки риска срыва срока
+// для графа из nodes вершин
+func deadlineRiskIterationsFor(nodes int) int {
+	if nodes == 0 {
+		return deadlineRiskIterations
+	}
+
+	return max(minDeadlineRiskIterations, min(deadlineRiskIterations, deadlineRiskSamples/nodes))
+}
+
+func convertDeadlineStatus(res *graphtools.DeadlineStatus) *grph_pb.DeadlineStatus {
+	shortenings := make([]*grph_pb.NodeShortening, 0, len(res.Shortenings))
+	for _, shortening := range res.Shortenings {
+		shortenings = append(shortenings, &grph_pb.NodeShortening{
+			NodeID:     shortening.NodeID,
+			Duration:   shortening.Duration,
+			Shortening: shortening.Shortening,
+		})
+	}
+
+	return &grph_pb.DeadlineStatus{
+		Deadline:    timestamppb.New(res.Deadline),
+		FinishAt:    timestamppb.New(res.FinishAt),
+		Met:         res.Met,
+		BufferDays:  res.BufferDays,
+		Shortenings: shortenings,
+		Recoverable: res.Recoverable,
+	}
+}
+
+// remainingValues - оставшееся время вершин загруженного графа
+func remainingValues(predictableGraph *grph_pb.PredictedGraphResponse) map[int64]float64 {
+	nodesValuesMap := make(map[int64]float64, len(predictableGraph.Nodes))
+	for _, node := range predictableGraph.Nodes {
+		node.RemainingTime = graphtools.RemainingWeight(node.PredictedTime, node.Task.GetPercentComplete(), node.Task.GetActualTime() != 0)
+		nodesValuesMap[node.Node.ID] = node.RemainingTime
+	}
+
+	return nodesValuesMap
+}
+
+// remainingDistributions - возвращает распределения оставшегося времени вершин,
+// выполненные вершины не занимают времени, начатые учитываются оставшейся частью
+func remainingDistributions(predictableGraph *grph_pb.PredictedGraphResponse) map[int64]graphtools.Distribution {
+	distributions := make(map[int64]graphtools.Distribution, len(predictableGraph.Nodes))
+	for _, node := range predictableGraph.Nodes {
+		distributions[node.Node.ID] = graphtools.NewTaskDistribution(
+			node.Task.GetPlannedTime(),
+			node.Task.GetActualTime(),
+			node.PredictedTime,
+		).Remaining(node.Task.GetPercentComplete(), node.Task.GetActualTime() != 0)
+	}
+
+	return distributions
+}
+
+// checkDeadline - проверяет, выполняется ли загруженный граф к своему сроку, и записывает
+// результат в predictableGraph, граф без срока не проверяется
+func (s *Service) checkDeadline(predictableGraph *grph_pb.PredictedGraphResponse, nodesValuesMap map[int64]float64, opts graphtools.ScheduleOptions) error {
+	deadline := predictableGraph.Graph.GetDeadline()
+	if deadline == nil {
+		return nil
+	}
+
+	res, err := graphtools.CheckDeadline(graph_wrapper.WrapPredictedGraph(predictableGraph), nodesValuesMap, opts, deadline.AsTime())
+	if err != nil {
+		if errors.Is(err, graphtools.ErrCycleInGraph) {
+			return cycleStatus(err)
+		}
+		if errors.Is(err, graphtools.ErrUnknownPriority) {
+			return status.Error(codes.InvalidArgument, "unknown priority")
+		}
+
+		s.log.Error("error while checking graph deadline", sl.Err(err))
+		return status.Error(codes.Internal, "internal")
+	}
+
+	predictableGraph.Deadline = convertDeadlineStatus(res)
+	return nil
+}
+
+// missProbability - оценивает моделированием вероятность того, что оставшаяся работа
+// загруженного графа не будет выполнена к его сроку, граф должен иметь срок
+func (s *Service) missProbability(predictableGraph *grph_pb.PredictedGraphResponse, opts graphtools.ScheduleOptions, iterations int, rnd *rand.Rand) (float64, error) {
+	result, err := graphtools.SimulateCriticalPath(
+		graph_wrapper.WrapPredictedGraph(predictableGraph),
+		remainingDistributions(predictableGraph),
+		opts,
+		iterations,
+		rnd,
+	)
+	if err != nil {
+		if errors.Is(err, graphtools.ErrCycleInGraph) {
+			return 0, cycleStatus(err)
+		}
+		if errors.Is(err, graphtools.ErrUnknownPriority) {
+			return 0, status.Error(codes.InvalidArgument, "unknown priority")
+		}
+
+		s.log.Error("error while simulating graph deadline", sl.Err(err))
+		return 0, status.Error(codes.Internal, "internal")
+	}
+
+	deadline := predictableGraph.Graph.GetDeadline().AsTime()
+	return result.ExceedProbability(deadline.Sub(opts.StartAt).Hours()), nil
+}
+
+func (s *Service) SetGraphDeadline(ctx context.Context, req *grph_pb.SetGraphDeadlineRequest) (*emptypb.Empty, error) {
+	var deadline *time.Time
+	if req.Deadline != nil {
+		t := req.Deadline.AsTime()
+		deadline = &t
+	}
+
+	if err := s.repository.SetGraphDeadline(ctx, actorID(ctx), req.GraphID, deadline); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "graph not found")
+		}
+
+		s.log.Error("error while setting graph deadline", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	s.broker.Reschedule(req.GraphID)
+
+	return &emptypb.Empty{}, nil
+}
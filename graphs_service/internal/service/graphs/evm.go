@@ -0,0 +1,113 @@
+package graphsservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	graphtools "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools"
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+	"github.com/liriquew/control_system/graphs_service/internal/repository"
+	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// evmBaseline - загружает сохраненное расписание, по которому считается плановый объем,
+// если baselineID не задан, используется последнее сохраненное расписание графа
+func (s *Service) evmBaseline(ctx context.Context, graphID, baselineID int64) (*models.Baseline, error) {
+	if baselineID == 0 {
+		baselines, err := s.repository.ListBaselines(ctx, graphID)
+		if err != nil {
+			s.log.Error("error while listing baselines", sl.Err(err))
+			return nil, status.Error(codes.Internal, "internal")
+		}
+		if len(baselines) == 0 {
+			return nil, status.Error(codes.FailedPrecondition, "graph has no baselines")
+		}
+		baselineID = baselines[0].ID
+	}
+
+	baseline, err := s.repository.GetBaseline(ctx, graphID, baselineID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "baseline not found")
+		}
+
+		s.log.Error("error while getting baseline", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return baseline, nil
+}
+
+func (s *Service) GetEVMReport(ctx context.Context, req *grph_pb.EVMReportRequest) (*grph_pb.EVMReportResponse, error) {
+	baseline, err := s.evmBaseline(ctx, req.GraphID, req.BaselineID)
+	if err != nil {
+		return nil, err
+	}
+
+	var baselineSnapshot graphtools.Snapshot
+	if err := json.Unmarshal(baseline.Snapshot, &baselineSnapshot); err != nil {
+		s.log.Error("error while unmarshaling baseline snapshot", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	predictableGraph, err := s.loadPredictedGraph(ctx, req.GraphID)
+	if err != nil {
+		return nil, err
+	}
+
+	asOf := time.Now().UTC()
+	if req.AsOf != nil {
+		asOf = req.AsOf.AsTime()
+	}
+
+	nodes := make([]graphtools.EVMNode, 0, len(predictableGraph.Nodes))
+	for _, node := range predictableGraph.Nodes {
+		// у вех и подграфов нет собственной стоимости
+		if node.Task == nil {
+			continue
+		}
+
+		nodes = append(nodes, graphtools.EVMNode{
+			NodeID:          node.Node.ID,
+			PlannedTime:     node.Task.PlannedTime,
+			ActualTime:      node.Task.ActualTime,
+			PercentComplete: node.Task.PercentComplete,
+			Done:            node.Task.ActualTime != 0,
+		})
+	}
+
+	report := graphtools.EarnedValue(&baselineSnapshot, nodes, asOf)
+
+	nodesValues := make([]*grph_pb.NodeEarnedValue, 0, len(report.Nodes))
+	for _, node := range report.Nodes {
+		nodesValues = append(nodesValues, &grph_pb.NodeEarnedValue{
+			NodeID:       node.NodeID,
+			PlannedValue: node.PlannedValue,
+			EarnedValue:  node.EarnedValue,
+			ActualCost:   node.ActualCost,
+		})
+	}
+
+	return &grph_pb.EVMReportResponse{
+		Baseline:             models.ConvertBaselineToProto(baseline),
+		AsOf:                 timestamppb.New(report.AsOf),
+		BudgetAtCompletion:   report.BudgetAtCompletion,
+		PlannedValue:         report.PlannedValue,
+		EarnedValue:          report.EarnedValue,
+		ActualCost:           report.ActualCost,
+		ScheduleVariance:     report.ScheduleVariance,
+		CostVariance:         report.CostVariance,
+		SPI:                  report.SPI,
+		CPI:                  report.CPI,
+		EstimateAtCompletion: report.EstimateAtCompletion,
+		EstimateToCompletion: report.EstimateToCompletion,
+		Nodes:                nodesValues,
+		UnbaselinedNodes:     report.UnbaselinedNodes,
+	}, nil
+}
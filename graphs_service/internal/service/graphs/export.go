@@ -0,0 +1,87 @@
+package graphsservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	graphtools "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools"
+	graph_wrapper "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/wrapper"
+	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type exportFormatInfo struct {
+	contentType string
+	extension   string
+}
+
+var exportFormats = map[grph_pb.ExportFormat]exportFormatInfo{
+	grph_pb.ExportFormat_DOT:     {contentType: "text/vnd.graphviz", extension: "dot"},
+	grph_pb.ExportFormat_Mermaid: {contentType: "text/vnd.mermaid", extension: "mmd"},
+	grph_pb.ExportFormat_MSPDI:   {contentType: "application/xml", extension: "xml"},
+}
+
+func (s *Service) ExportGraph(ctx context.Context, req *grph_pb.ExportGraphRequest) (*grph_pb.ExportGraphResponse, error) {
+	formatInfo, ok := exportFormats[req.Format]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown export format")
+	}
+
+	predictedGraph, err := s.predictGraph(ctx, req.GraphID, req.Priority, req.StartAt)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &graphtools.ExportGraph{
+		Name:     predictedGraph.Graph.Name,
+		StartAt:  predictedGraph.StartAt.AsTime(),
+		FinishAt: predictedGraph.FinishAt.AsTime(),
+		Nodes:    make([]graphtools.ExportNode, 0, len(predictedGraph.Nodes)),
+		Paths:    make([][]int64, 0, len(predictedGraph.Paths)),
+	}
+	for _, node := range predictedGraph.Nodes {
+		links := make(map[int64]graphtools.ExportLink, len(node.Node.Links))
+		for _, link := range node.Node.Links {
+			links[link.ToNodeID] = graphtools.ExportLink{Type: int(link.Type), Lag: link.Lag}
+		}
+
+		// у вех и подграфов нет задачи, вместо названия задачи берется название вершины
+		title := node.Node.Name
+		if node.Task != nil {
+			title = node.Task.Title
+		}
+
+		graph.Nodes = append(graph.Nodes, graphtools.ExportNode{
+			ID:           node.Node.ID,
+			TaskID:       node.Node.TaskID,
+			Title:        title,
+			Dependencies: node.Node.DependencyNodeIDs,
+			Links:        links,
+			Duration:     graph_wrapper.WrapNode(node).GetWeight(),
+			Start:        node.Schedule.Start.AsTime(),
+			Finish:       node.Schedule.Finish.AsTime(),
+		})
+	}
+	for _, path := range predictedGraph.Paths {
+		graph.Paths = append(graph.Paths, path.NodeIDs)
+	}
+
+	content, err := graphtools.Export(graph, int(req.Format))
+	if err != nil {
+		if errors.Is(err, graphtools.ErrUnknownFormat) {
+			return nil, status.Error(codes.InvalidArgument, "unknown export format")
+		}
+
+		s.log.Error("error while exporting graph", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return &grph_pb.ExportGraphResponse{
+		Content:     content,
+		ContentType: formatInfo.contentType,
+		FileName:    fmt.Sprintf("graph_%d.%s", req.GraphID, formatInfo.extension),
+	}, nil
+}
@@ -0,0 +1,27 @@
+package graphsservice
+
+import (
+	"math"
+
+	graphtools "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools"
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateLinks - проверяет тип и задержку связей между вершинами
+func validateLinks(links ...*grph_pb.Dependency) error {
+	for _, link := range links {
+		if link == nil {
+			continue
+		}
+		if err := graphtools.ValidateLinkType(int(link.Type)); err != nil {
+			return status.Errorf(codes.InvalidArgument, "unknown link type %d: %d -> %d", link.Type, link.FromNodeID, link.ToNodeID)
+		}
+		if math.IsNaN(link.Lag) || math.IsInf(link.Lag, 0) {
+			return status.Errorf(codes.InvalidArgument, "bad lag: %d -> %d", link.FromNodeID, link.ToNodeID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package graphsservice
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	tasksclient "github.com/liriquew/control_system/graphs_service/internal/grpc/clients/tasks"
+	"github.com/liriquew/control_system/graphs_service/internal/repository"
+	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// максимальная глубина вложенности подграфов при построении расписания
+const maxSubGraphDepth = 8
+
+// validateNodeKind - проверяет, что у вершины заданы только поля ее вида
+func validateNodeKind(node *grph_pb.Node) error {
+	switch node.Kind {
+	case grph_pb.NodeKind_TaskNode:
+		if node.TaskID <= 0 {
+			return status.Errorf(codes.InvalidArgument, "task node %d must have task", node.ID)
+		}
+		if node.SubGraphID != 0 {
+			return status.Errorf(codes.InvalidArgument, "task node %d can't refer to graph", node.ID)
+		}
+	case grph_pb.NodeKind_Milestone:
+		if node.TaskID != 0 || node.SubGraphID != 0 {
+			return status.Errorf(codes.InvalidArgument, "milestone %d can't refer to task or graph", node.ID)
+		}
+	case grph_pb.NodeKind_SubGraph:
+		if node.SubGraphID <= 0 {
+			return status.Errorf(codes.InvalidArgument, "sub-graph node %d must refer to graph", node.ID)
+		}
+		if node.TaskID != 0 {
+			return status.Errorf(codes.InvalidArgument, "sub-graph node %d can't have task", node.ID)
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "unknown kind %d of node %d", node.Kind, node.ID)
+	}
+
+	return nil
+}
+
+// checkSubGraph - проверяет, что граф graphID группы groupID может содержать подграф subGraphID:
+// подграф должен принадлежать той же группе и не должен содержать граф graphID на любой глубине
+func (s *Service) checkSubGraph(ctx context.Context, graphID, groupID, subGraphID int64) error {
+	if subGraphID == graphID {
+		return status.Error(codes.InvalidArgument, "graph can't contain itself")
+	}
+
+	subGroupID, err := s.repository.GetGraphGroup(ctx, subGraphID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return status.Errorf(codes.NotFound, "sub-graph %d not found", subGraphID)
+		}
+
+		s.log.Error("error while getting sub-graph group", sl.Err(err))
+		return status.Error(codes.Internal, "internal")
+	}
+	if subGroupID != groupID {
+		return status.Errorf(codes.InvalidArgument, "sub-graph %d belongs to another group", subGraphID)
+	}
+
+	// новый граф еще не может быть подграфом другого графа
+	if graphID == 0 {
+		return nil
+	}
+
+	visited := map[int64]struct{}{subGraphID: {}}
+	queue := []int64{subGraphID}
+	for len(queue) > 0 {
+		currGraphID := queue[0]
+		queue = queue[1:]
+
+		subGraphIDs, err := s.repository.GetSubGraphIDs(ctx, currGraphID)
+		if err != nil {
+			s.log.Error("error while getting sub-graphs", sl.Err(err))
+			return status.Error(codes.Internal, "internal")
+		}
+
+		for _, id := range subGraphIDs {
+			if id == graphID {
+				return status.Errorf(codes.FailedPrecondition, "sub-graph %d contains graph %d", subGraphID, graphID)
+			}
+			if _, ok := visited[id]; !ok {
+				visited[id] = struct{}{}
+				queue = append(queue, id)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkNode - проверяет вид вершины графа graphID и то, на что она ссылается
+func (s *Service) checkNode(ctx context.Context, graphID, groupID int64, node *grph_pb.Node) error {
+	if err := validateNodeKind(node); err != nil {
+		return err
+	}
+
+	switch node.Kind {
+	case grph_pb.NodeKind_TaskNode:
+		if err := s.tasksClient.TaskExists(ctx, node.TaskID, groupID); err != nil {
+			if errors.Is(err, tasksclient.ErrNotFound) {
+				return status.Errorf(codes.NotFound, "task %d not found", node.TaskID)
+			}
+
+			s.log.Error("error while checking is task exists", sl.Err(err))
+			return status.Error(codes.Internal, "internal")
+		}
+	case grph_pb.NodeKind_SubGraph:
+		return s.checkSubGraph(ctx, graphID, groupID, node.SubGraphID)
+	}
+
+	return nil
+}
+
+// subGraphDuration - предсказывает длительность подграфа в часах,
+// расписание подграфа строится с приоритетом по умолчанию от текущего момента,
+// parents - графы, внутри которых находится подграф
+func (s *Service) subGraphDuration(ctx context.Context, subGraphID int64, parents []int64) (float64, error) {
+	if slices.Contains(parents, subGraphID) {
+		return 0, status.Errorf(codes.FailedPrecondition, "sub-graph %d contains itself", subGraphID)
+	}
+	if len(parents) >= maxSubGraphDepth {
+		return 0, status.Errorf(codes.FailedPrecondition, "sub-graphs nesting is deeper than %d", maxSubGraphDepth)
+	}
+
+	subGraph, err := s.loadNestedGraph(ctx, subGraphID, parents)
+	if err != nil {
+		return 0, err
+	}
+
+	opts, err := s.scheduleOptions(ctx, subGraph.Graph.GroupID, grph_pb.Priority_MinTime, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.schedulePrediction(subGraph, opts); err != nil {
+		return 0, err
+	}
+
+	return subGraph.Duration, nil
+}
@@ -0,0 +1,286 @@
+package graphsservice
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/liriquew/control_system/graphs_service/internal/models"
+	"github.com/liriquew/control_system/graphs_service/internal/repository"
+	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// максимальное количество операций в одном патче
+const maxPatchOperations = 1000
+
+// checkNodeDependencies - проверяет новые зависимости вершины node от вершин графа nodes
+func checkNodeDependencies(nodes map[int64]*grph_pb.Node, node *grph_pb.Node) error {
+	if err := validateLinks(node.Links...); err != nil {
+		return err
+	}
+
+	seen := make(map[int64]struct{}, len(node.DependencyNodeIDs))
+	for _, depNodeID := range node.DependencyNodeIDs {
+		if _, ok := nodes[depNodeID]; !ok {
+			return status.Errorf(codes.InvalidArgument, "unknown node %d", depNodeID)
+		}
+		if depNodeID == node.ID {
+			return status.Errorf(codes.InvalidArgument, "self dependency: %d", node.ID)
+		}
+		if _, ok := seen[depNodeID]; ok {
+			return status.Errorf(codes.InvalidArgument, "dependency already exists: %d -> %d", node.ID, depNodeID)
+		}
+		seen[depNodeID] = struct{}{}
+	}
+
+	return nil
+}
+
+// applyPatch - применяет операции патча к вершинам графа в памяти и проверяет их,
+// новые вершины добавляются с временными идентификаторами, возвращает вершины графа после патча
+func applyPatch(graphNodes []*grph_pb.Node, ops []*grph_pb.PatchOperation) ([]*grph_pb.Node, error) {
+	nodes := make(map[int64]*grph_pb.Node, len(graphNodes))
+	for _, node := range graphNodes {
+		nodes[node.ID] = node
+	}
+
+	for _, op := range ops {
+		switch op.Type {
+		case grph_pb.PatchOperationType_AddNode:
+			if op.Node == nil {
+				return nil, status.Error(codes.InvalidArgument, "missing node")
+			}
+			if op.Node.ID >= 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "new node must have negative temporary ID, got %d", op.Node.ID)
+			}
+			if _, ok := nodes[op.Node.ID]; ok {
+				return nil, status.Errorf(codes.InvalidArgument, "duplicate temporary ID %d", op.Node.ID)
+			}
+			if err := validateNodeKind(op.Node); err != nil {
+				return nil, err
+			}
+			if err := checkNodeDependencies(nodes, op.Node); err != nil {
+				return nil, err
+			}
+
+			nodes[op.Node.ID] = proto.Clone(op.Node).(*grph_pb.Node)
+
+		case grph_pb.PatchOperationType_RemoveNode:
+			if _, ok := nodes[op.NodeID]; !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "unknown node %d", op.NodeID)
+			}
+
+			// вместе с вершиной удаляются все зависимости от нее
+			delete(nodes, op.NodeID)
+			for _, node := range nodes {
+				removeDependency(node, op.NodeID)
+			}
+
+		case grph_pb.PatchOperationType_AddDependency:
+			dep := op.Dependency
+			if dep == nil {
+				return nil, status.Error(codes.InvalidArgument, "missing dependency")
+			}
+			if err := validateLinks(dep); err != nil {
+				return nil, err
+			}
+			from, ok := nodes[dep.FromNodeID]
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "unknown node %d", dep.FromNodeID)
+			}
+			if _, ok := nodes[dep.ToNodeID]; !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "unknown node %d", dep.ToNodeID)
+			}
+			if dep.FromNodeID == dep.ToNodeID {
+				return nil, status.Errorf(codes.InvalidArgument, "self dependency: %d", dep.FromNodeID)
+			}
+			if slices.Contains(from.DependencyNodeIDs, dep.ToNodeID) {
+				return nil, status.Errorf(codes.InvalidArgument, "dependency already exists: %d -> %d", dep.FromNodeID, dep.ToNodeID)
+			}
+
+			from.DependencyNodeIDs = append(from.DependencyNodeIDs, dep.ToNodeID)
+			from.Links = append(from.Links, dep)
+
+		case grph_pb.PatchOperationType_RemoveDependency:
+			dep := op.Dependency
+			if dep == nil {
+				return nil, status.Error(codes.InvalidArgument, "missing dependency")
+			}
+			from, ok := nodes[dep.FromNodeID]
+			if !ok || !removeDependency(from, dep.ToNodeID) {
+				return nil, status.Errorf(codes.InvalidArgument, "dependency not found: %d -> %d", dep.FromNodeID, dep.ToNodeID)
+			}
+
+		case grph_pb.PatchOperationType_MoveNode:
+			if op.Node == nil {
+				return nil, status.Error(codes.InvalidArgument, "missing node")
+			}
+			node, ok := nodes[op.Node.ID]
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "unknown node %d", op.Node.ID)
+			}
+			if err := checkNodeDependencies(nodes, op.Node); err != nil {
+				return nil, err
+			}
+
+			node.DependencyNodeIDs = slices.Clone(op.Node.DependencyNodeIDs)
+			node.Links = slices.Clone(op.Node.Links)
+
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown operation type %d", op.Type)
+		}
+	}
+
+	return slices.Collect(maps.Values(nodes)), nil
+}
+
+// patchEvents - собирает события изменения графа по примененным операциям,
+// временные идентификаторы новых вершин заменяются на созданные
+func patchEvents(graphID int64, ops []*grph_pb.PatchOperation, createdNodes map[int64]int64) []*grph_pb.GraphEvent {
+	resolve := func(nodeID int64) int64 {
+		if createdID, ok := createdNodes[nodeID]; ok {
+			return createdID
+		}
+		return nodeID
+	}
+	resolveNode := func(node *grph_pb.Node) *grph_pb.Node {
+		res := &grph_pb.Node{
+			ID:                resolve(node.ID),
+			GraphID:           graphID,
+			TaskID:            node.TaskID,
+			Kind:              node.Kind,
+			SubGraphID:        node.SubGraphID,
+			Name:              node.Name,
+			DependencyNodeIDs: make([]int64, 0, len(node.DependencyNodeIDs)),
+		}
+		for _, depNodeID := range node.DependencyNodeIDs {
+			res.DependencyNodeIDs = append(res.DependencyNodeIDs, resolve(depNodeID))
+		}
+		for _, link := range node.Links {
+			res.Links = append(res.Links, &grph_pb.Dependency{
+				FromNodeID: res.ID,
+				ToNodeID:   resolve(link.ToNodeID),
+				Type:       link.Type,
+				Lag:        link.Lag,
+			})
+		}
+		return res
+	}
+	resolveDependency := func(dep *grph_pb.Dependency) *grph_pb.Dependency {
+		return &grph_pb.Dependency{
+			FromNodeID: resolve(dep.FromNodeID),
+			ToNodeID:   resolve(dep.ToNodeID),
+			Type:       dep.Type,
+			Lag:        dep.Lag,
+		}
+	}
+
+	events := make([]*grph_pb.GraphEvent, 0, len(ops))
+	for _, op := range ops {
+		event := &grph_pb.GraphEvent{GraphID: graphID}
+		switch op.Type {
+		case grph_pb.PatchOperationType_AddNode:
+			event.Type = grph_pb.GraphEventType_NodeCreated
+			event.Node = resolveNode(op.Node)
+		case grph_pb.PatchOperationType_RemoveNode:
+			event.Type = grph_pb.GraphEventType_NodeRemoved
+			event.Node = &grph_pb.Node{ID: resolve(op.NodeID), GraphID: graphID}
+		case grph_pb.PatchOperationType_AddDependency:
+			event.Type = grph_pb.GraphEventType_DependencyAdded
+			event.Dependency = resolveDependency(op.Dependency)
+		case grph_pb.PatchOperationType_RemoveDependency:
+			event.Type = grph_pb.GraphEventType_DependencyRemoved
+			event.Dependency = resolveDependency(op.Dependency)
+		case grph_pb.PatchOperationType_MoveNode:
+			event.Type = grph_pb.GraphEventType_NodeUpdated
+			event.Node = resolveNode(op.Node)
+		}
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func (s *Service) ApplyGraphPatch(ctx context.Context, req *grph_pb.GraphPatchRequest) (*grph_pb.GraphPatchResponse, error) {
+	if len(req.Operations) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty patch")
+	}
+	if len(req.Operations) > maxPatchOperations {
+		return nil, status.Errorf(codes.InvalidArgument, "too many operations, max %d", maxPatchOperations)
+	}
+
+	graph, err := s.repository.GetGraph(ctx, req.GraphID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "graph not found")
+		}
+
+		s.log.Error("error while getting graph", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+	if graph.GraphInfo.Version != req.Version {
+		return nil, status.Errorf(codes.Aborted, "graph version is %d", graph.GraphInfo.Version)
+	}
+
+	// патч проверяется целиком до записи, граф после него не должен содержать циклов
+	nodes, err := applyPatch(models.ConvertNodesToProto(graph.Nodes), req.Operations)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkNodesCycle(nodes); err != nil {
+		return nil, err
+	}
+
+	for _, op := range req.Operations {
+		if op.Type != grph_pb.PatchOperationType_AddNode {
+			continue
+		}
+
+		if err := s.checkNode(ctx, req.GraphID, graph.GraphInfo.GroupID, op.Node); err != nil {
+			return nil, err
+		}
+	}
+
+	version, createdNodes, err := s.repository.ApplyGraphPatch(ctx, actorID(ctx), req.GraphID, req.Version, req.Operations)
+	if err != nil {
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return nil, status.Error(codes.Aborted, "graph was changed, reload it and retry")
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "graph not found")
+		}
+		if errors.Is(err, repository.ErrTaskAlreadyInNode) ||
+			errors.Is(err, repository.ErrNotExists) ||
+			errors.Is(err, repository.ErrAlreadyExists) ||
+			errors.Is(err, repository.ErrSelfDependencyRejected) ||
+			errors.Is(err, repository.ErrUnknownLinkType) ||
+			errors.Is(err, repository.ErrBadNodeKind) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		s.log.Error("error while applying graph patch", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	s.publishChange(ctx, patchEvents(req.GraphID, req.Operations, createdNodes)...)
+
+	resp := &grph_pb.GraphPatchResponse{
+		Version:      version,
+		CreatedNodes: make([]*grph_pb.CreatedNode, 0, len(createdNodes)),
+	}
+	for _, op := range req.Operations {
+		if op.Type == grph_pb.PatchOperationType_AddNode {
+			resp.CreatedNodes = append(resp.CreatedNodes, &grph_pb.CreatedNode{
+				TempID: op.Node.ID,
+				NodeID: createdNodes[op.Node.ID],
+			})
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,219 @@
+package graphsservice
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+
+	tasksclient "github.com/liriquew/control_system/graphs_service/internal/grpc/clients/tasks"
+	graphtools "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools"
+	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// predictScenario - строит расписание графа с изменениями из сценария и сравнивает его
+// с расписанием исходного графа, сохраненные граф и задачи не изменяются
+func (s *Service) predictScenario(ctx context.Context, req *grph_pb.PredictGraphRequest) (*grph_pb.PredictedGraphResponse, error) {
+	base, err := s.loadPredictedGraph(ctx, req.GraphID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := s.scheduleOptions(ctx, base.Graph.GroupID, req.Priority, req.StartAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// копия делается до построения расписания, так как расписание записывается в граф
+	scenario := proto.Clone(base).(*grph_pb.PredictedGraphResponse)
+	if err := s.applyScenario(ctx, scenario, req.Scenario); err != nil {
+		return nil, err
+	}
+
+	if err := s.schedulePrediction(base, opts); err != nil {
+		return nil, err
+	}
+	if err := s.schedulePrediction(scenario, opts); err != nil {
+		return nil, err
+	}
+
+	diff := graphtools.CompareSnapshots(snapshotFromPrediction(base), snapshotFromPrediction(scenario))
+	scenario.Delta = &grph_pb.ScenarioDelta{
+		BaseDuration:        base.Duration,
+		DurationDelta:       diff.DurationDelta,
+		BaseFinishAt:        base.FinishAt,
+		FinishDrift:         diff.FinishDrift,
+		Nodes:               convertNodeDriftsToProto(diff.Nodes),
+		RemovedNodes:        diff.RemovedNodes,
+		AddedDependencies:   convertDependencyPairsToProto(diff.AddedDependencies),
+		RemovedDependencies: convertDependencyPairsToProto(diff.RemovedDependencies),
+		BasePaths:           base.Paths,
+	}
+
+	return scenario, nil
+}
+
+// applyScenario - применяет изменения сценария к загруженному графу,
+// изменения применяются в порядке: удаление вершин, удаление зависимостей,
+// добавление зависимостей, переназначение задач, изменение длительностей,
+// время переназначенных задач предсказывается заново моделью нового исполнителя
+func (s *Service) applyScenario(ctx context.Context, graph *grph_pb.PredictedGraphResponse, scenario *grph_pb.Scenario) error {
+	nodes := make(map[int64]*grph_pb.NodeWithTask, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		nodes[node.Node.ID] = node
+	}
+
+	// вместе с вершиной удаляются все зависимости от нее
+	for _, nodeID := range scenario.DropNodeIDs {
+		if _, ok := nodes[nodeID]; !ok {
+			return status.Errorf(codes.InvalidArgument, "unknown node %d", nodeID)
+		}
+		delete(nodes, nodeID)
+	}
+	graph.Nodes = slices.DeleteFunc(graph.Nodes, func(node *grph_pb.NodeWithTask) bool {
+		_, ok := nodes[node.Node.ID]
+		return !ok
+	})
+	for _, node := range graph.Nodes {
+		for _, nodeID := range scenario.DropNodeIDs {
+			removeDependency(node.Node, nodeID)
+		}
+	}
+
+	for _, dep := range scenario.RemoveDependencies {
+		from, ok := nodes[dep.FromNodeID]
+		if !ok || !removeDependency(from.Node, dep.ToNodeID) {
+			return status.Errorf(codes.InvalidArgument, "dependency not found: %d -> %d", dep.FromNodeID, dep.ToNodeID)
+		}
+	}
+
+	if err := validateLinks(scenario.AddDependencies...); err != nil {
+		return err
+	}
+	for _, dep := range scenario.AddDependencies {
+		from, ok := nodes[dep.FromNodeID]
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "unknown node %d", dep.FromNodeID)
+		}
+		if _, ok := nodes[dep.ToNodeID]; !ok {
+			return status.Errorf(codes.InvalidArgument, "unknown node %d", dep.ToNodeID)
+		}
+		if dep.FromNodeID == dep.ToNodeID {
+			return status.Errorf(codes.InvalidArgument, "self dependency: %d", dep.FromNodeID)
+		}
+		if slices.Contains(from.Node.DependencyNodeIDs, dep.ToNodeID) {
+			return status.Errorf(codes.InvalidArgument, "dependency already exists: %d -> %d", dep.FromNodeID, dep.ToNodeID)
+		}
+
+		from.Node.DependencyNodeIDs = append(from.Node.DependencyNodeIDs, dep.ToNodeID)
+		from.Node.Links = append(from.Node.Links, &grph_pb.Dependency{
+			FromNodeID: dep.FromNodeID,
+			ToNodeID:   dep.ToNodeID,
+			Type:       dep.Type,
+			Lag:        dep.Lag,
+		})
+	}
+
+	// задача -> новый исполнитель
+	assignees := make(map[int64]int64, len(scenario.Reassignments))
+	for _, reassignment := range scenario.Reassignments {
+		node, ok := nodes[reassignment.NodeID]
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "unknown node %d", reassignment.NodeID)
+		}
+		if reassignment.AssignedTo <= 0 {
+			return status.Errorf(codes.InvalidArgument, "bad assignee for node %d", reassignment.NodeID)
+		}
+		if node.Task == nil {
+			return status.Errorf(codes.InvalidArgument, "node %d has no task to reassign", reassignment.NodeID)
+		}
+
+		assignees[node.Task.ID] = reassignment.AssignedTo
+	}
+	if err := s.predictReassigned(ctx, graph, assignees); err != nil {
+		return err
+	}
+
+	for _, duration := range scenario.Durations {
+		node, ok := nodes[duration.NodeID]
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "unknown node %d", duration.NodeID)
+		}
+		if duration.Duration <= 0 || math.IsInf(duration.Duration, 0) || math.IsNaN(duration.Duration) {
+			return status.Errorf(codes.InvalidArgument, "bad duration for node %d", duration.NodeID)
+		}
+
+		node.PredictedTime = duration.Duration
+	}
+
+	return nil
+}
+
+// predictReassigned - назначает задачам графа новых исполнителей и предсказывает
+// их время моделями этих исполнителей, assignees - новый исполнитель для каждой задачи
+func (s *Service) predictReassigned(ctx context.Context, graph *grph_pb.PredictedGraphResponse, assignees map[int64]int64) error {
+	if len(assignees) == 0 {
+		return nil
+	}
+
+	predictedTasks, unpredictedUIDs, err := s.tasksClient.PredictReassignedTasks(ctx, assignees)
+	if err != nil {
+		if errors.Is(err, tasksclient.ErrNotFound) {
+			return status.Error(codes.NotFound, "tasks not found")
+		}
+
+		s.log.Error("error while predicting reassigned tasks", sl.Err(err))
+		return status.Error(codes.Internal, "internal")
+	}
+
+	predicted := make(map[int64]*tsks_pb.PredictedTask, len(predictedTasks))
+	for _, predictedTask := range predictedTasks {
+		predicted[predictedTask.Task.ID] = predictedTask
+	}
+
+	for _, node := range graph.Nodes {
+		if node.Task == nil {
+			continue
+		}
+		predictedTask, ok := predicted[node.Task.ID]
+		if !ok {
+			continue
+		}
+
+		node.Task.AssignedTo = assignees[node.Task.ID]
+		// время выполненной задачи известно, предсказание не нужно
+		node.PredictedTime = predictedTask.PredictedTime
+		if node.Task.ActualTime != 0 {
+			node.PredictedTime = node.Task.ActualTime
+		}
+	}
+
+	for _, uid := range unpredictedUIDs {
+		if !slices.Contains(graph.UnpredictedUIDs, uid) {
+			graph.UnpredictedUIDs = append(graph.UnpredictedUIDs, uid)
+		}
+	}
+
+	return nil
+}
+
+// removeDependency - удаляет зависимость вершины toNodeID от вершины node вместе со связью,
+// возвращает false, если такой зависимости нет
+func removeDependency(node *grph_pb.Node, toNodeID int64) bool {
+	idx := slices.Index(node.DependencyNodeIDs, toNodeID)
+	if idx == -1 {
+		return false
+	}
+
+	node.DependencyNodeIDs = slices.Delete(node.DependencyNodeIDs, idx, idx+1)
+	node.Links = slices.DeleteFunc(node.Links, func(link *grph_pb.Dependency) bool {
+		return link.ToNodeID == toNodeID
+	})
+
+	return true
+}
@@ -4,21 +4,34 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"math/rand/v2"
+	"slices"
 	"strconv"
+	"time"
 
 	"github.com/liriquew/control_system/graphs_service/internal/entities"
 	tasksclient "github.com/liriquew/control_system/graphs_service/internal/grpc/clients/tasks"
+	"github.com/liriquew/control_system/graphs_service/internal/lib/broker"
 	graphtools "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools"
 	graph_wrapper "github.com/liriquew/control_system/graphs_service/internal/lib/graph_tools/wrapper"
 	"github.com/liriquew/control_system/graphs_service/internal/models"
 	"github.com/liriquew/control_system/graphs_service/internal/repository"
 	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
 	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	grps_pb "github.com/liriquew/control_system/services_protos/groups_service"
 	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// количество итераций моделирования по умолчанию
+	defaultSimulationIterations = 1000
+	// максимальное количество итераций моделирования
+	maxSimulationIterations = 10000
 )
 
 type Repository interface {
@@ -28,20 +41,39 @@ type Repository interface {
 	ListGroupGraphs(ctx context.Context, groupID, offset int64) ([]*entities.GraphWithNodes, error)
 
 	GetGraph(ctx context.Context, graphID int64) (*entities.GraphWithNodes, error)
-	CreateNode(ctx context.Context, node *grph_pb.Node) (int64, error)
+	CreateNode(ctx context.Context, actorID int64, node *grph_pb.Node) (int64, error)
 	GetNode(ctx context.Context, graphID, nodeID int64) (*models.Node, error)
-	UpdateNode(ctx context.Context, node *grph_pb.Node) error
-	RemoveNode(ctx context.Context, nodeID int64) error
+	UpdateNode(ctx context.Context, actorID int64, node *grph_pb.Node) error
+	RemoveNode(ctx context.Context, actorID, nodeID int64) error
+	GetDeletedNode(ctx context.Context, graphID, nodeID int64) (*models.Node, []*models.Dependency, error)
+	RestoreNode(ctx context.Context, actorID, graphID, nodeID, version int64) (*models.Node, []*models.Dependency, error)
 	GetDependencies(ctx context.Context, nodeID int64) (*models.Node, error)
-	AddDependency(ctx context.Context, graphID int64, dep *grph_pb.Dependency) error
-	RemoveDependensy(ctx context.Context, dependency *grph_pb.Dependency) error
+	AddDependency(ctx context.Context, actorID, graphID, version int64, dep *grph_pb.Dependency) error
+	RemoveDependensy(ctx context.Context, actorID int64, dependency *grph_pb.Dependency) error
+	ApplyGraphPatch(ctx context.Context, actorID, graphID, version int64, ops []*grph_pb.PatchOperation) (int64, map[int64]int64, error)
+	GetSubGraphIDs(ctx context.Context, graphID int64) ([]int64, error)
+	SetGraphDeadline(ctx context.Context, actorID, graphID int64, deadline *time.Time) error
 
 	TaskInNode(ctx context.Context, taskID int64) (int64, error)
+
+	CreateBaseline(ctx context.Context, baseline *models.Baseline) (*models.Baseline, error)
+	ListBaselines(ctx context.Context, graphID int64) ([]*models.Baseline, error)
+	GetBaseline(ctx context.Context, graphID, baselineID int64) (*models.Baseline, error)
+
+	ListAuditEvents(ctx context.Context, req *grph_pb.ListAuditEventsRequest) ([]*models.AuditEvent, error)
 }
 
 type tasksClient interface {
 	GetPredictedTasks(context.Context, []int64) ([]*tsks_pb.PredictedTask, []int64, error)
+	PredictReassignedTasks(context.Context, map[int64]int64) ([]*tsks_pb.PredictedTask, []int64, error)
 	TaskExists(context.Context, int64, int64) error
+	GetGroupTasks(context.Context, int64) ([]*tsks_pb.Task, error)
+	CreateTask(context.Context, *tsks_pb.Task) (int64, error)
+	DeleteTask(context.Context, int64, int64) error
+}
+
+type groupsClient interface {
+	GetGroupCalendars(context.Context, int64) ([]*grps_pb.UserCalendar, error)
 }
 
 type authClient interface {
@@ -50,17 +82,23 @@ type authClient interface {
 
 type Service struct {
 	grph_pb.UnimplementedGraphsServer
-	repository  Repository
-	log         *slog.Logger
-	tasksClient tasksClient
+	repository   Repository
+	log          *slog.Logger
+	tasksClient  tasksClient
+	groupsClient groupsClient
+	broker       *broker.Broker
 }
 
-func New(log *slog.Logger, graphsRepository Repository, tc tasksClient) *Service {
-	return &Service{
-		log:         log,
-		repository:  graphsRepository,
-		tasksClient: tc,
+func New(log *slog.Logger, graphsRepository Repository, tc tasksClient, gc groupsClient) *Service {
+	s := &Service{
+		log:          log,
+		repository:   graphsRepository,
+		tasksClient:  tc,
+		groupsClient: gc,
 	}
+	s.broker = broker.New(s.publishSchedule, scheduleDebounce)
+
+	return s
 }
 
 func (s *Service) authenticate(ctx context.Context) (int64, error) {
@@ -82,6 +120,18 @@ func (s *Service) authenticate(ctx context.Context) (int64, error) {
 	return userID, nil
 }
 
+// actorID - возвращает пользователя из метаданных запроса, пользователь нужен
+// только для информации (журнал, события графа), поэтому ошибка не важна
+func actorID(ctx context.Context) int64 {
+	var userID int64
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get("user-id"); len(ids) != 0 {
+			userID, _ = strconv.ParseInt(ids[0], 10, 64)
+		}
+	}
+	return userID
+}
+
 func (s *Service) CreateGroupGraph(ctx context.Context, req *grph_pb.GraphWithNodes) (*grph_pb.GraphResponse, error) {
 	userID, err := s.authenticate(ctx)
 	if err != nil {
@@ -93,6 +143,25 @@ func (s *Service) CreateGroupGraph(ctx context.Context, req *grph_pb.GraphWithNo
 	if req.GraphInfo.Name == "" {
 		return nil, status.Error(codes.InvalidArgument, "empty graph name")
 	}
+	for _, node := range req.Nodes {
+		if err := validateLinks(node.Links...); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkNodesCycle(req.Nodes); err != nil {
+		return nil, err
+	}
+	// задачи вершин проверяются до создания графа в шлюзе
+	for _, node := range req.Nodes {
+		if err := validateNodeKind(node); err != nil {
+			return nil, err
+		}
+		if node.Kind == grph_pb.NodeKind_SubGraph {
+			if err := s.checkSubGraph(ctx, 0, req.GraphInfo.GroupID, node.SubGraphID); err != nil {
+				return nil, err
+			}
+		}
+	}
 
 	graphID, err := s.repository.CreateGraph(ctx, req.GraphInfo, req.Nodes)
 	if err != nil {
@@ -103,6 +172,9 @@ func (s *Service) CreateGroupGraph(ctx context.Context, req *grph_pb.GraphWithNo
 		if errors.Is(err, repository.ErrDependencyNotFound) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		if errors.Is(err, repository.ErrNotExists) || errors.Is(err, repository.ErrBadNodeKind) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		s.log.Error("error while creating graph ", sl.Err(err))
 		return nil, status.Error(codes.Internal, "internal")
 	}
@@ -166,6 +238,10 @@ func (s *Service) GetNode(ctx context.Context, req *grph_pb.GetNodeRequest) (*gr
 }
 
 func (s *Service) CreateNode(ctx context.Context, req *grph_pb.CreateNodeRequest) (*grph_pb.NodeResponse, error) {
+	if err := validateLinks(req.Node.Links...); err != nil {
+		return nil, err
+	}
+
 	groupID, err := s.repository.GetGraphGroup(ctx, req.Node.GraphID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
@@ -176,16 +252,11 @@ func (s *Service) CreateNode(ctx context.Context, req *grph_pb.CreateNodeRequest
 		return nil, status.Error(codes.Internal, "internal")
 	}
 
-	if err := s.tasksClient.TaskExists(ctx, req.Node.TaskID, groupID); err != nil {
-		if errors.Is(err, tasksclient.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, "task not found")
-		}
-
-		s.log.Error("error while checking is task exists", sl.Err(err))
-		return nil, status.Error(codes.Internal, "internal")
+	if err := s.checkNode(ctx, req.Node.GraphID, groupID, req.Node); err != nil {
+		return nil, err
 	}
 
-	nodeID, err := s.repository.CreateNode(ctx, req.Node)
+	nodeID, err := s.repository.CreateNode(ctx, actorID(ctx), req.Node)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotExists) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -196,6 +267,9 @@ func (s *Service) CreateNode(ctx context.Context, req *grph_pb.CreateNodeRequest
 		if errors.Is(err, repository.ErrTaskAlreadyInNode) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		if errors.Is(err, repository.ErrBadNodeKind) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 
 		s.log.Error("error while creating node", sl.Err(err))
 		return nil, status.Error(codes.Internal, "internal")
@@ -203,6 +277,12 @@ func (s *Service) CreateNode(ctx context.Context, req *grph_pb.CreateNodeRequest
 
 	req.Node.ID = nodeID
 
+	s.publishChange(ctx, &grph_pb.GraphEvent{
+		Type:    grph_pb.GraphEventType_NodeCreated,
+		GraphID: req.Node.GraphID,
+		Node:    req.Node,
+	})
+
 	return &grph_pb.NodeResponse{
 		Node: req.Node,
 	}, nil
@@ -219,29 +299,35 @@ func (s *Service) UpdateNode(ctx context.Context, req *grph_pb.UpdateNodeRequest
 		return nil, status.Error(codes.Internal, "internal")
 	}
 
-	if err := s.tasksClient.TaskExists(ctx, req.Node.TaskID, groupID); err != nil {
-		if errors.Is(err, tasksclient.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, "task not found")
-		}
-
-		s.log.Error("error while checking is task exists", sl.Err(err))
-		return nil, status.Error(codes.Internal, "internal")
+	if err := s.checkNode(ctx, req.Node.GraphID, groupID, req.Node); err != nil {
+		return nil, err
 	}
 
-	if err := s.repository.UpdateNode(ctx, req.Node); err != nil {
+	if err := s.repository.UpdateNode(ctx, actorID(ctx), req.Node); err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "node not found")
 		}
+		if errors.Is(err, repository.ErrNotExists) ||
+			errors.Is(err, repository.ErrTaskAlreadyInNode) ||
+			errors.Is(err, repository.ErrBadNodeKind) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 
 		s.log.Error("error while updating node", sl.Err(err))
 		return nil, status.Error(codes.Internal, "internal")
 	}
 
+	s.publishChange(ctx, &grph_pb.GraphEvent{
+		Type:    grph_pb.GraphEventType_NodeUpdated,
+		GraphID: req.Node.GraphID,
+		Node:    req.Node,
+	})
+
 	return &emptypb.Empty{}, nil
 }
 
 func (s *Service) RemoveNode(ctx context.Context, req *grph_pb.RemoveNodeRequest) (*emptypb.Empty, error) {
-	if err := s.repository.RemoveNode(ctx, req.NodeID); err != nil && !errors.Is(err, repository.ErrNotExists) {
+	if err := s.repository.RemoveNode(ctx, actorID(ctx), req.NodeID); err != nil && !errors.Is(err, repository.ErrNotExists) {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "node not found")
 		}
@@ -250,9 +336,104 @@ func (s *Service) RemoveNode(ctx context.Context, req *grph_pb.RemoveNodeRequest
 		return nil, status.Error(codes.Internal, "internal")
 	}
 
+	s.publishChange(ctx, &grph_pb.GraphEvent{
+		Type:    grph_pb.GraphEventType_NodeRemoved,
+		GraphID: req.GraphID,
+		Node: &grph_pb.Node{
+			ID:      req.NodeID,
+			GraphID: req.GraphID,
+		},
+	})
+
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) RestoreNode(ctx context.Context, req *grph_pb.RestoreNodeRequest) (*grph_pb.NodeResponse, error) {
+	var node *models.Node
+	var deps []*models.Dependency
+	err := retryOnVersionConflict(func() (err error) {
+		node, deps, err = s.restoreCheckedNode(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	restored := models.ConvertNodeToProto(node)
+	s.publishChange(ctx, &grph_pb.GraphEvent{
+		Type:    grph_pb.GraphEventType_NodeCreated,
+		GraphID: req.GraphID,
+		Node:    restored,
+	})
+	for _, dep := range deps {
+		s.publishChange(ctx, &grph_pb.GraphEvent{
+			Type:       grph_pb.GraphEventType_DependencyAdded,
+			GraphID:    req.GraphID,
+			Dependency: models.ConvertDependencyToProto(dep),
+		})
+	}
+
+	return &grph_pb.NodeResponse{
+		Node: restored,
+	}, nil
+}
+
+// restoreCheckedNode - восстанавливает вершину, если восстановленные зависимости не замыкают цикл,
+// возвращает repository.ErrVersionConflict, если граф изменился после проверки
+func (s *Service) restoreCheckedNode(ctx context.Context, req *grph_pb.RestoreNodeRequest) (*models.Node, []*models.Dependency, error) {
+	// граф читается до удаленной вершины, чтобы ее зависимости не оказались новее версии графа
+	graph, err := s.repository.GetGraph(ctx, req.GraphID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil, status.Error(codes.NotFound, "graph not found")
+		}
+
+		s.log.Error("error while getting graph", sl.Err(err))
+		return nil, nil, status.Error(codes.Internal, "internal")
+	}
+
+	node, deps, err := s.repository.GetDeletedNode(ctx, req.GraphID, req.NodeID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil, status.Error(codes.NotFound, "deleted node not found")
+		}
+
+		s.log.Error("error while getting deleted node", sl.Err(err))
+		return nil, nil, status.Error(codes.Internal, "internal")
+	}
+
+	// восстановленные зависимости не должны замыкать цикл, проверяем граф вместе с ними
+	graph.Nodes = append(graph.Nodes, node)
+	for _, dep := range deps {
+		for _, n := range graph.Nodes {
+			if n.ID == dep.FromNodeID {
+				n.DependencyNodeIDs = append(n.DependencyNodeIDs, dep.ToNodeID)
+			}
+		}
+	}
+	if err := checkCycle(graph); err != nil {
+		return nil, nil, err
+	}
+
+	node, deps, err = s.repository.RestoreNode(ctx, actorID(ctx), req.GraphID, req.NodeID, graph.GraphInfo.Version)
+	if err != nil {
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return nil, nil, err
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, nil, status.Error(codes.NotFound, "deleted node not found")
+		}
+		if errors.Is(err, repository.ErrTaskAlreadyInNode) {
+			return nil, nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		s.log.Error("error while restoring node", sl.Err(err))
+		return nil, nil, status.Error(codes.Internal, "internal")
+	}
+
+	return node, deps, nil
+}
+
 func (s *Service) GetDependencies(ctx context.Context, req *grph_pb.GetDependenciesRequest) (*grph_pb.NodeWithDependencies, error) {
 	node, err := s.repository.GetDependencies(ctx, req.NodeID)
 	if err != nil {
@@ -270,26 +451,75 @@ func (s *Service) GetDependencies(ctx context.Context, req *grph_pb.GetDependenc
 }
 
 func (s *Service) AddDependency(ctx context.Context, req *grph_pb.DependencyRequest) (*emptypb.Empty, error) {
-	if err := s.repository.AddDependency(ctx, req.GraphID, req.Dependency); err != nil {
+	if err := validateLinks(req.Dependency); err != nil {
+		return nil, err
+	}
+
+	err := retryOnVersionConflict(func() error {
+		return s.addCheckedDependency(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishChange(ctx, &grph_pb.GraphEvent{
+		Type:       grph_pb.GraphEventType_DependencyAdded,
+		GraphID:    req.GraphID,
+		Dependency: req.Dependency,
+	})
+
+	return &emptypb.Empty{}, nil
+}
+
+// addCheckedDependency - добавляет зависимость, если она не замыкает цикл,
+// возвращает repository.ErrVersionConflict, если граф изменился после проверки
+func (s *Service) addCheckedDependency(ctx context.Context, req *grph_pb.DependencyRequest) error {
+	// зависимость не должна замыкать цикл, проверяем граф вместе с ней
+	graph, err := s.repository.GetGraph(ctx, req.GraphID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return status.Error(codes.NotFound, "graph not found")
+		}
+
+		s.log.Error("error while getting graph", sl.Err(err))
+		return status.Error(codes.Internal, "internal")
+	}
+	// зависимость вершины от самой себя отклоняется при записи
+	for _, node := range graph.Nodes {
+		if node.ID == req.Dependency.FromNodeID && node.ID != req.Dependency.ToNodeID {
+			node.DependencyNodeIDs = append(node.DependencyNodeIDs, req.Dependency.ToNodeID)
+		}
+	}
+	if err := checkCycle(graph); err != nil {
+		return err
+	}
+
+	if err := s.repository.AddDependency(ctx, actorID(ctx), req.GraphID, graph.GraphInfo.Version, req.Dependency); err != nil {
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return err
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			return status.Error(codes.NotFound, "graph not found")
+		}
 		if errors.Is(err, repository.ErrNotExists) {
-			return nil, status.Error(codes.NotFound, err.Error())
+			return status.Error(codes.NotFound, err.Error())
 		}
-		if errors.Is(err, repository.ErrSelfDependencyRejected) {
-			return nil, status.Error(codes.InvalidArgument, err.Error())
+		if errors.Is(err, repository.ErrSelfDependencyRejected) || errors.Is(err, repository.ErrUnknownLinkType) {
+			return status.Error(codes.InvalidArgument, err.Error())
 		}
 		if errors.Is(err, repository.ErrAlreadyExists) {
-			return nil, status.Error(codes.AlreadyExists, err.Error())
+			return status.Error(codes.AlreadyExists, err.Error())
 		}
 
-		s.log.Error("error while removing node", sl.Err(err))
-		return nil, status.Error(codes.Internal, "internal")
+		s.log.Error("error while adding dependency", sl.Err(err))
+		return status.Error(codes.Internal, "internal")
 	}
 
-	return &emptypb.Empty{}, nil
+	return nil
 }
 
 func (s *Service) RemoveDependency(ctx context.Context, req *grph_pb.DependencyRequest) (*emptypb.Empty, error) {
-	if err := s.repository.RemoveDependensy(ctx, req.Dependency); err != nil {
+	if err := s.repository.RemoveDependensy(ctx, actorID(ctx), req.Dependency); err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "node not found")
 		}
@@ -298,11 +528,25 @@ func (s *Service) RemoveDependency(ctx context.Context, req *grph_pb.DependencyR
 		return nil, status.Error(codes.Internal, "internal")
 	}
 
+	s.publishChange(ctx, &grph_pb.GraphEvent{
+		Type:       grph_pb.GraphEventType_DependencyRemoved,
+		GraphID:    req.GraphID,
+		Dependency: req.Dependency,
+	})
+
 	return &emptypb.Empty{}, nil
 }
 
-func (s *Service) PredictGraph(ctx context.Context, req *grph_pb.PredictGraphRequest) (*grph_pb.PredictedGraphResponse, error) {
-	graph, err := s.repository.GetGraph(ctx, req.GraphID)
+// loadPredictedGraph - загружает граф вместе с задачами и их предсказанным временем
+func (s *Service) loadPredictedGraph(ctx context.Context, graphID int64) (*grph_pb.PredictedGraphResponse, error) {
+	return s.loadNestedGraph(ctx, graphID, nil)
+}
+
+// loadNestedGraph - загружает граф вместе с задачами и их предсказанным временем,
+// длительность вершин-подграфов предсказывается рекурсивно,
+// parents - графы, внутри которых находится загружаемый граф
+func (s *Service) loadNestedGraph(ctx context.Context, graphID int64, parents []int64) (*grph_pb.PredictedGraphResponse, error) {
+	graph, err := s.repository.GetGraph(ctx, graphID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "graph not found")
@@ -314,61 +558,267 @@ func (s *Service) PredictGraph(ctx context.Context, req *grph_pb.PredictGraphReq
 
 	tasksIDs := make([]int64, 0, len(graph.Nodes))
 	for _, node := range graph.Nodes {
-		tasksIDs = append(tasksIDs, node.TaskID)
+		if node.Kind == int32(grph_pb.NodeKind_TaskNode) {
+			tasksIDs = append(tasksIDs, node.TaskID)
+		}
 	}
 
-	predictedTasks, unpredictedUIDs, err := s.tasksClient.GetPredictedTasks(ctx, tasksIDs)
-	if err != nil {
-		if errors.Is(err, tasksclient.ErrNotFound) {
-			return nil, status.Error(codes.NotFound, "tasks not found")
+	var predictedTasks []*tsks_pb.PredictedTask
+	var unpredictedUIDs []int64
+	if len(tasksIDs) != 0 {
+		predictedTasks, unpredictedUIDs, err = s.tasksClient.GetPredictedTasks(ctx, tasksIDs)
+		if err != nil {
+			if errors.Is(err, tasksclient.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "tasks not found")
+			}
+
+			s.log.Error("error while getting tasks in Predict", sl.Err(err))
+			return nil, status.Error(codes.Internal, "internal")
 		}
-
-		s.log.Error("error while getting tasks in Predict", sl.Err(err))
-		return nil, status.Error(codes.Internal, "internal")
 	}
 
 	tasksNodesMap := make(map[int64]*grph_pb.Node, len(predictedTasks))
+	nodesWithTasks := make([]*grph_pb.NodeWithTask, 0, len(graph.Nodes))
 	for _, node := range graph.Nodes {
-		tasksNodesMap[node.TaskID] = models.ConvertNodeToProto(node)
+		switch grph_pb.NodeKind(node.Kind) {
+		case grph_pb.NodeKind_TaskNode:
+			tasksNodesMap[node.TaskID] = models.ConvertNodeToProto(node)
+		case grph_pb.NodeKind_Milestone:
+			// веха не занимает времени
+			nodesWithTasks = append(nodesWithTasks, &grph_pb.NodeWithTask{
+				Node: models.ConvertNodeToProto(node),
+			})
+		case grph_pb.NodeKind_SubGraph:
+			duration, err := s.subGraphDuration(ctx, node.SubGraphID, append(slices.Clone(parents), graphID))
+			if err != nil {
+				return nil, err
+			}
+
+			nodesWithTasks = append(nodesWithTasks, &grph_pb.NodeWithTask{
+				Node:          models.ConvertNodeToProto(node),
+				PredictedTime: duration,
+			})
+		}
 	}
 
-	nodesWithTasks := make([]*grph_pb.NodeWithTask, 0, len(predictedTasks))
 	for _, predictedTask := range predictedTasks {
+		// время выполненной задачи известно, предсказание не нужно
+		predictedTime := predictedTask.PredictedTime
+		if predictedTask.Task.ActualTime != 0 {
+			predictedTime = predictedTask.Task.ActualTime
+		}
+
 		nodesWithTasks = append(nodesWithTasks, &grph_pb.NodeWithTask{
 			Node:          tasksNodesMap[predictedTask.Task.ID],
 			Task:          predictedTask.Task,
-			PredictedTime: predictedTask.PredictedTime,
+			PredictedTime: predictedTime,
 		})
 	}
 
-	predictableGraph := &grph_pb.PredictedGraphResponse{
+	return &grph_pb.PredictedGraphResponse{
 		Graph:           models.ConvertGraphToProto(graph.GraphInfo),
 		Nodes:           nodesWithTasks,
 		UnpredictedUIDs: unpredictedUIDs,
+	}, nil
+}
+
+// scheduleOptions - собирает параметры построения расписания,
+// календари исполнителей загружаются из сервиса групп
+func (s *Service) scheduleOptions(ctx context.Context, groupID int64, priority grph_pb.Priority, startAt *timestamppb.Timestamp) (graphtools.ScheduleOptions, error) {
+	opts := graphtools.ScheduleOptions{
+		Priority: int(priority),
+		StartAt:  time.Now().UTC(),
+	}
+	if startAt != nil {
+		opts.StartAt = startAt.AsTime()
 	}
 
-	nodesValuesMap := make(map[int64]float64, len(predictableGraph.Nodes))
-	for _, node := range predictableGraph.Nodes {
-		nodesValuesMap[node.Node.ID] = node.PredictedTime
+	calendars, err := s.groupsClient.GetGroupCalendars(ctx, groupID)
+	if err != nil {
+		s.log.Error("error while getting group calendars", sl.Err(err))
+		return opts, status.Error(codes.Internal, "internal")
+	}
+
+	opts.Calendars = make(map[int64]*graphtools.Calendar, len(calendars))
+	for _, c := range calendars {
+		workDays := make([]time.Weekday, 0, len(c.WorkDays))
+		for _, day := range c.WorkDays {
+			workDays = append(workDays, time.Weekday(day))
+		}
+
+		daysOff := make([]time.Time, 0, len(c.DaysOff))
+		for _, day := range c.DaysOff {
+			daysOff = append(daysOff, day.AsTime())
+		}
+
+		opts.Calendars[c.UserID] = graphtools.NewCalendar(
+			time.Duration(c.WorkStartMinute)*time.Minute,
+			time.Duration(c.WorkEndMinute)*time.Minute,
+			workDays,
+			daysOff,
+			time.FixedZone("", int(c.UTCOffsetMinutes)*60),
+		)
+	}
+
+	return opts, nil
+}
+
+func (s *Service) PredictGraph(ctx context.Context, req *grph_pb.PredictGraphRequest) (*grph_pb.PredictedGraphResponse, error) {
+	if req.Scenario != nil {
+		return s.predictScenario(ctx, req)
+	}
+
+	return s.predictGraph(ctx, req.GraphID, req.Priority, req.StartAt)
+}
+
+// predictGraph - загружает граф и строит его расписание
+func (s *Service) predictGraph(ctx context.Context, graphID int64, priority grph_pb.Priority, startAt *timestamppb.Timestamp) (*grph_pb.PredictedGraphResponse, error) {
+	predictableGraph, err := s.loadPredictedGraph(ctx, graphID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := s.scheduleOptions(ctx, predictableGraph.Graph.GroupID, priority, startAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.schedulePrediction(predictableGraph, opts); err != nil {
+		return nil, err
 	}
 
-	paths, err := graphtools.FindCriticalPath(graph_wrapper.WrapPredictedGraph(predictableGraph), nodesValuesMap, int(req.Priority))
+	return predictableGraph, nil
+}
+
+// schedulePrediction - строит расписание загруженного графа и записывает его в predictableGraph
+func (s *Service) schedulePrediction(predictableGraph *grph_pb.PredictedGraphResponse, opts graphtools.ScheduleOptions) error {
+	// выполненные и начатые задачи учитываются только оставшейся частью,
+	// поэтому расписание строится от момента opts.StartAt для оставшейся работы
+	nodesValuesMap := remainingValues(predictableGraph)
+
+	if err := s.checkDeadline(predictableGraph, nodesValuesMap, opts); err != nil {
+		return err
+	}
+
+	schedule, err := graphtools.FindSchedule(graph_wrapper.WrapPredictedGraph(predictableGraph), nodesValuesMap, opts)
 	if err != nil {
 		if errors.Is(err, graphtools.ErrCycleInGraph) {
-			return nil, status.Error(codes.FailedPrecondition, "cycle found in graph")
+			return cycleStatus(err)
+		}
+		if errors.Is(err, graphtools.ErrUnknownPriority) {
+			return status.Error(codes.InvalidArgument, "unknown priority")
 		}
 
 		s.log.Error("error while searching critical path", sl.Err(err))
-		return nil, err
+		return err
 	}
 
-	for _, path := range paths {
+	for _, path := range schedule.Paths {
 		predictableGraph.Paths = append(predictableGraph.Paths, &grph_pb.Path{
 			NodeIDs: path,
 		})
 	}
 
-	return predictableGraph, nil
+	for _, node := range predictableGraph.Nodes {
+		nodeSchedule := schedule.Nodes[node.Node.ID]
+		node.Schedule = &grph_pb.NodeSchedule{
+			EarlyStart:  nodeSchedule.EarlyStart,
+			EarlyFinish: nodeSchedule.EarlyFinish,
+			LateStart:   nodeSchedule.LateStart,
+			LateFinish:  nodeSchedule.LateFinish,
+			TotalFloat:  nodeSchedule.TotalFloat,
+			FreeFloat:   nodeSchedule.FreeFloat,
+			Start:       timestamppb.New(nodeSchedule.Start),
+			Finish:      timestamppb.New(nodeSchedule.Finish),
+		}
+		node.AdditionalDependencies = schedule.ResourceDependencies[node.Node.ID]
+	}
+	predictableGraph.Duration = schedule.Duration
+	predictableGraph.StartAt = timestamppb.New(opts.StartAt)
+	predictableGraph.FinishAt = timestamppb.New(schedule.FinishAt)
+
+	return nil
+}
+
+func (s *Service) SimulateGraph(ctx context.Context, req *grph_pb.SimulateGraphRequest) (*grph_pb.SimulatedGraphResponse, error) {
+	iterations := req.Iterations
+	if iterations == 0 {
+		iterations = defaultSimulationIterations
+	}
+	if iterations < 0 || iterations > maxSimulationIterations {
+		return nil, status.Errorf(codes.InvalidArgument, "iterations must be in range [1, %d]", maxSimulationIterations)
+	}
+
+	seed := uint64(req.Seed)
+	if seed == 0 {
+		seed = uint64(time.Now().UnixNano())
+	}
+
+	predictableGraph, err := s.loadPredictedGraph(ctx, req.GraphID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := s.scheduleOptions(ctx, predictableGraph.Graph.GroupID, req.Priority, req.StartAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// моделируется оставшаяся работа графа от момента opts.StartAt
+	distributions := remainingDistributions(predictableGraph)
+
+	rnd := rand.New(rand.NewPCG(seed, seed))
+	result, err := graphtools.SimulateCriticalPath(
+		graph_wrapper.WrapPredictedGraph(predictableGraph),
+		distributions,
+		opts,
+		int(iterations),
+		rnd,
+	)
+	if err != nil {
+		if errors.Is(err, graphtools.ErrCycleInGraph) {
+			return nil, cycleStatus(err)
+		}
+		if errors.Is(err, graphtools.ErrUnknownPriority) {
+			return nil, status.Error(codes.InvalidArgument, "unknown priority")
+		}
+
+		s.log.Error("error while simulating graph", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	nodes := make([]*grph_pb.NodeCriticality, 0, len(predictableGraph.Nodes))
+	for _, node := range predictableGraph.Nodes {
+		dist := distributions[node.Node.ID]
+		nodes = append(nodes, &grph_pb.NodeCriticality{
+			NodeID:           node.Node.ID,
+			CriticalityIndex: result.Criticality[node.Node.ID],
+			MinTime:          dist.Min,
+			MostLikelyTime:   dist.Mode,
+			MaxTime:          dist.Max,
+		})
+	}
+
+	// вероятность срыва срока оценивается по тем же прогонам, что и процентили
+	if deadline := predictableGraph.Graph.GetDeadline(); deadline != nil {
+		if err := s.checkDeadline(predictableGraph, remainingValues(predictableGraph), opts); err != nil {
+			return nil, err
+		}
+
+		predictableGraph.Deadline.MissProbability = result.ExceedProbability(deadline.AsTime().Sub(opts.StartAt).Hours())
+	}
+
+	return &grph_pb.SimulatedGraphResponse{
+		Graph:           predictableGraph.Graph,
+		Iterations:      int64(result.Iterations),
+		P50:             result.Percentile(50),
+		P80:             result.Percentile(80),
+		P95:             result.Percentile(95),
+		Mean:            result.Mean,
+		Nodes:           nodes,
+		UnpredictedUIDs: predictableGraph.UnpredictedUIDs,
+		Deadline:        predictableGraph.Deadline,
+	}, nil
 }
 
 func (s *Service) TaskInNode(ctx context.Context, req *grph_pb.TaskInNodeRequest) (*grph_pb.TaskInNodeResponse, error) {
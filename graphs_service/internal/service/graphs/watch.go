@@ -0,0 +1,141 @@
+package graphsservice
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/liriquew/control_system/graphs_service/pkg/logger/sl"
+	grph_pb "github.com/liriquew/control_system/services_protos/graphs_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// изменения графа, пришедшие за это время после первого из них,
+// объединяются в один пересчет расписания для наблюдателей
+const scheduleDebounce = 500 * time.Millisecond
+
+// scheduleEvents - строит расписание графа и возвращает событие с его критическими путями
+// и событие срока графа: DeadlineAtRisk, если граф не выполняется к сроку или вероятность
+// срыва срока не меньше deadlineRiskProbability, иначе DeadlineRecovered
+func (s *Service) scheduleEvents(ctx context.Context, graphID int64) (*grph_pb.GraphEvent, *grph_pb.GraphEvent, error) {
+	predictedGraph, err := s.loadPredictedGraph(ctx, graphID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts, err := s.scheduleOptions(ctx, predictedGraph.Graph.GroupID, grph_pb.Priority_MinTime, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// оценка выполняется вместе с построением путей воркером графа, а не во время запросов,
+	// зерно зависит только от графа, чтобы оценка не менялась без изменений графа
+	var missProbability float64
+	if predictedGraph.Graph.GetDeadline() != nil {
+		seed := uint64(graphID)
+		iterations := deadlineRiskIterationsFor(len(predictedGraph.Nodes))
+		missProbability, err = s.missProbability(predictedGraph, opts, iterations, rand.New(rand.NewPCG(seed, seed)))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := s.schedulePrediction(predictedGraph, opts); err != nil {
+		return nil, nil, err
+	}
+
+	at := timestamppb.Now()
+	pathEvent := &grph_pb.GraphEvent{
+		Type:     grph_pb.GraphEventType_CriticalPathChanged,
+		GraphID:  graphID,
+		At:       at,
+		Paths:    predictedGraph.Paths,
+		Duration: predictedGraph.Duration,
+	}
+
+	// у графа без срока срок не может быть под угрозой
+	deadlineEvent := &grph_pb.GraphEvent{
+		Type:    grph_pb.GraphEventType_DeadlineRecovered,
+		GraphID: graphID,
+		At:      at,
+	}
+	if deadline := predictedGraph.Deadline; deadline != nil {
+		deadline.MissProbability = missProbability
+		deadlineEvent.Deadline = deadline
+		if !deadline.Met || missProbability >= deadlineRiskProbability {
+			deadlineEvent.Type = grph_pb.GraphEventType_DeadlineAtRisk
+		}
+	}
+
+	return pathEvent, deadlineEvent, nil
+}
+
+// publishSchedule - пересчитывает расписание графа и отправляет наблюдателям события
+// изменения критических путей и срока, если они изменились, вызывается воркером графа
+// в брокере, поэтому не выполняется во время запросов, изменяющих граф
+func (s *Service) publishSchedule(graphID int64) {
+	if !s.broker.HasWatchers(graphID) {
+		return
+	}
+
+	pathEvent, deadlineEvent, err := s.scheduleEvents(context.Background(), graphID)
+	if err != nil {
+		s.log.Warn("error while recalculating critical path", slog.Int64("graphID", graphID), sl.Err(err))
+		return
+	}
+	s.broker.PublishPaths(pathEvent)
+	s.broker.PublishDeadline(deadlineEvent)
+}
+
+// publishChange - отправляет события изменения одного графа наблюдателям,
+// события с новыми путями и сроком отправляются после пересчета расписания воркером графа
+func (s *Service) publishChange(ctx context.Context, events ...*grph_pb.GraphEvent) {
+	if len(events) == 0 || !s.broker.HasWatchers(events[0].GraphID) {
+		return
+	}
+
+	userID := actorID(ctx)
+	at := timestamppb.Now()
+	for _, event := range events {
+		event.UserID = userID
+		event.At = at
+		s.broker.Publish(event)
+	}
+}
+
+func (s *Service) WatchGraph(req *grph_pb.WatchGraphRequest, stream grph_pb.Graphs_WatchGraphServer) error {
+	ctx := stream.Context()
+
+	// подписка оформляется до построения расписания, чтобы не пропустить изменения
+	sub := s.broker.Subscribe(req.GraphID)
+	defer s.broker.Unsubscribe(sub)
+
+	pathEvent, deadlineEvent, err := s.scheduleEvents(ctx, req.GraphID)
+	if err != nil {
+		return err
+	}
+	s.broker.SendPaths(sub, pathEvent)
+	s.broker.SendDeadline(sub, deadlineEvent)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events:
+			if !ok {
+				if sub.Overflowed() {
+					return status.Error(codes.ResourceExhausted, "watcher is too slow")
+				}
+				return nil
+			}
+
+			if err := stream.Send(event); err != nil {
+				s.log.Debug("error while sending graph event", sl.Err(err))
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS graph_baselines (
+    id BIGINT NOT NULL PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    graph_id BIGINT NOT NULL,
+    name VARCHAR(128) NOT NULL,
+    created_by BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    duration DOUBLE PRECISION NOT NULL,
+    start_at TIMESTAMPTZ NOT NULL,
+    finish_at TIMESTAMPTZ NOT NULL,
+    snapshot JSONB NOT NULL,
+
+    CONSTRAINT fk_graph_baseline FOREIGN KEY (graph_id) REFERENCES graphs (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_graph_baselines_graph ON graph_baselines(graph_id);
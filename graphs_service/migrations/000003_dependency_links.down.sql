@@ -0,0 +1,4 @@
+ALTER TABLE dependencies
+    DROP CONSTRAINT IF EXISTS dependency_link_type,
+    DROP COLUMN IF EXISTS lag,
+    DROP COLUMN IF EXISTS link_type;
@@ -0,0 +1,4 @@
+ALTER TABLE dependencies
+    ADD COLUMN link_type SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN lag DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD CONSTRAINT dependency_link_type CHECK (link_type BETWEEN 0 AND 3);
@@ -0,0 +1,2 @@
+ALTER TABLE graphs
+    DROP COLUMN IF EXISTS version;
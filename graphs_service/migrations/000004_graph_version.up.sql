@@ -0,0 +1,2 @@
+ALTER TABLE graphs
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
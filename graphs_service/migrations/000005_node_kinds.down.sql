@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_nodes_subgraph;
+
+DELETE FROM nodes WHERE kind <> 0;
+
+ALTER TABLE nodes
+    DROP CONSTRAINT IF EXISTS node_kind,
+    DROP CONSTRAINT IF EXISTS fk_subgraph_node,
+    DROP COLUMN IF EXISTS name,
+    DROP COLUMN IF EXISTS subgraph_id,
+    DROP COLUMN IF EXISTS kind,
+    ALTER COLUMN task_id SET NOT NULL;
@@ -0,0 +1,13 @@
+ALTER TABLE nodes
+    ALTER COLUMN task_id DROP NOT NULL,
+    ADD COLUMN kind SMALLINT NOT NULL DEFAULT 0,
+    ADD COLUMN subgraph_id BIGINT,
+    ADD COLUMN name VARCHAR(128) NOT NULL DEFAULT '',
+    ADD CONSTRAINT fk_subgraph_node FOREIGN KEY (subgraph_id) REFERENCES graphs (id),
+    ADD CONSTRAINT node_kind CHECK (
+        (kind = 0 AND task_id IS NOT NULL AND subgraph_id IS NULL) OR
+        (kind = 1 AND task_id IS NULL AND subgraph_id IS NULL) OR
+        (kind = 2 AND task_id IS NULL AND subgraph_id IS NOT NULL)
+    );
+
+CREATE INDEX idx_nodes_subgraph ON nodes(subgraph_id) WHERE subgraph_id IS NOT NULL;
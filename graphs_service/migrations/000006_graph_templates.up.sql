@@ -0,0 +1,2 @@
+ALTER TABLE graphs
+    ADD COLUMN is_template BOOLEAN NOT NULL DEFAULT FALSE;
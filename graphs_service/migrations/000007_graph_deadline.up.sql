@@ -0,0 +1,2 @@
+ALTER TABLE graphs
+    ADD COLUMN deadline TIMESTAMPTZ;
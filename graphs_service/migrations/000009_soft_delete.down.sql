@@ -0,0 +1,11 @@
+-- restore events can't be removed from the append-only audit log
+ALTER TABLE audit_events DROP CONSTRAINT IF EXISTS audit_event_action;
+ALTER TABLE audit_events ADD CONSTRAINT audit_event_action CHECK (action IN ('create', 'update', 'delete')) NOT VALID;
+
+DELETE FROM nodes WHERE deleted_at IS NOT NULL;
+DROP INDEX IF EXISTS idx_nodes_deleted_at;
+DROP INDEX IF EXISTS uq_task_id;
+ALTER TABLE nodes ADD CONSTRAINT uq_task_id UNIQUE (task_id);
+
+ALTER TABLE dependencies DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE nodes DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,12 @@
+ALTER TABLE nodes ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+-- dependency is deleted while any of its nodes is deleted
+ALTER TABLE dependencies ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+-- task of a deleted node can be added to a new node
+ALTER TABLE nodes DROP CONSTRAINT IF EXISTS uq_task_id;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_task_id ON nodes (task_id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_nodes_deleted_at ON nodes (deleted_at) WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE audit_events DROP CONSTRAINT IF EXISTS audit_event_action;
+ALTER TABLE audit_events ADD CONSTRAINT audit_event_action CHECK (action IN ('create', 'update', 'delete', 'restore'));
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"github.com/liriquew/control_system/groups_service/internal/lib/config"
+	"github.com/liriquew/control_system/groups_service/internal/purge"
 	groups_service "github.com/liriquew/control_system/groups_service/internal/service/groups"
 	"github.com/liriquew/control_system/groups_service/pkg/logger/sl"
 
@@ -13,9 +14,10 @@ import (
 )
 
 type App struct {
-	GRPCServer *grpcapp.App
-	closers    []func() error
-	log        *slog.Logger
+	GRPCServer  *grpcapp.App
+	PurgeWorker *purge.Worker
+	closers     []func() error
+	log         *slog.Logger
 }
 
 func New(log *slog.Logger, cfg config.AppConfig) *App {
@@ -28,8 +30,10 @@ func New(log *slog.Logger, cfg config.AppConfig) *App {
 
 	app := grpcapp.New(log, service, cfg.ServiceConfig.Port)
 
-	mainApp := &App{GRPCServer: app, log: log}
-	mainApp.closers = append(mainApp.closers, storage.Close)
+	purgeWorker := purge.New(log, storage, cfg.Purge)
+
+	mainApp := &App{GRPCServer: app, PurgeWorker: purgeWorker, log: log}
+	mainApp.closers = append(mainApp.closers, purgeWorker.Close, storage.Close)
 	return mainApp
 }
 
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"time"
 
@@ -10,6 +11,26 @@ import (
 type AppConfig struct {
 	ServiceConfig ServiceConfig `yaml:"service_config" env-required:"true"`
 	Storage       StorageConfig `yaml:"postgres" env-required:"true"`
+	Purge         PurgeConfig   `yaml:"purge"`
+}
+
+// PurgeConfig sets how long deleted groups are kept
+// and how often they are purged
+type PurgeConfig struct {
+	Retention time.Duration `yaml:"retention" env-default:"720h"`
+	Interval  time.Duration `yaml:"interval" env-default:"1h"`
+}
+
+// Validate checks that purge period and retention period are positive
+func (c PurgeConfig) Validate() error {
+	if c.Interval <= 0 {
+		return fmt.Errorf("purge interval must be positive, got %s", c.Interval)
+	}
+	if c.Retention <= 0 {
+		return fmt.Errorf("purge retention must be positive, got %s", c.Retention)
+	}
+
+	return nil
 }
 
 type ServiceConfig struct {
@@ -56,5 +77,9 @@ func MustLoadPath(path string) AppConfig {
 		panic("error while reading config" + err.Error())
 	}
 
+	if err := cfg.Purge.Validate(); err != nil {
+		panic("invalid config: " + err.Error())
+	}
+
 	return cfg
 }
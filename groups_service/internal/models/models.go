@@ -3,6 +3,7 @@ package models
 import (
 	"time"
 
+	"github.com/lib/pq"
 	grpc_pb "github.com/liriquew/control_system/services_protos/groups_service"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -38,3 +39,44 @@ func ConvertMemberToProto(gm *GroupMember) *grpc_pb.GroupMember {
 		Role:    gm.Role,
 	}
 }
+
+type UserCalendar struct {
+	UserID           int64         `json:"user_id" db:"user_id"`
+	WorkStartMinute  int32         `json:"work_start_minute" db:"work_start_minute"`
+	WorkEndMinute    int32         `json:"work_end_minute" db:"work_end_minute"`
+	WorkDays         pq.Int32Array `json:"work_days" db:"work_days"`
+	UTCOffsetMinutes int32         `json:"utc_offset_minutes" db:"utc_offset_minutes"`
+	DaysOff          []time.Time   `json:"days_off" db:"-"`
+}
+
+func ConvertCalendarToProto(c *UserCalendar) *grpc_pb.UserCalendar {
+	daysOff := make([]*timestamppb.Timestamp, 0, len(c.DaysOff))
+	for _, day := range c.DaysOff {
+		daysOff = append(daysOff, timestamppb.New(day))
+	}
+
+	return &grpc_pb.UserCalendar{
+		UserID:           c.UserID,
+		WorkStartMinute:  c.WorkStartMinute,
+		WorkEndMinute:    c.WorkEndMinute,
+		WorkDays:         c.WorkDays,
+		UTCOffsetMinutes: c.UTCOffsetMinutes,
+		DaysOff:          daysOff,
+	}
+}
+
+func ConvertCalendarFromProto(c *grpc_pb.UserCalendar) *UserCalendar {
+	daysOff := make([]time.Time, 0, len(c.DaysOff))
+	for _, day := range c.DaysOff {
+		daysOff = append(daysOff, day.AsTime())
+	}
+
+	return &UserCalendar{
+		UserID:           c.UserID,
+		WorkStartMinute:  c.WorkStartMinute,
+		WorkEndMinute:    c.WorkEndMinute,
+		WorkDays:         c.WorkDays,
+		UTCOffsetMinutes: c.UTCOffsetMinutes,
+		DaysOff:          daysOff,
+	}
+}
@@ -0,0 +1,163 @@
+package groups_repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/liriquew/control_system/groups_service/internal/models"
+	grpc_pb "github.com/liriquew/control_system/services_protos/groups_service"
+)
+
+const (
+	auditEntityGroup  = "group"
+	auditEntityMember = "member"
+
+	auditActionRestore = "restore"
+
+	listAuditEventsBatchSize = 50
+)
+
+// auditRecord is a change of a single entity, Before is nil
+// for created entities and After is nil for deleted ones.
+// Action overrides the action inferred from Before and After
+type auditRecord struct {
+	GroupID  int64
+	ActorID  int64
+	Entity   string
+	EntityID int64
+	Action   string
+	Before   map[string]any
+	After    map[string]any
+}
+
+func groupSnapshot(group *models.Group) map[string]any {
+	return map[string]any{
+		"owner_id":    group.OwnerID,
+		"name":        group.Name,
+		"description": group.Description,
+	}
+}
+
+func memberSnapshot(member *models.GroupMember) map[string]any {
+	return map[string]any{
+		"user_id": member.UserID,
+		"role":    member.Role,
+	}
+}
+
+func auditJSON(fields map[string]any) (any, error) {
+	if fields == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+// recordAudit appends the change to the audit log, update keeps
+// only changed fields and is not recorded if nothing has changed
+func recordAudit(ctx context.Context, txn *sql.Tx, rec auditRecord) error {
+	action := "update"
+	switch {
+	case rec.Action != "":
+		action = rec.Action
+	case rec.Before == nil:
+		action = "create"
+	case rec.After == nil:
+		action = "delete"
+	default:
+		before := make(map[string]any)
+		after := make(map[string]any)
+		for field, value := range rec.After {
+			if !reflect.DeepEqual(rec.Before[field], value) {
+				before[field] = rec.Before[field]
+				after[field] = value
+			}
+		}
+		if len(after) == 0 {
+			return nil
+		}
+		rec.Before, rec.After = before, after
+	}
+
+	before, err := auditJSON(rec.Before)
+	if err != nil {
+		return err
+	}
+	after, err := auditJSON(rec.After)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO audit_events (group_id, actor_id, entity, entity_id, action, before, after)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err = txn.ExecContext(ctx, query, rec.GroupID, rec.ActorID, rec.Entity, rec.EntityID, action, before, after)
+	return err
+}
+
+// ListAuditEvents returns group audit events from newest to oldest
+func (r *Repository) ListAuditEvents(ctx context.Context, req *grpc_pb.ListAuditEventsRequest) ([]*models.AuditEvent, error) {
+	query := `
+		SELECT
+			id, group_id, actor_id, entity, entity_id, action,
+			COALESCE(before::text, '') AS before, COALESCE(after::text, '') AS after, created_at
+		FROM audit_events
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d
+	`
+
+	conditions := []string{"group_id=$1"}
+	args := []any{req.GroupID}
+
+	if req.Entity != "" {
+		args = append(args, req.Entity)
+		conditions = append(conditions, fmt.Sprintf("entity=$%d", len(args)))
+	}
+	if req.EntityID != 0 {
+		args = append(args, req.EntityID)
+		conditions = append(conditions, fmt.Sprintf("entity_id=$%d", len(args)))
+	}
+	if req.ActorID != 0 {
+		args = append(args, req.ActorID)
+		conditions = append(conditions, fmt.Sprintf("actor_id=$%d", len(args)))
+	}
+	if req.From != nil {
+		args = append(args, req.From.AsTime())
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if req.To != nil {
+		args = append(args, req.To.AsTime())
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+	if req.BeforeTime != nil {
+		args = append(args, req.BeforeTime.AsTime(), req.BeforeID)
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = listAuditEventsBatchSize
+	}
+	args = append(args, limit)
+
+	query = fmt.Sprintf(query, strings.Join(conditions, " AND "), len(args))
+
+	var events []*models.AuditEvent
+	if err := r.db.SelectContext(ctx, &events, query, args...); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/liriquew/control_system/groups_service/internal/lib/config"
 	"github.com/liriquew/control_system/groups_service/internal/models"
@@ -58,12 +59,14 @@ var (
 	ErrInvalideRole    = errors.New("invalide role param")
 	ErrWrongOwnerID    = errors.New("user with owner id not found")
 	ErrAlreadyInGroup  = errors.New("already in group")
+	ErrInvalidCalendar = errors.New("invalid calendar")
 )
 
 func (r *Repository) CheckAccess(ctx context.Context, userID, groupID int64) error {
 	query := `
 		SELECT 1 FROM group_members gm
-		WHERE gm.user_id = $1 AND gm.group_id = $2
+		JOIN groups g ON g.id = gm.group_id
+		WHERE gm.user_id = $1 AND gm.group_id = $2 AND g.deleted_at IS NULL
 	`
 
 	var val int
@@ -80,7 +83,8 @@ func (r *Repository) CheckAccess(ctx context.Context, userID, groupID int64) err
 func (r *Repository) checkPermission(ctx context.Context, userID, groupID int64, roles []string) error {
 	query := `
 		SELECT 1 FROM group_members gm
-		WHERE gm.user_id = $1 AND gm.group_id = $2 AND gm.role = ANY($3)
+		JOIN groups g ON g.id = gm.group_id
+		WHERE gm.user_id = $1 AND gm.group_id = $2 AND gm.role = ANY($3) AND g.deleted_at IS NULL
 	`
 
 	var val int64
@@ -136,6 +140,21 @@ func (r *Repository) CreateGroup(ctx context.Context, group *grpc_pb.Group) (int
 		return 0, err
 	}
 
+	err = recordAudit(ctx, txn, auditRecord{
+		GroupID:  group.ID,
+		ActorID:  group.OwnerID,
+		Entity:   auditEntityGroup,
+		EntityID: group.ID,
+		After: groupSnapshot(&models.Group{
+			OwnerID:     group.OwnerID,
+			Name:        group.Name,
+			Description: group.Description,
+		}),
+	})
+	if err != nil {
+		return 0, err
+	}
+
 	if err := txn.Commit(); err != nil {
 		return 0, err
 	}
@@ -147,7 +166,7 @@ func (r *Repository) GetGroup(ctx context.Context, userID, groupID int64) (*mode
 	query := `
 		SELECT g.id, g.owner_id, g.name, g.description, g.created_at FROM groups g
 		JOIN group_members m ON g.id = m.group_id
-		WHERE g.id = $1 AND m.user_id = $2
+		WHERE g.id = $1 AND m.user_id = $2 AND g.deleted_at IS NULL
 	`
 
 	var group models.Group
@@ -164,8 +183,9 @@ func (r *Repository) GetGroup(ctx context.Context, userID, groupID int64) (*mode
 
 func (r *Repository) ListUserGroups(ctx context.Context, userID int64, offset int64) ([]*models.Group, error) {
 	query := `
-		SELECT * FROM groups
-		WHERE id IN (SELECT group_id FROM group_members WHERE user_id=$1) ORDER BY created_at OFFSET $2 LIMIT 10
+		SELECT id, owner_id, name, description, created_at FROM groups
+		WHERE id IN (SELECT group_id FROM group_members WHERE user_id=$1) AND deleted_at IS NULL
+		ORDER BY created_at OFFSET $2 LIMIT 10
 	`
 
 	var groups []*models.Group
@@ -180,20 +200,117 @@ func (r *Repository) ListUserGroups(ctx context.Context, userID int64, offset in
 	return groups, err
 }
 
+// lockGroup selects not deleted group for update
+func lockGroup(ctx context.Context, txn *sql.Tx, groupID int64) (*models.Group, error) {
+	query := "SELECT id, owner_id, name, description FROM groups WHERE id=$1 AND deleted_at IS NULL FOR UPDATE"
+
+	var group models.Group
+	err := txn.QueryRowContext(ctx, query, groupID).Scan(&group.ID, &group.OwnerID, &group.Name, &group.Description)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &group, nil
+}
+
 func (r *Repository) DeleteGroup(ctx context.Context, ownerID, groupID int64) error {
-	query := `DELETE FROM groups WHERE id=$2 AND owner_id=$1`
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
 
-	result, err := r.db.ExecContext(ctx, query, ownerID, groupID)
+	group, err := lockGroup(ctx, txn, groupID)
 	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if group.OwnerID != ownerID {
+		return nil
+	}
+
+	// group and its members are kept until the group is purged
+	query := `UPDATE groups SET deleted_at=NOW() WHERE id=$2 AND owner_id=$1`
+	if _, err := txn.ExecContext(ctx, query, ownerID, groupID); err != nil {
 		return err
 	}
 
-	_, err = result.RowsAffected()
+	err = recordAudit(ctx, txn, auditRecord{
+		GroupID:  groupID,
+		ActorID:  ownerID,
+		Entity:   auditEntityGroup,
+		EntityID: groupID,
+		Before:   groupSnapshot(group),
+	})
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return txn.Commit()
+}
+
+// RestoreGroup restores deleted group with its members, only owner can restore the group
+func (r *Repository) RestoreGroup(ctx context.Context, ownerID, groupID int64) error {
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	query := `
+		SELECT id, owner_id, name, description FROM groups
+		WHERE id=$1 AND deleted_at IS NOT NULL
+		FOR UPDATE
+	`
+
+	var group models.Group
+	err = txn.QueryRowContext(ctx, query, groupID).Scan(&group.ID, &group.OwnerID, &group.Name, &group.Description)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+	if group.OwnerID != ownerID {
+		return ErrDenied
+	}
+
+	query = `UPDATE groups SET deleted_at=NULL WHERE id=$1`
+	if _, err := txn.ExecContext(ctx, query, groupID); err != nil {
+		return err
+	}
+
+	err = recordAudit(ctx, txn, auditRecord{
+		GroupID:  groupID,
+		ActorID:  ownerID,
+		Entity:   auditEntityGroup,
+		EntityID: groupID,
+		Action:   auditActionRestore,
+		After:    groupSnapshot(&group),
+	})
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit()
+}
+
+// PurgeGroups permanently deletes groups deleted earlier than retention ago
+// together with their members
+func (r *Repository) PurgeGroups(ctx context.Context, retention time.Duration) (int64, error) {
+	query := `DELETE FROM groups WHERE deleted_at < NOW() - make_interval(secs => $1)`
+
+	res, err := r.db.ExecContext(ctx, query, retention.Seconds())
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
 }
 
 func (r *Repository) UpdateGroup(ctx context.Context, group *grpc_pb.Group) error {
@@ -215,22 +332,38 @@ func (r *Repository) UpdateGroup(ctx context.Context, group *grpc_pb.Group) erro
 		argPos++
 	}
 
-	query := fmt.Sprintf("UPDATE groups SET %s WHERE id=$1", strings.Join(fields, ", "))
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	prev, err := lockGroup(ctx, txn, group.ID)
 	if err != nil {
 		return err
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	query := fmt.Sprintf("UPDATE groups SET %s WHERE id=$1 RETURNING owner_id, name, description", strings.Join(fields, ", "))
+
+	var updated models.Group
+	err = txn.QueryRowContext(ctx, query, args...).Scan(&updated.OwnerID, &updated.Name, &updated.Description)
 	if err != nil {
 		return err
 	}
-	if rowsAffected == 0 {
-		return ErrNotFound
+
+	err = recordAudit(ctx, txn, auditRecord{
+		GroupID:  group.ID,
+		ActorID:  group.OwnerID,
+		Entity:   auditEntityGroup,
+		EntityID: group.ID,
+		Before:   groupSnapshot(prev),
+		After:    groupSnapshot(&updated),
+	})
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return txn.Commit()
 }
 
 func (r *Repository) ListGroupMembers(ctx context.Context, groupID int64) ([]*models.GroupMember, error) {
@@ -248,7 +381,7 @@ func (r *Repository) ListGroupMembers(ctx context.Context, groupID int64) ([]*mo
 	return users, nil
 }
 
-func (r *Repository) AddGroupMember(ctx context.Context, member *grpc_pb.GroupMember) error {
+func (r *Repository) AddGroupMember(ctx context.Context, actorID int64, member *grpc_pb.GroupMember) error {
 	if member.Role == "" {
 		member.Role = "member"
 	}
@@ -263,12 +396,18 @@ func (r *Repository) AddGroupMember(ctx context.Context, member *grpc_pb.GroupMe
 		return ErrInvalideRole
 	}
 
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
 	query := `
         INSERT INTO group_members (group_id, user_id, role)
         VALUES ($1, $2, $3)
     `
 
-	_, err := r.db.ExecContext(ctx, query, member.GroupID, member.UserID, member.Role)
+	_, err = txn.ExecContext(ctx, query, member.GroupID, member.UserID, member.Role)
 
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
@@ -294,10 +433,54 @@ func (r *Repository) AddGroupMember(ctx context.Context, member *grpc_pb.GroupMe
 		return err
 	}
 
-	return nil
+	err = recordAudit(ctx, txn, auditRecord{
+		GroupID:  member.GroupID,
+		ActorID:  actorID,
+		Entity:   auditEntityMember,
+		EntityID: member.UserID,
+		After: memberSnapshot(&models.GroupMember{
+			UserID: member.UserID,
+			Role:   member.Role,
+		}),
+	})
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit()
+}
+
+// lockGroupMember selects the group member for update
+func lockGroupMember(ctx context.Context, txn *sql.Tx, groupID, userID int64) (*models.GroupMember, error) {
+	query := "SELECT group_id, user_id, role FROM group_members WHERE group_id=$1 AND user_id=$2 FOR UPDATE"
+
+	var member models.GroupMember
+	err := txn.QueryRowContext(ctx, query, groupID, userID).Scan(&member.GroupID, &member.UserID, &member.Role)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &member, nil
 }
 
-func (r *Repository) RemoveGroupMember(ctx context.Context, member *grpc_pb.GroupMember) error {
+func (r *Repository) RemoveGroupMember(ctx context.Context, actorID int64, member *grpc_pb.GroupMember) error {
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	prev, err := lockGroupMember(ctx, txn, member.GroupID, member.UserID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
 	query := `
 		DELETE FROM group_members
 		WHERE
@@ -305,17 +488,22 @@ func (r *Repository) RemoveGroupMember(ctx context.Context, member *grpc_pb.Grou
 			user_id=$2
 	`
 
-	result, err := r.db.ExecContext(ctx, query, member.GroupID, member.UserID)
-	if err != nil {
+	if _, err := txn.ExecContext(ctx, query, member.GroupID, member.UserID); err != nil {
 		return err
 	}
 
-	_, err = result.RowsAffected()
+	err = recordAudit(ctx, txn, auditRecord{
+		GroupID:  member.GroupID,
+		ActorID:  actorID,
+		Entity:   auditEntityMember,
+		EntityID: member.UserID,
+		Before:   memberSnapshot(prev),
+	})
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return txn.Commit()
 }
 
 func (r *Repository) ChangeMemberRole(ctx context.Context, ownerID int64, member *grpc_pb.GroupMember) error {
@@ -329,11 +517,22 @@ func (r *Repository) ChangeMemberRole(ctx context.Context, ownerID int64, member
 		return ErrInvalideRole
 	}
 
+	txn, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	prev, err := lockGroupMember(ctx, txn, member.GroupID, member.UserID)
+	if err != nil {
+		return err
+	}
+
 	query := `
         UPDATE group_members SET role=$1 WHERE group_id=$2 AND user_id=$3
     `
 
-	result, err := r.db.ExecContext(ctx, query, member.Role, member.GroupID, member.UserID)
+	_, err = txn.ExecContext(ctx, query, member.Role, member.GroupID, member.UserID)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code {
@@ -349,13 +548,139 @@ func (r *Repository) ChangeMemberRole(ctx context.Context, ownerID int64, member
 		return err
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	err = recordAudit(ctx, txn, auditRecord{
+		GroupID:  member.GroupID,
+		ActorID:  ownerID,
+		Entity:   auditEntityMember,
+		EntityID: member.UserID,
+		Before:   memberSnapshot(prev),
+		After: memberSnapshot(&models.GroupMember{
+			UserID: member.UserID,
+			Role:   member.Role,
+		}),
+	})
 	if err != nil {
 		return err
 	}
-	if rowsAffected == 0 {
-		return ErrNotFound
+
+	return txn.Commit()
+}
+
+func (r *Repository) SetUserCalendar(ctx context.Context, calendar *models.UserCalendar) error {
+	txn, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
 	}
+	defer txn.Rollback()
 
-	return nil
+	query := `
+		INSERT INTO user_calendars (user_id, work_start_minute, work_end_minute, work_days, utc_offset_minutes)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE SET
+			work_start_minute = EXCLUDED.work_start_minute,
+			work_end_minute = EXCLUDED.work_end_minute,
+			work_days = EXCLUDED.work_days,
+			utc_offset_minutes = EXCLUDED.utc_offset_minutes
+	`
+
+	_, err = txn.ExecContext(ctx, query,
+		calendar.UserID,
+		calendar.WorkStartMinute,
+		calendar.WorkEndMinute,
+		calendar.WorkDays,
+		calendar.UTCOffsetMinutes,
+	)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23514": // Код ошибки для CHECK violation
+				return fmt.Errorf("%w: %s", ErrInvalidCalendar, pqErr.Constraint)
+			default:
+				return fmt.Errorf("database error: %s", pqErr.Message)
+			}
+		}
+		return err
+	}
+
+	// выходные дни заменяются целиком
+	query = "DELETE FROM user_days_off WHERE user_id = $1"
+	if _, err := txn.ExecContext(ctx, query, calendar.UserID); err != nil {
+		return err
+	}
+
+	query = "INSERT INTO user_days_off (user_id, day) VALUES ($1, $2) ON CONFLICT DO NOTHING"
+	for _, day := range calendar.DaysOff {
+		if _, err := txn.ExecContext(ctx, query, calendar.UserID, day.Format("2006-01-02")); err != nil {
+			return err
+		}
+	}
+
+	return txn.Commit()
+}
+
+func (r *Repository) GetUserCalendar(ctx context.Context, userID int64) (*models.UserCalendar, error) {
+	query := `
+		SELECT user_id, work_start_minute, work_end_minute, work_days, utc_offset_minutes
+		FROM user_calendars
+		WHERE user_id = $1
+	`
+
+	calendars, err := r.selectCalendars(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(calendars) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return calendars[0], nil
+}
+
+func (r *Repository) GetGroupCalendars(ctx context.Context, groupID int64) ([]*models.UserCalendar, error) {
+	query := `
+		SELECT c.user_id, c.work_start_minute, c.work_end_minute, c.work_days, c.utc_offset_minutes
+		FROM user_calendars c
+		JOIN group_members gm ON gm.user_id = c.user_id
+		WHERE gm.group_id = $1
+	`
+
+	return r.selectCalendars(ctx, query, groupID)
+}
+
+func (r *Repository) selectCalendars(ctx context.Context, query string, args ...any) ([]*models.UserCalendar, error) {
+	var calendars []*models.UserCalendar
+	if err := r.db.SelectContext(ctx, &calendars, query, args...); err != nil {
+		return nil, err
+	}
+	if len(calendars) == 0 {
+		return calendars, nil
+	}
+
+	userIDs := make([]int64, 0, len(calendars))
+	calendarsMap := make(map[int64]*models.UserCalendar, len(calendars))
+	for _, c := range calendars {
+		userIDs = append(userIDs, c.UserID)
+		calendarsMap[c.UserID] = c
+	}
+
+	query = `
+		SELECT user_id, day FROM user_days_off
+		WHERE user_id = ANY($1)
+		ORDER BY day
+	`
+
+	var daysOff []struct {
+		UserID int64     `db:"user_id"`
+		Day    time.Time `db:"day"`
+	}
+	if err := r.db.SelectContext(ctx, &daysOff, query, pq.Array(userIDs)); err != nil {
+		return nil, err
+	}
+
+	for _, dayOff := range daysOff {
+		c := calendarsMap[dayOff.UserID]
+		c.DaysOff = append(c.DaysOff, dayOff.Day)
+	}
+
+	return calendars, nil
 }
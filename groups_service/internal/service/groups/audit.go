@@ -0,0 +1,50 @@
+package groups
+
+import (
+	"context"
+	"errors"
+
+	"github.com/liriquew/control_system/groups_service/internal/models"
+	repository "github.com/liriquew/control_system/groups_service/internal/repository"
+	"github.com/liriquew/control_system/groups_service/pkg/logger/sl"
+	grpc_pb "github.com/liriquew/control_system/services_protos/groups_service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxAuditEventsLimit = 100
+
+func (s *Service) ListAuditEvents(ctx context.Context, req *grpc_pb.ListAuditEventsRequest) (*grpc_pb.AuditEventList, error) {
+	userID, err := s.Authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+	if req.Limit < 0 || req.Limit > maxAuditEventsLimit {
+		return nil, status.Error(codes.InvalidArgument, "limit must be between 0 and 100")
+	}
+
+	if err := s.repository.CheckAdminPermission(ctx, userID, req.GroupID); err != nil {
+		if errors.Is(err, repository.ErrDenied) {
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+
+		s.log.Error("error while checking admin permission", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	events, err := s.repository.ListAuditEvents(ctx, req)
+	if err != nil {
+		s.log.Error("error while listing audit events", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	resp := make([]*grpc_pb.AuditEvent, 0, len(events))
+	for _, event := range events {
+		resp = append(resp, models.ConvertAuditEventToProto(event))
+	}
+
+	return &grpc_pb.AuditEventList{
+		Events: resp,
+	}, nil
+}
@@ -25,11 +25,18 @@ type Repository interface {
 	ListUserGroups(ctx context.Context, userID int64, offset int64) ([]*models.Group, error)
 	GetGroup(ctx context.Context, userID, groupID int64) (*models.Group, error)
 	DeleteGroup(ctx context.Context, ownerID, groupID int64) error
+	RestoreGroup(ctx context.Context, ownerID, groupID int64) error
 	UpdateGroup(ctx context.Context, group *grpc_pb.Group) error
 	ListGroupMembers(ctx context.Context, groupID int64) ([]*models.GroupMember, error)
-	AddGroupMember(ctx context.Context, member *grpc_pb.GroupMember) error
-	RemoveGroupMember(ctx context.Context, member *grpc_pb.GroupMember) error
+	AddGroupMember(ctx context.Context, actorID int64, member *grpc_pb.GroupMember) error
+	RemoveGroupMember(ctx context.Context, actorID int64, member *grpc_pb.GroupMember) error
 	ChangeMemberRole(ctx context.Context, ownerID int64, member *grpc_pb.GroupMember) error
+
+	SetUserCalendar(ctx context.Context, calendar *models.UserCalendar) error
+	GetUserCalendar(ctx context.Context, userID int64) (*models.UserCalendar, error)
+	GetGroupCalendars(ctx context.Context, groupID int64) ([]*models.UserCalendar, error)
+
+	ListAuditEvents(ctx context.Context, req *grpc_pb.ListAuditEventsRequest) ([]*models.AuditEvent, error)
 }
 
 type Service struct {
@@ -163,6 +170,28 @@ func (s *Service) DeleteGroup(ctx context.Context, groupID *grpc_pb.GroupID) (*e
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) RestoreGroup(ctx context.Context, groupID *grpc_pb.GroupID) (*emptypb.Empty, error) {
+	userID, err := s.Authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	if err := s.repository.RestoreGroup(ctx, userID, groupID.ID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "deleted group not found")
+		}
+		if errors.Is(err, repository.ErrDenied) {
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+
+		s.log.Error("error while restoring group", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *Service) UpdateGroup(ctx context.Context, group *grpc_pb.Group) (*emptypb.Empty, error) {
 	userID, err := s.Authenticate(ctx)
 	if err != nil {
@@ -238,7 +267,7 @@ func (s *Service) AddGroupMember(ctx context.Context, GroupMember *grpc_pb.Group
 		return nil, status.Error(codes.Internal, "internal")
 	}
 
-	if err := s.repository.AddGroupMember(ctx, GroupMember); err != nil {
+	if err := s.repository.AddGroupMember(ctx, userID, GroupMember); err != nil {
 		if errors.Is(err, repository.ErrNotExists) {
 			return nil, status.Error(codes.NotFound, "group not found")
 		}
@@ -272,7 +301,7 @@ func (s *Service) RemoveGroupMember(ctx context.Context, GroupMember *grpc_pb.Gr
 		return nil, status.Error(codes.Internal, "internal")
 	}
 
-	if err := s.repository.RemoveGroupMember(ctx, GroupMember); err != nil {
+	if err := s.repository.RemoveGroupMember(ctx, userID, GroupMember); err != nil {
 		s.log.Error("error while removing group member", sl.Err(err))
 		return nil, status.Error(codes.Internal, "internal")
 	}
@@ -348,3 +377,89 @@ func (s *Service) CheckMemberPermission(ctx context.Context, groupMember *grpc_p
 
 	return &emptypb.Empty{}, nil
 }
+
+const (
+	minutesInDay = 24 * 60
+
+	minUTCOffsetMinutes = -12 * 60
+	maxUTCOffsetMinutes = 14 * 60
+)
+
+func validateCalendar(calendar *grpc_pb.UserCalendar) error {
+	if calendar.WorkStartMinute < 0 || calendar.WorkStartMinute >= calendar.WorkEndMinute || calendar.WorkEndMinute >= minutesInDay {
+		return status.Error(codes.InvalidArgument, "work hours must satisfy 0 <= start < end < 1440")
+	}
+	if calendar.UTCOffsetMinutes < minUTCOffsetMinutes || calendar.UTCOffsetMinutes > maxUTCOffsetMinutes {
+		return status.Error(codes.InvalidArgument, "utc offset must be between -720 and 840 minutes")
+	}
+	for _, day := range calendar.WorkDays {
+		// дни недели нумеруются с воскресенья, как в time.Weekday
+		if day < 0 || day > 6 {
+			return status.Error(codes.InvalidArgument, "work days must be between 0 (sunday) and 6 (saturday)")
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) SetUserCalendar(ctx context.Context, calendar *grpc_pb.UserCalendar) (*emptypb.Empty, error) {
+	userID, err := s.Authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	if err := validateCalendar(calendar); err != nil {
+		return nil, err
+	}
+	// пользователь может изменить только свой календарь
+	calendar.UserID = userID
+
+	if err := s.repository.SetUserCalendar(ctx, models.ConvertCalendarFromProto(calendar)); err != nil {
+		if errors.Is(err, repository.ErrInvalidCalendar) {
+			return nil, status.Error(codes.InvalidArgument, "invalid calendar")
+		}
+
+		s.log.Error("error while setting user calendar", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetUserCalendar(ctx context.Context, _ *emptypb.Empty) (*grpc_pb.UserCalendar, error) {
+	userID, err := s.Authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	calendar, err := s.repository.GetUserCalendar(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "calendar not found")
+		}
+
+		s.log.Error("error while getting user calendar", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return models.ConvertCalendarToProto(calendar), nil
+}
+
+func (s *Service) GetGroupCalendars(ctx context.Context, groupID *grpc_pb.GroupID) (*grpc_pb.UserCalendarsList, error) {
+	calendars, err := s.repository.GetGroupCalendars(ctx, groupID.ID)
+	if err != nil {
+		s.log.Error("error while getting group calendars", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	resp := make([]*grpc_pb.UserCalendar, 0, len(calendars))
+	for _, c := range calendars {
+		resp = append(resp, models.ConvertCalendarToProto(c))
+	}
+
+	return &grpc_pb.UserCalendarsList{
+		Calendars: resp,
+	}, nil
+}
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_days_off;
+DROP TABLE IF EXISTS user_calendars;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS user_calendars (
+    user_id BIGINT NOT NULL PRIMARY KEY,
+    work_start_minute INT NOT NULL DEFAULT 540,
+    work_end_minute INT NOT NULL DEFAULT 1080,
+    work_days INT[] NOT NULL DEFAULT '{1,2,3,4,5}',
+    utc_offset_minutes INT NOT NULL DEFAULT 0,
+
+    CONSTRAINT calendar_work_hours CHECK (0 <= work_start_minute AND work_start_minute < work_end_minute AND work_end_minute < 1440),
+    CONSTRAINT calendar_utc_offset CHECK (utc_offset_minutes BETWEEN -720 AND 840)
+);
+
+CREATE TABLE IF NOT EXISTS user_days_off (
+    user_id BIGINT NOT NULL,
+    day DATE NOT NULL,
+
+    PRIMARY KEY (user_id, day),
+
+    CONSTRAINT fk_day_off_calendar FOREIGN KEY (user_id) REFERENCES user_calendars(user_id) ON DELETE CASCADE
+);
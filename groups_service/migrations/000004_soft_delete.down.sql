@@ -0,0 +1,7 @@
+-- restore events can't be removed from the append-only audit log
+ALTER TABLE audit_events DROP CONSTRAINT IF EXISTS audit_event_action;
+ALTER TABLE audit_events ADD CONSTRAINT audit_event_action CHECK (action IN ('create', 'update', 'delete')) NOT VALID;
+
+DELETE FROM groups WHERE deleted_at IS NOT NULL;
+DROP INDEX IF EXISTS idx_groups_deleted_at;
+ALTER TABLE groups DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,6 @@
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_groups_deleted_at ON groups (deleted_at) WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE audit_events DROP CONSTRAINT IF EXISTS audit_event_action;
+ALTER TABLE audit_events ADD CONSTRAINT audit_event_action CHECK (action IN ('create', 'update', 'delete', 'restore'));
@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -22,11 +23,115 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type NodeKind int32
+
+const (
+	NodeKind_TaskNode  NodeKind = 0
+	NodeKind_Milestone NodeKind = 1
+	NodeKind_SubGraph  NodeKind = 2
+)
+
+// Enum value maps for NodeKind.
+var (
+	NodeKind_name = map[int32]string{
+		0: "TaskNode",
+		1: "Milestone",
+		2: "SubGraph",
+	}
+	NodeKind_value = map[string]int32{
+		"TaskNode":  0,
+		"Milestone": 1,
+		"SubGraph":  2,
+	}
+)
+
+func (x NodeKind) Enum() *NodeKind {
+	p := new(NodeKind)
+	*p = x
+	return p
+}
+
+func (x NodeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NodeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_graphs_service_graphs_service_proto_enumTypes[0].Descriptor()
+}
+
+func (NodeKind) Type() protoreflect.EnumType {
+	return &file_graphs_service_graphs_service_proto_enumTypes[0]
+}
+
+func (x NodeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NodeKind.Descriptor instead.
+func (NodeKind) EnumDescriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{0}
+}
+
+type LinkType int32
+
+const (
+	LinkType_FinishToStart  LinkType = 0
+	LinkType_StartToStart   LinkType = 1
+	LinkType_FinishToFinish LinkType = 2
+	LinkType_StartToFinish  LinkType = 3
+)
+
+// Enum value maps for LinkType.
+var (
+	LinkType_name = map[int32]string{
+		0: "FinishToStart",
+		1: "StartToStart",
+		2: "FinishToFinish",
+		3: "StartToFinish",
+	}
+	LinkType_value = map[string]int32{
+		"FinishToStart":  0,
+		"StartToStart":   1,
+		"FinishToFinish": 2,
+		"StartToFinish":  3,
+	}
+)
+
+func (x LinkType) Enum() *LinkType {
+	p := new(LinkType)
+	*p = x
+	return p
+}
+
+func (x LinkType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LinkType) Descriptor() protoreflect.EnumDescriptor {
+	return file_graphs_service_graphs_service_proto_enumTypes[1].Descriptor()
+}
+
+func (LinkType) Type() protoreflect.EnumType {
+	return &file_graphs_service_graphs_service_proto_enumTypes[1]
+}
+
+func (x LinkType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LinkType.Descriptor instead.
+func (LinkType) EnumDescriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{1}
+}
+
 type Priority int32
 
 const (
-	Priority_MinTime Priority = 0
-	Priority_MaxTime Priority = 1
+	Priority_MinTime              Priority = 0
+	Priority_MaxTime              Priority = 1
+	Priority_LatestStart          Priority = 2
+	Priority_MostSuccessors       Priority = 3
+	Priority_RankPositionalWeight Priority = 4
 )
 
 // Enum value maps for Priority.
@@ -34,10 +139,16 @@ var (
 	Priority_name = map[int32]string{
 		0: "MinTime",
 		1: "MaxTime",
+		2: "LatestStart",
+		3: "MostSuccessors",
+		4: "RankPositionalWeight",
 	}
 	Priority_value = map[string]int32{
-		"MinTime": 0,
-		"MaxTime": 1,
+		"MinTime":              0,
+		"MaxTime":              1,
+		"LatestStart":          2,
+		"MostSuccessors":       3,
+		"RankPositionalWeight": 4,
 	}
 )
 
@@ -52,11 +163,11 @@ func (x Priority) String() string {
 }
 
 func (Priority) Descriptor() protoreflect.EnumDescriptor {
-	return file_graphs_service_graphs_service_proto_enumTypes[0].Descriptor()
+	return file_graphs_service_graphs_service_proto_enumTypes[2].Descriptor()
 }
 
 func (Priority) Type() protoreflect.EnumType {
-	return &file_graphs_service_graphs_service_proto_enumTypes[0]
+	return &file_graphs_service_graphs_service_proto_enumTypes[2]
 }
 
 func (x Priority) Number() protoreflect.EnumNumber {
@@ -65,7 +176,175 @@ func (x Priority) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Priority.Descriptor instead.
 func (Priority) EnumDescriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{0}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{2}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_DOT     ExportFormat = 0
+	ExportFormat_Mermaid ExportFormat = 1
+	ExportFormat_MSPDI   ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "DOT",
+		1: "Mermaid",
+		2: "MSPDI",
+	}
+	ExportFormat_value = map[string]int32{
+		"DOT":     0,
+		"Mermaid": 1,
+		"MSPDI":   2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_graphs_service_graphs_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_graphs_service_graphs_service_proto_enumTypes[3]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{3}
+}
+
+type GraphEventType int32
+
+const (
+	GraphEventType_NodeCreated         GraphEventType = 0
+	GraphEventType_NodeUpdated         GraphEventType = 1
+	GraphEventType_NodeRemoved         GraphEventType = 2
+	GraphEventType_DependencyAdded     GraphEventType = 3
+	GraphEventType_DependencyRemoved   GraphEventType = 4
+	GraphEventType_CriticalPathChanged GraphEventType = 5
+	GraphEventType_DeadlineAtRisk      GraphEventType = 6
+	GraphEventType_DeadlineRecovered   GraphEventType = 7
+)
+
+// Enum value maps for GraphEventType.
+var (
+	GraphEventType_name = map[int32]string{
+		0: "NodeCreated",
+		1: "NodeUpdated",
+		2: "NodeRemoved",
+		3: "DependencyAdded",
+		4: "DependencyRemoved",
+		5: "CriticalPathChanged",
+		6: "DeadlineAtRisk",
+		7: "DeadlineRecovered",
+	}
+	GraphEventType_value = map[string]int32{
+		"NodeCreated":         0,
+		"NodeUpdated":         1,
+		"NodeRemoved":         2,
+		"DependencyAdded":     3,
+		"DependencyRemoved":   4,
+		"CriticalPathChanged": 5,
+		"DeadlineAtRisk":      6,
+		"DeadlineRecovered":   7,
+	}
+)
+
+func (x GraphEventType) Enum() *GraphEventType {
+	p := new(GraphEventType)
+	*p = x
+	return p
+}
+
+func (x GraphEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GraphEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_graphs_service_graphs_service_proto_enumTypes[4].Descriptor()
+}
+
+func (GraphEventType) Type() protoreflect.EnumType {
+	return &file_graphs_service_graphs_service_proto_enumTypes[4]
+}
+
+func (x GraphEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GraphEventType.Descriptor instead.
+func (GraphEventType) EnumDescriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{4}
+}
+
+type PatchOperationType int32
+
+const (
+	PatchOperationType_AddNode          PatchOperationType = 0
+	PatchOperationType_RemoveNode       PatchOperationType = 1
+	PatchOperationType_AddDependency    PatchOperationType = 2
+	PatchOperationType_RemoveDependency PatchOperationType = 3
+	PatchOperationType_MoveNode         PatchOperationType = 4
+)
+
+// Enum value maps for PatchOperationType.
+var (
+	PatchOperationType_name = map[int32]string{
+		0: "AddNode",
+		1: "RemoveNode",
+		2: "AddDependency",
+		3: "RemoveDependency",
+		4: "MoveNode",
+	}
+	PatchOperationType_value = map[string]int32{
+		"AddNode":          0,
+		"RemoveNode":       1,
+		"AddDependency":    2,
+		"RemoveDependency": 3,
+		"MoveNode":         4,
+	}
+)
+
+func (x PatchOperationType) Enum() *PatchOperationType {
+	p := new(PatchOperationType)
+	*p = x
+	return p
+}
+
+func (x PatchOperationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PatchOperationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_graphs_service_graphs_service_proto_enumTypes[5].Descriptor()
+}
+
+func (PatchOperationType) Type() protoreflect.EnumType {
+	return &file_graphs_service_graphs_service_proto_enumTypes[5]
+}
+
+func (x PatchOperationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PatchOperationType.Descriptor instead.
+func (PatchOperationType) EnumDescriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{5}
 }
 
 // Base Entities
@@ -78,6 +357,12 @@ type Graph struct {
 	Name      string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
 	GroupID   int64  `protobuf:"varint,3,opt,name=GroupID,proto3" json:"GroupID,omitempty"`
 	CreatedBy int64  `protobuf:"varint,4,opt,name=CreatedBy,proto3" json:"CreatedBy,omitempty"`
+	// incremented by every change of nodes or dependencies
+	Version int64 `protobuf:"varint,5,opt,name=Version,proto3" json:"Version,omitempty"`
+	// templates are instantiated by InstantiateTemplate
+	IsTemplate bool `protobuf:"varint,6,opt,name=IsTemplate,proto3" json:"IsTemplate,omitempty"`
+	// target finish date, not set if the graph has no deadline
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=Deadline,proto3" json:"Deadline,omitempty"`
 }
 
 func (x *Graph) Reset() {
@@ -140,15 +425,42 @@ func (x *Graph) GetCreatedBy() int64 {
 	return 0
 }
 
+func (x *Graph) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Graph) GetIsTemplate() bool {
+	if x != nil {
+		return x.IsTemplate
+	}
+	return false
+}
+
+func (x *Graph) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
 type Node struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                int64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	GraphID           int64   `protobuf:"varint,2,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
-	TaskID            int64   `protobuf:"varint,3,opt,name=TaskID,proto3" json:"TaskID,omitempty"`
-	DependencyNodeIDs []int64 `protobuf:"varint,5,rep,packed,name=DependencyNodeIDs,proto3" json:"DependencyNodeIDs,omitempty"`
+	ID      int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	GraphID int64 `protobuf:"varint,2,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	// set only for TaskNode
+	TaskID            int64         `protobuf:"varint,3,opt,name=TaskID,proto3" json:"TaskID,omitempty"`
+	DependencyNodeIDs []int64       `protobuf:"varint,5,rep,packed,name=DependencyNodeIDs,proto3" json:"DependencyNodeIDs,omitempty"`
+	Links             []*Dependency `protobuf:"bytes,6,rep,name=Links,proto3" json:"Links,omitempty"`
+	Kind              NodeKind      `protobuf:"varint,7,opt,name=Kind,proto3,enum=graphs.NodeKind" json:"Kind,omitempty"`
+	// set only for SubGraph, the graph must belong to the same group
+	SubGraphID int64  `protobuf:"varint,8,opt,name=SubGraphID,proto3" json:"SubGraphID,omitempty"`
+	Name       string `protobuf:"bytes,9,opt,name=Name,proto3" json:"Name,omitempty"`
 }
 
 func (x *Node) Reset() {
@@ -211,13 +523,43 @@ func (x *Node) GetDependencyNodeIDs() []int64 {
 	return nil
 }
 
+func (x *Node) GetLinks() []*Dependency {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *Node) GetKind() NodeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NodeKind_TaskNode
+}
+
+func (x *Node) GetSubGraphID() int64 {
+	if x != nil {
+		return x.SubGraphID
+	}
+	return 0
+}
+
+func (x *Node) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 type Dependency struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FromNodeID int64 `protobuf:"varint,1,opt,name=FromNodeID,proto3" json:"FromNodeID,omitempty"`
-	ToNodeID   int64 `protobuf:"varint,2,opt,name=ToNodeID,proto3" json:"ToNodeID,omitempty"`
+	FromNodeID int64    `protobuf:"varint,1,opt,name=FromNodeID,proto3" json:"FromNodeID,omitempty"`
+	ToNodeID   int64    `protobuf:"varint,2,opt,name=ToNodeID,proto3" json:"ToNodeID,omitempty"`
+	Type       LinkType `protobuf:"varint,3,opt,name=Type,proto3,enum=graphs.LinkType" json:"Type,omitempty"`
+	Lag        float64  `protobuf:"fixed64,4,opt,name=Lag,proto3" json:"Lag,omitempty"`
 }
 
 func (x *Dependency) Reset() {
@@ -266,6 +608,69 @@ func (x *Dependency) GetToNodeID() int64 {
 	return 0
 }
 
+func (x *Dependency) GetType() LinkType {
+	if x != nil {
+		return x.Type
+	}
+	return LinkType_FinishToStart
+}
+
+func (x *Dependency) GetLag() float64 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+// FailedPrecondition details: every node depends on the previous one,
+// the first node depends on the last one
+type CycleDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeIDs []int64 `protobuf:"varint,1,rep,packed,name=NodeIDs,proto3" json:"NodeIDs,omitempty"`
+}
+
+func (x *CycleDetails) Reset() {
+	*x = CycleDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CycleDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleDetails) ProtoMessage() {}
+
+func (x *CycleDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleDetails.ProtoReflect.Descriptor instead.
+func (*CycleDetails) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CycleDetails) GetNodeIDs() []int64 {
+	if x != nil {
+		return x.NodeIDs
+	}
+	return nil
+}
+
 type GraphWithNodes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -278,7 +683,7 @@ type GraphWithNodes struct {
 func (x *GraphWithNodes) Reset() {
 	*x = GraphWithNodes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[3]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -291,7 +696,7 @@ func (x *GraphWithNodes) String() string {
 func (*GraphWithNodes) ProtoMessage() {}
 
 func (x *GraphWithNodes) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[3]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +709,7 @@ func (x *GraphWithNodes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphWithNodes.ProtoReflect.Descriptor instead.
 func (*GraphWithNodes) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{3}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GraphWithNodes) GetGraphInfo() *Graph {
@@ -332,7 +737,7 @@ type Path struct {
 func (x *Path) Reset() {
 	*x = Path{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[4]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -345,7 +750,7 @@ func (x *Path) String() string {
 func (*Path) ProtoMessage() {}
 
 func (x *Path) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[4]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,7 +763,7 @@ func (x *Path) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Path.ProtoReflect.Descriptor instead.
 func (*Path) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{4}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Path) GetNodeIDs() []int64 {
@@ -380,7 +785,7 @@ type GraphResponse struct {
 func (x *GraphResponse) Reset() {
 	*x = GraphResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[5]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -393,7 +798,7 @@ func (x *GraphResponse) String() string {
 func (*GraphResponse) ProtoMessage() {}
 
 func (x *GraphResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[5]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,7 +811,7 @@ func (x *GraphResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphResponse.ProtoReflect.Descriptor instead.
 func (*GraphResponse) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{5}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GraphResponse) GetGraphID() int64 {
@@ -428,7 +833,7 @@ type ListGroupGraphsRequest struct {
 func (x *ListGroupGraphsRequest) Reset() {
 	*x = ListGroupGraphsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[6]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -441,7 +846,7 @@ func (x *ListGroupGraphsRequest) String() string {
 func (*ListGroupGraphsRequest) ProtoMessage() {}
 
 func (x *ListGroupGraphsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[6]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +859,7 @@ func (x *ListGroupGraphsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListGroupGraphsRequest.ProtoReflect.Descriptor instead.
 func (*ListGroupGraphsRequest) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{6}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListGroupGraphsRequest) GetOffset() int64 {
@@ -482,7 +887,7 @@ type GraphListResponse struct {
 func (x *GraphListResponse) Reset() {
 	*x = GraphListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[7]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -495,7 +900,7 @@ func (x *GraphListResponse) String() string {
 func (*GraphListResponse) ProtoMessage() {}
 
 func (x *GraphListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[7]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,7 +913,7 @@ func (x *GraphListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GraphListResponse.ProtoReflect.Descriptor instead.
 func (*GraphListResponse) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{7}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GraphListResponse) GetGraphs() []*GraphWithNodes {
@@ -529,7 +934,7 @@ type GetGraphRequest struct {
 func (x *GetGraphRequest) Reset() {
 	*x = GetGraphRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[8]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -542,7 +947,7 @@ func (x *GetGraphRequest) String() string {
 func (*GetGraphRequest) ProtoMessage() {}
 
 func (x *GetGraphRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[8]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -555,7 +960,7 @@ func (x *GetGraphRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGraphRequest.ProtoReflect.Descriptor instead.
 func (*GetGraphRequest) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{8}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetGraphRequest) GetGraphID() int64 {
@@ -577,7 +982,7 @@ type GetNodeRequest struct {
 func (x *GetNodeRequest) Reset() {
 	*x = GetNodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[9]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -590,7 +995,7 @@ func (x *GetNodeRequest) String() string {
 func (*GetNodeRequest) ProtoMessage() {}
 
 func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[9]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,7 +1008,7 @@ func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNodeRequest.ProtoReflect.Descriptor instead.
 func (*GetNodeRequest) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{9}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetNodeRequest) GetGraphID() int64 {
@@ -631,7 +1036,7 @@ type NodeResponse struct {
 func (x *NodeResponse) Reset() {
 	*x = NodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[10]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -644,7 +1049,7 @@ func (x *NodeResponse) String() string {
 func (*NodeResponse) ProtoMessage() {}
 
 func (x *NodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[10]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -657,7 +1062,7 @@ func (x *NodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeResponse.ProtoReflect.Descriptor instead.
 func (*NodeResponse) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{10}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *NodeResponse) GetNode() *Node {
@@ -678,7 +1083,7 @@ type CreateNodeRequest struct {
 func (x *CreateNodeRequest) Reset() {
 	*x = CreateNodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[11]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -691,7 +1096,7 @@ func (x *CreateNodeRequest) String() string {
 func (*CreateNodeRequest) ProtoMessage() {}
 
 func (x *CreateNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[11]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -704,7 +1109,7 @@ func (x *CreateNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateNodeRequest.ProtoReflect.Descriptor instead.
 func (*CreateNodeRequest) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{11}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CreateNodeRequest) GetNode() *Node {
@@ -725,7 +1130,7 @@ type UpdateNodeRequest struct {
 func (x *UpdateNodeRequest) Reset() {
 	*x = UpdateNodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[12]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -738,7 +1143,7 @@ func (x *UpdateNodeRequest) String() string {
 func (*UpdateNodeRequest) ProtoMessage() {}
 
 func (x *UpdateNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[12]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,7 +1156,7 @@ func (x *UpdateNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateNodeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateNodeRequest) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{12}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateNodeRequest) GetNode() *Node {
@@ -773,7 +1178,7 @@ type RemoveNodeRequest struct {
 func (x *RemoveNodeRequest) Reset() {
 	*x = RemoveNodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[13]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -786,7 +1191,7 @@ func (x *RemoveNodeRequest) String() string {
 func (*RemoveNodeRequest) ProtoMessage() {}
 
 func (x *RemoveNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[13]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,7 +1204,7 @@ func (x *RemoveNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveNodeRequest.ProtoReflect.Descriptor instead.
 func (*RemoveNodeRequest) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{13}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RemoveNodeRequest) GetGraphID() int64 {
@@ -816,6 +1221,61 @@ func (x *RemoveNodeRequest) GetNodeID() int64 {
 	return 0
 }
 
+type RestoreNodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraphID int64 `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	NodeID  int64 `protobuf:"varint,2,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+}
+
+func (x *RestoreNodeRequest) Reset() {
+	*x = RestoreNodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNodeRequest) ProtoMessage() {}
+
+func (x *RestoreNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNodeRequest.ProtoReflect.Descriptor instead.
+func (*RestoreNodeRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RestoreNodeRequest) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+func (x *RestoreNodeRequest) GetNodeID() int64 {
+	if x != nil {
+		return x.NodeID
+	}
+	return 0
+}
+
 type GetDependenciesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -828,7 +1288,7 @@ type GetDependenciesRequest struct {
 func (x *GetDependenciesRequest) Reset() {
 	*x = GetDependenciesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[14]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -841,7 +1301,7 @@ func (x *GetDependenciesRequest) String() string {
 func (*GetDependenciesRequest) ProtoMessage() {}
 
 func (x *GetDependenciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[14]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,7 +1314,7 @@ func (x *GetDependenciesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDependenciesRequest.ProtoReflect.Descriptor instead.
 func (*GetDependenciesRequest) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{14}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetDependenciesRequest) GetGraphID() int64 {
@@ -882,7 +1342,7 @@ type NodeWithDependencies struct {
 func (x *NodeWithDependencies) Reset() {
 	*x = NodeWithDependencies{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[15]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -895,7 +1355,7 @@ func (x *NodeWithDependencies) String() string {
 func (*NodeWithDependencies) ProtoMessage() {}
 
 func (x *NodeWithDependencies) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[15]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,7 +1368,7 @@ func (x *NodeWithDependencies) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeWithDependencies.ProtoReflect.Descriptor instead.
 func (*NodeWithDependencies) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{15}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *NodeWithDependencies) GetNode() *Node {
@@ -930,7 +1390,7 @@ type DependencyRequest struct {
 func (x *DependencyRequest) Reset() {
 	*x = DependencyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[16]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -943,7 +1403,7 @@ func (x *DependencyRequest) String() string {
 func (*DependencyRequest) ProtoMessage() {}
 
 func (x *DependencyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[16]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,7 +1416,7 @@ func (x *DependencyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DependencyRequest.ProtoReflect.Descriptor instead.
 func (*DependencyRequest) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{16}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DependencyRequest) GetGraphID() int64 {
@@ -978,14 +1438,17 @@ type PredictGraphRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GraphID  int64    `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
-	Priority Priority `protobuf:"varint,2,opt,name=Priority,proto3,enum=graphs.Priority" json:"Priority,omitempty"`
+	GraphID  int64                  `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	Priority Priority               `protobuf:"varint,2,opt,name=Priority,proto3,enum=graphs.Priority" json:"Priority,omitempty"`
+	StartAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=StartAt,proto3" json:"StartAt,omitempty"`
+	// ephemeral overrides, stored graph and tasks are not modified
+	Scenario *Scenario `protobuf:"bytes,4,opt,name=Scenario,proto3" json:"Scenario,omitempty"`
 }
 
 func (x *PredictGraphRequest) Reset() {
 	*x = PredictGraphRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[17]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -998,7 +1461,7 @@ func (x *PredictGraphRequest) String() string {
 func (*PredictGraphRequest) ProtoMessage() {}
 
 func (x *PredictGraphRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[17]
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1011,7 +1474,7 @@ func (x *PredictGraphRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PredictGraphRequest.ProtoReflect.Descriptor instead.
 func (*PredictGraphRequest) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{17}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PredictGraphRequest) GetGraphID() int64 {
@@ -1028,34 +1491,47 @@ func (x *PredictGraphRequest) GetPriority() Priority {
 	return Priority_MinTime
 }
 
-type NodeWithTask struct {
+func (x *PredictGraphRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *PredictGraphRequest) GetScenario() *Scenario {
+	if x != nil {
+		return x.Scenario
+	}
+	return nil
+}
+
+type TaskReassignment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node                   *Node               `protobuf:"bytes,1,opt,name=Node,proto3" json:"Node,omitempty"`
-	Task                   *tasks_service.Task `protobuf:"bytes,2,opt,name=Task,proto3" json:"Task,omitempty"`
-	AdditionalDependencies []int64             `protobuf:"varint,3,rep,packed,name=AdditionalDependencies,proto3" json:"AdditionalDependencies,omitempty"`
-	PredictedTime          float64             `protobuf:"fixed64,4,opt,name=PredictedTime,proto3" json:"PredictedTime,omitempty"`
+	NodeID int64 `protobuf:"varint,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	// task time is predicted again with the model of this user
+	AssignedTo int64 `protobuf:"varint,2,opt,name=AssignedTo,proto3" json:"AssignedTo,omitempty"`
 }
 
-func (x *NodeWithTask) Reset() {
-	*x = NodeWithTask{}
+func (x *TaskReassignment) Reset() {
+	*x = TaskReassignment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[18]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodeWithTask) String() string {
+func (x *TaskReassignment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeWithTask) ProtoMessage() {}
+func (*TaskReassignment) ProtoMessage() {}
 
-func (x *NodeWithTask) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[18]
+func (x *TaskReassignment) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,67 +1542,52 @@ func (x *NodeWithTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeWithTask.ProtoReflect.Descriptor instead.
-func (*NodeWithTask) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *NodeWithTask) GetNode() *Node {
-	if x != nil {
-		return x.Node
-	}
-	return nil
-}
-
-func (x *NodeWithTask) GetTask() *tasks_service.Task {
-	if x != nil {
-		return x.Task
-	}
-	return nil
+// Deprecated: Use TaskReassignment.ProtoReflect.Descriptor instead.
+func (*TaskReassignment) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *NodeWithTask) GetAdditionalDependencies() []int64 {
+func (x *TaskReassignment) GetNodeID() int64 {
 	if x != nil {
-		return x.AdditionalDependencies
+		return x.NodeID
 	}
-	return nil
+	return 0
 }
 
-func (x *NodeWithTask) GetPredictedTime() float64 {
+func (x *TaskReassignment) GetAssignedTo() int64 {
 	if x != nil {
-		return x.PredictedTime
+		return x.AssignedTo
 	}
 	return 0
 }
 
-type PredictedGraphResponse struct {
+type DurationOverride struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Graph           *Graph          `protobuf:"bytes,1,opt,name=Graph,proto3" json:"Graph,omitempty"`
-	Nodes           []*NodeWithTask `protobuf:"bytes,2,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
-	UnpredictedUIDs []int64         `protobuf:"varint,3,rep,packed,name=UnpredictedUIDs,proto3" json:"UnpredictedUIDs,omitempty"`
-	Paths           []*Path         `protobuf:"bytes,4,rep,name=Paths,proto3" json:"Paths,omitempty"`
+	NodeID int64 `protobuf:"varint,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	// hours
+	Duration float64 `protobuf:"fixed64,2,opt,name=Duration,proto3" json:"Duration,omitempty"`
 }
 
-func (x *PredictedGraphResponse) Reset() {
-	*x = PredictedGraphResponse{}
+func (x *DurationOverride) Reset() {
+	*x = DurationOverride{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[19]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PredictedGraphResponse) String() string {
+func (x *DurationOverride) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PredictedGraphResponse) ProtoMessage() {}
+func (*DurationOverride) ProtoMessage() {}
 
-func (x *PredictedGraphResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[19]
+func (x *DurationOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,64 +1598,56 @@ func (x *PredictedGraphResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PredictedGraphResponse.ProtoReflect.Descriptor instead.
-func (*PredictedGraphResponse) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *PredictedGraphResponse) GetGraph() *Graph {
-	if x != nil {
-		return x.Graph
-	}
-	return nil
-}
-
-func (x *PredictedGraphResponse) GetNodes() []*NodeWithTask {
-	if x != nil {
-		return x.Nodes
-	}
-	return nil
+// Deprecated: Use DurationOverride.ProtoReflect.Descriptor instead.
+func (*DurationOverride) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *PredictedGraphResponse) GetUnpredictedUIDs() []int64 {
+func (x *DurationOverride) GetNodeID() int64 {
 	if x != nil {
-		return x.UnpredictedUIDs
+		return x.NodeID
 	}
-	return nil
+	return 0
 }
 
-func (x *PredictedGraphResponse) GetPaths() []*Path {
+func (x *DurationOverride) GetDuration() float64 {
 	if x != nil {
-		return x.Paths
+		return x.Duration
 	}
-	return nil
+	return 0
 }
 
-type NodeDoneRequest struct {
+// Overrides are applied in order: dropped nodes, removed dependencies,
+// added dependencies, reassignments, durations
+type Scenario struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeID int64 `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Reassignments      []*TaskReassignment `protobuf:"bytes,1,rep,name=Reassignments,proto3" json:"Reassignments,omitempty"`
+	Durations          []*DurationOverride `protobuf:"bytes,2,rep,name=Durations,proto3" json:"Durations,omitempty"`
+	AddDependencies    []*Dependency       `protobuf:"bytes,3,rep,name=AddDependencies,proto3" json:"AddDependencies,omitempty"`
+	RemoveDependencies []*Dependency       `protobuf:"bytes,4,rep,name=RemoveDependencies,proto3" json:"RemoveDependencies,omitempty"`
+	DropNodeIDs        []int64             `protobuf:"varint,5,rep,packed,name=DropNodeIDs,proto3" json:"DropNodeIDs,omitempty"`
 }
 
-func (x *NodeDoneRequest) Reset() {
-	*x = NodeDoneRequest{}
+func (x *Scenario) Reset() {
+	*x = Scenario{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[20]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodeDoneRequest) String() string {
+func (x *Scenario) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeDoneRequest) ProtoMessage() {}
+func (*Scenario) ProtoMessage() {}
 
-func (x *NodeDoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[20]
+func (x *Scenario) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,90 +1658,80 @@ func (x *NodeDoneRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeDoneRequest.ProtoReflect.Descriptor instead.
-func (*NodeDoneRequest) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Scenario.ProtoReflect.Descriptor instead.
+func (*Scenario) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *NodeDoneRequest) GetNodeID() int64 {
+func (x *Scenario) GetReassignments() []*TaskReassignment {
 	if x != nil {
-		return x.NodeID
+		return x.Reassignments
 	}
-	return 0
-}
-
-type TaskInNodeRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	TaskID int64 `protobuf:"varint,1,opt,name=TaskID,proto3" json:"TaskID,omitempty"`
+	return nil
 }
 
-func (x *TaskInNodeRequest) Reset() {
-	*x = TaskInNodeRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Scenario) GetDurations() []*DurationOverride {
+	if x != nil {
+		return x.Durations
 	}
+	return nil
 }
 
-func (x *TaskInNodeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TaskInNodeRequest) ProtoMessage() {}
-
-func (x *TaskInNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Scenario) GetAddDependencies() []*Dependency {
+	if x != nil {
+		return x.AddDependencies
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use TaskInNodeRequest.ProtoReflect.Descriptor instead.
-func (*TaskInNodeRequest) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{21}
+func (x *Scenario) GetRemoveDependencies() []*Dependency {
+	if x != nil {
+		return x.RemoveDependencies
+	}
+	return nil
 }
 
-func (x *TaskInNodeRequest) GetTaskID() int64 {
+func (x *Scenario) GetDropNodeIDs() []int64 {
 	if x != nil {
-		return x.TaskID
+		return x.DropNodeIDs
 	}
-	return 0
+	return nil
 }
 
-type TaskInNodeResponse struct {
+// Scenario schedule compared to the schedule of the unmodified graph
+type ScenarioDelta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NodeID int64 `protobuf:"varint,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	BaseDuration        float64                `protobuf:"fixed64,1,opt,name=BaseDuration,proto3" json:"BaseDuration,omitempty"`
+	DurationDelta       float64                `protobuf:"fixed64,2,opt,name=DurationDelta,proto3" json:"DurationDelta,omitempty"`
+	BaseFinishAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=BaseFinishAt,proto3" json:"BaseFinishAt,omitempty"`
+	FinishDrift         float64                `protobuf:"fixed64,4,opt,name=FinishDrift,proto3" json:"FinishDrift,omitempty"`
+	Nodes               []*NodeDrift           `protobuf:"bytes,5,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	RemovedNodes        []int64                `protobuf:"varint,6,rep,packed,name=RemovedNodes,proto3" json:"RemovedNodes,omitempty"`
+	AddedDependencies   []*Dependency          `protobuf:"bytes,7,rep,name=AddedDependencies,proto3" json:"AddedDependencies,omitempty"`
+	RemovedDependencies []*Dependency          `protobuf:"bytes,8,rep,name=RemovedDependencies,proto3" json:"RemovedDependencies,omitempty"`
+	BasePaths           []*Path                `protobuf:"bytes,9,rep,name=BasePaths,proto3" json:"BasePaths,omitempty"`
 }
 
-func (x *TaskInNodeResponse) Reset() {
-	*x = TaskInNodeResponse{}
+func (x *ScenarioDelta) Reset() {
+	*x = ScenarioDelta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_graphs_service_graphs_service_proto_msgTypes[22]
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskInNodeResponse) String() string {
+func (x *ScenarioDelta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskInNodeResponse) ProtoMessage() {}
+func (*ScenarioDelta) ProtoMessage() {}
 
-func (x *TaskInNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_graphs_service_graphs_service_proto_msgTypes[22]
+func (x *ScenarioDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1299,18 +1742,3389 @@ func (x *TaskInNodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskInNodeResponse.ProtoReflect.Descriptor instead.
+// Deprecated: Use ScenarioDelta.ProtoReflect.Descriptor instead.
+func (*ScenarioDelta) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ScenarioDelta) GetBaseDuration() float64 {
+	if x != nil {
+		return x.BaseDuration
+	}
+	return 0
+}
+
+func (x *ScenarioDelta) GetDurationDelta() float64 {
+	if x != nil {
+		return x.DurationDelta
+	}
+	return 0
+}
+
+func (x *ScenarioDelta) GetBaseFinishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BaseFinishAt
+	}
+	return nil
+}
+
+func (x *ScenarioDelta) GetFinishDrift() float64 {
+	if x != nil {
+		return x.FinishDrift
+	}
+	return 0
+}
+
+func (x *ScenarioDelta) GetNodes() []*NodeDrift {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ScenarioDelta) GetRemovedNodes() []int64 {
+	if x != nil {
+		return x.RemovedNodes
+	}
+	return nil
+}
+
+func (x *ScenarioDelta) GetAddedDependencies() []*Dependency {
+	if x != nil {
+		return x.AddedDependencies
+	}
+	return nil
+}
+
+func (x *ScenarioDelta) GetRemovedDependencies() []*Dependency {
+	if x != nil {
+		return x.RemovedDependencies
+	}
+	return nil
+}
+
+func (x *ScenarioDelta) GetBasePaths() []*Path {
+	if x != nil {
+		return x.BasePaths
+	}
+	return nil
+}
+
+type NodeSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EarlyStart  float64                `protobuf:"fixed64,1,opt,name=EarlyStart,proto3" json:"EarlyStart,omitempty"`
+	EarlyFinish float64                `protobuf:"fixed64,2,opt,name=EarlyFinish,proto3" json:"EarlyFinish,omitempty"`
+	LateStart   float64                `protobuf:"fixed64,3,opt,name=LateStart,proto3" json:"LateStart,omitempty"`
+	LateFinish  float64                `protobuf:"fixed64,4,opt,name=LateFinish,proto3" json:"LateFinish,omitempty"`
+	TotalFloat  float64                `protobuf:"fixed64,5,opt,name=TotalFloat,proto3" json:"TotalFloat,omitempty"`
+	FreeFloat   float64                `protobuf:"fixed64,6,opt,name=FreeFloat,proto3" json:"FreeFloat,omitempty"`
+	Start       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=Start,proto3" json:"Start,omitempty"`
+	Finish      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=Finish,proto3" json:"Finish,omitempty"`
+}
+
+func (x *NodeSchedule) Reset() {
+	*x = NodeSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeSchedule) ProtoMessage() {}
+
+func (x *NodeSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeSchedule.ProtoReflect.Descriptor instead.
+func (*NodeSchedule) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *NodeSchedule) GetEarlyStart() float64 {
+	if x != nil {
+		return x.EarlyStart
+	}
+	return 0
+}
+
+func (x *NodeSchedule) GetEarlyFinish() float64 {
+	if x != nil {
+		return x.EarlyFinish
+	}
+	return 0
+}
+
+func (x *NodeSchedule) GetLateStart() float64 {
+	if x != nil {
+		return x.LateStart
+	}
+	return 0
+}
+
+func (x *NodeSchedule) GetLateFinish() float64 {
+	if x != nil {
+		return x.LateFinish
+	}
+	return 0
+}
+
+func (x *NodeSchedule) GetTotalFloat() float64 {
+	if x != nil {
+		return x.TotalFloat
+	}
+	return 0
+}
+
+func (x *NodeSchedule) GetFreeFloat() float64 {
+	if x != nil {
+		return x.FreeFloat
+	}
+	return 0
+}
+
+func (x *NodeSchedule) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *NodeSchedule) GetFinish() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Finish
+	}
+	return nil
+}
+
+type NodeWithTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node *Node `protobuf:"bytes,1,opt,name=Node,proto3" json:"Node,omitempty"`
+	// not set for milestones and sub-graphs,
+	// PredictedTime of a sub-graph is its predicted duration
+	Task                   *tasks_service.Task `protobuf:"bytes,2,opt,name=Task,proto3" json:"Task,omitempty"`
+	AdditionalDependencies []int64             `protobuf:"varint,3,rep,packed,name=AdditionalDependencies,proto3" json:"AdditionalDependencies,omitempty"`
+	PredictedTime          float64             `protobuf:"fixed64,4,opt,name=PredictedTime,proto3" json:"PredictedTime,omitempty"`
+	Schedule               *NodeSchedule       `protobuf:"bytes,5,opt,name=Schedule,proto3" json:"Schedule,omitempty"`
+	// hours of work left: zero for completed task,
+	// the unfinished part of PredictedTime for task in progress
+	RemainingTime float64 `protobuf:"fixed64,6,opt,name=RemainingTime,proto3" json:"RemainingTime,omitempty"`
+}
+
+func (x *NodeWithTask) Reset() {
+	*x = NodeWithTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeWithTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeWithTask) ProtoMessage() {}
+
+func (x *NodeWithTask) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeWithTask.ProtoReflect.Descriptor instead.
+func (*NodeWithTask) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *NodeWithTask) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *NodeWithTask) GetTask() *tasks_service.Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *NodeWithTask) GetAdditionalDependencies() []int64 {
+	if x != nil {
+		return x.AdditionalDependencies
+	}
+	return nil
+}
+
+func (x *NodeWithTask) GetPredictedTime() float64 {
+	if x != nil {
+		return x.PredictedTime
+	}
+	return 0
+}
+
+func (x *NodeWithTask) GetSchedule() *NodeSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *NodeWithTask) GetRemainingTime() float64 {
+	if x != nil {
+		return x.RemainingTime
+	}
+	return 0
+}
+
+type PredictedGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Graph           *Graph                 `protobuf:"bytes,1,opt,name=Graph,proto3" json:"Graph,omitempty"`
+	Nodes           []*NodeWithTask        `protobuf:"bytes,2,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	UnpredictedUIDs []int64                `protobuf:"varint,3,rep,packed,name=UnpredictedUIDs,proto3" json:"UnpredictedUIDs,omitempty"`
+	Paths           []*Path                `protobuf:"bytes,4,rep,name=Paths,proto3" json:"Paths,omitempty"`
+	Duration        float64                `protobuf:"fixed64,5,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	StartAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=StartAt,proto3" json:"StartAt,omitempty"`
+	FinishAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=FinishAt,proto3" json:"FinishAt,omitempty"`
+	// set only when the request contains a scenario
+	Delta *ScenarioDelta `protobuf:"bytes,8,opt,name=Delta,proto3" json:"Delta,omitempty"`
+	// set only when the graph has a deadline
+	Deadline *DeadlineStatus `protobuf:"bytes,9,opt,name=Deadline,proto3" json:"Deadline,omitempty"`
+}
+
+func (x *PredictedGraphResponse) Reset() {
+	*x = PredictedGraphResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PredictedGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PredictedGraphResponse) ProtoMessage() {}
+
+func (x *PredictedGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PredictedGraphResponse.ProtoReflect.Descriptor instead.
+func (*PredictedGraphResponse) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PredictedGraphResponse) GetGraph() *Graph {
+	if x != nil {
+		return x.Graph
+	}
+	return nil
+}
+
+func (x *PredictedGraphResponse) GetNodes() []*NodeWithTask {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *PredictedGraphResponse) GetUnpredictedUIDs() []int64 {
+	if x != nil {
+		return x.UnpredictedUIDs
+	}
+	return nil
+}
+
+func (x *PredictedGraphResponse) GetPaths() []*Path {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *PredictedGraphResponse) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *PredictedGraphResponse) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *PredictedGraphResponse) GetFinishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishAt
+	}
+	return nil
+}
+
+func (x *PredictedGraphResponse) GetDelta() *ScenarioDelta {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+func (x *PredictedGraphResponse) GetDeadline() *DeadlineStatus {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+type NodeShortening struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID int64 `protobuf:"varint,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	// remaining time of the node in hours
+	Duration float64 `protobuf:"fixed64,2,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	// hours by which the node must be shortened
+	Shortening float64 `protobuf:"fixed64,3,opt,name=Shortening,proto3" json:"Shortening,omitempty"`
+}
+
+func (x *NodeShortening) Reset() {
+	*x = NodeShortening{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeShortening) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeShortening) ProtoMessage() {}
+
+func (x *NodeShortening) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeShortening.ProtoReflect.Descriptor instead.
+func (*NodeShortening) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *NodeShortening) GetNodeID() int64 {
+	if x != nil {
+		return x.NodeID
+	}
+	return 0
+}
+
+func (x *NodeShortening) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *NodeShortening) GetShortening() float64 {
+	if x != nil {
+		return x.Shortening
+	}
+	return 0
+}
+
+type DeadlineStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=Deadline,proto3" json:"Deadline,omitempty"`
+	FinishAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=FinishAt,proto3" json:"FinishAt,omitempty"`
+	Met      bool                   `protobuf:"varint,3,opt,name=Met,proto3" json:"Met,omitempty"`
+	// negative if the deadline is missed
+	BufferDays float64 `protobuf:"fixed64,4,opt,name=BufferDays,proto3" json:"BufferDays,omitempty"`
+	// critical path nodes to shorten to meet the deadline, empty if it is met
+	Shortenings []*NodeShortening `protobuf:"bytes,5,rep,name=Shortenings,proto3" json:"Shortenings,omitempty"`
+	// false if the deadline is missed even with all shortenings
+	Recoverable bool `protobuf:"varint,6,opt,name=Recoverable,proto3" json:"Recoverable,omitempty"`
+	// probability to miss the deadline by Monte Carlo simulation,
+	// set only for SimulateGraph and deadline events
+	MissProbability float64 `protobuf:"fixed64,7,opt,name=MissProbability,proto3" json:"MissProbability,omitempty"`
+}
+
+func (x *DeadlineStatus) Reset() {
+	*x = DeadlineStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadlineStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadlineStatus) ProtoMessage() {}
+
+func (x *DeadlineStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadlineStatus.ProtoReflect.Descriptor instead.
+func (*DeadlineStatus) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeadlineStatus) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *DeadlineStatus) GetFinishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishAt
+	}
+	return nil
+}
+
+func (x *DeadlineStatus) GetMet() bool {
+	if x != nil {
+		return x.Met
+	}
+	return false
+}
+
+func (x *DeadlineStatus) GetBufferDays() float64 {
+	if x != nil {
+		return x.BufferDays
+	}
+	return 0
+}
+
+func (x *DeadlineStatus) GetShortenings() []*NodeShortening {
+	if x != nil {
+		return x.Shortenings
+	}
+	return nil
+}
+
+func (x *DeadlineStatus) GetRecoverable() bool {
+	if x != nil {
+		return x.Recoverable
+	}
+	return false
+}
+
+func (x *DeadlineStatus) GetMissProbability() float64 {
+	if x != nil {
+		return x.MissProbability
+	}
+	return 0
+}
+
+// Unset Deadline removes the graph deadline
+type SetGraphDeadlineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraphID  int64                  `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=Deadline,proto3" json:"Deadline,omitempty"`
+}
+
+func (x *SetGraphDeadlineRequest) Reset() {
+	*x = SetGraphDeadlineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGraphDeadlineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGraphDeadlineRequest) ProtoMessage() {}
+
+func (x *SetGraphDeadlineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGraphDeadlineRequest.ProtoReflect.Descriptor instead.
+func (*SetGraphDeadlineRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetGraphDeadlineRequest) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+func (x *SetGraphDeadlineRequest) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+type SimulateGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraphID    int64                  `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	Priority   Priority               `protobuf:"varint,2,opt,name=Priority,proto3,enum=graphs.Priority" json:"Priority,omitempty"`
+	Iterations int64                  `protobuf:"varint,3,opt,name=Iterations,proto3" json:"Iterations,omitempty"`
+	Seed       int64                  `protobuf:"varint,4,opt,name=Seed,proto3" json:"Seed,omitempty"`
+	StartAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=StartAt,proto3" json:"StartAt,omitempty"`
+}
+
+func (x *SimulateGraphRequest) Reset() {
+	*x = SimulateGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateGraphRequest) ProtoMessage() {}
+
+func (x *SimulateGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateGraphRequest.ProtoReflect.Descriptor instead.
+func (*SimulateGraphRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SimulateGraphRequest) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+func (x *SimulateGraphRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_MinTime
+}
+
+func (x *SimulateGraphRequest) GetIterations() int64 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *SimulateGraphRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *SimulateGraphRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+type NodeCriticality struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID           int64   `protobuf:"varint,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	CriticalityIndex float64 `protobuf:"fixed64,2,opt,name=CriticalityIndex,proto3" json:"CriticalityIndex,omitempty"`
+	// remaining time of the node, completed node takes no time
+	MinTime        float64 `protobuf:"fixed64,3,opt,name=MinTime,proto3" json:"MinTime,omitempty"`
+	MostLikelyTime float64 `protobuf:"fixed64,4,opt,name=MostLikelyTime,proto3" json:"MostLikelyTime,omitempty"`
+	MaxTime        float64 `protobuf:"fixed64,5,opt,name=MaxTime,proto3" json:"MaxTime,omitempty"`
+}
+
+func (x *NodeCriticality) Reset() {
+	*x = NodeCriticality{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeCriticality) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeCriticality) ProtoMessage() {}
+
+func (x *NodeCriticality) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeCriticality.ProtoReflect.Descriptor instead.
+func (*NodeCriticality) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *NodeCriticality) GetNodeID() int64 {
+	if x != nil {
+		return x.NodeID
+	}
+	return 0
+}
+
+func (x *NodeCriticality) GetCriticalityIndex() float64 {
+	if x != nil {
+		return x.CriticalityIndex
+	}
+	return 0
+}
+
+func (x *NodeCriticality) GetMinTime() float64 {
+	if x != nil {
+		return x.MinTime
+	}
+	return 0
+}
+
+func (x *NodeCriticality) GetMostLikelyTime() float64 {
+	if x != nil {
+		return x.MostLikelyTime
+	}
+	return 0
+}
+
+func (x *NodeCriticality) GetMaxTime() float64 {
+	if x != nil {
+		return x.MaxTime
+	}
+	return 0
+}
+
+type SimulatedGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Graph      *Graph `protobuf:"bytes,1,opt,name=Graph,proto3" json:"Graph,omitempty"`
+	Iterations int64  `protobuf:"varint,2,opt,name=Iterations,proto3" json:"Iterations,omitempty"`
+	// hours of the remaining work from StartAt
+	P50             float64            `protobuf:"fixed64,3,opt,name=P50,proto3" json:"P50,omitempty"`
+	P80             float64            `protobuf:"fixed64,4,opt,name=P80,proto3" json:"P80,omitempty"`
+	P95             float64            `protobuf:"fixed64,5,opt,name=P95,proto3" json:"P95,omitempty"`
+	Mean            float64            `protobuf:"fixed64,6,opt,name=Mean,proto3" json:"Mean,omitempty"`
+	Nodes           []*NodeCriticality `protobuf:"bytes,7,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	UnpredictedUIDs []int64            `protobuf:"varint,8,rep,packed,name=UnpredictedUIDs,proto3" json:"UnpredictedUIDs,omitempty"`
+	// set only when the graph has a deadline
+	Deadline *DeadlineStatus `protobuf:"bytes,9,opt,name=Deadline,proto3" json:"Deadline,omitempty"`
+}
+
+func (x *SimulatedGraphResponse) Reset() {
+	*x = SimulatedGraphResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulatedGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedGraphResponse) ProtoMessage() {}
+
+func (x *SimulatedGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedGraphResponse.ProtoReflect.Descriptor instead.
+func (*SimulatedGraphResponse) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SimulatedGraphResponse) GetGraph() *Graph {
+	if x != nil {
+		return x.Graph
+	}
+	return nil
+}
+
+func (x *SimulatedGraphResponse) GetIterations() int64 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *SimulatedGraphResponse) GetP50() float64 {
+	if x != nil {
+		return x.P50
+	}
+	return 0
+}
+
+func (x *SimulatedGraphResponse) GetP80() float64 {
+	if x != nil {
+		return x.P80
+	}
+	return 0
+}
+
+func (x *SimulatedGraphResponse) GetP95() float64 {
+	if x != nil {
+		return x.P95
+	}
+	return 0
+}
+
+func (x *SimulatedGraphResponse) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *SimulatedGraphResponse) GetNodes() []*NodeCriticality {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *SimulatedGraphResponse) GetUnpredictedUIDs() []int64 {
+	if x != nil {
+		return x.UnpredictedUIDs
+	}
+	return nil
+}
+
+func (x *SimulatedGraphResponse) GetDeadline() *DeadlineStatus {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+// Finds the cheapest shortening of tasks on critical paths by their crash cost
+// and crash min time, so that the graph takes no longer than TargetDuration
+type CrashGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraphID  int64                  `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	Priority Priority               `protobuf:"varint,2,opt,name=Priority,proto3,enum=graphs.Priority" json:"Priority,omitempty"`
+	StartAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=StartAt,proto3" json:"StartAt,omitempty"`
+	// hours, the time from StartAt to the graph deadline if not set
+	TargetDuration float64 `protobuf:"fixed64,4,opt,name=TargetDuration,proto3" json:"TargetDuration,omitempty"`
+}
+
+func (x *CrashGraphRequest) Reset() {
+	*x = CrashGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrashGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashGraphRequest) ProtoMessage() {}
+
+func (x *CrashGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashGraphRequest.ProtoReflect.Descriptor instead.
+func (*CrashGraphRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CrashGraphRequest) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+func (x *CrashGraphRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_MinTime
+}
+
+func (x *CrashGraphRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *CrashGraphRequest) GetTargetDuration() float64 {
+	if x != nil {
+		return x.TargetDuration
+	}
+	return 0
+}
+
+type NodeCrash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID int64 `protobuf:"varint,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	// remaining time of the node in hours before and after shortening
+	Duration        float64 `protobuf:"fixed64,2,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	CrashedDuration float64 `protobuf:"fixed64,3,opt,name=CrashedDuration,proto3" json:"CrashedDuration,omitempty"`
+	Cost            float64 `protobuf:"fixed64,4,opt,name=Cost,proto3" json:"Cost,omitempty"`
+}
+
+func (x *NodeCrash) Reset() {
+	*x = NodeCrash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeCrash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeCrash) ProtoMessage() {}
+
+func (x *NodeCrash) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeCrash.ProtoReflect.Descriptor instead.
+func (*NodeCrash) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *NodeCrash) GetNodeID() int64 {
+	if x != nil {
+		return x.NodeID
+	}
+	return 0
+}
+
+func (x *NodeCrash) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *NodeCrash) GetCrashedDuration() float64 {
+	if x != nil {
+		return x.CrashedDuration
+	}
+	return 0
+}
+
+func (x *NodeCrash) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type CrashGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Graph           *Graph                 `protobuf:"bytes,1,opt,name=Graph,proto3" json:"Graph,omitempty"`
+	TargetDuration  float64                `protobuf:"fixed64,2,opt,name=TargetDuration,proto3" json:"TargetDuration,omitempty"`
+	InitialDuration float64                `protobuf:"fixed64,3,opt,name=InitialDuration,proto3" json:"InitialDuration,omitempty"`
+	Duration        float64                `protobuf:"fixed64,4,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	FinishAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=FinishAt,proto3" json:"FinishAt,omitempty"`
+	// false if the target can't be reached by shortening tasks
+	Met             bool         `protobuf:"varint,6,opt,name=Met,proto3" json:"Met,omitempty"`
+	Cost            float64      `protobuf:"fixed64,7,opt,name=Cost,proto3" json:"Cost,omitempty"`
+	Nodes           []*NodeCrash `protobuf:"bytes,8,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	UnpredictedUIDs []int64      `protobuf:"varint,9,rep,packed,name=UnpredictedUIDs,proto3" json:"UnpredictedUIDs,omitempty"`
+}
+
+func (x *CrashGraphResponse) Reset() {
+	*x = CrashGraphResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrashGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashGraphResponse) ProtoMessage() {}
+
+func (x *CrashGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashGraphResponse.ProtoReflect.Descriptor instead.
+func (*CrashGraphResponse) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CrashGraphResponse) GetGraph() *Graph {
+	if x != nil {
+		return x.Graph
+	}
+	return nil
+}
+
+func (x *CrashGraphResponse) GetTargetDuration() float64 {
+	if x != nil {
+		return x.TargetDuration
+	}
+	return 0
+}
+
+func (x *CrashGraphResponse) GetInitialDuration() float64 {
+	if x != nil {
+		return x.InitialDuration
+	}
+	return 0
+}
+
+func (x *CrashGraphResponse) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CrashGraphResponse) GetFinishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishAt
+	}
+	return nil
+}
+
+func (x *CrashGraphResponse) GetMet() bool {
+	if x != nil {
+		return x.Met
+	}
+	return false
+}
+
+func (x *CrashGraphResponse) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *CrashGraphResponse) GetNodes() []*NodeCrash {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *CrashGraphResponse) GetUnpredictedUIDs() []int64 {
+	if x != nil {
+		return x.UnpredictedUIDs
+	}
+	return nil
+}
+
+type Baseline struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	GraphID   int64                  `protobuf:"varint,2,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	CreatedBy int64                  `protobuf:"varint,4,opt,name=CreatedBy,proto3" json:"CreatedBy,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	Duration  float64                `protobuf:"fixed64,6,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	StartAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=StartAt,proto3" json:"StartAt,omitempty"`
+	FinishAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=FinishAt,proto3" json:"FinishAt,omitempty"`
+}
+
+func (x *Baseline) Reset() {
+	*x = Baseline{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Baseline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Baseline) ProtoMessage() {}
+
+func (x *Baseline) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Baseline.ProtoReflect.Descriptor instead.
+func (*Baseline) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Baseline) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Baseline) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+func (x *Baseline) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Baseline) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Baseline) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Baseline) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Baseline) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *Baseline) GetFinishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishAt
+	}
+	return nil
+}
+
+type CreateBaselineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraphID  int64                  `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Priority Priority               `protobuf:"varint,3,opt,name=Priority,proto3,enum=graphs.Priority" json:"Priority,omitempty"`
+	StartAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=StartAt,proto3" json:"StartAt,omitempty"`
+}
+
+func (x *CreateBaselineRequest) Reset() {
+	*x = CreateBaselineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBaselineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBaselineRequest) ProtoMessage() {}
+
+func (x *CreateBaselineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBaselineRequest.ProtoReflect.Descriptor instead.
+func (*CreateBaselineRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateBaselineRequest) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+func (x *CreateBaselineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBaselineRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_MinTime
+}
+
+func (x *CreateBaselineRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+type BaselineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Baseline *Baseline `protobuf:"bytes,1,opt,name=Baseline,proto3" json:"Baseline,omitempty"`
+}
+
+func (x *BaselineResponse) Reset() {
+	*x = BaselineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BaselineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaselineResponse) ProtoMessage() {}
+
+func (x *BaselineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaselineResponse.ProtoReflect.Descriptor instead.
+func (*BaselineResponse) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BaselineResponse) GetBaseline() *Baseline {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+type ListBaselinesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraphID int64 `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+}
+
+func (x *ListBaselinesRequest) Reset() {
+	*x = ListBaselinesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBaselinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBaselinesRequest) ProtoMessage() {}
+
+func (x *ListBaselinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBaselinesRequest.ProtoReflect.Descriptor instead.
+func (*ListBaselinesRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListBaselinesRequest) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+type BaselineListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Baselines []*Baseline `protobuf:"bytes,1,rep,name=Baselines,proto3" json:"Baselines,omitempty"`
+}
+
+func (x *BaselineListResponse) Reset() {
+	*x = BaselineListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BaselineListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaselineListResponse) ProtoMessage() {}
+
+func (x *BaselineListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaselineListResponse.ProtoReflect.Descriptor instead.
+func (*BaselineListResponse) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BaselineListResponse) GetBaselines() []*Baseline {
+	if x != nil {
+		return x.Baselines
+	}
+	return nil
+}
+
+type CompareBaselineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraphID    int64                  `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	BaselineID int64                  `protobuf:"varint,2,opt,name=BaselineID,proto3" json:"BaselineID,omitempty"`
+	Priority   Priority               `protobuf:"varint,3,opt,name=Priority,proto3,enum=graphs.Priority" json:"Priority,omitempty"`
+	StartAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=StartAt,proto3" json:"StartAt,omitempty"`
+}
+
+func (x *CompareBaselineRequest) Reset() {
+	*x = CompareBaselineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareBaselineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareBaselineRequest) ProtoMessage() {}
+
+func (x *CompareBaselineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareBaselineRequest.ProtoReflect.Descriptor instead.
+func (*CompareBaselineRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CompareBaselineRequest) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+func (x *CompareBaselineRequest) GetBaselineID() int64 {
+	if x != nil {
+		return x.BaselineID
+	}
+	return 0
+}
+
+func (x *CompareBaselineRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_MinTime
+}
+
+func (x *CompareBaselineRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+type NodeDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID          int64   `protobuf:"varint,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	BaselineWeight  float64 `protobuf:"fixed64,2,opt,name=BaselineWeight,proto3" json:"BaselineWeight,omitempty"`
+	CurrentWeight   float64 `protobuf:"fixed64,3,opt,name=CurrentWeight,proto3" json:"CurrentWeight,omitempty"`
+	StartDrift      float64 `protobuf:"fixed64,4,opt,name=StartDrift,proto3" json:"StartDrift,omitempty"`
+	FinishDrift     float64 `protobuf:"fixed64,5,opt,name=FinishDrift,proto3" json:"FinishDrift,omitempty"`
+	TotalFloatDelta float64 `protobuf:"fixed64,6,opt,name=TotalFloatDelta,proto3" json:"TotalFloatDelta,omitempty"`
+	WasCritical     bool    `protobuf:"varint,7,opt,name=WasCritical,proto3" json:"WasCritical,omitempty"`
+	IsCritical      bool    `protobuf:"varint,8,opt,name=IsCritical,proto3" json:"IsCritical,omitempty"`
+}
+
+func (x *NodeDrift) Reset() {
+	*x = NodeDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDrift) ProtoMessage() {}
+
+func (x *NodeDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeDrift.ProtoReflect.Descriptor instead.
+func (*NodeDrift) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *NodeDrift) GetNodeID() int64 {
+	if x != nil {
+		return x.NodeID
+	}
+	return 0
+}
+
+func (x *NodeDrift) GetBaselineWeight() float64 {
+	if x != nil {
+		return x.BaselineWeight
+	}
+	return 0
+}
+
+func (x *NodeDrift) GetCurrentWeight() float64 {
+	if x != nil {
+		return x.CurrentWeight
+	}
+	return 0
+}
+
+func (x *NodeDrift) GetStartDrift() float64 {
+	if x != nil {
+		return x.StartDrift
+	}
+	return 0
+}
+
+func (x *NodeDrift) GetFinishDrift() float64 {
+	if x != nil {
+		return x.FinishDrift
+	}
+	return 0
+}
+
+func (x *NodeDrift) GetTotalFloatDelta() float64 {
+	if x != nil {
+		return x.TotalFloatDelta
+	}
+	return 0
+}
+
+func (x *NodeDrift) GetWasCritical() bool {
+	if x != nil {
+		return x.WasCritical
+	}
+	return false
+}
+
+func (x *NodeDrift) GetIsCritical() bool {
+	if x != nil {
+		return x.IsCritical
+	}
+	return false
+}
+
+type BaselineComparisonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Baseline            *Baseline              `protobuf:"bytes,1,opt,name=Baseline,proto3" json:"Baseline,omitempty"`
+	CurrentDuration     float64                `protobuf:"fixed64,2,opt,name=CurrentDuration,proto3" json:"CurrentDuration,omitempty"`
+	DurationDelta       float64                `protobuf:"fixed64,3,opt,name=DurationDelta,proto3" json:"DurationDelta,omitempty"`
+	CurrentFinishAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=CurrentFinishAt,proto3" json:"CurrentFinishAt,omitempty"`
+	FinishDrift         float64                `protobuf:"fixed64,5,opt,name=FinishDrift,proto3" json:"FinishDrift,omitempty"`
+	Nodes               []*NodeDrift           `protobuf:"bytes,6,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	AddedNodes          []int64                `protobuf:"varint,7,rep,packed,name=AddedNodes,proto3" json:"AddedNodes,omitempty"`
+	RemovedNodes        []int64                `protobuf:"varint,8,rep,packed,name=RemovedNodes,proto3" json:"RemovedNodes,omitempty"`
+	AddedDependencies   []*Dependency          `protobuf:"bytes,9,rep,name=AddedDependencies,proto3" json:"AddedDependencies,omitempty"`
+	RemovedDependencies []*Dependency          `protobuf:"bytes,10,rep,name=RemovedDependencies,proto3" json:"RemovedDependencies,omitempty"`
+	BaselinePaths       []*Path                `protobuf:"bytes,11,rep,name=BaselinePaths,proto3" json:"BaselinePaths,omitempty"`
+	CurrentPaths        []*Path                `protobuf:"bytes,12,rep,name=CurrentPaths,proto3" json:"CurrentPaths,omitempty"`
+	UnpredictedUIDs     []int64                `protobuf:"varint,13,rep,packed,name=UnpredictedUIDs,proto3" json:"UnpredictedUIDs,omitempty"`
+}
+
+func (x *BaselineComparisonResponse) Reset() {
+	*x = BaselineComparisonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BaselineComparisonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaselineComparisonResponse) ProtoMessage() {}
+
+func (x *BaselineComparisonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaselineComparisonResponse.ProtoReflect.Descriptor instead.
+func (*BaselineComparisonResponse) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BaselineComparisonResponse) GetBaseline() *Baseline {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+func (x *BaselineComparisonResponse) GetCurrentDuration() float64 {
+	if x != nil {
+		return x.CurrentDuration
+	}
+	return 0
+}
+
+func (x *BaselineComparisonResponse) GetDurationDelta() float64 {
+	if x != nil {
+		return x.DurationDelta
+	}
+	return 0
+}
+
+func (x *BaselineComparisonResponse) GetCurrentFinishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CurrentFinishAt
+	}
+	return nil
+}
+
+func (x *BaselineComparisonResponse) GetFinishDrift() float64 {
+	if x != nil {
+		return x.FinishDrift
+	}
+	return 0
+}
+
+func (x *BaselineComparisonResponse) GetNodes() []*NodeDrift {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *BaselineComparisonResponse) GetAddedNodes() []int64 {
+	if x != nil {
+		return x.AddedNodes
+	}
+	return nil
+}
+
+func (x *BaselineComparisonResponse) GetRemovedNodes() []int64 {
+	if x != nil {
+		return x.RemovedNodes
+	}
+	return nil
+}
+
+func (x *BaselineComparisonResponse) GetAddedDependencies() []*Dependency {
+	if x != nil {
+		return x.AddedDependencies
+	}
+	return nil
+}
+
+func (x *BaselineComparisonResponse) GetRemovedDependencies() []*Dependency {
+	if x != nil {
+		return x.RemovedDependencies
+	}
+	return nil
+}
+
+func (x *BaselineComparisonResponse) GetBaselinePaths() []*Path {
+	if x != nil {
+		return x.BaselinePaths
+	}
+	return nil
+}
+
+func (x *BaselineComparisonResponse) GetCurrentPaths() []*Path {
+	if x != nil {
+		return x.CurrentPaths
+	}
+	return nil
+}
+
+func (x *BaselineComparisonResponse) GetUnpredictedUIDs() []int64 {
+	if x != nil {
+		return x.UnpredictedUIDs
+	}
+	return nil
+}
+
+type NodeDoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID int64 `protobuf:"varint,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+}
+
+func (x *NodeDoneRequest) Reset() {
+	*x = NodeDoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeDoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDoneRequest) ProtoMessage() {}
+
+func (x *NodeDoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeDoneRequest.ProtoReflect.Descriptor instead.
+func (*NodeDoneRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *NodeDoneRequest) GetNodeID() int64 {
+	if x != nil {
+		return x.NodeID
+	}
+	return 0
+}
+
+type TaskInNodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskID int64 `protobuf:"varint,1,opt,name=TaskID,proto3" json:"TaskID,omitempty"`
+}
+
+func (x *TaskInNodeRequest) Reset() {
+	*x = TaskInNodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskInNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInNodeRequest) ProtoMessage() {}
+
+func (x *TaskInNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInNodeRequest.ProtoReflect.Descriptor instead.
+func (*TaskInNodeRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TaskInNodeRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+type TaskInNodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID int64 `protobuf:"varint,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+}
+
+func (x *TaskInNodeResponse) Reset() {
+	*x = TaskInNodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskInNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskInNodeResponse) ProtoMessage() {}
+
+func (x *TaskInNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskInNodeResponse.ProtoReflect.Descriptor instead.
 func (*TaskInNodeResponse) Descriptor() ([]byte, []int) {
-	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{22}
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TaskInNodeResponse) GetNodeID() int64 {
+	if x != nil {
+		return x.NodeID
+	}
+	return 0
+}
+
+type EVMReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraphID int64 `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	// latest baseline if not set
+	BaselineID int64 `protobuf:"varint,2,opt,name=BaselineID,proto3" json:"BaselineID,omitempty"`
+	// now if not set
+	AsOf *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=AsOf,proto3" json:"AsOf,omitempty"`
+}
+
+func (x *EVMReportRequest) Reset() {
+	*x = EVMReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EVMReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVMReportRequest) ProtoMessage() {}
+
+func (x *EVMReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVMReportRequest.ProtoReflect.Descriptor instead.
+func (*EVMReportRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *EVMReportRequest) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+func (x *EVMReportRequest) GetBaselineID() int64 {
+	if x != nil {
+		return x.BaselineID
+	}
+	return 0
+}
+
+func (x *EVMReportRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type NodeEarnedValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID       int64   `protobuf:"varint,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	PlannedValue float64 `protobuf:"fixed64,2,opt,name=PlannedValue,proto3" json:"PlannedValue,omitempty"`
+	EarnedValue  float64 `protobuf:"fixed64,3,opt,name=EarnedValue,proto3" json:"EarnedValue,omitempty"`
+	ActualCost   float64 `protobuf:"fixed64,4,opt,name=ActualCost,proto3" json:"ActualCost,omitempty"`
+}
+
+func (x *NodeEarnedValue) Reset() {
+	*x = NodeEarnedValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeEarnedValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeEarnedValue) ProtoMessage() {}
+
+func (x *NodeEarnedValue) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeEarnedValue.ProtoReflect.Descriptor instead.
+func (*NodeEarnedValue) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *NodeEarnedValue) GetNodeID() int64 {
+	if x != nil {
+		return x.NodeID
+	}
+	return 0
+}
+
+func (x *NodeEarnedValue) GetPlannedValue() float64 {
+	if x != nil {
+		return x.PlannedValue
+	}
+	return 0
+}
+
+func (x *NodeEarnedValue) GetEarnedValue() float64 {
+	if x != nil {
+		return x.EarnedValue
+	}
+	return 0
+}
+
+func (x *NodeEarnedValue) GetActualCost() float64 {
+	if x != nil {
+		return x.ActualCost
+	}
+	return 0
+}
+
+// Earned value metrics in hours, task budget is Task.PlannedTime.
+// ActualCost includes completed tasks only, CPI and CostVariance
+// are computed over completed tasks
+type EVMReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Baseline             *Baseline              `protobuf:"bytes,1,opt,name=Baseline,proto3" json:"Baseline,omitempty"`
+	AsOf                 *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=AsOf,proto3" json:"AsOf,omitempty"`
+	BudgetAtCompletion   float64                `protobuf:"fixed64,3,opt,name=BudgetAtCompletion,proto3" json:"BudgetAtCompletion,omitempty"`
+	PlannedValue         float64                `protobuf:"fixed64,4,opt,name=PlannedValue,proto3" json:"PlannedValue,omitempty"`
+	EarnedValue          float64                `protobuf:"fixed64,5,opt,name=EarnedValue,proto3" json:"EarnedValue,omitempty"`
+	ActualCost           float64                `protobuf:"fixed64,6,opt,name=ActualCost,proto3" json:"ActualCost,omitempty"`
+	ScheduleVariance     float64                `protobuf:"fixed64,7,opt,name=ScheduleVariance,proto3" json:"ScheduleVariance,omitempty"`
+	CostVariance         float64                `protobuf:"fixed64,8,opt,name=CostVariance,proto3" json:"CostVariance,omitempty"`
+	SPI                  float64                `protobuf:"fixed64,9,opt,name=SPI,proto3" json:"SPI,omitempty"`
+	CPI                  float64                `protobuf:"fixed64,10,opt,name=CPI,proto3" json:"CPI,omitempty"`
+	EstimateAtCompletion float64                `protobuf:"fixed64,11,opt,name=EstimateAtCompletion,proto3" json:"EstimateAtCompletion,omitempty"`
+	EstimateToCompletion float64                `protobuf:"fixed64,12,opt,name=EstimateToCompletion,proto3" json:"EstimateToCompletion,omitempty"`
+	Nodes                []*NodeEarnedValue     `protobuf:"bytes,13,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	UnbaselinedNodes     []int64                `protobuf:"varint,14,rep,packed,name=UnbaselinedNodes,proto3" json:"UnbaselinedNodes,omitempty"`
+}
+
+func (x *EVMReportResponse) Reset() {
+	*x = EVMReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EVMReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EVMReportResponse) ProtoMessage() {}
+
+func (x *EVMReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EVMReportResponse.ProtoReflect.Descriptor instead.
+func (*EVMReportResponse) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *EVMReportResponse) GetBaseline() *Baseline {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+func (x *EVMReportResponse) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *EVMReportResponse) GetBudgetAtCompletion() float64 {
+	if x != nil {
+		return x.BudgetAtCompletion
+	}
+	return 0
+}
+
+func (x *EVMReportResponse) GetPlannedValue() float64 {
+	if x != nil {
+		return x.PlannedValue
+	}
+	return 0
+}
+
+func (x *EVMReportResponse) GetEarnedValue() float64 {
+	if x != nil {
+		return x.EarnedValue
+	}
+	return 0
+}
+
+func (x *EVMReportResponse) GetActualCost() float64 {
+	if x != nil {
+		return x.ActualCost
+	}
+	return 0
+}
+
+func (x *EVMReportResponse) GetScheduleVariance() float64 {
+	if x != nil {
+		return x.ScheduleVariance
+	}
+	return 0
+}
+
+func (x *EVMReportResponse) GetCostVariance() float64 {
+	if x != nil {
+		return x.CostVariance
+	}
+	return 0
+}
+
+func (x *EVMReportResponse) GetSPI() float64 {
+	if x != nil {
+		return x.SPI
+	}
+	return 0
+}
+
+func (x *EVMReportResponse) GetCPI() float64 {
+	if x != nil {
+		return x.CPI
+	}
+	return 0
+}
+
+func (x *EVMReportResponse) GetEstimateAtCompletion() float64 {
+	if x != nil {
+		return x.EstimateAtCompletion
+	}
+	return 0
+}
+
+func (x *EVMReportResponse) GetEstimateToCompletion() float64 {
+	if x != nil {
+		return x.EstimateToCompletion
+	}
+	return 0
+}
+
+func (x *EVMReportResponse) GetNodes() []*NodeEarnedValue {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *EVMReportResponse) GetUnbaselinedNodes() []int64 {
+	if x != nil {
+		return x.UnbaselinedNodes
+	}
+	return nil
+}
+
+type ExportGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraphID  int64                  `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	Format   ExportFormat           `protobuf:"varint,2,opt,name=Format,proto3,enum=graphs.ExportFormat" json:"Format,omitempty"`
+	Priority Priority               `protobuf:"varint,3,opt,name=Priority,proto3,enum=graphs.Priority" json:"Priority,omitempty"`
+	StartAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=StartAt,proto3" json:"StartAt,omitempty"`
+}
+
+func (x *ExportGraphRequest) Reset() {
+	*x = ExportGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGraphRequest) ProtoMessage() {}
+
+func (x *ExportGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGraphRequest.ProtoReflect.Descriptor instead.
+func (*ExportGraphRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExportGraphRequest) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+func (x *ExportGraphRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_DOT
+}
+
+func (x *ExportGraphRequest) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_MinTime
+}
+
+func (x *ExportGraphRequest) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+type ExportGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content     []byte `protobuf:"bytes,1,opt,name=Content,proto3" json:"Content,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	FileName    string `protobuf:"bytes,3,opt,name=FileName,proto3" json:"FileName,omitempty"`
+}
+
+func (x *ExportGraphResponse) Reset() {
+	*x = ExportGraphResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGraphResponse) ProtoMessage() {}
+
+func (x *ExportGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGraphResponse.ProtoReflect.Descriptor instead.
+func (*ExportGraphResponse) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ExportGraphResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ExportGraphResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportGraphResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type AnalyzeGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraphID int64 `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+}
+
+func (x *AnalyzeGraphRequest) Reset() {
+	*x = AnalyzeGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyzeGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeGraphRequest) ProtoMessage() {}
+
+func (x *AnalyzeGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeGraphRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeGraphRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AnalyzeGraphRequest) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+type NodeDegree struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeID int64 `protobuf:"varint,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	FanIn  int64 `protobuf:"varint,2,opt,name=FanIn,proto3" json:"FanIn,omitempty"`
+	FanOut int64 `protobuf:"varint,3,opt,name=FanOut,proto3" json:"FanOut,omitempty"`
+}
+
+func (x *NodeDegree) Reset() {
+	*x = NodeDegree{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeDegree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDegree) ProtoMessage() {}
+
+func (x *NodeDegree) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeDegree.ProtoReflect.Descriptor instead.
+func (*NodeDegree) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *NodeDegree) GetNodeID() int64 {
+	if x != nil {
+		return x.NodeID
+	}
+	return 0
+}
+
+func (x *NodeDegree) GetFanIn() int64 {
+	if x != nil {
+		return x.FanIn
+	}
+	return 0
+}
+
+func (x *NodeDegree) GetFanOut() int64 {
+	if x != nil {
+		return x.FanOut
+	}
+	return 0
+}
+
+type GraphAnalysisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraphID        int64         `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	Nodes          int64         `protobuf:"varint,2,opt,name=Nodes,proto3" json:"Nodes,omitempty"`
+	Dependencies   int64         `protobuf:"varint,3,opt,name=Dependencies,proto3" json:"Dependencies,omitempty"`
+	Components     int64         `protobuf:"varint,4,opt,name=Components,proto3" json:"Components,omitempty"`
+	LongestPath    []int64       `protobuf:"varint,5,rep,packed,name=LongestPath,proto3" json:"LongestPath,omitempty"`
+	LevelWidths    []int64       `protobuf:"varint,6,rep,packed,name=LevelWidths,proto3" json:"LevelWidths,omitempty"`
+	MaxParallelism int64         `protobuf:"varint,7,opt,name=MaxParallelism,proto3" json:"MaxParallelism,omitempty"`
+	FanOutHotSpots []*NodeDegree `protobuf:"bytes,8,rep,name=FanOutHotSpots,proto3" json:"FanOutHotSpots,omitempty"`
+	FanInHotSpots  []*NodeDegree `protobuf:"bytes,9,rep,name=FanInHotSpots,proto3" json:"FanInHotSpots,omitempty"`
+	OrphanNodeIDs  []int64       `protobuf:"varint,10,rep,packed,name=OrphanNodeIDs,proto3" json:"OrphanNodeIDs,omitempty"`
+}
+
+func (x *GraphAnalysisResponse) Reset() {
+	*x = GraphAnalysisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraphAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphAnalysisResponse) ProtoMessage() {}
+
+func (x *GraphAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*GraphAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GraphAnalysisResponse) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+func (x *GraphAnalysisResponse) GetNodes() int64 {
+	if x != nil {
+		return x.Nodes
+	}
+	return 0
+}
+
+func (x *GraphAnalysisResponse) GetDependencies() int64 {
+	if x != nil {
+		return x.Dependencies
+	}
+	return 0
+}
+
+func (x *GraphAnalysisResponse) GetComponents() int64 {
+	if x != nil {
+		return x.Components
+	}
+	return 0
+}
+
+func (x *GraphAnalysisResponse) GetLongestPath() []int64 {
+	if x != nil {
+		return x.LongestPath
+	}
+	return nil
+}
+
+func (x *GraphAnalysisResponse) GetLevelWidths() []int64 {
+	if x != nil {
+		return x.LevelWidths
+	}
+	return nil
+}
+
+func (x *GraphAnalysisResponse) GetMaxParallelism() int64 {
+	if x != nil {
+		return x.MaxParallelism
+	}
+	return 0
+}
+
+func (x *GraphAnalysisResponse) GetFanOutHotSpots() []*NodeDegree {
+	if x != nil {
+		return x.FanOutHotSpots
+	}
+	return nil
+}
+
+func (x *GraphAnalysisResponse) GetFanInHotSpots() []*NodeDegree {
+	if x != nil {
+		return x.FanInHotSpots
+	}
+	return nil
+}
+
+func (x *GraphAnalysisResponse) GetOrphanNodeIDs() []int64 {
+	if x != nil {
+		return x.OrphanNodeIDs
+	}
+	return nil
+}
+
+type WatchGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraphID int64 `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+}
+
+func (x *WatchGraphRequest) Reset() {
+	*x = WatchGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGraphRequest) ProtoMessage() {}
+
+func (x *WatchGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGraphRequest.ProtoReflect.Descriptor instead.
+func (*WatchGraphRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *WatchGraphRequest) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+// The first event of the stream is CriticalPathChanged with the current critical paths,
+// followed by DeadlineAtRisk if the graph deadline is at risk
+type GraphEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    GraphEventType `protobuf:"varint,1,opt,name=Type,proto3,enum=graphs.GraphEventType" json:"Type,omitempty"`
+	GraphID int64          `protobuf:"varint,2,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	// user who made the change, 0 for CriticalPathChanged
+	UserID int64                  `protobuf:"varint,3,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	At     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=At,proto3" json:"At,omitempty"`
+	// set for node events, removed node contains only ID and GraphID
+	Node *Node `protobuf:"bytes,5,opt,name=Node,proto3" json:"Node,omitempty"`
+	// set for dependency events
+	Dependency *Dependency `protobuf:"bytes,6,opt,name=Dependency,proto3" json:"Dependency,omitempty"`
+	// set for CriticalPathChanged
+	Paths    []*Path `protobuf:"bytes,7,rep,name=Paths,proto3" json:"Paths,omitempty"`
+	Duration float64 `protobuf:"fixed64,8,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	// set for DeadlineAtRisk and DeadlineRecovered
+	Deadline *DeadlineStatus `protobuf:"bytes,9,opt,name=Deadline,proto3" json:"Deadline,omitempty"`
+}
+
+func (x *GraphEvent) Reset() {
+	*x = GraphEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraphEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphEvent) ProtoMessage() {}
+
+func (x *GraphEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphEvent.ProtoReflect.Descriptor instead.
+func (*GraphEvent) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GraphEvent) GetType() GraphEventType {
+	if x != nil {
+		return x.Type
+	}
+	return GraphEventType_NodeCreated
+}
+
+func (x *GraphEvent) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+func (x *GraphEvent) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *GraphEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *GraphEvent) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *GraphEvent) GetDependency() *Dependency {
+	if x != nil {
+		return x.Dependency
+	}
+	return nil
+}
+
+func (x *GraphEvent) GetPaths() []*Path {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *GraphEvent) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *GraphEvent) GetDeadline() *DeadlineStatus {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+type PatchOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type PatchOperationType `protobuf:"varint,1,opt,name=Type,proto3,enum=graphs.PatchOperationType" json:"Type,omitempty"`
+	// AddNode: new node with negative temporary ID, later operations
+	// of the patch may refer to the node by this ID
+	// MoveNode: node with new dependencies, which replace current ones
+	Node *Node `protobuf:"bytes,2,opt,name=Node,proto3" json:"Node,omitempty"`
+	// RemoveNode
+	NodeID int64 `protobuf:"varint,3,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	// AddDependency, RemoveDependency
+	Dependency *Dependency `protobuf:"bytes,4,opt,name=Dependency,proto3" json:"Dependency,omitempty"`
+}
+
+func (x *PatchOperation) Reset() {
+	*x = PatchOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PatchOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchOperation) ProtoMessage() {}
+
+func (x *PatchOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchOperation.ProtoReflect.Descriptor instead.
+func (*PatchOperation) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PatchOperation) GetType() PatchOperationType {
+	if x != nil {
+		return x.Type
+	}
+	return PatchOperationType_AddNode
+}
+
+func (x *PatchOperation) GetNode() *Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *PatchOperation) GetNodeID() int64 {
+	if x != nil {
+		return x.NodeID
+	}
+	return 0
+}
+
+func (x *PatchOperation) GetDependency() *Dependency {
+	if x != nil {
+		return x.Dependency
+	}
+	return nil
+}
+
+// Operations are applied in order in one transaction,
+// the patch is rejected with Aborted if Version is not the current graph version
+type GraphPatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraphID    int64             `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	Version    int64             `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	Operations []*PatchOperation `protobuf:"bytes,3,rep,name=Operations,proto3" json:"Operations,omitempty"`
+}
+
+func (x *GraphPatchRequest) Reset() {
+	*x = GraphPatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraphPatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphPatchRequest) ProtoMessage() {}
+
+func (x *GraphPatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphPatchRequest.ProtoReflect.Descriptor instead.
+func (*GraphPatchRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GraphPatchRequest) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
+}
+
+func (x *GraphPatchRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GraphPatchRequest) GetOperations() []*PatchOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type CreatedNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TempID int64 `protobuf:"varint,1,opt,name=TempID,proto3" json:"TempID,omitempty"`
+	NodeID int64 `protobuf:"varint,2,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+}
+
+func (x *CreatedNode) Reset() {
+	*x = CreatedNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatedNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatedNode) ProtoMessage() {}
+
+func (x *CreatedNode) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatedNode.ProtoReflect.Descriptor instead.
+func (*CreatedNode) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreatedNode) GetTempID() int64 {
+	if x != nil {
+		return x.TempID
+	}
+	return 0
+}
+
+func (x *CreatedNode) GetNodeID() int64 {
+	if x != nil {
+		return x.NodeID
+	}
+	return 0
+}
+
+type GraphPatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version      int64          `protobuf:"varint,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	CreatedNodes []*CreatedNode `protobuf:"bytes,2,rep,name=CreatedNodes,proto3" json:"CreatedNodes,omitempty"`
+}
+
+func (x *GraphPatchResponse) Reset() {
+	*x = GraphPatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GraphPatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphPatchResponse) ProtoMessage() {}
+
+func (x *GraphPatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphPatchResponse.ProtoReflect.Descriptor instead.
+func (*GraphPatchResponse) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GraphPatchResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GraphPatchResponse) GetCreatedNodes() []*CreatedNode {
+	if x != nil {
+		return x.CreatedNodes
+	}
+	return nil
+}
+
+// Copies graph nodes and dependencies into a new graph,
+// a fresh task is created for every task node of the copy
+type CloneGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GraphID int64 `protobuf:"varint,1,opt,name=GraphID,proto3" json:"GraphID,omitempty"`
+	// target group, the source graph group if not set
+	GroupID int64 `protobuf:"varint,2,opt,name=GroupID,proto3" json:"GroupID,omitempty"`
+	// the source graph name if not set
+	Name       string `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	AsTemplate bool   `protobuf:"varint,4,opt,name=AsTemplate,proto3" json:"AsTemplate,omitempty"`
+}
+
+func (x *CloneGraphRequest) Reset() {
+	*x = CloneGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneGraphRequest) ProtoMessage() {}
+
+func (x *CloneGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneGraphRequest.ProtoReflect.Descriptor instead.
+func (*CloneGraphRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CloneGraphRequest) GetGraphID() int64 {
+	if x != nil {
+		return x.GraphID
+	}
+	return 0
 }
 
-func (x *TaskInNodeResponse) GetNodeID() int64 {
+func (x *CloneGraphRequest) GetGroupID() int64 {
 	if x != nil {
-		return x.NodeID
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *CloneGraphRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneGraphRequest) GetAsTemplate() bool {
+	if x != nil {
+		return x.AsTemplate
+	}
+	return false
+}
+
+type InstantiateTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateID int64 `protobuf:"varint,1,opt,name=TemplateID,proto3" json:"TemplateID,omitempty"`
+	// target group, the template group if not set
+	GroupID int64 `protobuf:"varint,2,opt,name=GroupID,proto3" json:"GroupID,omitempty"`
+	// the template name if not set
+	Name string `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *InstantiateTemplateRequest) Reset() {
+	*x = InstantiateTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstantiateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstantiateTemplateRequest) ProtoMessage() {}
+
+func (x *InstantiateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstantiateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*InstantiateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *InstantiateTemplateRequest) GetTemplateID() int64 {
+	if x != nil {
+		return x.TemplateID
+	}
+	return 0
+}
+
+func (x *InstantiateTemplateRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *InstantiateTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	GroupID  int64  `protobuf:"varint,2,opt,name=GroupID,proto3" json:"GroupID,omitempty"`
+	ActorID  int64  `protobuf:"varint,3,opt,name=ActorID,proto3" json:"ActorID,omitempty"`
+	Entity   string `protobuf:"bytes,4,opt,name=Entity,proto3" json:"Entity,omitempty"`
+	EntityID int64  `protobuf:"varint,5,opt,name=EntityID,proto3" json:"EntityID,omitempty"`
+	// create, update or delete
+	Action string `protobuf:"bytes,6,opt,name=Action,proto3" json:"Action,omitempty"`
+	// json objects with the entity fields, Before is empty for create,
+	// After is empty for delete, update keeps only changed fields
+	Before    string                 `protobuf:"bytes,7,opt,name=Before,proto3" json:"Before,omitempty"`
+	After     string                 `protobuf:"bytes,8,opt,name=After,proto3" json:"After,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AuditEvent) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetActorID() int64 {
+	if x != nil {
+		return x.ActorID
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetEntityID() int64 {
+	if x != nil {
+		return x.EntityID
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID int64 `protobuf:"varint,1,opt,name=GroupID,proto3" json:"GroupID,omitempty"`
+	// optional filters
+	Entity   string                 `protobuf:"bytes,2,opt,name=Entity,proto3" json:"Entity,omitempty"`
+	EntityID int64                  `protobuf:"varint,3,opt,name=EntityID,proto3" json:"EntityID,omitempty"`
+	ActorID  int64                  `protobuf:"varint,4,opt,name=ActorID,proto3" json:"ActorID,omitempty"`
+	From     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=From,proto3" json:"From,omitempty"`
+	To       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=To,proto3" json:"To,omitempty"`
+	// events are returned from newest to oldest,
+	// only events older than (BeforeTime, BeforeID) are returned if set
+	BeforeTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=BeforeTime,proto3" json:"BeforeTime,omitempty"`
+	BeforeID   int64                  `protobuf:"varint,8,opt,name=BeforeID,proto3" json:"BeforeID,omitempty"`
+	Limit      int32                  `protobuf:"varint,9,opt,name=Limit,proto3" json:"Limit,omitempty"`
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListAuditEventsRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetEntityID() int64 {
+	if x != nil {
+		return x.EntityID
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetActorID() int64 {
+	if x != nil {
+		return x.ActorID
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetBeforeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BeforeTime
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetBeforeID() int64 {
+	if x != nil {
+		return x.BeforeID
 	}
 	return 0
 }
 
+func (x *ListAuditEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AuditEventList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*AuditEvent `protobuf:"bytes,1,rep,name=Events,proto3" json:"Events,omitempty"`
+}
+
+func (x *AuditEventList) Reset() {
+	*x = AuditEventList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_graphs_service_graphs_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEventList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEventList) ProtoMessage() {}
+
+func (x *AuditEventList) ProtoReflect() protoreflect.Message {
+	mi := &file_graphs_service_graphs_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEventList.ProtoReflect.Descriptor instead.
+func (*AuditEventList) Descriptor() ([]byte, []int) {
+	return file_graphs_service_graphs_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AuditEventList) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
 var File_graphs_service_graphs_service_proto protoreflect.FileDescriptor
 
 var file_graphs_service_graphs_service_proto_rawDesc = []byte{
@@ -1318,180 +5132,858 @@ var file_graphs_service_graphs_service_proto_rawDesc = []byte{
 	0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x1a, 0x1b, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x74, 0x61, 0x73, 0x6b,
-	0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x63, 0x0a,
-	0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x42, 0x79, 0x22, 0x76, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72,
-	0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61,
-	0x70, 0x68, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11,
-	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x11, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
-	0x6e, 0x63, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x22, 0x48, 0x0a, 0x0a, 0x44, 0x65,
-	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x72, 0x6f, 0x6d,
-	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x46, 0x72,
-	0x6f, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x6f, 0x4e, 0x6f,
-	0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x54, 0x6f, 0x4e, 0x6f,
-	0x64, 0x65, 0x49, 0x44, 0x22, 0x61, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x57, 0x69, 0x74,
-	0x68, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x61, 0x70,
-	0x68, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x09, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x52, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x18, 0x0a, 0x07, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
-	0x52, 0x07, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x22, 0x29, 0x0a, 0x0d, 0x47, 0x72, 0x61,
-	0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72,
-	0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61,
-	0x70, 0x68, 0x49, 0x44, 0x22, 0x4a, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
-	0x22, 0x43, 0x0a, 0x11, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x47, 0x72, 0x61, 0x70, 0x68, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47,
-	0x72, 0x61, 0x70, 0x68, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x06, 0x47,
-	0x72, 0x61, 0x70, 0x68, 0x73, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70,
-	0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68,
-	0x49, 0x44, 0x22, 0x42, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x74, 0x61, 0x73,
+	0x6b, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5,
+	0x01, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x49, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x36,
+	0x0a, 0x08, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x44, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73,
+	0x6b, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49,
+	0x44, 0x12, 0x2c, 0x0a, 0x11, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x11, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x12,
+	0x28, 0x0a, 0x05, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x05, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x4b, 0x69, 0x6e,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x53, 0x75, 0x62, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x72, 0x6f, 0x6d, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x46, 0x72, 0x6f, 0x6d, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x6f, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x54, 0x6f, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x24,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x4c, 0x61, 0x67, 0x22, 0x28, 0x0a, 0x0c, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73,
+	0x22, 0x61, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x2b, 0x0a, 0x09, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x52, 0x09, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x22, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x22, 0x20, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x44, 0x73, 0x22, 0x29, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44,
+	0x22, 0x4a, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x43, 0x0a, 0x11,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x06, 0x47, 0x72, 0x61, 0x70, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x06, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x73, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12, 0x16,
-	0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x22, 0x30, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x35, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
-	0x04, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72,
-	0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x22,
-	0x35, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x45, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47,
-	0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72,
-	0x61, 0x70, 0x68, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x22, 0x4a, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x22, 0x42,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x44, 0x22, 0x30, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x4e, 0x6f, 0x64, 0x65, 0x22, 0x35, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x4e, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x35, 0x0a, 0x11, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4e, 0x6f,
+	0x64, 0x65, 0x22, 0x45, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x6f, 0x64, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68,
 	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49,
 	0x44, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x22, 0x38, 0x0a, 0x14, 0x4e, 0x6f, 0x64,
-	0x65, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x12, 0x20, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4e,
-	0x6f, 0x64, 0x65, 0x22, 0x61, 0x0a, 0x11, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70,
+	0x03, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x22, 0x46, 0x0a, 0x12, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x44, 0x22, 0x4a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x22, 0x38, 0x0a,
+	0x14, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x61, 0x0a, 0x11, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0a,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xc1, 0x01, 0x0a, 0x13, 0x50,
+	0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x08,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74,
+	0x12, 0x2c, 0x0a, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x53, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x52, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x22, 0x4a,
+	0x0a, 0x10, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x22, 0x46, 0x0a, 0x10, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xa6, 0x02, 0x0a, 0x08, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12,
+	0x3e, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0d, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x36, 0x0a, 0x09, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x09, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x52, 0x0f, 0x41, 0x64, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x72, 0x6f,
+	0x70, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0b,
+	0x44, 0x72, 0x6f, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x22, 0xbc, 0x03, 0x0a, 0x0d,
+	0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x22, 0x0a,
+	0x0c, 0x42, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x42, 0x61, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x0c, 0x42, 0x61, 0x73, 0x65, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x42, 0x61, 0x73, 0x65, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x44, 0x72, 0x69, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x05, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x65, 0x64, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x11, 0x41, 0x64, 0x64, 0x65, 0x64, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x2a,
+	0x0a, 0x09, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x09, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x0c, 0x4e,
+	0x6f, 0x64, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x45,
+	0x61, 0x72, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x45, 0x61, 0x72, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x45,
+	0x61, 0x72, 0x6c, 0x79, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x45, 0x61, 0x72, 0x6c, 0x79, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x1c, 0x0a,
+	0x09, 0x4c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x4c, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x4c,
+	0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x4c, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x46,
+	0x72, 0x65, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x46, 0x72, 0x65, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x22,
+	0x87, 0x02, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x20, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x36, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x16, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x50,
+	0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x30, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x52, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa2, 0x03, 0x0a, 0x16, 0x50, 0x72,
+	0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x52, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x2a, 0x0a, 0x05, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x55, 0x6e, 0x70, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x74, 0x65, 0x64, 0x55, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f,
+	0x55, 0x6e, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x55, 0x49, 0x44, 0x73, 0x12,
+	0x22, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x50, 0x61,
+	0x74, 0x68, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x34, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x41,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x2b, 0x0a,
+	0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x53, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x44, 0x65,
+	0x6c, 0x74, 0x61, 0x52, 0x05, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x32, 0x0a, 0x08, 0x44, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x64,
+	0x0a, 0x0e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x22, 0xb8, 0x02, 0x0a, 0x0e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x36, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x4d, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x44, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x42,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x44, 0x61, 0x79, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x53, 0x68, 0x6f,
+	0x72, 0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x68, 0x6f, 0x72,
+	0x74, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x4d, 0x69, 0x73, 0x73, 0x50, 0x72, 0x6f,
+	0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x4d, 0x69, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22,
+	0x6b, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x44, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x08, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xc8, 0x01, 0x0a,
+	0x14, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12,
+	0x2c, 0x0a, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x53, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x53, 0x65, 0x65,
+	0x64, 0x12, 0x34, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65,
+	0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x43,
+	0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x18, 0x0a, 0x07, 0x4d, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x4d, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x4d, 0x6f, 0x73,
+	0x74, 0x4c, 0x69, 0x6b, 0x65, 0x6c, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x4d, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x6c, 0x79, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x07, 0x4d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb4, 0x02, 0x0a, 0x16,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x52, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x49,
+	0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x50,
+	0x35, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x50, 0x35, 0x30, 0x12, 0x10, 0x0a,
+	0x03, 0x50, 0x38, 0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x50, 0x38, 0x30, 0x12,
+	0x10, 0x0a, 0x03, 0x50, 0x39, 0x35, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x50, 0x39,
+	0x35, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x65, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x4d, 0x65, 0x61, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x05, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x55, 0x6e, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63,
+	0x74, 0x65, 0x64, 0x55, 0x49, 0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x55,
+	0x6e, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x55, 0x49, 0x44, 0x73, 0x12, 0x32,
+	0x0a, 0x08, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x61, 0x73, 0x68, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70,
 	0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68,
-	0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e,
-	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0a, 0x44, 0x65, 0x70, 0x65,
-	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x5d, 0x0a, 0x13, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63,
-	0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x61, 0x70,
-	0x68, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x50, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xaf, 0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69,
-	0x74, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x20, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54,
-	0x61, 0x73, 0x6b, 0x52, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x36, 0x0a, 0x16, 0x41, 0x64, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x16, 0x41, 0x64, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63,
-	0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x65, 0x64,
-	0x69, 0x63, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68,
-	0x52, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x2a, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x4e, 0x6f,
-	0x64, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x55, 0x6e, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74,
-	0x65, 0x64, 0x55, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x55, 0x6e,
-	0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x55, 0x49, 0x44, 0x73, 0x12, 0x22, 0x0a,
-	0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67,
-	0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x50, 0x61, 0x74, 0x68,
-	0x73, 0x22, 0x29, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x22, 0x2b, 0x0a, 0x11,
-	0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x12, 0x54, 0x61, 0x73,
-	0x6b, 0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x2a, 0x24, 0x0a, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x01, 0x32, 0xbe, 0x06,
-	0x0a, 0x06, 0x47, 0x72, 0x61, 0x70, 0x68, 0x73, 0x12, 0x41, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x16, 0x2e, 0x67,
-	0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x57, 0x69, 0x74, 0x68, 0x4e,
-	0x6f, 0x64, 0x65, 0x73, 0x1a, 0x15, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72,
-	0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x4c,
-	0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x73, 0x12, 0x1e,
-	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74,
-	0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x17, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x57, 0x69, 0x74,
-	0x68, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64,
-	0x65, 0x12, 0x16, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x72, 0x61, 0x70,
-	0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x2e,
-	0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
-	0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
-	0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x2e, 0x67,
-	0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x3f, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x2e,
-	0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x4f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x12, 0x42, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
-	0x63, 0x79, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x65,
-	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x49, 0x44, 0x12, 0x2c, 0x0a, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x34, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7d,
+	0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x72, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x28, 0x0a, 0x0f, 0x43, 0x72, 0x61, 0x73, 0x68, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x43, 0x72, 0x61, 0x73, 0x68, 0x65,
+	0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x73,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x43, 0x6f, 0x73, 0x74, 0x22, 0xd8, 0x02,
+	0x0a, 0x12, 0x43, 0x72, 0x61, 0x73, 0x68, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x52, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x4d, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x4d, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x55, 0x6e, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x55, 0x49, 0x44,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x55, 0x6e, 0x70, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x74, 0x65, 0x64, 0x55, 0x49, 0x44, 0x73, 0x22, 0xaa, 0x02, 0x0a, 0x08, 0x42, 0x61, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x36, 0x0a,
+	0x08, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x41, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a,
+	0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x52, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41,
+	0x74, 0x22, 0x40, 0x0a, 0x10, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x42, 0x61, 0x73, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x22, 0x30, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x49, 0x44, 0x22, 0x46, 0x0a, 0x14, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x09, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x09, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0xb6, 0x01,
+	0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x49, 0x44, 0x12, 0x2c, 0x0a, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x34, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x22, 0x9f, 0x02, 0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x44,
+	0x72, 0x69, 0x66, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e,
+	0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x72, 0x69, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x44, 0x72, 0x69, 0x66, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x28, 0x0a, 0x0f,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x57, 0x61, 0x73, 0x43, 0x72, 0x69,
+	0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x57, 0x61, 0x73,
+	0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x43, 0x72,
+	0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73,
+	0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x22, 0x87, 0x05, 0x0a, 0x1a, 0x42, 0x61, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x42, 0x61, 0x73, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x08, 0x42, 0x61, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x24, 0x0a, 0x0d, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x0f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x44, 0x72, 0x69, 0x66, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x27, 0x0a,
+	0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52,
+	0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x65, 0x64, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x41, 0x64, 0x64, 0x65,
+	0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x11, 0x41, 0x64,
+	0x64, 0x65, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x11, 0x41, 0x64, 0x64, 0x65, 0x64,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x13,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x13, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x12, 0x32, 0x0a, 0x0d, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x73, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x0d, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x30, 0x0a, 0x0c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x52, 0x0c, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x55, 0x6e, 0x70, 0x72,
+	0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x55, 0x49, 0x44, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x0f, 0x55, 0x6e, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x55, 0x49,
+	0x44, 0x73, 0x22, 0x29, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x6f, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x22, 0x2b, 0x0a,
+	0x11, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x12, 0x54, 0x61,
+	0x73, 0x6b, 0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x22, 0x7c, 0x0a, 0x10, 0x45, 0x56, 0x4d, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x42, 0x61, 0x73, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x04, 0x41, 0x73, 0x4f, 0x66, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x41, 0x73, 0x4f, 0x66, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x45,
+	0x61, 0x72, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65,
+	0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x45, 0x61, 0x72,
+	0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x75,
+	0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x41, 0x63,
+	0x74, 0x75, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x22, 0xbe, 0x04, 0x0a, 0x11, 0x45, 0x56, 0x4d,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x08, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x08, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2e, 0x0a, 0x04,
+	0x41, 0x73, 0x4f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x41, 0x73, 0x4f, 0x66, 0x12, 0x2e, 0x0a, 0x12,
+	0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x41, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c,
+	0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x43, 0x6f,
+	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x43, 0x6f, 0x73, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x43, 0x6f, 0x73, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x50, 0x49, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x53, 0x50, 0x49, 0x12, 0x10, 0x0a, 0x03, 0x43, 0x50, 0x49, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x43, 0x50, 0x49, 0x12, 0x32, 0x0a, 0x14, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x41, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x14, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d,
+	0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x61, 0x72, 0x6e, 0x65,
+	0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2a, 0x0a,
+	0x10, 0x55, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x03, 0x52, 0x10, 0x55, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x12, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x06, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x52, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x50, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x50, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x22, 0x6d, 0x0a, 0x13,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x13, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x22, 0x52, 0x0a, 0x0a,
+	0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x61, 0x6e, 0x49, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x46, 0x61, 0x6e, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x61, 0x6e, 0x4f,
+	0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74,
+	0x22, 0x93, 0x03, 0x0a, 0x15, 0x47, 0x72, 0x61, 0x70, 0x68, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x0b, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x57, 0x69, 0x64, 0x74, 0x68, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x57, 0x69, 0x64, 0x74,
+	0x68, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x4d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65,
+	0x6c, 0x69, 0x73, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x4d, 0x61, 0x78, 0x50,
+	0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x12, 0x3a, 0x0a, 0x0e, 0x46, 0x61,
+	0x6e, 0x4f, 0x75, 0x74, 0x48, 0x6f, 0x74, 0x53, 0x70, 0x6f, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x44, 0x65, 0x67, 0x72, 0x65, 0x65, 0x52, 0x0e, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x48, 0x6f,
+	0x74, 0x53, 0x70, 0x6f, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x46, 0x61, 0x6e, 0x49, 0x6e, 0x48,
+	0x6f, 0x74, 0x53, 0x70, 0x6f, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x67, 0x72, 0x65,
+	0x65, 0x52, 0x0d, 0x46, 0x61, 0x6e, 0x49, 0x6e, 0x48, 0x6f, 0x74, 0x53, 0x70, 0x6f, 0x74, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x44, 0x73, 0x22, 0x2d, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x49, 0x44, 0x22, 0xe0, 0x02, 0x0a, 0x0a, 0x47, 0x72, 0x61, 0x70, 0x68, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x2a, 0x0a, 0x02, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x41, 0x74, 0x12, 0x20,
+	0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x32, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0a, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x50, 0x61, 0x74,
+	0x68, 0x52, 0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x08, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e,
+	0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08,
+	0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xae, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x74,
+	0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x73, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x4e,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x32, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0a, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x7f, 0x0a, 0x11, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e,
+	0x50, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3d, 0x0a, 0x0b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x65, 0x6d,
+	0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x54, 0x65, 0x6d, 0x70, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x22, 0x67, 0x0a, 0x12, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x22, 0x7b, 0x0a, 0x11, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x61, 0x70, 0x68, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x41, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x41, 0x73, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22,
+	0x6a, 0x0a, 0x1a, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x84, 0x02, 0x0a, 0x0a,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xca, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x41, 0x63,
+	0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x54,
+	0x6f, 0x12, 0x3a, 0x0a, 0x0a, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x3c, 0x0a, 0x0e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x2a, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x35, 0x0a,
+	0x08, 0x4e, 0x6f, 0x64, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x61, 0x73,
+	0x6b, 0x4e, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x69, 0x6c, 0x65, 0x73,
+	0x74, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x75, 0x62, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x10, 0x02, 0x2a, 0x56, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x11, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54,
+	0x6f, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x6f, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x10, 0x03, 0x2a, 0x63, 0x0a, 0x08,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x69, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65,
+	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x73, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x61, 0x6e, 0x6b, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x10,
+	0x04, 0x2a, 0x2f, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x4f, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x65,
+	0x72, 0x6d, 0x61, 0x69, 0x64, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x53, 0x50, 0x44, 0x49,
+	0x10, 0x02, 0x2a, 0xb3, 0x01, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x41, 0x64, 0x64, 0x65, 0x64, 0x10, 0x03, 0x12, 0x15, 0x0a,
+	0x11, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c,
+	0x50, 0x61, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x10, 0x05, 0x12, 0x12, 0x0a,
+	0x0e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x10,
+	0x06, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x10, 0x07, 0x2a, 0x68, 0x0a, 0x12, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41,
+	0x64, 0x64, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x10, 0x02, 0x12, 0x14,
+	0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x4e, 0x6f, 0x64, 0x65,
+	0x10, 0x04, 0x32, 0x8e, 0x0f, 0x0a, 0x06, 0x47, 0x72, 0x61, 0x70, 0x68, 0x73, 0x12, 0x41, 0x0a,
+	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x12, 0x16, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x1a, 0x15, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x47, 0x72, 0x61, 0x70, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x17, 0x2e, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x57, 0x69, 0x74, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44,
-	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x61, 0x70,
-	0x68, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4b, 0x0a, 0x0c,
-	0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x1b, 0x2e, 0x67,
-	0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x47, 0x72, 0x61,
-	0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x72, 0x61, 0x70,
-	0x68, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x47, 0x72, 0x61, 0x70,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x54, 0x61, 0x73,
-	0x6b, 0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73,
-	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x42,
-	0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x72,
-	0x69, 0x71, 0x75, 0x65, 0x77, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x3b, 0x67, 0x72, 0x61, 0x70,
-	0x68, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x57, 0x69, 0x74, 0x68, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x73, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x10, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x19, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x4b, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x12, 0x1b, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x64,
+	0x69, 0x63, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74,
+	0x65, 0x64, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x0d, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x12, 0x1c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x0a, 0x0a, 0x43, 0x72, 0x61, 0x73, 0x68, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x19, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x43, 0x72, 0x61, 0x73, 0x68, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73,
+	0x2e, 0x43, 0x72, 0x61, 0x73, 0x68, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x42, 0x61,
+	0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12,
+	0x1c, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0f, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1e,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x42,
+	0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x45, 0x56, 0x4d, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x18, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x45, 0x56, 0x4d, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x45, 0x56, 0x4d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x1a, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4a, 0x0a, 0x0c, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12,
+	0x1b, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x73, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0f, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x2e,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x12, 0x19, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x6e,
+	0x65, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x73, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x44, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x49, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43,
+	0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x2e, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6c, 0x69, 0x72, 0x69, 0x71, 0x75, 0x65, 0x77, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73,
+	0x3b, 0x67, 0x72, 0x61, 0x70, 0x68, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1506,80 +5998,236 @@ func file_graphs_service_graphs_service_proto_rawDescGZIP() []byte {
 	return file_graphs_service_graphs_service_proto_rawDescData
 }
 
-var file_graphs_service_graphs_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_graphs_service_graphs_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_graphs_service_graphs_service_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_graphs_service_graphs_service_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_graphs_service_graphs_service_proto_goTypes = []interface{}{
-	(Priority)(0),                  // 0: graphs.Priority
-	(*Graph)(nil),                  // 1: graphs.Graph
-	(*Node)(nil),                   // 2: graphs.Node
-	(*Dependency)(nil),             // 3: graphs.Dependency
-	(*GraphWithNodes)(nil),         // 4: graphs.GraphWithNodes
-	(*Path)(nil),                   // 5: graphs.Path
-	(*GraphResponse)(nil),          // 6: graphs.GraphResponse
-	(*ListGroupGraphsRequest)(nil), // 7: graphs.ListGroupGraphsRequest
-	(*GraphListResponse)(nil),      // 8: graphs.GraphListResponse
-	(*GetGraphRequest)(nil),        // 9: graphs.GetGraphRequest
-	(*GetNodeRequest)(nil),         // 10: graphs.GetNodeRequest
-	(*NodeResponse)(nil),           // 11: graphs.NodeResponse
-	(*CreateNodeRequest)(nil),      // 12: graphs.CreateNodeRequest
-	(*UpdateNodeRequest)(nil),      // 13: graphs.UpdateNodeRequest
-	(*RemoveNodeRequest)(nil),      // 14: graphs.RemoveNodeRequest
-	(*GetDependenciesRequest)(nil), // 15: graphs.GetDependenciesRequest
-	(*NodeWithDependencies)(nil),   // 16: graphs.NodeWithDependencies
-	(*DependencyRequest)(nil),      // 17: graphs.DependencyRequest
-	(*PredictGraphRequest)(nil),    // 18: graphs.PredictGraphRequest
-	(*NodeWithTask)(nil),           // 19: graphs.NodeWithTask
-	(*PredictedGraphResponse)(nil), // 20: graphs.PredictedGraphResponse
-	(*NodeDoneRequest)(nil),        // 21: graphs.NodeDoneRequest
-	(*TaskInNodeRequest)(nil),      // 22: graphs.TaskInNodeRequest
-	(*TaskInNodeResponse)(nil),     // 23: graphs.TaskInNodeResponse
-	(*tasks_service.Task)(nil),     // 24: tasks.Task
-	(*emptypb.Empty)(nil),          // 25: google.protobuf.Empty
+	(NodeKind)(0),                      // 0: graphs.NodeKind
+	(LinkType)(0),                      // 1: graphs.LinkType
+	(Priority)(0),                      // 2: graphs.Priority
+	(ExportFormat)(0),                  // 3: graphs.ExportFormat
+	(GraphEventType)(0),                // 4: graphs.GraphEventType
+	(PatchOperationType)(0),            // 5: graphs.PatchOperationType
+	(*Graph)(nil),                      // 6: graphs.Graph
+	(*Node)(nil),                       // 7: graphs.Node
+	(*Dependency)(nil),                 // 8: graphs.Dependency
+	(*CycleDetails)(nil),               // 9: graphs.CycleDetails
+	(*GraphWithNodes)(nil),             // 10: graphs.GraphWithNodes
+	(*Path)(nil),                       // 11: graphs.Path
+	(*GraphResponse)(nil),              // 12: graphs.GraphResponse
+	(*ListGroupGraphsRequest)(nil),     // 13: graphs.ListGroupGraphsRequest
+	(*GraphListResponse)(nil),          // 14: graphs.GraphListResponse
+	(*GetGraphRequest)(nil),            // 15: graphs.GetGraphRequest
+	(*GetNodeRequest)(nil),             // 16: graphs.GetNodeRequest
+	(*NodeResponse)(nil),               // 17: graphs.NodeResponse
+	(*CreateNodeRequest)(nil),          // 18: graphs.CreateNodeRequest
+	(*UpdateNodeRequest)(nil),          // 19: graphs.UpdateNodeRequest
+	(*RemoveNodeRequest)(nil),          // 20: graphs.RemoveNodeRequest
+	(*RestoreNodeRequest)(nil),         // 21: graphs.RestoreNodeRequest
+	(*GetDependenciesRequest)(nil),     // 22: graphs.GetDependenciesRequest
+	(*NodeWithDependencies)(nil),       // 23: graphs.NodeWithDependencies
+	(*DependencyRequest)(nil),          // 24: graphs.DependencyRequest
+	(*PredictGraphRequest)(nil),        // 25: graphs.PredictGraphRequest
+	(*TaskReassignment)(nil),           // 26: graphs.TaskReassignment
+	(*DurationOverride)(nil),           // 27: graphs.DurationOverride
+	(*Scenario)(nil),                   // 28: graphs.Scenario
+	(*ScenarioDelta)(nil),              // 29: graphs.ScenarioDelta
+	(*NodeSchedule)(nil),               // 30: graphs.NodeSchedule
+	(*NodeWithTask)(nil),               // 31: graphs.NodeWithTask
+	(*PredictedGraphResponse)(nil),     // 32: graphs.PredictedGraphResponse
+	(*NodeShortening)(nil),             // 33: graphs.NodeShortening
+	(*DeadlineStatus)(nil),             // 34: graphs.DeadlineStatus
+	(*SetGraphDeadlineRequest)(nil),    // 35: graphs.SetGraphDeadlineRequest
+	(*SimulateGraphRequest)(nil),       // 36: graphs.SimulateGraphRequest
+	(*NodeCriticality)(nil),            // 37: graphs.NodeCriticality
+	(*SimulatedGraphResponse)(nil),     // 38: graphs.SimulatedGraphResponse
+	(*CrashGraphRequest)(nil),          // 39: graphs.CrashGraphRequest
+	(*NodeCrash)(nil),                  // 40: graphs.NodeCrash
+	(*CrashGraphResponse)(nil),         // 41: graphs.CrashGraphResponse
+	(*Baseline)(nil),                   // 42: graphs.Baseline
+	(*CreateBaselineRequest)(nil),      // 43: graphs.CreateBaselineRequest
+	(*BaselineResponse)(nil),           // 44: graphs.BaselineResponse
+	(*ListBaselinesRequest)(nil),       // 45: graphs.ListBaselinesRequest
+	(*BaselineListResponse)(nil),       // 46: graphs.BaselineListResponse
+	(*CompareBaselineRequest)(nil),     // 47: graphs.CompareBaselineRequest
+	(*NodeDrift)(nil),                  // 48: graphs.NodeDrift
+	(*BaselineComparisonResponse)(nil), // 49: graphs.BaselineComparisonResponse
+	(*NodeDoneRequest)(nil),            // 50: graphs.NodeDoneRequest
+	(*TaskInNodeRequest)(nil),          // 51: graphs.TaskInNodeRequest
+	(*TaskInNodeResponse)(nil),         // 52: graphs.TaskInNodeResponse
+	(*EVMReportRequest)(nil),           // 53: graphs.EVMReportRequest
+	(*NodeEarnedValue)(nil),            // 54: graphs.NodeEarnedValue
+	(*EVMReportResponse)(nil),          // 55: graphs.EVMReportResponse
+	(*ExportGraphRequest)(nil),         // 56: graphs.ExportGraphRequest
+	(*ExportGraphResponse)(nil),        // 57: graphs.ExportGraphResponse
+	(*AnalyzeGraphRequest)(nil),        // 58: graphs.AnalyzeGraphRequest
+	(*NodeDegree)(nil),                 // 59: graphs.NodeDegree
+	(*GraphAnalysisResponse)(nil),      // 60: graphs.GraphAnalysisResponse
+	(*WatchGraphRequest)(nil),          // 61: graphs.WatchGraphRequest
+	(*GraphEvent)(nil),                 // 62: graphs.GraphEvent
+	(*PatchOperation)(nil),             // 63: graphs.PatchOperation
+	(*GraphPatchRequest)(nil),          // 64: graphs.GraphPatchRequest
+	(*CreatedNode)(nil),                // 65: graphs.CreatedNode
+	(*GraphPatchResponse)(nil),         // 66: graphs.GraphPatchResponse
+	(*CloneGraphRequest)(nil),          // 67: graphs.CloneGraphRequest
+	(*InstantiateTemplateRequest)(nil), // 68: graphs.InstantiateTemplateRequest
+	(*AuditEvent)(nil),                 // 69: graphs.AuditEvent
+	(*ListAuditEventsRequest)(nil),     // 70: graphs.ListAuditEventsRequest
+	(*AuditEventList)(nil),             // 71: graphs.AuditEventList
+	(*timestamppb.Timestamp)(nil),      // 72: google.protobuf.Timestamp
+	(*tasks_service.Task)(nil),         // 73: tasks.Task
+	(*emptypb.Empty)(nil),              // 74: google.protobuf.Empty
 }
 var file_graphs_service_graphs_service_proto_depIdxs = []int32{
-	1,  // 0: graphs.GraphWithNodes.GraphInfo:type_name -> graphs.Graph
-	2,  // 1: graphs.GraphWithNodes.Nodes:type_name -> graphs.Node
-	4,  // 2: graphs.GraphListResponse.Graphs:type_name -> graphs.GraphWithNodes
-	2,  // 3: graphs.NodeResponse.Node:type_name -> graphs.Node
-	2,  // 4: graphs.CreateNodeRequest.Node:type_name -> graphs.Node
-	2,  // 5: graphs.UpdateNodeRequest.Node:type_name -> graphs.Node
-	2,  // 6: graphs.NodeWithDependencies.Node:type_name -> graphs.Node
-	3,  // 7: graphs.DependencyRequest.Dependency:type_name -> graphs.Dependency
-	0,  // 8: graphs.PredictGraphRequest.Priority:type_name -> graphs.Priority
-	2,  // 9: graphs.NodeWithTask.Node:type_name -> graphs.Node
-	24, // 10: graphs.NodeWithTask.Task:type_name -> tasks.Task
-	1,  // 11: graphs.PredictedGraphResponse.Graph:type_name -> graphs.Graph
-	19, // 12: graphs.PredictedGraphResponse.Nodes:type_name -> graphs.NodeWithTask
-	5,  // 13: graphs.PredictedGraphResponse.Paths:type_name -> graphs.Path
-	4,  // 14: graphs.Graphs.CreateGroupGraph:input_type -> graphs.GraphWithNodes
-	7,  // 15: graphs.Graphs.ListGroupGraphs:input_type -> graphs.ListGroupGraphsRequest
-	9,  // 16: graphs.Graphs.GetGraph:input_type -> graphs.GetGraphRequest
-	10, // 17: graphs.Graphs.GetNode:input_type -> graphs.GetNodeRequest
-	12, // 18: graphs.Graphs.CreateNode:input_type -> graphs.CreateNodeRequest
-	13, // 19: graphs.Graphs.UpdateNode:input_type -> graphs.UpdateNodeRequest
-	14, // 20: graphs.Graphs.RemoveNode:input_type -> graphs.RemoveNodeRequest
-	15, // 21: graphs.Graphs.GetDependencies:input_type -> graphs.GetDependenciesRequest
-	17, // 22: graphs.Graphs.AddDependency:input_type -> graphs.DependencyRequest
-	17, // 23: graphs.Graphs.RemoveDependency:input_type -> graphs.DependencyRequest
-	18, // 24: graphs.Graphs.PredictGraph:input_type -> graphs.PredictGraphRequest
-	22, // 25: graphs.Graphs.TaskInNode:input_type -> graphs.TaskInNodeRequest
-	6,  // 26: graphs.Graphs.CreateGroupGraph:output_type -> graphs.GraphResponse
-	8,  // 27: graphs.Graphs.ListGroupGraphs:output_type -> graphs.GraphListResponse
-	4,  // 28: graphs.Graphs.GetGraph:output_type -> graphs.GraphWithNodes
-	11, // 29: graphs.Graphs.GetNode:output_type -> graphs.NodeResponse
-	11, // 30: graphs.Graphs.CreateNode:output_type -> graphs.NodeResponse
-	25, // 31: graphs.Graphs.UpdateNode:output_type -> google.protobuf.Empty
-	25, // 32: graphs.Graphs.RemoveNode:output_type -> google.protobuf.Empty
-	16, // 33: graphs.Graphs.GetDependencies:output_type -> graphs.NodeWithDependencies
-	25, // 34: graphs.Graphs.AddDependency:output_type -> google.protobuf.Empty
-	25, // 35: graphs.Graphs.RemoveDependency:output_type -> google.protobuf.Empty
-	20, // 36: graphs.Graphs.PredictGraph:output_type -> graphs.PredictedGraphResponse
-	23, // 37: graphs.Graphs.TaskInNode:output_type -> graphs.TaskInNodeResponse
-	26, // [26:38] is the sub-list for method output_type
-	14, // [14:26] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	72,  // 0: graphs.Graph.Deadline:type_name -> google.protobuf.Timestamp
+	8,   // 1: graphs.Node.Links:type_name -> graphs.Dependency
+	0,   // 2: graphs.Node.Kind:type_name -> graphs.NodeKind
+	1,   // 3: graphs.Dependency.Type:type_name -> graphs.LinkType
+	6,   // 4: graphs.GraphWithNodes.GraphInfo:type_name -> graphs.Graph
+	7,   // 5: graphs.GraphWithNodes.Nodes:type_name -> graphs.Node
+	10,  // 6: graphs.GraphListResponse.Graphs:type_name -> graphs.GraphWithNodes
+	7,   // 7: graphs.NodeResponse.Node:type_name -> graphs.Node
+	7,   // 8: graphs.CreateNodeRequest.Node:type_name -> graphs.Node
+	7,   // 9: graphs.UpdateNodeRequest.Node:type_name -> graphs.Node
+	7,   // 10: graphs.NodeWithDependencies.Node:type_name -> graphs.Node
+	8,   // 11: graphs.DependencyRequest.Dependency:type_name -> graphs.Dependency
+	2,   // 12: graphs.PredictGraphRequest.Priority:type_name -> graphs.Priority
+	72,  // 13: graphs.PredictGraphRequest.StartAt:type_name -> google.protobuf.Timestamp
+	28,  // 14: graphs.PredictGraphRequest.Scenario:type_name -> graphs.Scenario
+	26,  // 15: graphs.Scenario.Reassignments:type_name -> graphs.TaskReassignment
+	27,  // 16: graphs.Scenario.Durations:type_name -> graphs.DurationOverride
+	8,   // 17: graphs.Scenario.AddDependencies:type_name -> graphs.Dependency
+	8,   // 18: graphs.Scenario.RemoveDependencies:type_name -> graphs.Dependency
+	72,  // 19: graphs.ScenarioDelta.BaseFinishAt:type_name -> google.protobuf.Timestamp
+	48,  // 20: graphs.ScenarioDelta.Nodes:type_name -> graphs.NodeDrift
+	8,   // 21: graphs.ScenarioDelta.AddedDependencies:type_name -> graphs.Dependency
+	8,   // 22: graphs.ScenarioDelta.RemovedDependencies:type_name -> graphs.Dependency
+	11,  // 23: graphs.ScenarioDelta.BasePaths:type_name -> graphs.Path
+	72,  // 24: graphs.NodeSchedule.Start:type_name -> google.protobuf.Timestamp
+	72,  // 25: graphs.NodeSchedule.Finish:type_name -> google.protobuf.Timestamp
+	7,   // 26: graphs.NodeWithTask.Node:type_name -> graphs.Node
+	73,  // 27: graphs.NodeWithTask.Task:type_name -> tasks.Task
+	30,  // 28: graphs.NodeWithTask.Schedule:type_name -> graphs.NodeSchedule
+	6,   // 29: graphs.PredictedGraphResponse.Graph:type_name -> graphs.Graph
+	31,  // 30: graphs.PredictedGraphResponse.Nodes:type_name -> graphs.NodeWithTask
+	11,  // 31: graphs.PredictedGraphResponse.Paths:type_name -> graphs.Path
+	72,  // 32: graphs.PredictedGraphResponse.StartAt:type_name -> google.protobuf.Timestamp
+	72,  // 33: graphs.PredictedGraphResponse.FinishAt:type_name -> google.protobuf.Timestamp
+	29,  // 34: graphs.PredictedGraphResponse.Delta:type_name -> graphs.ScenarioDelta
+	34,  // 35: graphs.PredictedGraphResponse.Deadline:type_name -> graphs.DeadlineStatus
+	72,  // 36: graphs.DeadlineStatus.Deadline:type_name -> google.protobuf.Timestamp
+	72,  // 37: graphs.DeadlineStatus.FinishAt:type_name -> google.protobuf.Timestamp
+	33,  // 38: graphs.DeadlineStatus.Shortenings:type_name -> graphs.NodeShortening
+	72,  // 39: graphs.SetGraphDeadlineRequest.Deadline:type_name -> google.protobuf.Timestamp
+	2,   // 40: graphs.SimulateGraphRequest.Priority:type_name -> graphs.Priority
+	72,  // 41: graphs.SimulateGraphRequest.StartAt:type_name -> google.protobuf.Timestamp
+	6,   // 42: graphs.SimulatedGraphResponse.Graph:type_name -> graphs.Graph
+	37,  // 43: graphs.SimulatedGraphResponse.Nodes:type_name -> graphs.NodeCriticality
+	34,  // 44: graphs.SimulatedGraphResponse.Deadline:type_name -> graphs.DeadlineStatus
+	2,   // 45: graphs.CrashGraphRequest.Priority:type_name -> graphs.Priority
+	72,  // 46: graphs.CrashGraphRequest.StartAt:type_name -> google.protobuf.Timestamp
+	6,   // 47: graphs.CrashGraphResponse.Graph:type_name -> graphs.Graph
+	72,  // 48: graphs.CrashGraphResponse.FinishAt:type_name -> google.protobuf.Timestamp
+	40,  // 49: graphs.CrashGraphResponse.Nodes:type_name -> graphs.NodeCrash
+	72,  // 50: graphs.Baseline.CreatedAt:type_name -> google.protobuf.Timestamp
+	72,  // 51: graphs.Baseline.StartAt:type_name -> google.protobuf.Timestamp
+	72,  // 52: graphs.Baseline.FinishAt:type_name -> google.protobuf.Timestamp
+	2,   // 53: graphs.CreateBaselineRequest.Priority:type_name -> graphs.Priority
+	72,  // 54: graphs.CreateBaselineRequest.StartAt:type_name -> google.protobuf.Timestamp
+	42,  // 55: graphs.BaselineResponse.Baseline:type_name -> graphs.Baseline
+	42,  // 56: graphs.BaselineListResponse.Baselines:type_name -> graphs.Baseline
+	2,   // 57: graphs.CompareBaselineRequest.Priority:type_name -> graphs.Priority
+	72,  // 58: graphs.CompareBaselineRequest.StartAt:type_name -> google.protobuf.Timestamp
+	42,  // 59: graphs.BaselineComparisonResponse.Baseline:type_name -> graphs.Baseline
+	72,  // 60: graphs.BaselineComparisonResponse.CurrentFinishAt:type_name -> google.protobuf.Timestamp
+	48,  // 61: graphs.BaselineComparisonResponse.Nodes:type_name -> graphs.NodeDrift
+	8,   // 62: graphs.BaselineComparisonResponse.AddedDependencies:type_name -> graphs.Dependency
+	8,   // 63: graphs.BaselineComparisonResponse.RemovedDependencies:type_name -> graphs.Dependency
+	11,  // 64: graphs.BaselineComparisonResponse.BaselinePaths:type_name -> graphs.Path
+	11,  // 65: graphs.BaselineComparisonResponse.CurrentPaths:type_name -> graphs.Path
+	72,  // 66: graphs.EVMReportRequest.AsOf:type_name -> google.protobuf.Timestamp
+	42,  // 67: graphs.EVMReportResponse.Baseline:type_name -> graphs.Baseline
+	72,  // 68: graphs.EVMReportResponse.AsOf:type_name -> google.protobuf.Timestamp
+	54,  // 69: graphs.EVMReportResponse.Nodes:type_name -> graphs.NodeEarnedValue
+	3,   // 70: graphs.ExportGraphRequest.Format:type_name -> graphs.ExportFormat
+	2,   // 71: graphs.ExportGraphRequest.Priority:type_name -> graphs.Priority
+	72,  // 72: graphs.ExportGraphRequest.StartAt:type_name -> google.protobuf.Timestamp
+	59,  // 73: graphs.GraphAnalysisResponse.FanOutHotSpots:type_name -> graphs.NodeDegree
+	59,  // 74: graphs.GraphAnalysisResponse.FanInHotSpots:type_name -> graphs.NodeDegree
+	4,   // 75: graphs.GraphEvent.Type:type_name -> graphs.GraphEventType
+	72,  // 76: graphs.GraphEvent.At:type_name -> google.protobuf.Timestamp
+	7,   // 77: graphs.GraphEvent.Node:type_name -> graphs.Node
+	8,   // 78: graphs.GraphEvent.Dependency:type_name -> graphs.Dependency
+	11,  // 79: graphs.GraphEvent.Paths:type_name -> graphs.Path
+	34,  // 80: graphs.GraphEvent.Deadline:type_name -> graphs.DeadlineStatus
+	5,   // 81: graphs.PatchOperation.Type:type_name -> graphs.PatchOperationType
+	7,   // 82: graphs.PatchOperation.Node:type_name -> graphs.Node
+	8,   // 83: graphs.PatchOperation.Dependency:type_name -> graphs.Dependency
+	63,  // 84: graphs.GraphPatchRequest.Operations:type_name -> graphs.PatchOperation
+	65,  // 85: graphs.GraphPatchResponse.CreatedNodes:type_name -> graphs.CreatedNode
+	72,  // 86: graphs.AuditEvent.CreatedAt:type_name -> google.protobuf.Timestamp
+	72,  // 87: graphs.ListAuditEventsRequest.From:type_name -> google.protobuf.Timestamp
+	72,  // 88: graphs.ListAuditEventsRequest.To:type_name -> google.protobuf.Timestamp
+	72,  // 89: graphs.ListAuditEventsRequest.BeforeTime:type_name -> google.protobuf.Timestamp
+	69,  // 90: graphs.AuditEventList.Events:type_name -> graphs.AuditEvent
+	10,  // 91: graphs.Graphs.CreateGroupGraph:input_type -> graphs.GraphWithNodes
+	13,  // 92: graphs.Graphs.ListGroupGraphs:input_type -> graphs.ListGroupGraphsRequest
+	15,  // 93: graphs.Graphs.GetGraph:input_type -> graphs.GetGraphRequest
+	16,  // 94: graphs.Graphs.GetNode:input_type -> graphs.GetNodeRequest
+	18,  // 95: graphs.Graphs.CreateNode:input_type -> graphs.CreateNodeRequest
+	19,  // 96: graphs.Graphs.UpdateNode:input_type -> graphs.UpdateNodeRequest
+	20,  // 97: graphs.Graphs.RemoveNode:input_type -> graphs.RemoveNodeRequest
+	21,  // 98: graphs.Graphs.RestoreNode:input_type -> graphs.RestoreNodeRequest
+	22,  // 99: graphs.Graphs.GetDependencies:input_type -> graphs.GetDependenciesRequest
+	24,  // 100: graphs.Graphs.AddDependency:input_type -> graphs.DependencyRequest
+	24,  // 101: graphs.Graphs.RemoveDependency:input_type -> graphs.DependencyRequest
+	25,  // 102: graphs.Graphs.PredictGraph:input_type -> graphs.PredictGraphRequest
+	36,  // 103: graphs.Graphs.SimulateGraph:input_type -> graphs.SimulateGraphRequest
+	39,  // 104: graphs.Graphs.CrashGraph:input_type -> graphs.CrashGraphRequest
+	43,  // 105: graphs.Graphs.CreateBaseline:input_type -> graphs.CreateBaselineRequest
+	45,  // 106: graphs.Graphs.ListBaselines:input_type -> graphs.ListBaselinesRequest
+	47,  // 107: graphs.Graphs.CompareBaseline:input_type -> graphs.CompareBaselineRequest
+	53,  // 108: graphs.Graphs.GetEVMReport:input_type -> graphs.EVMReportRequest
+	56,  // 109: graphs.Graphs.ExportGraph:input_type -> graphs.ExportGraphRequest
+	58,  // 110: graphs.Graphs.AnalyzeGraph:input_type -> graphs.AnalyzeGraphRequest
+	61,  // 111: graphs.Graphs.WatchGraph:input_type -> graphs.WatchGraphRequest
+	64,  // 112: graphs.Graphs.ApplyGraphPatch:input_type -> graphs.GraphPatchRequest
+	67,  // 113: graphs.Graphs.CloneGraph:input_type -> graphs.CloneGraphRequest
+	68,  // 114: graphs.Graphs.InstantiateTemplate:input_type -> graphs.InstantiateTemplateRequest
+	35,  // 115: graphs.Graphs.SetGraphDeadline:input_type -> graphs.SetGraphDeadlineRequest
+	70,  // 116: graphs.Graphs.ListAuditEvents:input_type -> graphs.ListAuditEventsRequest
+	51,  // 117: graphs.Graphs.TaskInNode:input_type -> graphs.TaskInNodeRequest
+	12,  // 118: graphs.Graphs.CreateGroupGraph:output_type -> graphs.GraphResponse
+	14,  // 119: graphs.Graphs.ListGroupGraphs:output_type -> graphs.GraphListResponse
+	10,  // 120: graphs.Graphs.GetGraph:output_type -> graphs.GraphWithNodes
+	17,  // 121: graphs.Graphs.GetNode:output_type -> graphs.NodeResponse
+	17,  // 122: graphs.Graphs.CreateNode:output_type -> graphs.NodeResponse
+	74,  // 123: graphs.Graphs.UpdateNode:output_type -> google.protobuf.Empty
+	74,  // 124: graphs.Graphs.RemoveNode:output_type -> google.protobuf.Empty
+	17,  // 125: graphs.Graphs.RestoreNode:output_type -> graphs.NodeResponse
+	23,  // 126: graphs.Graphs.GetDependencies:output_type -> graphs.NodeWithDependencies
+	74,  // 127: graphs.Graphs.AddDependency:output_type -> google.protobuf.Empty
+	74,  // 128: graphs.Graphs.RemoveDependency:output_type -> google.protobuf.Empty
+	32,  // 129: graphs.Graphs.PredictGraph:output_type -> graphs.PredictedGraphResponse
+	38,  // 130: graphs.Graphs.SimulateGraph:output_type -> graphs.SimulatedGraphResponse
+	41,  // 131: graphs.Graphs.CrashGraph:output_type -> graphs.CrashGraphResponse
+	44,  // 132: graphs.Graphs.CreateBaseline:output_type -> graphs.BaselineResponse
+	46,  // 133: graphs.Graphs.ListBaselines:output_type -> graphs.BaselineListResponse
+	49,  // 134: graphs.Graphs.CompareBaseline:output_type -> graphs.BaselineComparisonResponse
+	55,  // 135: graphs.Graphs.GetEVMReport:output_type -> graphs.EVMReportResponse
+	57,  // 136: graphs.Graphs.ExportGraph:output_type -> graphs.ExportGraphResponse
+	60,  // 137: graphs.Graphs.AnalyzeGraph:output_type -> graphs.GraphAnalysisResponse
+	62,  // 138: graphs.Graphs.WatchGraph:output_type -> graphs.GraphEvent
+	66,  // 139: graphs.Graphs.ApplyGraphPatch:output_type -> graphs.GraphPatchResponse
+	12,  // 140: graphs.Graphs.CloneGraph:output_type -> graphs.GraphResponse
+	12,  // 141: graphs.Graphs.InstantiateTemplate:output_type -> graphs.GraphResponse
+	74,  // 142: graphs.Graphs.SetGraphDeadline:output_type -> google.protobuf.Empty
+	71,  // 143: graphs.Graphs.ListAuditEvents:output_type -> graphs.AuditEventList
+	52,  // 144: graphs.Graphs.TaskInNode:output_type -> graphs.TaskInNodeResponse
+	118, // [118:145] is the sub-list for method output_type
+	91,  // [91:118] is the sub-list for method input_type
+	91,  // [91:91] is the sub-list for extension type_name
+	91,  // [91:91] is the sub-list for extension extendee
+	0,   // [0:91] is the sub-list for field type_name
 }
 
 func init() { file_graphs_service_graphs_service_proto_init() }
@@ -1600,8 +6248,272 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Node); i {
+		file_graphs_service_graphs_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Dependency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CycleDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraphWithNodes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Path); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraphResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupGraphsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraphListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateNodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateNodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveNodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreNodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDependenciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeWithDependencies); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DependencyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PredictGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskReassignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DurationOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scenario); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScenarioDelta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1612,8 +6524,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Dependency); i {
+		file_graphs_service_graphs_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeSchedule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1624,8 +6536,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GraphWithNodes); i {
+		file_graphs_service_graphs_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeWithTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1636,8 +6548,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Path); i {
+		file_graphs_service_graphs_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PredictedGraphResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1648,8 +6560,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GraphResponse); i {
+		file_graphs_service_graphs_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeShortening); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1660,8 +6572,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListGroupGraphsRequest); i {
+		file_graphs_service_graphs_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadlineStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1672,8 +6584,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GraphListResponse); i {
+		file_graphs_service_graphs_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGraphDeadlineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1684,8 +6596,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGraphRequest); i {
+		file_graphs_service_graphs_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateGraphRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1696,8 +6608,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNodeRequest); i {
+		file_graphs_service_graphs_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeCriticality); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1708,8 +6620,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeResponse); i {
+		file_graphs_service_graphs_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulatedGraphResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1720,8 +6632,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateNodeRequest); i {
+		file_graphs_service_graphs_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrashGraphRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1732,8 +6644,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateNodeRequest); i {
+		file_graphs_service_graphs_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeCrash); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1744,8 +6656,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveNodeRequest); i {
+		file_graphs_service_graphs_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrashGraphResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1756,8 +6668,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDependenciesRequest); i {
+		file_graphs_service_graphs_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Baseline); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1768,8 +6680,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeWithDependencies); i {
+		file_graphs_service_graphs_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBaselineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1780,8 +6692,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DependencyRequest); i {
+		file_graphs_service_graphs_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BaselineResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1792,8 +6704,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PredictGraphRequest); i {
+		file_graphs_service_graphs_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBaselinesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1804,8 +6716,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeWithTask); i {
+		file_graphs_service_graphs_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BaselineListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1816,8 +6728,8 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PredictedGraphResponse); i {
+		file_graphs_service_graphs_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareBaselineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1828,7 +6740,31 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_graphs_service_graphs_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BaselineComparisonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NodeDoneRequest); i {
 			case 0:
 				return &v.state
@@ -1840,7 +6776,7 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_graphs_service_graphs_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaskInNodeRequest); i {
 			case 0:
 				return &v.state
@@ -1852,7 +6788,7 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
-		file_graphs_service_graphs_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_graphs_service_graphs_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaskInNodeResponse); i {
 			case 0:
 				return &v.state
@@ -1864,14 +6800,242 @@ func file_graphs_service_graphs_service_proto_init() {
 				return nil
 			}
 		}
+		file_graphs_service_graphs_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVMReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeEarnedValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EVMReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportGraphResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyzeGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeDegree); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraphAnalysisResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraphEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PatchOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraphPatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatedNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GraphPatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstantiateTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_graphs_service_graphs_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEventList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_graphs_service_graphs_service_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   23,
+			NumEnums:      6,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -30,10 +30,27 @@ type GraphsClient interface {
 	CreateNode(ctx context.Context, in *CreateNodeRequest, opts ...grpc.CallOption) (*NodeResponse, error)
 	UpdateNode(ctx context.Context, in *UpdateNodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveNode(ctx context.Context, in *RemoveNodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Restores a removed node with its dependencies on live nodes,
+	// removed nodes are purged after the retention period
+	RestoreNode(ctx context.Context, in *RestoreNodeRequest, opts ...grpc.CallOption) (*NodeResponse, error)
 	GetDependencies(ctx context.Context, in *GetDependenciesRequest, opts ...grpc.CallOption) (*NodeWithDependencies, error)
 	AddDependency(ctx context.Context, in *DependencyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveDependency(ctx context.Context, in *DependencyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	PredictGraph(ctx context.Context, in *PredictGraphRequest, opts ...grpc.CallOption) (*PredictedGraphResponse, error)
+	SimulateGraph(ctx context.Context, in *SimulateGraphRequest, opts ...grpc.CallOption) (*SimulatedGraphResponse, error)
+	CrashGraph(ctx context.Context, in *CrashGraphRequest, opts ...grpc.CallOption) (*CrashGraphResponse, error)
+	CreateBaseline(ctx context.Context, in *CreateBaselineRequest, opts ...grpc.CallOption) (*BaselineResponse, error)
+	ListBaselines(ctx context.Context, in *ListBaselinesRequest, opts ...grpc.CallOption) (*BaselineListResponse, error)
+	CompareBaseline(ctx context.Context, in *CompareBaselineRequest, opts ...grpc.CallOption) (*BaselineComparisonResponse, error)
+	GetEVMReport(ctx context.Context, in *EVMReportRequest, opts ...grpc.CallOption) (*EVMReportResponse, error)
+	ExportGraph(ctx context.Context, in *ExportGraphRequest, opts ...grpc.CallOption) (*ExportGraphResponse, error)
+	AnalyzeGraph(ctx context.Context, in *AnalyzeGraphRequest, opts ...grpc.CallOption) (*GraphAnalysisResponse, error)
+	WatchGraph(ctx context.Context, in *WatchGraphRequest, opts ...grpc.CallOption) (Graphs_WatchGraphClient, error)
+	ApplyGraphPatch(ctx context.Context, in *GraphPatchRequest, opts ...grpc.CallOption) (*GraphPatchResponse, error)
+	CloneGraph(ctx context.Context, in *CloneGraphRequest, opts ...grpc.CallOption) (*GraphResponse, error)
+	InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*GraphResponse, error)
+	SetGraphDeadline(ctx context.Context, in *SetGraphDeadlineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*AuditEventList, error)
 	TaskInNode(ctx context.Context, in *TaskInNodeRequest, opts ...grpc.CallOption) (*TaskInNodeResponse, error)
 }
 
@@ -108,6 +125,15 @@ func (c *graphsClient) RemoveNode(ctx context.Context, in *RemoveNodeRequest, op
 	return out, nil
 }
 
+func (c *graphsClient) RestoreNode(ctx context.Context, in *RestoreNodeRequest, opts ...grpc.CallOption) (*NodeResponse, error) {
+	out := new(NodeResponse)
+	err := c.cc.Invoke(ctx, "/graphs.Graphs/RestoreNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *graphsClient) GetDependencies(ctx context.Context, in *GetDependenciesRequest, opts ...grpc.CallOption) (*NodeWithDependencies, error) {
 	out := new(NodeWithDependencies)
 	err := c.cc.Invoke(ctx, "/graphs.Graphs/GetDependencies", in, out, opts...)
@@ -144,6 +170,155 @@ func (c *graphsClient) PredictGraph(ctx context.Context, in *PredictGraphRequest
 	return out, nil
 }
 
+func (c *graphsClient) SimulateGraph(ctx context.Context, in *SimulateGraphRequest, opts ...grpc.CallOption) (*SimulatedGraphResponse, error) {
+	out := new(SimulatedGraphResponse)
+	err := c.cc.Invoke(ctx, "/graphs.Graphs/SimulateGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphsClient) CrashGraph(ctx context.Context, in *CrashGraphRequest, opts ...grpc.CallOption) (*CrashGraphResponse, error) {
+	out := new(CrashGraphResponse)
+	err := c.cc.Invoke(ctx, "/graphs.Graphs/CrashGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphsClient) CreateBaseline(ctx context.Context, in *CreateBaselineRequest, opts ...grpc.CallOption) (*BaselineResponse, error) {
+	out := new(BaselineResponse)
+	err := c.cc.Invoke(ctx, "/graphs.Graphs/CreateBaseline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphsClient) ListBaselines(ctx context.Context, in *ListBaselinesRequest, opts ...grpc.CallOption) (*BaselineListResponse, error) {
+	out := new(BaselineListResponse)
+	err := c.cc.Invoke(ctx, "/graphs.Graphs/ListBaselines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphsClient) CompareBaseline(ctx context.Context, in *CompareBaselineRequest, opts ...grpc.CallOption) (*BaselineComparisonResponse, error) {
+	out := new(BaselineComparisonResponse)
+	err := c.cc.Invoke(ctx, "/graphs.Graphs/CompareBaseline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphsClient) GetEVMReport(ctx context.Context, in *EVMReportRequest, opts ...grpc.CallOption) (*EVMReportResponse, error) {
+	out := new(EVMReportResponse)
+	err := c.cc.Invoke(ctx, "/graphs.Graphs/GetEVMReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphsClient) ExportGraph(ctx context.Context, in *ExportGraphRequest, opts ...grpc.CallOption) (*ExportGraphResponse, error) {
+	out := new(ExportGraphResponse)
+	err := c.cc.Invoke(ctx, "/graphs.Graphs/ExportGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphsClient) AnalyzeGraph(ctx context.Context, in *AnalyzeGraphRequest, opts ...grpc.CallOption) (*GraphAnalysisResponse, error) {
+	out := new(GraphAnalysisResponse)
+	err := c.cc.Invoke(ctx, "/graphs.Graphs/AnalyzeGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphsClient) WatchGraph(ctx context.Context, in *WatchGraphRequest, opts ...grpc.CallOption) (Graphs_WatchGraphClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Graphs_ServiceDesc.Streams[0], "/graphs.Graphs/WatchGraph", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &graphsWatchGraphClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Graphs_WatchGraphClient interface {
+	Recv() (*GraphEvent, error)
+	grpc.ClientStream
+}
+
+type graphsWatchGraphClient struct {
+	grpc.ClientStream
+}
+
+func (x *graphsWatchGraphClient) Recv() (*GraphEvent, error) {
+	m := new(GraphEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *graphsClient) ApplyGraphPatch(ctx context.Context, in *GraphPatchRequest, opts ...grpc.CallOption) (*GraphPatchResponse, error) {
+	out := new(GraphPatchResponse)
+	err := c.cc.Invoke(ctx, "/graphs.Graphs/ApplyGraphPatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphsClient) CloneGraph(ctx context.Context, in *CloneGraphRequest, opts ...grpc.CallOption) (*GraphResponse, error) {
+	out := new(GraphResponse)
+	err := c.cc.Invoke(ctx, "/graphs.Graphs/CloneGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphsClient) InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*GraphResponse, error) {
+	out := new(GraphResponse)
+	err := c.cc.Invoke(ctx, "/graphs.Graphs/InstantiateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphsClient) SetGraphDeadline(ctx context.Context, in *SetGraphDeadlineRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/graphs.Graphs/SetGraphDeadline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *graphsClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*AuditEventList, error) {
+	out := new(AuditEventList)
+	err := c.cc.Invoke(ctx, "/graphs.Graphs/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *graphsClient) TaskInNode(ctx context.Context, in *TaskInNodeRequest, opts ...grpc.CallOption) (*TaskInNodeResponse, error) {
 	out := new(TaskInNodeResponse)
 	err := c.cc.Invoke(ctx, "/graphs.Graphs/TaskInNode", in, out, opts...)
@@ -164,10 +339,27 @@ type GraphsServer interface {
 	CreateNode(context.Context, *CreateNodeRequest) (*NodeResponse, error)
 	UpdateNode(context.Context, *UpdateNodeRequest) (*emptypb.Empty, error)
 	RemoveNode(context.Context, *RemoveNodeRequest) (*emptypb.Empty, error)
+	// Restores a removed node with its dependencies on live nodes,
+	// removed nodes are purged after the retention period
+	RestoreNode(context.Context, *RestoreNodeRequest) (*NodeResponse, error)
 	GetDependencies(context.Context, *GetDependenciesRequest) (*NodeWithDependencies, error)
 	AddDependency(context.Context, *DependencyRequest) (*emptypb.Empty, error)
 	RemoveDependency(context.Context, *DependencyRequest) (*emptypb.Empty, error)
 	PredictGraph(context.Context, *PredictGraphRequest) (*PredictedGraphResponse, error)
+	SimulateGraph(context.Context, *SimulateGraphRequest) (*SimulatedGraphResponse, error)
+	CrashGraph(context.Context, *CrashGraphRequest) (*CrashGraphResponse, error)
+	CreateBaseline(context.Context, *CreateBaselineRequest) (*BaselineResponse, error)
+	ListBaselines(context.Context, *ListBaselinesRequest) (*BaselineListResponse, error)
+	CompareBaseline(context.Context, *CompareBaselineRequest) (*BaselineComparisonResponse, error)
+	GetEVMReport(context.Context, *EVMReportRequest) (*EVMReportResponse, error)
+	ExportGraph(context.Context, *ExportGraphRequest) (*ExportGraphResponse, error)
+	AnalyzeGraph(context.Context, *AnalyzeGraphRequest) (*GraphAnalysisResponse, error)
+	WatchGraph(*WatchGraphRequest, Graphs_WatchGraphServer) error
+	ApplyGraphPatch(context.Context, *GraphPatchRequest) (*GraphPatchResponse, error)
+	CloneGraph(context.Context, *CloneGraphRequest) (*GraphResponse, error)
+	InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*GraphResponse, error)
+	SetGraphDeadline(context.Context, *SetGraphDeadlineRequest) (*emptypb.Empty, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*AuditEventList, error)
 	TaskInNode(context.Context, *TaskInNodeRequest) (*TaskInNodeResponse, error)
 	mustEmbedUnimplementedGraphsServer()
 }
@@ -197,6 +389,9 @@ func (UnimplementedGraphsServer) UpdateNode(context.Context, *UpdateNodeRequest)
 func (UnimplementedGraphsServer) RemoveNode(context.Context, *RemoveNodeRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveNode not implemented")
 }
+func (UnimplementedGraphsServer) RestoreNode(context.Context, *RestoreNodeRequest) (*NodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreNode not implemented")
+}
 func (UnimplementedGraphsServer) GetDependencies(context.Context, *GetDependenciesRequest) (*NodeWithDependencies, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDependencies not implemented")
 }
@@ -209,6 +404,48 @@ func (UnimplementedGraphsServer) RemoveDependency(context.Context, *DependencyRe
 func (UnimplementedGraphsServer) PredictGraph(context.Context, *PredictGraphRequest) (*PredictedGraphResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PredictGraph not implemented")
 }
+func (UnimplementedGraphsServer) SimulateGraph(context.Context, *SimulateGraphRequest) (*SimulatedGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateGraph not implemented")
+}
+func (UnimplementedGraphsServer) CrashGraph(context.Context, *CrashGraphRequest) (*CrashGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrashGraph not implemented")
+}
+func (UnimplementedGraphsServer) CreateBaseline(context.Context, *CreateBaselineRequest) (*BaselineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBaseline not implemented")
+}
+func (UnimplementedGraphsServer) ListBaselines(context.Context, *ListBaselinesRequest) (*BaselineListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBaselines not implemented")
+}
+func (UnimplementedGraphsServer) CompareBaseline(context.Context, *CompareBaselineRequest) (*BaselineComparisonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareBaseline not implemented")
+}
+func (UnimplementedGraphsServer) GetEVMReport(context.Context, *EVMReportRequest) (*EVMReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEVMReport not implemented")
+}
+func (UnimplementedGraphsServer) ExportGraph(context.Context, *ExportGraphRequest) (*ExportGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGraph not implemented")
+}
+func (UnimplementedGraphsServer) AnalyzeGraph(context.Context, *AnalyzeGraphRequest) (*GraphAnalysisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeGraph not implemented")
+}
+func (UnimplementedGraphsServer) WatchGraph(*WatchGraphRequest, Graphs_WatchGraphServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGraph not implemented")
+}
+func (UnimplementedGraphsServer) ApplyGraphPatch(context.Context, *GraphPatchRequest) (*GraphPatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyGraphPatch not implemented")
+}
+func (UnimplementedGraphsServer) CloneGraph(context.Context, *CloneGraphRequest) (*GraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneGraph not implemented")
+}
+func (UnimplementedGraphsServer) InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*GraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateTemplate not implemented")
+}
+func (UnimplementedGraphsServer) SetGraphDeadline(context.Context, *SetGraphDeadlineRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGraphDeadline not implemented")
+}
+func (UnimplementedGraphsServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*AuditEventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedGraphsServer) TaskInNode(context.Context, *TaskInNodeRequest) (*TaskInNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TaskInNode not implemented")
 }
@@ -351,6 +588,24 @@ func _Graphs_RemoveNode_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Graphs_RestoreNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphsServer).RestoreNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphs.Graphs/RestoreNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphsServer).RestoreNode(ctx, req.(*RestoreNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Graphs_GetDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDependenciesRequest)
 	if err := dec(in); err != nil {
@@ -423,6 +678,261 @@ func _Graphs_PredictGraph_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Graphs_SimulateGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphsServer).SimulateGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphs.Graphs/SimulateGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphsServer).SimulateGraph(ctx, req.(*SimulateGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graphs_CrashGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrashGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphsServer).CrashGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphs.Graphs/CrashGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphsServer).CrashGraph(ctx, req.(*CrashGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graphs_CreateBaseline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBaselineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphsServer).CreateBaseline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphs.Graphs/CreateBaseline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphsServer).CreateBaseline(ctx, req.(*CreateBaselineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graphs_ListBaselines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBaselinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphsServer).ListBaselines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphs.Graphs/ListBaselines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphsServer).ListBaselines(ctx, req.(*ListBaselinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graphs_CompareBaseline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareBaselineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphsServer).CompareBaseline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphs.Graphs/CompareBaseline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphsServer).CompareBaseline(ctx, req.(*CompareBaselineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graphs_GetEVMReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EVMReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphsServer).GetEVMReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphs.Graphs/GetEVMReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphsServer).GetEVMReport(ctx, req.(*EVMReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graphs_ExportGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphsServer).ExportGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphs.Graphs/ExportGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphsServer).ExportGraph(ctx, req.(*ExportGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graphs_AnalyzeGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphsServer).AnalyzeGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphs.Graphs/AnalyzeGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphsServer).AnalyzeGraph(ctx, req.(*AnalyzeGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graphs_WatchGraph_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGraphRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GraphsServer).WatchGraph(m, &graphsWatchGraphServer{stream})
+}
+
+type Graphs_WatchGraphServer interface {
+	Send(*GraphEvent) error
+	grpc.ServerStream
+}
+
+type graphsWatchGraphServer struct {
+	grpc.ServerStream
+}
+
+func (x *graphsWatchGraphServer) Send(m *GraphEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Graphs_ApplyGraphPatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GraphPatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphsServer).ApplyGraphPatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphs.Graphs/ApplyGraphPatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphsServer).ApplyGraphPatch(ctx, req.(*GraphPatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graphs_CloneGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphsServer).CloneGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphs.Graphs/CloneGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphsServer).CloneGraph(ctx, req.(*CloneGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graphs_InstantiateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstantiateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphsServer).InstantiateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphs.Graphs/InstantiateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphsServer).InstantiateTemplate(ctx, req.(*InstantiateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graphs_SetGraphDeadline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGraphDeadlineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphsServer).SetGraphDeadline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphs.Graphs/SetGraphDeadline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphsServer).SetGraphDeadline(ctx, req.(*SetGraphDeadlineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Graphs_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GraphsServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/graphs.Graphs/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GraphsServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Graphs_TaskInNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TaskInNodeRequest)
 	if err := dec(in); err != nil {
@@ -476,6 +986,10 @@ var Graphs_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveNode",
 			Handler:    _Graphs_RemoveNode_Handler,
 		},
+		{
+			MethodName: "RestoreNode",
+			Handler:    _Graphs_RestoreNode_Handler,
+		},
 		{
 			MethodName: "GetDependencies",
 			Handler:    _Graphs_GetDependencies_Handler,
@@ -492,11 +1006,69 @@ var Graphs_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PredictGraph",
 			Handler:    _Graphs_PredictGraph_Handler,
 		},
+		{
+			MethodName: "SimulateGraph",
+			Handler:    _Graphs_SimulateGraph_Handler,
+		},
+		{
+			MethodName: "CrashGraph",
+			Handler:    _Graphs_CrashGraph_Handler,
+		},
+		{
+			MethodName: "CreateBaseline",
+			Handler:    _Graphs_CreateBaseline_Handler,
+		},
+		{
+			MethodName: "ListBaselines",
+			Handler:    _Graphs_ListBaselines_Handler,
+		},
+		{
+			MethodName: "CompareBaseline",
+			Handler:    _Graphs_CompareBaseline_Handler,
+		},
+		{
+			MethodName: "GetEVMReport",
+			Handler:    _Graphs_GetEVMReport_Handler,
+		},
+		{
+			MethodName: "ExportGraph",
+			Handler:    _Graphs_ExportGraph_Handler,
+		},
+		{
+			MethodName: "AnalyzeGraph",
+			Handler:    _Graphs_AnalyzeGraph_Handler,
+		},
+		{
+			MethodName: "ApplyGraphPatch",
+			Handler:    _Graphs_ApplyGraphPatch_Handler,
+		},
+		{
+			MethodName: "CloneGraph",
+			Handler:    _Graphs_CloneGraph_Handler,
+		},
+		{
+			MethodName: "InstantiateTemplate",
+			Handler:    _Graphs_InstantiateTemplate_Handler,
+		},
+		{
+			MethodName: "SetGraphDeadline",
+			Handler:    _Graphs_SetGraphDeadline_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Graphs_ListAuditEvents_Handler,
+		},
 		{
 			MethodName: "TaskInNode",
 			Handler:    _Graphs_TaskInNode_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGraph",
+			Handler:       _Graphs_WatchGraph_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "graphs_service/graphs_service.proto",
 }
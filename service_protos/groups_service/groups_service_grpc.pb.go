@@ -26,7 +26,10 @@ type GroupsClient interface {
 	CreateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*GroupID, error)
 	ListUserGroups(ctx context.Context, in *Offset, opts ...grpc.CallOption) (*GroupsList, error)
 	GetGroup(ctx context.Context, in *GroupID, opts ...grpc.CallOption) (*Group, error)
+	// deleted group is kept with its members until it's purged after the retention period
 	DeleteGroup(ctx context.Context, in *GroupID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// restores deleted group, only owner can restore the group
+	RestoreGroup(ctx context.Context, in *GroupID, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListGroupMembers(ctx context.Context, in *GroupID, opts ...grpc.CallOption) (*GroupMembersList, error)
 	AddGroupMember(ctx context.Context, in *GroupMember, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -35,6 +38,10 @@ type GroupsClient interface {
 	CheckAdminPermission(ctx context.Context, in *GroupMember, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CheckEditorPermission(ctx context.Context, in *GroupMember, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CheckMemberPermission(ctx context.Context, in *GroupMember, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetUserCalendar(ctx context.Context, in *UserCalendar, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUserCalendar(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserCalendar, error)
+	GetGroupCalendars(ctx context.Context, in *GroupID, opts ...grpc.CallOption) (*UserCalendarsList, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*AuditEventList, error)
 }
 
 type groupsClient struct {
@@ -81,6 +88,15 @@ func (c *groupsClient) DeleteGroup(ctx context.Context, in *GroupID, opts ...grp
 	return out, nil
 }
 
+func (c *groupsClient) RestoreGroup(ctx context.Context, in *GroupID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/groups.Groups/RestoreGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *groupsClient) UpdateGroup(ctx context.Context, in *Group, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/groups.Groups/UpdateGroup", in, out, opts...)
@@ -153,6 +169,42 @@ func (c *groupsClient) CheckMemberPermission(ctx context.Context, in *GroupMembe
 	return out, nil
 }
 
+func (c *groupsClient) SetUserCalendar(ctx context.Context, in *UserCalendar, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/groups.Groups/SetUserCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) GetUserCalendar(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*UserCalendar, error) {
+	out := new(UserCalendar)
+	err := c.cc.Invoke(ctx, "/groups.Groups/GetUserCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) GetGroupCalendars(ctx context.Context, in *GroupID, opts ...grpc.CallOption) (*UserCalendarsList, error) {
+	out := new(UserCalendarsList)
+	err := c.cc.Invoke(ctx, "/groups.Groups/GetGroupCalendars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*AuditEventList, error) {
+	out := new(AuditEventList)
+	err := c.cc.Invoke(ctx, "/groups.Groups/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupsServer is the server API for Groups service.
 // All implementations must embed UnimplementedGroupsServer
 // for forward compatibility
@@ -160,7 +212,10 @@ type GroupsServer interface {
 	CreateGroup(context.Context, *Group) (*GroupID, error)
 	ListUserGroups(context.Context, *Offset) (*GroupsList, error)
 	GetGroup(context.Context, *GroupID) (*Group, error)
+	// deleted group is kept with its members until it's purged after the retention period
 	DeleteGroup(context.Context, *GroupID) (*emptypb.Empty, error)
+	// restores deleted group, only owner can restore the group
+	RestoreGroup(context.Context, *GroupID) (*emptypb.Empty, error)
 	UpdateGroup(context.Context, *Group) (*emptypb.Empty, error)
 	ListGroupMembers(context.Context, *GroupID) (*GroupMembersList, error)
 	AddGroupMember(context.Context, *GroupMember) (*emptypb.Empty, error)
@@ -169,6 +224,10 @@ type GroupsServer interface {
 	CheckAdminPermission(context.Context, *GroupMember) (*emptypb.Empty, error)
 	CheckEditorPermission(context.Context, *GroupMember) (*emptypb.Empty, error)
 	CheckMemberPermission(context.Context, *GroupMember) (*emptypb.Empty, error)
+	SetUserCalendar(context.Context, *UserCalendar) (*emptypb.Empty, error)
+	GetUserCalendar(context.Context, *emptypb.Empty) (*UserCalendar, error)
+	GetGroupCalendars(context.Context, *GroupID) (*UserCalendarsList, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*AuditEventList, error)
 	mustEmbedUnimplementedGroupsServer()
 }
 
@@ -188,6 +247,9 @@ func (UnimplementedGroupsServer) GetGroup(context.Context, *GroupID) (*Group, er
 func (UnimplementedGroupsServer) DeleteGroup(context.Context, *GroupID) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
 }
+func (UnimplementedGroupsServer) RestoreGroup(context.Context, *GroupID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreGroup not implemented")
+}
 func (UnimplementedGroupsServer) UpdateGroup(context.Context, *Group) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
 }
@@ -212,6 +274,18 @@ func (UnimplementedGroupsServer) CheckEditorPermission(context.Context, *GroupMe
 func (UnimplementedGroupsServer) CheckMemberPermission(context.Context, *GroupMember) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckMemberPermission not implemented")
 }
+func (UnimplementedGroupsServer) SetUserCalendar(context.Context, *UserCalendar) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserCalendar not implemented")
+}
+func (UnimplementedGroupsServer) GetUserCalendar(context.Context, *emptypb.Empty) (*UserCalendar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserCalendar not implemented")
+}
+func (UnimplementedGroupsServer) GetGroupCalendars(context.Context, *GroupID) (*UserCalendarsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupCalendars not implemented")
+}
+func (UnimplementedGroupsServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*AuditEventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedGroupsServer) mustEmbedUnimplementedGroupsServer() {}
 
 // UnsafeGroupsServer may be embedded to opt out of forward compatibility for this service.
@@ -297,6 +371,24 @@ func _Groups_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Groups_RestoreGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).RestoreGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groups.Groups/RestoreGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).RestoreGroup(ctx, req.(*GroupID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Groups_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Group)
 	if err := dec(in); err != nil {
@@ -441,6 +533,78 @@ func _Groups_CheckMemberPermission_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Groups_SetUserCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCalendar)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).SetUserCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groups.Groups/SetUserCalendar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).SetUserCalendar(ctx, req.(*UserCalendar))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_GetUserCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).GetUserCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groups.Groups/GetUserCalendar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).GetUserCalendar(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_GetGroupCalendars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GroupID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).GetGroupCalendars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groups.Groups/GetGroupCalendars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).GetGroupCalendars(ctx, req.(*GroupID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groups.Groups/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Groups_ServiceDesc is the grpc.ServiceDesc for Groups service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -464,6 +628,10 @@ var Groups_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteGroup",
 			Handler:    _Groups_DeleteGroup_Handler,
 		},
+		{
+			MethodName: "RestoreGroup",
+			Handler:    _Groups_RestoreGroup_Handler,
+		},
 		{
 			MethodName: "UpdateGroup",
 			Handler:    _Groups_UpdateGroup_Handler,
@@ -496,6 +664,22 @@ var Groups_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckMemberPermission",
 			Handler:    _Groups_CheckMemberPermission_Handler,
 		},
+		{
+			MethodName: "SetUserCalendar",
+			Handler:    _Groups_SetUserCalendar_Handler,
+		},
+		{
+			MethodName: "GetUserCalendar",
+			Handler:    _Groups_GetUserCalendar_Handler,
+		},
+		{
+			MethodName: "GetGroupCalendars",
+			Handler:    _Groups_GetGroupCalendars_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Groups_ListAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "groups_service/groups_service.proto",
@@ -22,6 +22,162 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TaskStatus int32
+
+const (
+	TaskStatus_Backlog    TaskStatus = 0
+	TaskStatus_InProgress TaskStatus = 1
+	TaskStatus_Blocked    TaskStatus = 2
+	TaskStatus_Review     TaskStatus = 3
+	TaskStatus_Done       TaskStatus = 4
+	TaskStatus_Cancelled  TaskStatus = 5
+)
+
+// Enum value maps for TaskStatus.
+var (
+	TaskStatus_name = map[int32]string{
+		0: "Backlog",
+		1: "InProgress",
+		2: "Blocked",
+		3: "Review",
+		4: "Done",
+		5: "Cancelled",
+	}
+	TaskStatus_value = map[string]int32{
+		"Backlog":    0,
+		"InProgress": 1,
+		"Blocked":    2,
+		"Review":     3,
+		"Done":       4,
+		"Cancelled":  5,
+	}
+)
+
+func (x TaskStatus) Enum() *TaskStatus {
+	p := new(TaskStatus)
+	*p = x
+	return p
+}
+
+func (x TaskStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_tasks_service_tasks_service_proto_enumTypes[0].Descriptor()
+}
+
+func (TaskStatus) Type() protoreflect.EnumType {
+	return &file_tasks_service_tasks_service_proto_enumTypes[0]
+}
+
+func (x TaskStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskStatus.Descriptor instead.
+func (TaskStatus) EnumDescriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{0}
+}
+
+type TaskState int32
+
+const (
+	TaskState_AnyState  TaskState = 0
+	TaskState_Open      TaskState = 1
+	TaskState_Completed TaskState = 2
+)
+
+// Enum value maps for TaskState.
+var (
+	TaskState_name = map[int32]string{
+		0: "AnyState",
+		1: "Open",
+		2: "Completed",
+	}
+	TaskState_value = map[string]int32{
+		"AnyState":  0,
+		"Open":      1,
+		"Completed": 2,
+	}
+)
+
+func (x TaskState) Enum() *TaskState {
+	p := new(TaskState)
+	*p = x
+	return p
+}
+
+func (x TaskState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskState) Descriptor() protoreflect.EnumDescriptor {
+	return file_tasks_service_tasks_service_proto_enumTypes[1].Descriptor()
+}
+
+func (TaskState) Type() protoreflect.EnumType {
+	return &file_tasks_service_tasks_service_proto_enumTypes[1]
+}
+
+func (x TaskState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskState.Descriptor instead.
+func (TaskState) EnumDescriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{1}
+}
+
+type TaskSortKey int32
+
+const (
+	TaskSortKey_CreatedAt   TaskSortKey = 0
+	TaskSortKey_PlannedTime TaskSortKey = 1
+	TaskSortKey_Title       TaskSortKey = 2
+)
+
+// Enum value maps for TaskSortKey.
+var (
+	TaskSortKey_name = map[int32]string{
+		0: "CreatedAt",
+		1: "PlannedTime",
+		2: "Title",
+	}
+	TaskSortKey_value = map[string]int32{
+		"CreatedAt":   0,
+		"PlannedTime": 1,
+		"Title":       2,
+	}
+)
+
+func (x TaskSortKey) Enum() *TaskSortKey {
+	p := new(TaskSortKey)
+	*p = x
+	return p
+}
+
+func (x TaskSortKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskSortKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_tasks_service_tasks_service_proto_enumTypes[2].Descriptor()
+}
+
+func (TaskSortKey) Type() protoreflect.EnumType {
+	return &file_tasks_service_tasks_service_proto_enumTypes[2]
+}
+
+func (x TaskSortKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskSortKey.Descriptor instead.
+func (TaskSortKey) EnumDescriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{2}
+}
+
 type Task struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -37,6 +193,15 @@ type Task struct {
 	Tags        []int32                `protobuf:"varint,8,rep,packed,name=Tags,proto3" json:"Tags,omitempty"`
 	ActualTime  float64                `protobuf:"fixed64,9,opt,name=ActualTime,proto3" json:"ActualTime,omitempty"`
 	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	// 0..100, completed task always has 100
+	PercentComplete float64                `protobuf:"fixed64,11,opt,name=PercentComplete,proto3" json:"PercentComplete,omitempty"`
+	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=CompletedAt,proto3" json:"CompletedAt,omitempty"`
+	// cost of shortening the task by one hour
+	CrashCost float64 `protobuf:"fixed64,13,opt,name=CrashCost,proto3" json:"CrashCost,omitempty"`
+	// minimal time of the shortened task, 0 if the task can't be shortened
+	CrashMinTime float64 `protobuf:"fixed64,14,opt,name=CrashMinTime,proto3" json:"CrashMinTime,omitempty"`
+	// changed only by TransitionTask, completed task is Done
+	Status TaskStatus `protobuf:"varint,15,opt,name=Status,proto3,enum=tasks.TaskStatus" json:"Status,omitempty"`
 }
 
 func (x *Task) Reset() {
@@ -141,12 +306,49 @@ func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Task) GetPercentComplete() float64 {
+	if x != nil {
+		return x.PercentComplete
+	}
+	return 0
+}
+
+func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Task) GetCrashCost() float64 {
+	if x != nil {
+		return x.CrashCost
+	}
+	return 0
+}
+
+func (x *Task) GetCrashMinTime() float64 {
+	if x != nil {
+		return x.CrashMinTime
+	}
+	return 0
+}
+
+func (x *Task) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_Backlog
+}
+
 type TaskList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Tasks []*Task `protobuf:"bytes,1,rep,name=Tasks,proto3" json:"Tasks,omitempty"`
+	// cursor of the next page, empty if there are no more tasks
+	NextCursor string `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 }
 
 func (x *TaskList) Reset() {
@@ -188,6 +390,13 @@ func (x *TaskList) GetTasks() []*Task {
 	return nil
 }
 
+func (x *TaskList) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type TaskID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -345,18 +554,142 @@ func (x *TaskExistsRequest) GetGroupID() int64 {
 	return 0
 }
 
+// zero fields don't filter tasks
+type TaskFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID    int64   `protobuf:"varint,1,opt,name=GroupID,proto3" json:"GroupID,omitempty"`
+	AssignedTo int64   `protobuf:"varint,2,opt,name=AssignedTo,proto3" json:"AssignedTo,omitempty"`
+	Tags       []int32 `protobuf:"varint,3,rep,packed,name=Tags,proto3" json:"Tags,omitempty"`
+	// task must have all Tags, otherwise any of them
+	AllTags bool      `protobuf:"varint,4,opt,name=AllTags,proto3" json:"AllTags,omitempty"`
+	State   TaskState `protobuf:"varint,5,opt,name=State,proto3,enum=tasks.TaskState" json:"State,omitempty"`
+	// created at range [CreatedFrom, CreatedTo)
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedFrom,proto3" json:"CreatedFrom,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=CreatedTo,proto3" json:"CreatedTo,omitempty"`
+	// case insensitive title substring
+	Title string `protobuf:"bytes,8,opt,name=Title,proto3" json:"Title,omitempty"`
+	// task has any of the statuses
+	Statuses []TaskStatus `protobuf:"varint,9,rep,packed,name=Statuses,proto3,enum=tasks.TaskStatus" json:"Statuses,omitempty"`
+}
+
+func (x *TaskFilter) Reset() {
+	*x = TaskFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskFilter) ProtoMessage() {}
+
+func (x *TaskFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskFilter.ProtoReflect.Descriptor instead.
+func (*TaskFilter) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TaskFilter) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *TaskFilter) GetAssignedTo() int64 {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return 0
+}
+
+func (x *TaskFilter) GetTags() []int32 {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetAllTags() bool {
+	if x != nil {
+		return x.AllTags
+	}
+	return false
+}
+
+func (x *TaskFilter) GetState() TaskState {
+	if x != nil {
+		return x.State
+	}
+	return TaskState_AnyState
+}
+
+func (x *TaskFilter) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TaskFilter) GetStatuses() []TaskStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
 type TaskListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Offset int64 `protobuf:"varint,1,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Filter *TaskFilter `protobuf:"bytes,2,opt,name=Filter,proto3" json:"Filter,omitempty"`
+	SortBy TaskSortKey `protobuf:"varint,3,opt,name=SortBy,proto3,enum=tasks.TaskSortKey" json:"SortBy,omitempty"`
+	// tasks are sorted in descending order by default
+	Asc bool `protobuf:"varint,4,opt,name=Asc,proto3" json:"Asc,omitempty"`
+	// opaque cursor from the previous page, empty for the first page,
+	// must be used with the same filter and sort
+	Cursor string `protobuf:"bytes,5,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	// page size, 0 for default
+	Limit int32 `protobuf:"varint,6,opt,name=Limit,proto3" json:"Limit,omitempty"`
 }
 
 func (x *TaskListRequest) Reset() {
 	*x = TaskListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tasks_service_tasks_service_proto_msgTypes[5]
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -369,7 +702,7 @@ func (x *TaskListRequest) String() string {
 func (*TaskListRequest) ProtoMessage() {}
 
 func (x *TaskListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tasks_service_tasks_service_proto_msgTypes[5]
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -382,12 +715,40 @@ func (x *TaskListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskListRequest.ProtoReflect.Descriptor instead.
 func (*TaskListRequest) Descriptor() ([]byte, []int) {
-	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{5}
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TaskListRequest) GetFilter() *TaskFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *TaskListRequest) GetSortBy() TaskSortKey {
+	if x != nil {
+		return x.SortBy
+	}
+	return TaskSortKey_CreatedAt
+}
+
+func (x *TaskListRequest) GetAsc() bool {
+	if x != nil {
+		return x.Asc
+	}
+	return false
 }
 
-func (x *TaskListRequest) GetOffset() int64 {
+func (x *TaskListRequest) GetCursor() string {
 	if x != nil {
-		return x.Offset
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *TaskListRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
@@ -405,7 +766,7 @@ type PredictedTask struct {
 func (x *PredictedTask) Reset() {
 	*x = PredictedTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tasks_service_tasks_service_proto_msgTypes[6]
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -418,7 +779,7 @@ func (x *PredictedTask) String() string {
 func (*PredictedTask) ProtoMessage() {}
 
 func (x *PredictedTask) ProtoReflect() protoreflect.Message {
-	mi := &file_tasks_service_tasks_service_proto_msgTypes[6]
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -431,7 +792,7 @@ func (x *PredictedTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PredictedTask.ProtoReflect.Descriptor instead.
 func (*PredictedTask) Descriptor() ([]byte, []int) {
-	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{6}
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PredictedTask) GetTask() *Task {
@@ -467,7 +828,7 @@ type PredictedTaskList struct {
 func (x *PredictedTaskList) Reset() {
 	*x = PredictedTaskList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tasks_service_tasks_service_proto_msgTypes[7]
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -480,7 +841,7 @@ func (x *PredictedTaskList) String() string {
 func (*PredictedTaskList) ProtoMessage() {}
 
 func (x *PredictedTaskList) ProtoReflect() protoreflect.Message {
-	mi := &file_tasks_service_tasks_service_proto_msgTypes[7]
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +854,7 @@ func (x *PredictedTaskList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PredictedTaskList.ProtoReflect.Descriptor instead.
 func (*PredictedTaskList) Descriptor() ([]byte, []int) {
-	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{7}
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PredictedTaskList) GetTasks() []*PredictedTask {
@@ -521,7 +882,7 @@ type TasksIDs struct {
 func (x *TasksIDs) Reset() {
 	*x = TasksIDs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tasks_service_tasks_service_proto_msgTypes[8]
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -534,7 +895,7 @@ func (x *TasksIDs) String() string {
 func (*TasksIDs) ProtoMessage() {}
 
 func (x *TasksIDs) ProtoReflect() protoreflect.Message {
-	mi := &file_tasks_service_tasks_service_proto_msgTypes[8]
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +908,7 @@ func (x *TasksIDs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TasksIDs.ProtoReflect.Descriptor instead.
 func (*TasksIDs) Descriptor() ([]byte, []int) {
-	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{8}
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TasksIDs) GetIDs() []int64 {
@@ -557,33 +918,33 @@ func (x *TasksIDs) GetIDs() []int64 {
 	return nil
 }
 
-type TaskTimes struct {
+type TaskAssignee struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID          int64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	PlannedTime float64 `protobuf:"fixed64,2,opt,name=PlannedTime,proto3" json:"PlannedTime,omitempty"`
-	ActualTime  float64 `protobuf:"fixed64,3,opt,name=ActualTime,proto3" json:"ActualTime,omitempty"`
+	TaskID int64 `protobuf:"varint,1,opt,name=TaskID,proto3" json:"TaskID,omitempty"`
+	// user whose model predicts the task
+	AssignedTo int64 `protobuf:"varint,2,opt,name=AssignedTo,proto3" json:"AssignedTo,omitempty"`
 }
 
-func (x *TaskTimes) Reset() {
-	*x = TaskTimes{}
+func (x *TaskAssignee) Reset() {
+	*x = TaskAssignee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tasks_service_tasks_service_proto_msgTypes[9]
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskTimes) String() string {
+func (x *TaskAssignee) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskTimes) ProtoMessage() {}
+func (*TaskAssignee) ProtoMessage() {}
 
-func (x *TaskTimes) ProtoReflect() protoreflect.Message {
-	mi := &file_tasks_service_tasks_service_proto_msgTypes[9]
+func (x *TaskAssignee) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,57 +955,160 @@ func (x *TaskTimes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskTimes.ProtoReflect.Descriptor instead.
-func (*TaskTimes) Descriptor() ([]byte, []int) {
-	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *TaskTimes) GetID() int64 {
-	if x != nil {
-		return x.ID
-	}
-	return 0
+// Deprecated: Use TaskAssignee.ProtoReflect.Descriptor instead.
+func (*TaskAssignee) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TaskTimes) GetPlannedTime() float64 {
+func (x *TaskAssignee) GetTaskID() int64 {
 	if x != nil {
-		return x.PlannedTime
+		return x.TaskID
 	}
 	return 0
 }
 
-func (x *TaskTimes) GetActualTime() float64 {
+func (x *TaskAssignee) GetAssignedTo() int64 {
 	if x != nil {
-		return x.ActualTime
+		return x.AssignedTo
 	}
 	return 0
 }
 
-type TasksTimes struct {
+type ReassignedTasks struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Times []*TaskTimes `protobuf:"bytes,1,rep,name=Times,proto3" json:"Times,omitempty"`
+	Tasks []*TaskAssignee `protobuf:"bytes,1,rep,name=Tasks,proto3" json:"Tasks,omitempty"`
 }
 
-func (x *TasksTimes) Reset() {
-	*x = TasksTimes{}
+func (x *ReassignedTasks) Reset() {
+	*x = ReassignedTasks{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tasks_service_tasks_service_proto_msgTypes[10]
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TasksTimes) String() string {
+func (x *ReassignedTasks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TasksTimes) ProtoMessage() {}
+func (*ReassignedTasks) ProtoMessage() {}
 
-func (x *TasksTimes) ProtoReflect() protoreflect.Message {
-	mi := &file_tasks_service_tasks_service_proto_msgTypes[10]
+func (x *ReassignedTasks) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignedTasks.ProtoReflect.Descriptor instead.
+func (*ReassignedTasks) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReassignedTasks) GetTasks() []*TaskAssignee {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type TaskTimes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          int64   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	PlannedTime float64 `protobuf:"fixed64,2,opt,name=PlannedTime,proto3" json:"PlannedTime,omitempty"`
+	ActualTime  float64 `protobuf:"fixed64,3,opt,name=ActualTime,proto3" json:"ActualTime,omitempty"`
+}
+
+func (x *TaskTimes) Reset() {
+	*x = TaskTimes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskTimes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTimes) ProtoMessage() {}
+
+func (x *TaskTimes) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTimes.ProtoReflect.Descriptor instead.
+func (*TaskTimes) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TaskTimes) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *TaskTimes) GetPlannedTime() float64 {
+	if x != nil {
+		return x.PlannedTime
+	}
+	return 0
+}
+
+func (x *TaskTimes) GetActualTime() float64 {
+	if x != nil {
+		return x.ActualTime
+	}
+	return 0
+}
+
+type TasksTimes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Times []*TaskTimes `protobuf:"bytes,1,rep,name=Times,proto3" json:"Times,omitempty"`
+}
+
+func (x *TasksTimes) Reset() {
+	*x = TasksTimes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TasksTimes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TasksTimes) ProtoMessage() {}
+
+func (x *TasksTimes) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -657,42 +1121,1213 @@ func (x *TasksTimes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TasksTimes.ProtoReflect.Descriptor instead.
 func (*TasksTimes) Descriptor() ([]byte, []int) {
-	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{10}
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TasksTimes) GetTimes() []*TaskTimes {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+type TaskDoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskID int64 `protobuf:"varint,1,opt,name=TaskID,proto3" json:"TaskID,omitempty"`
+	// actual time in hours, ignored if the task has work sessions
+	Time float64 `protobuf:"fixed64,2,opt,name=Time,proto3" json:"Time,omitempty"`
+}
+
+func (x *TaskDoneRequest) Reset() {
+	*x = TaskDoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskDoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskDoneRequest) ProtoMessage() {}
+
+func (x *TaskDoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskDoneRequest.ProtoReflect.Descriptor instead.
+func (*TaskDoneRequest) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TaskDoneRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *TaskDoneRequest) GetTime() float64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type TasksBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 for user tasks
+	GroupID int64   `protobuf:"varint,1,opt,name=GroupID,proto3" json:"GroupID,omitempty"`
+	Tasks   []*Task `protobuf:"bytes,2,rep,name=Tasks,proto3" json:"Tasks,omitempty"`
+}
+
+func (x *TasksBatch) Reset() {
+	*x = TasksBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TasksBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TasksBatch) ProtoMessage() {}
+
+func (x *TasksBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TasksBatch.ProtoReflect.Descriptor instead.
+func (*TasksBatch) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TasksBatch) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *TasksBatch) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type DeleteTasksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 for user tasks
+	GroupID int64   `protobuf:"varint,1,opt,name=GroupID,proto3" json:"GroupID,omitempty"`
+	IDs     []int64 `protobuf:"varint,2,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
+}
+
+func (x *DeleteTasksRequest) Reset() {
+	*x = DeleteTasksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTasksRequest) ProtoMessage() {}
+
+func (x *DeleteTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTasksRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTasksRequest) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteTasksRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *DeleteTasksRequest) GetIDs() []int64 {
+	if x != nil {
+		return x.IDs
+	}
+	return nil
+}
+
+// result of a single batch item
+type TaskResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// grpc status code of the item, OK if the item is processed
+	Code  int32  `protobuf:"varint,2,opt,name=Code,proto3" json:"Code,omitempty"`
+	Error string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *TaskResult) Reset() {
+	*x = TaskResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskResult) ProtoMessage() {}
+
+func (x *TaskResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskResult.ProtoReflect.Descriptor instead.
+func (*TaskResult) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TaskResult) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *TaskResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *TaskResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TasksBatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results in the order of the request items
+	Results []*TaskResult `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+}
+
+func (x *TasksBatchResult) Reset() {
+	*x = TasksBatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TasksBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TasksBatchResult) ProtoMessage() {}
+
+func (x *TasksBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TasksBatchResult.ProtoReflect.Descriptor instead.
+func (*TasksBatchResult) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TasksBatchResult) GetResults() []*TaskResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type TransitionTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskID int64      `protobuf:"varint,1,opt,name=TaskID,proto3" json:"TaskID,omitempty"`
+	Status TaskStatus `protobuf:"varint,2,opt,name=Status,proto3,enum=tasks.TaskStatus" json:"Status,omitempty"`
+	// actual time in hours, required for Done if the task has no work sessions
+	ActualTime float64 `protobuf:"fixed64,3,opt,name=ActualTime,proto3" json:"ActualTime,omitempty"`
+}
+
+func (x *TransitionTaskRequest) Reset() {
+	*x = TransitionTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransitionTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransitionTaskRequest) ProtoMessage() {}
+
+func (x *TransitionTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransitionTaskRequest.ProtoReflect.Descriptor instead.
+func (*TransitionTaskRequest) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TransitionTaskRequest) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *TransitionTaskRequest) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_Backlog
+}
+
+func (x *TransitionTaskRequest) GetActualTime() float64 {
+	if x != nil {
+		return x.ActualTime
+	}
+	return 0
+}
+
+type TaskStatusChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From      TaskStatus             `protobuf:"varint,1,opt,name=From,proto3,enum=tasks.TaskStatus" json:"From,omitempty"`
+	To        TaskStatus             `protobuf:"varint,2,opt,name=To,proto3,enum=tasks.TaskStatus" json:"To,omitempty"`
+	ChangedBy int64                  `protobuf:"varint,3,opt,name=ChangedBy,proto3" json:"ChangedBy,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ChangedAt,proto3" json:"ChangedAt,omitempty"`
+}
+
+func (x *TaskStatusChange) Reset() {
+	*x = TaskStatusChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskStatusChange) ProtoMessage() {}
+
+func (x *TaskStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskStatusChange.ProtoReflect.Descriptor instead.
+func (*TaskStatusChange) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TaskStatusChange) GetFrom() TaskStatus {
+	if x != nil {
+		return x.From
+	}
+	return TaskStatus_Backlog
+}
+
+func (x *TaskStatusChange) GetTo() TaskStatus {
+	if x != nil {
+		return x.To
+	}
+	return TaskStatus_Backlog
+}
+
+func (x *TaskStatusChange) GetChangedBy() int64 {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return 0
+}
+
+func (x *TaskStatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type TaskStatusHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// changes in chronological order
+	Changes []*TaskStatusChange `protobuf:"bytes,1,rep,name=Changes,proto3" json:"Changes,omitempty"`
+}
+
+func (x *TaskStatusHistory) Reset() {
+	*x = TaskStatusHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskStatusHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskStatusHistory) ProtoMessage() {}
+
+func (x *TaskStatusHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskStatusHistory.ProtoReflect.Descriptor instead.
+func (*TaskStatusHistory) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TaskStatusHistory) GetChanges() []*TaskStatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type WorkSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        int64                  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	TaskID    int64                  `protobuf:"varint,2,opt,name=TaskID,proto3" json:"TaskID,omitempty"`
+	UserID    int64                  `protobuf:"varint,3,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=StartedAt,proto3" json:"StartedAt,omitempty"`
+	// unset while the session is active
+	StoppedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=StoppedAt,proto3" json:"StoppedAt,omitempty"`
+	// duration of the stopped session in hours
+	Time float64 `protobuf:"fixed64,6,opt,name=Time,proto3" json:"Time,omitempty"`
+}
+
+func (x *WorkSession) Reset() {
+	*x = WorkSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkSession) ProtoMessage() {}
+
+func (x *WorkSession) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkSession.ProtoReflect.Descriptor instead.
+func (*WorkSession) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WorkSession) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *WorkSession) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *WorkSession) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *WorkSession) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *WorkSession) GetStoppedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StoppedAt
+	}
+	return nil
+}
+
+func (x *WorkSession) GetTime() float64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type Mention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   int64  `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Mention) GetUserID() int64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *Mention) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type Comment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	TaskID   int64  `protobuf:"varint,2,opt,name=TaskID,proto3" json:"TaskID,omitempty"`
+	AuthorID int64  `protobuf:"varint,3,opt,name=AuthorID,proto3" json:"AuthorID,omitempty"`
+	Text     string `protobuf:"bytes,4,opt,name=Text,proto3" json:"Text,omitempty"`
+	// mentioned users, only UserID is required in requests
+	Mentions  []*Mention             `protobuf:"bytes,5,rep,name=Mentions,proto3" json:"Mentions,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	// unset if the comment wasn't edited
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Comment) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *Comment) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *Comment) GetAuthorID() int64 {
+	if x != nil {
+		return x.AuthorID
+	}
+	return 0
+}
+
+func (x *Comment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Comment) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CommentID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskID int64 `protobuf:"varint,1,opt,name=TaskID,proto3" json:"TaskID,omitempty"`
+	ID     int64 `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *CommentID) Reset() {
+	*x = CommentID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentID) ProtoMessage() {}
+
+func (x *CommentID) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentID.ProtoReflect.Descriptor instead.
+func (*CommentID) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CommentID) GetTaskID() int64 {
+	if x != nil {
+		return x.TaskID
+	}
+	return 0
+}
+
+func (x *CommentID) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+// change of a single task field made by UpdateTask
+type TaskFieldChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChangedBy int64                  `protobuf:"varint,1,opt,name=ChangedBy,proto3" json:"ChangedBy,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=ChangedAt,proto3" json:"ChangedAt,omitempty"`
+	Field     string                 `protobuf:"bytes,3,opt,name=Field,proto3" json:"Field,omitempty"`
+	OldValue  string                 `protobuf:"bytes,4,opt,name=OldValue,proto3" json:"OldValue,omitempty"`
+	NewValue  string                 `protobuf:"bytes,5,opt,name=NewValue,proto3" json:"NewValue,omitempty"`
+}
+
+func (x *TaskFieldChange) Reset() {
+	*x = TaskFieldChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskFieldChange) ProtoMessage() {}
+
+func (x *TaskFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskFieldChange.ProtoReflect.Descriptor instead.
+func (*TaskFieldChange) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TaskFieldChange) GetChangedBy() int64 {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return 0
+}
+
+func (x *TaskFieldChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *TaskFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TaskFieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *TaskFieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+// exactly one of the fields is set
+type ActivityItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *Comment         `protobuf:"bytes,1,opt,name=Comment,proto3" json:"Comment,omitempty"`
+	Change  *TaskFieldChange `protobuf:"bytes,2,opt,name=Change,proto3" json:"Change,omitempty"`
+}
+
+func (x *ActivityItem) Reset() {
+	*x = ActivityItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityItem) ProtoMessage() {}
+
+func (x *ActivityItem) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityItem.ProtoReflect.Descriptor instead.
+func (*ActivityItem) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ActivityItem) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *ActivityItem) GetChange() *TaskFieldChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+type TaskActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// items in chronological order
+	Items []*ActivityItem `protobuf:"bytes,1,rep,name=Items,proto3" json:"Items,omitempty"`
+}
+
+func (x *TaskActivity) Reset() {
+	*x = TaskActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskActivity) ProtoMessage() {}
+
+func (x *TaskActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskActivity.ProtoReflect.Descriptor instead.
+func (*TaskActivity) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TaskActivity) GetItems() []*ActivityItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID       int64  `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	GroupID  int64  `protobuf:"varint,2,opt,name=GroupID,proto3" json:"GroupID,omitempty"`
+	ActorID  int64  `protobuf:"varint,3,opt,name=ActorID,proto3" json:"ActorID,omitempty"`
+	Entity   string `protobuf:"bytes,4,opt,name=Entity,proto3" json:"Entity,omitempty"`
+	EntityID int64  `protobuf:"varint,5,opt,name=EntityID,proto3" json:"EntityID,omitempty"`
+	// create, update or delete
+	Action string `protobuf:"bytes,6,opt,name=Action,proto3" json:"Action,omitempty"`
+	// json objects with the entity fields, Before is empty for create,
+	// After is empty for delete, update keeps only changed fields
+	Before    string                 `protobuf:"bytes,7,opt,name=Before,proto3" json:"Before,omitempty"`
+	After     string                 `protobuf:"bytes,8,opt,name=After,proto3" json:"After,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AuditEvent) GetID() int64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetActorID() int64 {
+	if x != nil {
+		return x.ActorID
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetEntityID() int64 {
+	if x != nil {
+		return x.EntityID
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID int64 `protobuf:"varint,1,opt,name=GroupID,proto3" json:"GroupID,omitempty"`
+	// optional filters
+	Entity   string                 `protobuf:"bytes,2,opt,name=Entity,proto3" json:"Entity,omitempty"`
+	EntityID int64                  `protobuf:"varint,3,opt,name=EntityID,proto3" json:"EntityID,omitempty"`
+	ActorID  int64                  `protobuf:"varint,4,opt,name=ActorID,proto3" json:"ActorID,omitempty"`
+	From     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=From,proto3" json:"From,omitempty"`
+	To       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=To,proto3" json:"To,omitempty"`
+	// events are returned from newest to oldest,
+	// only events older than (BeforeTime, BeforeID) are returned if set
+	BeforeTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=BeforeTime,proto3" json:"BeforeTime,omitempty"`
+	BeforeID   int64                  `protobuf:"varint,8,opt,name=BeforeID,proto3" json:"BeforeID,omitempty"`
+	Limit      int32                  `protobuf:"varint,9,opt,name=Limit,proto3" json:"Limit,omitempty"`
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListAuditEventsRequest) GetGroupID() int64 {
+	if x != nil {
+		return x.GroupID
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetEntityID() int64 {
+	if x != nil {
+		return x.EntityID
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetActorID() int64 {
+	if x != nil {
+		return x.ActorID
+	}
+	return 0
 }
 
-func (x *TasksTimes) GetTimes() []*TaskTimes {
+func (x *ListAuditEventsRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Times
+		return x.From
 	}
 	return nil
 }
 
-type TaskDoneRequest struct {
+func (x *ListAuditEventsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetBeforeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BeforeTime
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetBeforeID() int64 {
+	if x != nil {
+		return x.BeforeID
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AuditEventList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskID int64   `protobuf:"varint,1,opt,name=TaskID,proto3" json:"TaskID,omitempty"`
-	Time   float64 `protobuf:"fixed64,2,opt,name=Time,proto3" json:"Time,omitempty"`
+	Events []*AuditEvent `protobuf:"bytes,1,rep,name=Events,proto3" json:"Events,omitempty"`
 }
 
-func (x *TaskDoneRequest) Reset() {
-	*x = TaskDoneRequest{}
+func (x *AuditEventList) Reset() {
+	*x = AuditEventList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_tasks_service_tasks_service_proto_msgTypes[11]
+		mi := &file_tasks_service_tasks_service_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TaskDoneRequest) String() string {
+func (x *AuditEventList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskDoneRequest) ProtoMessage() {}
+func (*AuditEventList) ProtoMessage() {}
 
-func (x *TaskDoneRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tasks_service_tasks_service_proto_msgTypes[11]
+func (x *AuditEventList) ProtoReflect() protoreflect.Message {
+	mi := &file_tasks_service_tasks_service_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,23 +2338,16 @@ func (x *TaskDoneRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskDoneRequest.ProtoReflect.Descriptor instead.
-func (*TaskDoneRequest) Descriptor() ([]byte, []int) {
-	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *TaskDoneRequest) GetTaskID() int64 {
-	if x != nil {
-		return x.TaskID
-	}
-	return 0
+// Deprecated: Use AuditEventList.ProtoReflect.Descriptor instead.
+func (*AuditEventList) Descriptor() ([]byte, []int) {
+	return file_tasks_service_tasks_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *TaskDoneRequest) GetTime() float64 {
+func (x *AuditEventList) GetEvents() []*AuditEvent {
 	if x != nil {
-		return x.Time
+		return x.Events
 	}
-	return 0
+	return nil
 }
 
 var File_tasks_service_tasks_service_proto protoreflect.FileDescriptor
@@ -731,7 +2359,7 @@ var file_tasks_service_tasks_service_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x02, 0x0a, 0x04, 0x54, 0x61, 0x73,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8b, 0x04, 0x0a, 0x04, 0x54, 0x61, 0x73,
 	0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49,
 	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12,
@@ -751,90 +2379,353 @@ var file_tasks_service_tasks_service_proto_rawDesc = []byte{
 	0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0x2d, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a,
-	0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74,
-	0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73,
-	0x22, 0x32, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x44, 0x22, 0x19, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12,
-	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x22,
-	0x45, 0x0a, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x29, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x22, 0x74, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61,
-	0x73, 0x6b, 0x12, 0x1f, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x50, 0x72, 0x65, 0x64,
-	0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x65,
-	0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x50, 0x72,
-	0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x22, 0x69, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x64, 0x69,
-	0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x05,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61,
-	0x73, 0x6b, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x55, 0x6e, 0x70, 0x72,
-	0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x55, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x03, 0x52, 0x0f, 0x55, 0x6e, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x55, 0x49,
-	0x44, 0x73, 0x22, 0x1c, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x49, 0x44, 0x73, 0x12, 0x10,
-	0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x49, 0x44, 0x73,
-	0x22, 0x5d, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x20, 0x0a,
-	0x0b, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0b, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0a, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x22,
-	0x34, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x26, 0x0a,
-	0x05, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
-	0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x05,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x6f, 0x6e,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44,
-	0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
-	0x54, 0x69, 0x6d, 0x65, 0x32, 0x9a, 0x04, 0x0a, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x28,
-	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0b, 0x2e, 0x74,
-	0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0d, 0x2e, 0x74, 0x61, 0x73, 0x6b,
-	0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x0d, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b,
-	0x49, 0x44, 0x1a, 0x0b, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x12,
-	0x36, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
-	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54,
-	0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x74, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x61,
+	0x73, 0x68, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x43, 0x72,
+	0x61, 0x73, 0x68, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x72, 0x61, 0x73, 0x68,
+	0x4d, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x43,
+	0x72, 0x61, 0x73, 0x68, 0x4d, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4d, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x32, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0x19, 0x0a, 0x07, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x49, 0x44, 0x22, 0x45, 0x0a, 0x11, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73,
+	0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0xd9, 0x02, 0x0a, 0x0a,
+	0x54, 0x61, 0x73, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x54, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x6c, 0x6c, 0x54,
+	0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x41, 0x6c, 0x6c, 0x54, 0x61,
+	0x67, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x54, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x54, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x53, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x53, 0x6f, 0x72, 0x74,
+	0x42, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x73, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x03, 0x41, 0x73, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x74, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x64,
+	0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1f, 0x0a, 0x04, 0x54, 0x61, 0x73,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x72,
+	0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x22, 0x69,
+	0x0a, 0x11, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12,
+	0x28, 0x0a, 0x0f, 0x55, 0x6e, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x55, 0x49,
+	0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x55, 0x6e, 0x70, 0x72, 0x65, 0x64,
+	0x69, 0x63, 0x74, 0x65, 0x64, 0x55, 0x49, 0x44, 0x73, 0x22, 0x1c, 0x0a, 0x08, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x49, 0x44, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x46, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x41,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12,
+	0x1e, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x22,
+	0x3c, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x65, 0x52, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x5d, 0x0a,
+	0x09, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x0a,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x05, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x22, 0x3d, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x6f, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x49, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x21, 0x0a, 0x05, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x40, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03,
+	0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x49, 0x44, 0x73, 0x22, 0x46,
+	0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3f, 0x0a, 0x10, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x7a, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x41, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x10, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x21, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x02,
+	0x54, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x38, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x22, 0x46, 0x0a, 0x11, 0x54, 0x61,
+	0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x31, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x0b, 0x57, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x38, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09,
+	0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x53, 0x74, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x3d, 0x0a, 0x07, 0x4d, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x02, 0x0a, 0x07, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a,
+	0x08, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x33, 0x0a,
+	0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61,
+	0x73, 0x6b, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x54, 0x61, 0x73, 0x6b,
+	0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x49, 0x44, 0x22, 0xb7, 0x01, 0x0a, 0x0f, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x42, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x4e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x68, 0x0a, 0x0c,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x28, 0x0a, 0x07,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x39, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x84, 0x02, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x63,
+	0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x41, 0x63, 0x74,
+	0x6f, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x38,
+	0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xca, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x04, 0x46,
+	0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x54,
+	0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x02, 0x54, 0x6f, 0x12, 0x3a, 0x0a, 0x0a, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3b, 0x0a, 0x0e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2a, 0x5b, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x0b, 0x0a, 0x07, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x10, 0x00, 0x12, 0x0e, 0x0a,
+	0x0a, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x6f, 0x6e, 0x65, 0x10, 0x04,
+	0x12, 0x0d, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x10, 0x05, 0x2a,
+	0x32, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x0a, 0x08,
+	0x41, 0x6e, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4f, 0x70,
+	0x65, 0x6e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x10, 0x02, 0x2a, 0x38, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x6f, 0x72, 0x74, 0x4b,
+	0x65, 0x79, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x10,
+	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x10, 0x02, 0x32, 0x97, 0x0b,
+	0x0a, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0b, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61,
-	0x73, 0x6b, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x0a, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0d, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73,
-	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x32, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0d,
-	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x1a, 0x14, 0x2e,
-	0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x3e, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x12, 0x18, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54,
-	0x61, 0x73, 0x6b, 0x73, 0x12, 0x0e, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x44, 0x1a, 0x0f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73,
-	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x64,
-	0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x0f, 0x2e, 0x74, 0x61, 0x73,
-	0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x49, 0x44, 0x73, 0x1a, 0x18, 0x2e, 0x74, 0x61,
-	0x73, 0x6b, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x73,
-	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x6f, 0x6e,
-	0x65, 0x12, 0x16, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x6f,
-	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6c, 0x69, 0x72, 0x69, 0x71, 0x75, 0x65, 0x77, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x3b, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x73, 0x6b, 0x1a, 0x0d, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49,
+	0x44, 0x12, 0x25, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0d, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x1a, 0x0b, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x36, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x3b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x0a,
+	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0b, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x33, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0d,
+	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x0d, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0b, 0x50,
+	0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0d, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x1a, 0x14, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x39, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x11,
+	0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x1a, 0x17, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x11, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x17, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x12, 0x19, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x0e, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x1a, 0x0f, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12,
+	0x0f, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x49, 0x44, 0x73,
+	0x1a, 0x18, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74,
+	0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x16, 0x50, 0x72,
+	0x65, 0x64, 0x69, 0x63, 0x74, 0x52, 0x65, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x12, 0x16, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x52, 0x65, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x1a, 0x18, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x61,
+	0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x6f,
+	0x6e, 0x65, 0x12, 0x16, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x44,
+	0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x46, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x1c, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x0d, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49,
+	0x44, 0x1a, 0x18, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x09, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x12, 0x0d, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x53,
+	0x74, 0x6f, 0x70, 0x57, 0x6f, 0x72, 0x6b, 0x12, 0x0d, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x1a, 0x12, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0e, 0x2e, 0x74, 0x61,
+	0x73, 0x6b, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x0d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x0e, 0x2e, 0x74,
+	0x61, 0x73, 0x6b, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x2e,
+	0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x0d, 0x2e, 0x74, 0x61, 0x73,
+	0x6b, 0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x1a, 0x13, 0x2e, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x47,
+	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1d, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x72, 0x69, 0x71, 0x75, 0x65, 0x77, 0x2f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x74, 0x61,
+	0x73, 0x6b, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3b, 0x74, 0x61, 0x73, 0x6b,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -849,54 +2740,138 @@ func file_tasks_service_tasks_service_proto_rawDescGZIP() []byte {
 	return file_tasks_service_tasks_service_proto_rawDescData
 }
 
-var file_tasks_service_tasks_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_tasks_service_tasks_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_tasks_service_tasks_service_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_tasks_service_tasks_service_proto_goTypes = []interface{}{
-	(*Task)(nil),                  // 0: tasks.Task
-	(*TaskList)(nil),              // 1: tasks.TaskList
-	(*TaskID)(nil),                // 2: tasks.TaskID
-	(*GroupID)(nil),               // 3: tasks.GroupID
-	(*TaskExistsRequest)(nil),     // 4: tasks.TaskExistsRequest
-	(*TaskListRequest)(nil),       // 5: tasks.TaskListRequest
-	(*PredictedTask)(nil),         // 6: tasks.PredictedTask
-	(*PredictedTaskList)(nil),     // 7: tasks.PredictedTaskList
-	(*TasksIDs)(nil),              // 8: tasks.TasksIDs
-	(*TaskTimes)(nil),             // 9: tasks.TaskTimes
-	(*TasksTimes)(nil),            // 10: tasks.TasksTimes
-	(*TaskDoneRequest)(nil),       // 11: tasks.TaskDoneRequest
-	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 13: google.protobuf.Empty
+	(TaskStatus)(0),                // 0: tasks.TaskStatus
+	(TaskState)(0),                 // 1: tasks.TaskState
+	(TaskSortKey)(0),               // 2: tasks.TaskSortKey
+	(*Task)(nil),                   // 3: tasks.Task
+	(*TaskList)(nil),               // 4: tasks.TaskList
+	(*TaskID)(nil),                 // 5: tasks.TaskID
+	(*GroupID)(nil),                // 6: tasks.GroupID
+	(*TaskExistsRequest)(nil),      // 7: tasks.TaskExistsRequest
+	(*TaskFilter)(nil),             // 8: tasks.TaskFilter
+	(*TaskListRequest)(nil),        // 9: tasks.TaskListRequest
+	(*PredictedTask)(nil),          // 10: tasks.PredictedTask
+	(*PredictedTaskList)(nil),      // 11: tasks.PredictedTaskList
+	(*TasksIDs)(nil),               // 12: tasks.TasksIDs
+	(*TaskAssignee)(nil),           // 13: tasks.TaskAssignee
+	(*ReassignedTasks)(nil),        // 14: tasks.ReassignedTasks
+	(*TaskTimes)(nil),              // 15: tasks.TaskTimes
+	(*TasksTimes)(nil),             // 16: tasks.TasksTimes
+	(*TaskDoneRequest)(nil),        // 17: tasks.TaskDoneRequest
+	(*TasksBatch)(nil),             // 18: tasks.TasksBatch
+	(*DeleteTasksRequest)(nil),     // 19: tasks.DeleteTasksRequest
+	(*TaskResult)(nil),             // 20: tasks.TaskResult
+	(*TasksBatchResult)(nil),       // 21: tasks.TasksBatchResult
+	(*TransitionTaskRequest)(nil),  // 22: tasks.TransitionTaskRequest
+	(*TaskStatusChange)(nil),       // 23: tasks.TaskStatusChange
+	(*TaskStatusHistory)(nil),      // 24: tasks.TaskStatusHistory
+	(*WorkSession)(nil),            // 25: tasks.WorkSession
+	(*Mention)(nil),                // 26: tasks.Mention
+	(*Comment)(nil),                // 27: tasks.Comment
+	(*CommentID)(nil),              // 28: tasks.CommentID
+	(*TaskFieldChange)(nil),        // 29: tasks.TaskFieldChange
+	(*ActivityItem)(nil),           // 30: tasks.ActivityItem
+	(*TaskActivity)(nil),           // 31: tasks.TaskActivity
+	(*AuditEvent)(nil),             // 32: tasks.AuditEvent
+	(*ListAuditEventsRequest)(nil), // 33: tasks.ListAuditEventsRequest
+	(*AuditEventList)(nil),         // 34: tasks.AuditEventList
+	(*timestamppb.Timestamp)(nil),  // 35: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),          // 36: google.protobuf.Empty
 }
 var file_tasks_service_tasks_service_proto_depIdxs = []int32{
-	12, // 0: tasks.Task.CreatedAt:type_name -> google.protobuf.Timestamp
-	0,  // 1: tasks.TaskList.Tasks:type_name -> tasks.Task
-	0,  // 2: tasks.PredictedTask.Task:type_name -> tasks.Task
-	6,  // 3: tasks.PredictedTaskList.Tasks:type_name -> tasks.PredictedTask
-	9,  // 4: tasks.TasksTimes.Times:type_name -> tasks.TaskTimes
-	0,  // 5: tasks.Tasks.CreateTask:input_type -> tasks.Task
-	2,  // 6: tasks.Tasks.GetTask:input_type -> tasks.TaskID
-	5,  // 7: tasks.Tasks.GetTaskList:input_type -> tasks.TaskListRequest
-	0,  // 8: tasks.Tasks.UpdateTask:input_type -> tasks.Task
-	2,  // 9: tasks.Tasks.DeleteTask:input_type -> tasks.TaskID
-	2,  // 10: tasks.Tasks.PredictTask:input_type -> tasks.TaskID
-	4,  // 11: tasks.Tasks.TaskExists:input_type -> tasks.TaskExistsRequest
-	3,  // 12: tasks.Tasks.GetGroupTasks:input_type -> tasks.GroupID
-	8,  // 13: tasks.Tasks.GetPredictedTasks:input_type -> tasks.TasksIDs
-	11, // 14: tasks.Tasks.TaskDone:input_type -> tasks.TaskDoneRequest
-	2,  // 15: tasks.Tasks.CreateTask:output_type -> tasks.TaskID
-	0,  // 16: tasks.Tasks.GetTask:output_type -> tasks.Task
-	1,  // 17: tasks.Tasks.GetTaskList:output_type -> tasks.TaskList
-	13, // 18: tasks.Tasks.UpdateTask:output_type -> google.protobuf.Empty
-	13, // 19: tasks.Tasks.DeleteTask:output_type -> google.protobuf.Empty
-	6,  // 20: tasks.Tasks.PredictTask:output_type -> tasks.PredictedTask
-	13, // 21: tasks.Tasks.TaskExists:output_type -> google.protobuf.Empty
-	1,  // 22: tasks.Tasks.GetGroupTasks:output_type -> tasks.TaskList
-	7,  // 23: tasks.Tasks.GetPredictedTasks:output_type -> tasks.PredictedTaskList
-	13, // 24: tasks.Tasks.TaskDone:output_type -> google.protobuf.Empty
-	15, // [15:25] is the sub-list for method output_type
-	5,  // [5:15] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	35, // 0: tasks.Task.CreatedAt:type_name -> google.protobuf.Timestamp
+	35, // 1: tasks.Task.CompletedAt:type_name -> google.protobuf.Timestamp
+	0,  // 2: tasks.Task.Status:type_name -> tasks.TaskStatus
+	3,  // 3: tasks.TaskList.Tasks:type_name -> tasks.Task
+	1,  // 4: tasks.TaskFilter.State:type_name -> tasks.TaskState
+	35, // 5: tasks.TaskFilter.CreatedFrom:type_name -> google.protobuf.Timestamp
+	35, // 6: tasks.TaskFilter.CreatedTo:type_name -> google.protobuf.Timestamp
+	0,  // 7: tasks.TaskFilter.Statuses:type_name -> tasks.TaskStatus
+	8,  // 8: tasks.TaskListRequest.Filter:type_name -> tasks.TaskFilter
+	2,  // 9: tasks.TaskListRequest.SortBy:type_name -> tasks.TaskSortKey
+	3,  // 10: tasks.PredictedTask.Task:type_name -> tasks.Task
+	10, // 11: tasks.PredictedTaskList.Tasks:type_name -> tasks.PredictedTask
+	13, // 12: tasks.ReassignedTasks.Tasks:type_name -> tasks.TaskAssignee
+	15, // 13: tasks.TasksTimes.Times:type_name -> tasks.TaskTimes
+	3,  // 14: tasks.TasksBatch.Tasks:type_name -> tasks.Task
+	20, // 15: tasks.TasksBatchResult.Results:type_name -> tasks.TaskResult
+	0,  // 16: tasks.TransitionTaskRequest.Status:type_name -> tasks.TaskStatus
+	0,  // 17: tasks.TaskStatusChange.From:type_name -> tasks.TaskStatus
+	0,  // 18: tasks.TaskStatusChange.To:type_name -> tasks.TaskStatus
+	35, // 19: tasks.TaskStatusChange.ChangedAt:type_name -> google.protobuf.Timestamp
+	23, // 20: tasks.TaskStatusHistory.Changes:type_name -> tasks.TaskStatusChange
+	35, // 21: tasks.WorkSession.StartedAt:type_name -> google.protobuf.Timestamp
+	35, // 22: tasks.WorkSession.StoppedAt:type_name -> google.protobuf.Timestamp
+	26, // 23: tasks.Comment.Mentions:type_name -> tasks.Mention
+	35, // 24: tasks.Comment.CreatedAt:type_name -> google.protobuf.Timestamp
+	35, // 25: tasks.Comment.UpdatedAt:type_name -> google.protobuf.Timestamp
+	35, // 26: tasks.TaskFieldChange.ChangedAt:type_name -> google.protobuf.Timestamp
+	27, // 27: tasks.ActivityItem.Comment:type_name -> tasks.Comment
+	29, // 28: tasks.ActivityItem.Change:type_name -> tasks.TaskFieldChange
+	30, // 29: tasks.TaskActivity.Items:type_name -> tasks.ActivityItem
+	35, // 30: tasks.AuditEvent.CreatedAt:type_name -> google.protobuf.Timestamp
+	35, // 31: tasks.ListAuditEventsRequest.From:type_name -> google.protobuf.Timestamp
+	35, // 32: tasks.ListAuditEventsRequest.To:type_name -> google.protobuf.Timestamp
+	35, // 33: tasks.ListAuditEventsRequest.BeforeTime:type_name -> google.protobuf.Timestamp
+	32, // 34: tasks.AuditEventList.Events:type_name -> tasks.AuditEvent
+	3,  // 35: tasks.Tasks.CreateTask:input_type -> tasks.Task
+	5,  // 36: tasks.Tasks.GetTask:input_type -> tasks.TaskID
+	9,  // 37: tasks.Tasks.GetTaskList:input_type -> tasks.TaskListRequest
+	9,  // 38: tasks.Tasks.GetGroupTaskList:input_type -> tasks.TaskListRequest
+	3,  // 39: tasks.Tasks.UpdateTask:input_type -> tasks.Task
+	5,  // 40: tasks.Tasks.DeleteTask:input_type -> tasks.TaskID
+	5,  // 41: tasks.Tasks.RestoreTask:input_type -> tasks.TaskID
+	5,  // 42: tasks.Tasks.PredictTask:input_type -> tasks.TaskID
+	18, // 43: tasks.Tasks.CreateTasks:input_type -> tasks.TasksBatch
+	18, // 44: tasks.Tasks.UpdateTasks:input_type -> tasks.TasksBatch
+	19, // 45: tasks.Tasks.DeleteTasks:input_type -> tasks.DeleteTasksRequest
+	7,  // 46: tasks.Tasks.TaskExists:input_type -> tasks.TaskExistsRequest
+	6,  // 47: tasks.Tasks.GetGroupTasks:input_type -> tasks.GroupID
+	12, // 48: tasks.Tasks.GetPredictedTasks:input_type -> tasks.TasksIDs
+	14, // 49: tasks.Tasks.PredictReassignedTasks:input_type -> tasks.ReassignedTasks
+	17, // 50: tasks.Tasks.TaskDone:input_type -> tasks.TaskDoneRequest
+	22, // 51: tasks.Tasks.TransitionTask:input_type -> tasks.TransitionTaskRequest
+	5,  // 52: tasks.Tasks.GetTaskStatusHistory:input_type -> tasks.TaskID
+	5,  // 53: tasks.Tasks.StartWork:input_type -> tasks.TaskID
+	5,  // 54: tasks.Tasks.StopWork:input_type -> tasks.TaskID
+	27, // 55: tasks.Tasks.CreateComment:input_type -> tasks.Comment
+	27, // 56: tasks.Tasks.UpdateComment:input_type -> tasks.Comment
+	28, // 57: tasks.Tasks.DeleteComment:input_type -> tasks.CommentID
+	5,  // 58: tasks.Tasks.GetTaskActivity:input_type -> tasks.TaskID
+	33, // 59: tasks.Tasks.ListAuditEvents:input_type -> tasks.ListAuditEventsRequest
+	5,  // 60: tasks.Tasks.CreateTask:output_type -> tasks.TaskID
+	3,  // 61: tasks.Tasks.GetTask:output_type -> tasks.Task
+	4,  // 62: tasks.Tasks.GetTaskList:output_type -> tasks.TaskList
+	4,  // 63: tasks.Tasks.GetGroupTaskList:output_type -> tasks.TaskList
+	36, // 64: tasks.Tasks.UpdateTask:output_type -> google.protobuf.Empty
+	36, // 65: tasks.Tasks.DeleteTask:output_type -> google.protobuf.Empty
+	36, // 66: tasks.Tasks.RestoreTask:output_type -> google.protobuf.Empty
+	10, // 67: tasks.Tasks.PredictTask:output_type -> tasks.PredictedTask
+	21, // 68: tasks.Tasks.CreateTasks:output_type -> tasks.TasksBatchResult
+	21, // 69: tasks.Tasks.UpdateTasks:output_type -> tasks.TasksBatchResult
+	21, // 70: tasks.Tasks.DeleteTasks:output_type -> tasks.TasksBatchResult
+	36, // 71: tasks.Tasks.TaskExists:output_type -> google.protobuf.Empty
+	4,  // 72: tasks.Tasks.GetGroupTasks:output_type -> tasks.TaskList
+	11, // 73: tasks.Tasks.GetPredictedTasks:output_type -> tasks.PredictedTaskList
+	11, // 74: tasks.Tasks.PredictReassignedTasks:output_type -> tasks.PredictedTaskList
+	36, // 75: tasks.Tasks.TaskDone:output_type -> google.protobuf.Empty
+	36, // 76: tasks.Tasks.TransitionTask:output_type -> google.protobuf.Empty
+	24, // 77: tasks.Tasks.GetTaskStatusHistory:output_type -> tasks.TaskStatusHistory
+	25, // 78: tasks.Tasks.StartWork:output_type -> tasks.WorkSession
+	25, // 79: tasks.Tasks.StopWork:output_type -> tasks.WorkSession
+	27, // 80: tasks.Tasks.CreateComment:output_type -> tasks.Comment
+	27, // 81: tasks.Tasks.UpdateComment:output_type -> tasks.Comment
+	36, // 82: tasks.Tasks.DeleteComment:output_type -> google.protobuf.Empty
+	31, // 83: tasks.Tasks.GetTaskActivity:output_type -> tasks.TaskActivity
+	34, // 84: tasks.Tasks.ListAuditEvents:output_type -> tasks.AuditEventList
+	60, // [60:85] is the sub-list for method output_type
+	35, // [35:60] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_tasks_service_tasks_service_proto_init() }
@@ -966,7 +2941,7 @@ func file_tasks_service_tasks_service_proto_init() {
 			}
 		}
 		file_tasks_service_tasks_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskListRequest); i {
+			switch v := v.(*TaskFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -978,7 +2953,7 @@ func file_tasks_service_tasks_service_proto_init() {
 			}
 		}
 		file_tasks_service_tasks_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PredictedTask); i {
+			switch v := v.(*TaskListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -990,7 +2965,7 @@ func file_tasks_service_tasks_service_proto_init() {
 			}
 		}
 		file_tasks_service_tasks_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PredictedTaskList); i {
+			switch v := v.(*PredictedTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1002,7 +2977,7 @@ func file_tasks_service_tasks_service_proto_init() {
 			}
 		}
 		file_tasks_service_tasks_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TasksIDs); i {
+			switch v := v.(*PredictedTaskList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1014,7 +2989,7 @@ func file_tasks_service_tasks_service_proto_init() {
 			}
 		}
 		file_tasks_service_tasks_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskTimes); i {
+			switch v := v.(*TasksIDs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1026,7 +3001,7 @@ func file_tasks_service_tasks_service_proto_init() {
 			}
 		}
 		file_tasks_service_tasks_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TasksTimes); i {
+			switch v := v.(*TaskAssignee); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1038,6 +3013,42 @@ func file_tasks_service_tasks_service_proto_init() {
 			}
 		}
 		file_tasks_service_tasks_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReassignedTasks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskTimes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TasksTimes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TaskDoneRequest); i {
 			case 0:
 				return &v.state
@@ -1049,19 +3060,224 @@ func file_tasks_service_tasks_service_proto_init() {
 				return nil
 			}
 		}
+		file_tasks_service_tasks_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TasksBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTasksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TasksBatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransitionTaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskStatusChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskStatusHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskFieldChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskActivity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tasks_service_tasks_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEventList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tasks_service_tasks_service_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      3,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_tasks_service_tasks_service_proto_goTypes,
 		DependencyIndexes: file_tasks_service_tasks_service_proto_depIdxs,
+		EnumInfos:         file_tasks_service_tasks_service_proto_enumTypes,
 		MessageInfos:      file_tasks_service_tasks_service_proto_msgTypes,
 	}.Build()
 	File_tasks_service_tasks_service_proto = out.File
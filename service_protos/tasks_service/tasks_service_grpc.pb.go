@@ -25,14 +25,35 @@ const _ = grpc.SupportPackageIsVersion7
 type TasksClient interface {
 	CreateTask(ctx context.Context, in *Task, opts ...grpc.CallOption) (*TaskID, error)
 	GetTask(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*Task, error)
+	// tasks created by or assigned to the caller
 	GetTaskList(ctx context.Context, in *TaskListRequest, opts ...grpc.CallOption) (*TaskList, error)
+	// all tasks of Filter.GroupID, group membership is checked by the caller
+	GetGroupTaskList(ctx context.Context, in *TaskListRequest, opts ...grpc.CallOption) (*TaskList, error)
 	UpdateTask(ctx context.Context, in *Task, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// deleted task is kept until it's purged after the retention period
 	DeleteTask(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// restores deleted task of TaskID.GroupID or caller's personal task
+	RestoreTask(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	PredictTask(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*PredictedTask, error)
+	// batch operations, each batch is processed in one transaction
+	CreateTasks(ctx context.Context, in *TasksBatch, opts ...grpc.CallOption) (*TasksBatchResult, error)
+	UpdateTasks(ctx context.Context, in *TasksBatch, opts ...grpc.CallOption) (*TasksBatchResult, error)
+	DeleteTasks(ctx context.Context, in *DeleteTasksRequest, opts ...grpc.CallOption) (*TasksBatchResult, error)
 	TaskExists(ctx context.Context, in *TaskExistsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetGroupTasks(ctx context.Context, in *GroupID, opts ...grpc.CallOption) (*TaskList, error)
 	GetPredictedTasks(ctx context.Context, in *TasksIDs, opts ...grpc.CallOption) (*PredictedTaskList, error)
+	// predicts tasks as if they were assigned to other users, stored tasks are not changed
+	PredictReassignedTasks(ctx context.Context, in *ReassignedTasks, opts ...grpc.CallOption) (*PredictedTaskList, error)
 	TaskDone(ctx context.Context, in *TaskDoneRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	TransitionTask(ctx context.Context, in *TransitionTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetTaskStatusHistory(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*TaskStatusHistory, error)
+	StartWork(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*WorkSession, error)
+	StopWork(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*WorkSession, error)
+	CreateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Comment, error)
+	UpdateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Comment, error)
+	DeleteComment(ctx context.Context, in *CommentID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetTaskActivity(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*TaskActivity, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*AuditEventList, error)
 }
 
 type tasksClient struct {
@@ -70,6 +91,15 @@ func (c *tasksClient) GetTaskList(ctx context.Context, in *TaskListRequest, opts
 	return out, nil
 }
 
+func (c *tasksClient) GetGroupTaskList(ctx context.Context, in *TaskListRequest, opts ...grpc.CallOption) (*TaskList, error) {
+	out := new(TaskList)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/GetGroupTaskList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tasksClient) UpdateTask(ctx context.Context, in *Task, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/tasks.Tasks/UpdateTask", in, out, opts...)
@@ -88,6 +118,15 @@ func (c *tasksClient) DeleteTask(ctx context.Context, in *TaskID, opts ...grpc.C
 	return out, nil
 }
 
+func (c *tasksClient) RestoreTask(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/RestoreTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tasksClient) PredictTask(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*PredictedTask, error) {
 	out := new(PredictedTask)
 	err := c.cc.Invoke(ctx, "/tasks.Tasks/PredictTask", in, out, opts...)
@@ -97,6 +136,33 @@ func (c *tasksClient) PredictTask(ctx context.Context, in *TaskID, opts ...grpc.
 	return out, nil
 }
 
+func (c *tasksClient) CreateTasks(ctx context.Context, in *TasksBatch, opts ...grpc.CallOption) (*TasksBatchResult, error) {
+	out := new(TasksBatchResult)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/CreateTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) UpdateTasks(ctx context.Context, in *TasksBatch, opts ...grpc.CallOption) (*TasksBatchResult, error) {
+	out := new(TasksBatchResult)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/UpdateTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) DeleteTasks(ctx context.Context, in *DeleteTasksRequest, opts ...grpc.CallOption) (*TasksBatchResult, error) {
+	out := new(TasksBatchResult)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/DeleteTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tasksClient) TaskExists(ctx context.Context, in *TaskExistsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/tasks.Tasks/TaskExists", in, out, opts...)
@@ -124,6 +190,15 @@ func (c *tasksClient) GetPredictedTasks(ctx context.Context, in *TasksIDs, opts
 	return out, nil
 }
 
+func (c *tasksClient) PredictReassignedTasks(ctx context.Context, in *ReassignedTasks, opts ...grpc.CallOption) (*PredictedTaskList, error) {
+	out := new(PredictedTaskList)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/PredictReassignedTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tasksClient) TaskDone(ctx context.Context, in *TaskDoneRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/tasks.Tasks/TaskDone", in, out, opts...)
@@ -133,20 +208,122 @@ func (c *tasksClient) TaskDone(ctx context.Context, in *TaskDoneRequest, opts ..
 	return out, nil
 }
 
+func (c *tasksClient) TransitionTask(ctx context.Context, in *TransitionTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/TransitionTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) GetTaskStatusHistory(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*TaskStatusHistory, error) {
+	out := new(TaskStatusHistory)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/GetTaskStatusHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) StartWork(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*WorkSession, error) {
+	out := new(WorkSession)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/StartWork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) StopWork(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*WorkSession, error) {
+	out := new(WorkSession)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/StopWork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) CreateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Comment, error) {
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/CreateComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) UpdateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Comment, error) {
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/UpdateComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) DeleteComment(ctx context.Context, in *CommentID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) GetTaskActivity(ctx context.Context, in *TaskID, opts ...grpc.CallOption) (*TaskActivity, error) {
+	out := new(TaskActivity)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/GetTaskActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tasksClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*AuditEventList, error) {
+	out := new(AuditEventList)
+	err := c.cc.Invoke(ctx, "/tasks.Tasks/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TasksServer is the server API for Tasks service.
 // All implementations must embed UnimplementedTasksServer
 // for forward compatibility
 type TasksServer interface {
 	CreateTask(context.Context, *Task) (*TaskID, error)
 	GetTask(context.Context, *TaskID) (*Task, error)
+	// tasks created by or assigned to the caller
 	GetTaskList(context.Context, *TaskListRequest) (*TaskList, error)
+	// all tasks of Filter.GroupID, group membership is checked by the caller
+	GetGroupTaskList(context.Context, *TaskListRequest) (*TaskList, error)
 	UpdateTask(context.Context, *Task) (*emptypb.Empty, error)
+	// deleted task is kept until it's purged after the retention period
 	DeleteTask(context.Context, *TaskID) (*emptypb.Empty, error)
+	// restores deleted task of TaskID.GroupID or caller's personal task
+	RestoreTask(context.Context, *TaskID) (*emptypb.Empty, error)
 	PredictTask(context.Context, *TaskID) (*PredictedTask, error)
+	// batch operations, each batch is processed in one transaction
+	CreateTasks(context.Context, *TasksBatch) (*TasksBatchResult, error)
+	UpdateTasks(context.Context, *TasksBatch) (*TasksBatchResult, error)
+	DeleteTasks(context.Context, *DeleteTasksRequest) (*TasksBatchResult, error)
 	TaskExists(context.Context, *TaskExistsRequest) (*emptypb.Empty, error)
 	GetGroupTasks(context.Context, *GroupID) (*TaskList, error)
 	GetPredictedTasks(context.Context, *TasksIDs) (*PredictedTaskList, error)
+	// predicts tasks as if they were assigned to other users, stored tasks are not changed
+	PredictReassignedTasks(context.Context, *ReassignedTasks) (*PredictedTaskList, error)
 	TaskDone(context.Context, *TaskDoneRequest) (*emptypb.Empty, error)
+	TransitionTask(context.Context, *TransitionTaskRequest) (*emptypb.Empty, error)
+	GetTaskStatusHistory(context.Context, *TaskID) (*TaskStatusHistory, error)
+	StartWork(context.Context, *TaskID) (*WorkSession, error)
+	StopWork(context.Context, *TaskID) (*WorkSession, error)
+	CreateComment(context.Context, *Comment) (*Comment, error)
+	UpdateComment(context.Context, *Comment) (*Comment, error)
+	DeleteComment(context.Context, *CommentID) (*emptypb.Empty, error)
+	GetTaskActivity(context.Context, *TaskID) (*TaskActivity, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*AuditEventList, error)
 	mustEmbedUnimplementedTasksServer()
 }
 
@@ -163,15 +340,30 @@ func (UnimplementedTasksServer) GetTask(context.Context, *TaskID) (*Task, error)
 func (UnimplementedTasksServer) GetTaskList(context.Context, *TaskListRequest) (*TaskList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskList not implemented")
 }
+func (UnimplementedTasksServer) GetGroupTaskList(context.Context, *TaskListRequest) (*TaskList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupTaskList not implemented")
+}
 func (UnimplementedTasksServer) UpdateTask(context.Context, *Task) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTask not implemented")
 }
 func (UnimplementedTasksServer) DeleteTask(context.Context, *TaskID) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
 }
+func (UnimplementedTasksServer) RestoreTask(context.Context, *TaskID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTask not implemented")
+}
 func (UnimplementedTasksServer) PredictTask(context.Context, *TaskID) (*PredictedTask, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PredictTask not implemented")
 }
+func (UnimplementedTasksServer) CreateTasks(context.Context, *TasksBatch) (*TasksBatchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTasks not implemented")
+}
+func (UnimplementedTasksServer) UpdateTasks(context.Context, *TasksBatch) (*TasksBatchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTasks not implemented")
+}
+func (UnimplementedTasksServer) DeleteTasks(context.Context, *DeleteTasksRequest) (*TasksBatchResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTasks not implemented")
+}
 func (UnimplementedTasksServer) TaskExists(context.Context, *TaskExistsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TaskExists not implemented")
 }
@@ -181,9 +373,39 @@ func (UnimplementedTasksServer) GetGroupTasks(context.Context, *GroupID) (*TaskL
 func (UnimplementedTasksServer) GetPredictedTasks(context.Context, *TasksIDs) (*PredictedTaskList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPredictedTasks not implemented")
 }
+func (UnimplementedTasksServer) PredictReassignedTasks(context.Context, *ReassignedTasks) (*PredictedTaskList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PredictReassignedTasks not implemented")
+}
 func (UnimplementedTasksServer) TaskDone(context.Context, *TaskDoneRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TaskDone not implemented")
 }
+func (UnimplementedTasksServer) TransitionTask(context.Context, *TransitionTaskRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionTask not implemented")
+}
+func (UnimplementedTasksServer) GetTaskStatusHistory(context.Context, *TaskID) (*TaskStatusHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskStatusHistory not implemented")
+}
+func (UnimplementedTasksServer) StartWork(context.Context, *TaskID) (*WorkSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartWork not implemented")
+}
+func (UnimplementedTasksServer) StopWork(context.Context, *TaskID) (*WorkSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopWork not implemented")
+}
+func (UnimplementedTasksServer) CreateComment(context.Context, *Comment) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedTasksServer) UpdateComment(context.Context, *Comment) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedTasksServer) DeleteComment(context.Context, *CommentID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedTasksServer) GetTaskActivity(context.Context, *TaskID) (*TaskActivity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskActivity not implemented")
+}
+func (UnimplementedTasksServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*AuditEventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedTasksServer) mustEmbedUnimplementedTasksServer() {}
 
 // UnsafeTasksServer may be embedded to opt out of forward compatibility for this service.
@@ -251,6 +473,24 @@ func _Tasks_GetTaskList_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tasks_GetGroupTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).GetGroupTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/GetGroupTaskList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).GetGroupTaskList(ctx, req.(*TaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Tasks_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Task)
 	if err := dec(in); err != nil {
@@ -287,6 +527,24 @@ func _Tasks_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tasks_RestoreTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).RestoreTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/RestoreTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).RestoreTask(ctx, req.(*TaskID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Tasks_PredictTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TaskID)
 	if err := dec(in); err != nil {
@@ -305,6 +563,60 @@ func _Tasks_PredictTask_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tasks_CreateTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TasksBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).CreateTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/CreateTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).CreateTasks(ctx, req.(*TasksBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_UpdateTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TasksBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).UpdateTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/UpdateTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).UpdateTasks(ctx, req.(*TasksBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_DeleteTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).DeleteTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/DeleteTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).DeleteTasks(ctx, req.(*DeleteTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Tasks_TaskExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TaskExistsRequest)
 	if err := dec(in); err != nil {
@@ -359,6 +671,24 @@ func _Tasks_GetPredictedTasks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tasks_PredictReassignedTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignedTasks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).PredictReassignedTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/PredictReassignedTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).PredictReassignedTasks(ctx, req.(*ReassignedTasks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Tasks_TaskDone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TaskDoneRequest)
 	if err := dec(in); err != nil {
@@ -377,6 +707,168 @@ func _Tasks_TaskDone_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tasks_TransitionTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).TransitionTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/TransitionTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).TransitionTask(ctx, req.(*TransitionTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_GetTaskStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).GetTaskStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/GetTaskStatusHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).GetTaskStatusHistory(ctx, req.(*TaskID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_StartWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).StartWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/StartWork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).StartWork(ctx, req.(*TaskID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_StopWork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).StopWork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/StopWork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).StopWork(ctx, req.(*TaskID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Comment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/CreateComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).CreateComment(ctx, req.(*Comment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Comment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/UpdateComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).UpdateComment(ctx, req.(*Comment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommentID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/DeleteComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).DeleteComment(ctx, req.(*CommentID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_GetTaskActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).GetTaskActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/GetTaskActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).GetTaskActivity(ctx, req.(*TaskID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tasks_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TasksServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasks.Tasks/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TasksServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Tasks_ServiceDesc is the grpc.ServiceDesc for Tasks service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -396,6 +888,10 @@ var Tasks_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTaskList",
 			Handler:    _Tasks_GetTaskList_Handler,
 		},
+		{
+			MethodName: "GetGroupTaskList",
+			Handler:    _Tasks_GetGroupTaskList_Handler,
+		},
 		{
 			MethodName: "UpdateTask",
 			Handler:    _Tasks_UpdateTask_Handler,
@@ -404,10 +900,26 @@ var Tasks_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTask",
 			Handler:    _Tasks_DeleteTask_Handler,
 		},
+		{
+			MethodName: "RestoreTask",
+			Handler:    _Tasks_RestoreTask_Handler,
+		},
 		{
 			MethodName: "PredictTask",
 			Handler:    _Tasks_PredictTask_Handler,
 		},
+		{
+			MethodName: "CreateTasks",
+			Handler:    _Tasks_CreateTasks_Handler,
+		},
+		{
+			MethodName: "UpdateTasks",
+			Handler:    _Tasks_UpdateTasks_Handler,
+		},
+		{
+			MethodName: "DeleteTasks",
+			Handler:    _Tasks_DeleteTasks_Handler,
+		},
 		{
 			MethodName: "TaskExists",
 			Handler:    _Tasks_TaskExists_Handler,
@@ -420,10 +932,50 @@ var Tasks_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPredictedTasks",
 			Handler:    _Tasks_GetPredictedTasks_Handler,
 		},
+		{
+			MethodName: "PredictReassignedTasks",
+			Handler:    _Tasks_PredictReassignedTasks_Handler,
+		},
 		{
 			MethodName: "TaskDone",
 			Handler:    _Tasks_TaskDone_Handler,
 		},
+		{
+			MethodName: "TransitionTask",
+			Handler:    _Tasks_TransitionTask_Handler,
+		},
+		{
+			MethodName: "GetTaskStatusHistory",
+			Handler:    _Tasks_GetTaskStatusHistory_Handler,
+		},
+		{
+			MethodName: "StartWork",
+			Handler:    _Tasks_StartWork_Handler,
+		},
+		{
+			MethodName: "StopWork",
+			Handler:    _Tasks_StopWork_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _Tasks_CreateComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _Tasks_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _Tasks_DeleteComment_Handler,
+		},
+		{
+			MethodName: "GetTaskActivity",
+			Handler:    _Tasks_GetTaskActivity_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Tasks_ListAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tasks_service/tasks_service.proto",
@@ -28,6 +28,10 @@ func main() {
 		application.OutboxMachine.MustRun()
 	}()
 
+	go func() {
+		application.PurgeWorker.MustRun()
+	}()
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
 
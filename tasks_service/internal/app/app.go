@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"log/slog"
 
+	authclient "github.com/liriquew/control_system/tasks_service/internal/grpc/clients/auth_client"
 	predictionsclient "github.com/liriquew/control_system/tasks_service/internal/grpc/clients/predictions_client"
 	"github.com/liriquew/control_system/tasks_service/internal/kafka"
 	"github.com/liriquew/control_system/tasks_service/internal/lib/config"
 	"github.com/liriquew/control_system/tasks_service/internal/outbox"
+	"github.com/liriquew/control_system/tasks_service/internal/purge"
 	tasks_service "github.com/liriquew/control_system/tasks_service/internal/service/tasks"
 	"github.com/liriquew/control_system/tasks_service/pkg/logger/sl"
 
@@ -18,6 +20,7 @@ import (
 type App struct {
 	GRPCServer    *grpcapp.App
 	OutboxMachine *outbox.Worker
+	PurgeWorker   *purge.Worker
 	closers       []func() error
 	log           *slog.Logger
 }
@@ -38,14 +41,21 @@ func New(log *slog.Logger, cfg config.AppConfig) *App {
 		panic(err)
 	}
 
+	authClient, err := authclient.New(log, cfg.AuthClient)
+	if err != nil {
+		panic(err)
+	}
+
 	outbox := outbox.New(log, producer, storage)
 
-	tasksService := tasks_service.New(log, storage, predictionsClient)
+	purgeWorker := purge.New(log, storage, cfg.Purge)
+
+	tasksService := tasks_service.New(log, storage, predictionsClient, authClient)
 
 	app := grpcapp.New(log, tasksService, cfg.TasksService.Port)
 
-	mainApp := &App{GRPCServer: app, log: log, OutboxMachine: outbox}
-	mainApp.closers = append(mainApp.closers, storage.Close, outbox.Close)
+	mainApp := &App{GRPCServer: app, log: log, OutboxMachine: outbox, PurgeWorker: purgeWorker}
+	mainApp.closers = append(mainApp.closers, purgeWorker.Close, outbox.Close, storage.Close)
 	return mainApp
 }
 
@@ -0,0 +1,79 @@
+package authclient
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	grpclog "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+
+	auth_pb "github.com/liriquew/control_system/services_protos/auth_service"
+	"github.com/liriquew/control_system/tasks_service/internal/lib/config"
+	"github.com/liriquew/control_system/tasks_service/internal/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Client struct {
+	client auth_pb.AuthClient
+	log    *slog.Logger
+}
+
+func New(log *slog.Logger, cfg config.ClientConfig) (*Client, error) {
+	const op = "authclient.New"
+
+	retryOpts := []grpcretry.CallOption{
+		grpcretry.WithCodes(codes.Aborted, codes.DeadlineExceeded),
+		grpcretry.WithMax(uint(cfg.Retries)),
+		grpcretry.WithPerRetryTimeout(cfg.Timeout),
+	}
+
+	logOpts := []grpclog.Option{
+		grpclog.WithLogOnEvents(grpclog.PayloadReceived, grpclog.PayloadSent),
+	}
+
+	address := fmt.Sprintf("%s:%s", cfg.Host, cfg.Port)
+	cc, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(
+			grpclog.UnaryClientInterceptor(InterceptorLogger(log), logOpts...),
+			grpcretry.UnaryClientInterceptor(retryOpts...),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &Client{
+		client: auth_pb.NewAuthClient(cc),
+		log:    log,
+	}, nil
+}
+
+func InterceptorLogger(log *slog.Logger) grpclog.Logger {
+	return grpclog.LoggerFunc(func(ctx context.Context, level grpclog.Level, msg string, fields ...any) {
+		log.Log(ctx, slog.Level(level), msg, fields...)
+	})
+}
+
+// GetUsersDetails returns usernames of existing users, unknown users are absent in the result
+func (c *Client) GetUsersDetails(ctx context.Context, userIDs []int64) ([]*models.Mention, error) {
+	resp, err := c.client.GetUsersDetails(ctx, &auth_pb.UserIDs{
+		UserIDs: userIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*models.Mention, 0, len(resp.Users))
+	for _, user := range resp.Users {
+		res = append(res, &models.Mention{
+			UserID:   user.UserID,
+			Username: user.Username,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Mention struct {
+	CommentID int64  `db:"comment_id"`
+	UserID    int64  `db:"user_id"`
+	Username  string `db:"username"`
+}
+
+type Comment struct {
+	ID        int64        `db:"id"`
+	TaskID    int64        `db:"task_id"`
+	AuthorID  int64        `db:"author_id"`
+	Text      string       `db:"text"`
+	CreatedAt time.Time    `db:"created_at"`
+	UpdatedAt sql.NullTime `db:"updated_at"`
+	Mentions  []*Mention   `db:"-"`
+}
+
+// TaskChange is a change of a single task field, values are formatted as text
+type TaskChange struct {
+	ChangedBy int64     `db:"changed_by"`
+	ChangedAt time.Time `db:"changed_at"`
+	Field     string    `db:"field"`
+	OldValue  string    `db:"old_value"`
+	NewValue  string    `db:"new_value"`
+}
+
+func ConvertCommentToProto(comment *Comment) *tsks_pb.Comment {
+	mentions := make([]*tsks_pb.Mention, 0, len(comment.Mentions))
+	for _, mention := range comment.Mentions {
+		mentions = append(mentions, &tsks_pb.Mention{
+			UserID:   mention.UserID,
+			Username: mention.Username,
+		})
+	}
+
+	var updatedAt *timestamppb.Timestamp
+	if comment.UpdatedAt.Valid {
+		updatedAt = timestamppb.New(comment.UpdatedAt.Time)
+	}
+
+	return &tsks_pb.Comment{
+		ID:        comment.ID,
+		TaskID:    comment.TaskID,
+		AuthorID:  comment.AuthorID,
+		Text:      comment.Text,
+		Mentions:  mentions,
+		CreatedAt: timestamppb.New(comment.CreatedAt),
+		UpdatedAt: updatedAt,
+	}
+}
+
+func ConvertTaskChangeToProto(change *TaskChange) *tsks_pb.TaskFieldChange {
+	return &tsks_pb.TaskFieldChange{
+		ChangedBy: change.ChangedBy,
+		ChangedAt: timestamppb.New(change.ChangedAt),
+		Field:     change.Field,
+		OldValue:  change.OldValue,
+		NewValue:  change.NewValue,
+	}
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AuditEvent struct {
+	ID        int64     `db:"id"`
+	GroupID   int64     `db:"group_id"`
+	ActorID   int64     `db:"actor_id"`
+	Entity    string    `db:"entity"`
+	EntityID  int64     `db:"entity_id"`
+	Action    string    `db:"action"`
+	Before    string    `db:"before"`
+	After     string    `db:"after"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func ConvertAuditEventToProto(event *AuditEvent) *tsks_pb.AuditEvent {
+	return &tsks_pb.AuditEvent{
+		ID:        event.ID,
+		GroupID:   event.GroupID,
+		ActorID:   event.ActorID,
+		Entity:    event.Entity,
+		EntityID:  event.EntityID,
+		Action:    event.Action,
+		Before:    event.Before,
+		After:     event.After,
+		CreatedAt: timestamppb.New(event.CreatedAt),
+	}
+}
@@ -20,9 +20,21 @@ type Task struct {
 	PlannedTime float64       `db:"planned_time" json:"planned_time,omitempty"`
 	ActualTime  float64       `db:"actual_time" json:"actual_time,omitempty"`
 	CreatedAt   time.Time     `db:"created_at"`
+	// 0..100, completed task always has 100
+	PercentComplete float64      `db:"percent_complete"`
+	CompletedAt     sql.NullTime `db:"completed_at"`
+	// cost of shortening the task by one hour and minimal time of the shortened task
+	CrashCost    float64 `db:"crash_cost"`
+	CrashMinTime float64 `db:"crash_min_time"`
+	Status       string  `db:"status"`
 }
 
 func ConvertModelToProto(task *Task) *tsks_pb.Task {
+	var completedAt *timestamppb.Timestamp
+	if task.CompletedAt.Valid {
+		completedAt = timestamppb.New(task.CompletedAt.Time)
+	}
+
 	return &tsks_pb.Task{
 		ID:          task.ID,
 		CreatedBy:   task.CreatedBy,
@@ -34,6 +46,13 @@ func ConvertModelToProto(task *Task) *tsks_pb.Task {
 		PlannedTime: task.PlannedTime,
 		ActualTime:  task.ActualTime,
 		CreatedAt:   timestamppb.New(task.CreatedAt),
+
+		PercentComplete: task.PercentComplete,
+		CompletedAt:     completedAt,
+
+		CrashCost:    task.CrashCost,
+		CrashMinTime: task.CrashMinTime,
+		Status:       StatusToProto(task.Status),
 	}
 }
 
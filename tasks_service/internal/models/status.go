@@ -0,0 +1,75 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	StatusBacklog    = "backlog"
+	StatusInProgress = "in_progress"
+	StatusBlocked    = "blocked"
+	StatusReview     = "review"
+	StatusDone       = "done"
+	StatusCancelled  = "cancelled"
+)
+
+var statusesToProto = map[string]tsks_pb.TaskStatus{
+	StatusBacklog:    tsks_pb.TaskStatus_Backlog,
+	StatusInProgress: tsks_pb.TaskStatus_InProgress,
+	StatusBlocked:    tsks_pb.TaskStatus_Blocked,
+	StatusReview:     tsks_pb.TaskStatus_Review,
+	StatusDone:       tsks_pb.TaskStatus_Done,
+	StatusCancelled:  tsks_pb.TaskStatus_Cancelled,
+}
+
+// allowed status transitions, done is final
+var statusTransitions = map[string][]string{
+	StatusBacklog:    {StatusInProgress, StatusCancelled},
+	StatusInProgress: {StatusBacklog, StatusBlocked, StatusReview, StatusDone, StatusCancelled},
+	StatusBlocked:    {StatusInProgress, StatusCancelled},
+	StatusReview:     {StatusInProgress, StatusDone},
+	StatusCancelled:  {StatusBacklog},
+}
+
+func StatusToProto(status string) tsks_pb.TaskStatus {
+	return statusesToProto[status]
+}
+
+// StatusFromProto returns db status, false for unknown status
+func StatusFromProto(status tsks_pb.TaskStatus) (string, bool) {
+	for name, s := range statusesToProto {
+		if s == status {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func CanTransition(from, to string) bool {
+	return slices.Contains(statusTransitions[from], to)
+}
+
+// IsClosedStatus reports whether task with status can't be changed
+func IsClosedStatus(status string) bool {
+	return status == StatusDone || status == StatusCancelled
+}
+
+type TaskStatusChange struct {
+	From      string    `db:"from_status"`
+	To        string    `db:"to_status"`
+	ChangedBy int64     `db:"changed_by"`
+	ChangedAt time.Time `db:"changed_at"`
+}
+
+func ConvertStatusChangeToProto(change *TaskStatusChange) *tsks_pb.TaskStatusChange {
+	return &tsks_pb.TaskStatusChange{
+		From:      StatusToProto(change.From),
+		To:        StatusToProto(change.To),
+		ChangedBy: change.ChangedBy,
+		ChangedAt: timestamppb.New(change.ChangedAt),
+	}
+}
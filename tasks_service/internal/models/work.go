@@ -0,0 +1,34 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WorkSession is a period of work of the user on the task,
+// StoppedAt is null while the session is active
+type WorkSession struct {
+	ID        int64        `db:"id"`
+	TaskID    int64        `db:"task_id"`
+	UserID    int64        `db:"user_id"`
+	StartedAt time.Time    `db:"started_at"`
+	StoppedAt sql.NullTime `db:"stopped_at"`
+}
+
+func ConvertWorkSessionToProto(session *WorkSession) *tsks_pb.WorkSession {
+	resp := &tsks_pb.WorkSession{
+		ID:        session.ID,
+		TaskID:    session.TaskID,
+		UserID:    session.UserID,
+		StartedAt: timestamppb.New(session.StartedAt),
+	}
+	if session.StoppedAt.Valid {
+		resp.StoppedAt = timestamppb.New(session.StoppedAt.Time)
+		resp.Time = session.StoppedAt.Time.Sub(session.StartedAt).Hours()
+	}
+
+	return resp
+}
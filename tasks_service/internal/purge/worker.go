@@ -0,0 +1,94 @@
+package purge
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/liriquew/control_system/tasks_service/internal/lib/config"
+	"github.com/liriquew/control_system/tasks_service/pkg/logger/sl"
+)
+
+var (
+	ErrTimeoutLimitExceeded = errors.New("worker shutdown take too much time")
+)
+
+type TasksRepository interface {
+	PurgeTasks(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// Worker permanently deletes tasks which were deleted
+// earlier than the retention period ago
+type Worker struct {
+	repository TasksRepository
+	retention  time.Duration
+	interval   time.Duration
+	// cancels Run, done is closed when Run is stopped
+	cancel context.CancelFunc
+	ctx    context.Context
+	done   chan struct{}
+	log    *slog.Logger
+}
+
+// New - constructor, returns Worker instance
+func New(log *slog.Logger, repository TasksRepository, cfg config.PurgeConfig) *Worker {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Worker{
+		repository: repository,
+		retention:  cfg.Retention,
+		interval:   cfg.Interval,
+		ctx:        ctx,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+		log:        log,
+	}
+}
+
+func (w *Worker) MustRun() {
+	defer close(w.done)
+
+	w.Run(w.ctx)
+}
+
+func (w *Worker) Close() error {
+	w.cancel()
+
+	select {
+	case <-w.done:
+	case <-time.After(time.Second):
+		return ErrTimeoutLimitExceeded
+	}
+
+	return nil
+}
+
+// Run purges deleted tasks every interval until ctx is canceled
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) purge(ctx context.Context) {
+	purged, err := w.repository.PurgeTasks(ctx, w.retention)
+	if err != nil {
+		if !errors.Is(err, context.Canceled) {
+			w.log.Error("error while purging deleted tasks", sl.Err(err))
+		}
+		return
+	}
+
+	if purged != 0 {
+		w.log.Info("deleted tasks purged", slog.Int64("count", purged))
+	}
+}
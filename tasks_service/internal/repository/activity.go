@@ -0,0 +1,345 @@
+package tasks_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"github.com/liriquew/control_system/tasks_service/internal/models"
+)
+
+// lockTask returns task fields tracked in the activity and audit log and locks the task
+// until the end of transaction
+func lockTask(ctx context.Context, txn *sql.Tx, taskID int64) (*models.Task, error) {
+	query := `
+	SELECT
+		t.title, COALESCE(t.description, ''), t.planned_time, COALESCE(t.actual_time, 0), t.tags,
+		t.percent_complete, t.crash_cost, t.crash_min_time, t.status, tg.assigned_to, tg.group_id
+	FROM tasks t LEFT JOIN tasks_groups tg ON t.id = tg.task_id
+	WHERE t.id=$1 AND t.deleted_at IS NULL
+	FOR UPDATE OF t`
+
+	task := &models.Task{ID: taskID}
+	err := txn.QueryRowContext(ctx, query, taskID).Scan(
+		&task.Title, &task.Description, &task.PlannedTime, &task.ActualTime, &task.Tags,
+		&task.PercentComplete, &task.CrashCost, &task.CrashMinTime, &task.Status, &task.AssignedTo, &task.GroupID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return task, nil
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatNullInt(v sql.NullInt64) string {
+	if !v.Valid {
+		return ""
+	}
+	return strconv.FormatInt(v.Int64, 10)
+}
+
+func formatTags(tags []int32) string {
+	res := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		res = append(res, strconv.FormatInt(int64(tag), 10))
+	}
+	return strings.Join(res, ",")
+}
+
+func appendTaskChange(changes []*models.TaskChange, field, oldValue, newValue string) []*models.TaskChange {
+	if oldValue == newValue {
+		return changes
+	}
+
+	return append(changes, &models.TaskChange{
+		Field:    field,
+		OldValue: oldValue,
+		NewValue: newValue,
+	})
+}
+
+// taskChanges returns changes of fields set by the update, field names match the api
+func taskChanges(prev *models.Task, task *tsks_pb.Task) []*models.TaskChange {
+	var changes []*models.TaskChange
+	if task.Title != "" {
+		changes = appendTaskChange(changes, "title", prev.Title, task.Title)
+	}
+	if task.Description != "" {
+		changes = appendTaskChange(changes, "description", prev.Description, task.Description)
+	}
+	if task.PlannedTime != 0 {
+		changes = appendTaskChange(changes, "planned_time", formatFloat(prev.PlannedTime), formatFloat(task.PlannedTime))
+	}
+	if task.ActualTime != 0 {
+		changes = appendTaskChange(changes, "actual_time", formatFloat(prev.ActualTime), formatFloat(task.ActualTime))
+		changes = appendTaskChange(changes, "percent_complete", formatFloat(prev.PercentComplete), formatFloat(100))
+	} else if task.PercentComplete != 0 {
+		changes = appendTaskChange(changes, "percent_complete", formatFloat(prev.PercentComplete), formatFloat(task.PercentComplete))
+	}
+	if len(task.Tags) != 0 {
+		changes = appendTaskChange(changes, "tags", formatTags(prev.Tags), formatTags(task.Tags))
+	}
+	if task.CrashCost != 0 {
+		changes = appendTaskChange(changes, "crash_cost", formatFloat(prev.CrashCost), formatFloat(task.CrashCost))
+	}
+	if task.CrashMinTime != 0 {
+		changes = appendTaskChange(changes, "crash_min_time", formatFloat(prev.CrashMinTime), formatFloat(task.CrashMinTime))
+	}
+
+	return changes
+}
+
+func recordTaskChanges(ctx context.Context, txn *sql.Tx, taskID, changedBy int64, changes []*models.TaskChange) error {
+	query := `
+	INSERT INTO task_changes (task_id, changed_by, field, old_value, new_value)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	for _, change := range changes {
+		if _, err := txn.ExecContext(ctx, query, taskID, changedBy, change.Field, change.OldValue, change.NewValue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *TaskRepository) GetTaskChanges(ctx context.Context, taskID int64) ([]*models.TaskChange, error) {
+	query := `
+	SELECT changed_by, changed_at, field, old_value, new_value
+	FROM task_changes WHERE task_id=$1
+	ORDER BY changed_at, id`
+
+	var changes []*models.TaskChange
+	if err := s.db.SelectContext(ctx, &changes, query, taskID); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func saveMentions(ctx context.Context, txn *sql.Tx, comment *models.Comment) error {
+	query := "INSERT INTO comment_mentions (comment_id, user_id, username) VALUES ($1, $2, $3)"
+
+	for _, mention := range comment.Mentions {
+		if _, err := txn.ExecContext(ctx, query, comment.ID, mention.UserID, mention.Username); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CreateComment saves comment with resolved mentions, sets comment id and creation time
+func (s *TaskRepository) CreateComment(ctx context.Context, comment *models.Comment) error {
+	txn, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	query := `
+	INSERT INTO comments (task_id, author_id, text) VALUES ($1, $2, $3)
+	RETURNING id, created_at`
+
+	if err := txn.QueryRowContext(ctx, query, comment.TaskID, comment.AuthorID, comment.Text).Scan(&comment.ID, &comment.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := saveMentions(ctx, txn, comment); err != nil {
+		return err
+	}
+
+	if err := auditComment(ctx, txn, comment.AuthorID, nil, comment); err != nil {
+		return err
+	}
+
+	return txn.Commit()
+}
+
+// lockComment returns the comment with mentioned users and locks it until the end of transaction
+func lockComment(ctx context.Context, txn *sql.Tx, taskID, commentID int64) (*models.Comment, error) {
+	query := "SELECT id, task_id, author_id, text FROM comments WHERE id=$1 AND task_id=$2 FOR UPDATE"
+
+	var comment models.Comment
+	err := txn.QueryRowContext(ctx, query, commentID, taskID).Scan(&comment.ID, &comment.TaskID, &comment.AuthorID, &comment.Text)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	query = "SELECT user_id FROM comment_mentions WHERE comment_id=$1 ORDER BY user_id"
+	rows, err := txn.QueryContext(ctx, query, commentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		mention := &models.Mention{CommentID: commentID}
+		if err := rows.Scan(&mention.UserID); err != nil {
+			return nil, err
+		}
+		comment.Mentions = append(comment.Mentions, mention)
+	}
+
+	return &comment, rows.Err()
+}
+
+// auditComment records the comment change, prev is nil for created comment and comment is nil for deleted one
+func auditComment(ctx context.Context, txn *sql.Tx, actorID int64, prev, comment *models.Comment) error {
+	rec := auditRecord{
+		ActorID: actorID,
+		Entity:  auditEntityComment,
+	}
+	var taskID int64
+	if prev != nil {
+		rec.EntityID, taskID = prev.ID, prev.TaskID
+		rec.Before = commentSnapshot(prev)
+	}
+	if comment != nil {
+		rec.EntityID, taskID = comment.ID, comment.TaskID
+		rec.After = commentSnapshot(comment)
+	}
+
+	groupID, err := taskGroupID(ctx, txn, taskID)
+	if err != nil {
+		return err
+	}
+	rec.GroupID = groupID
+
+	return recordAudit(ctx, txn, rec)
+}
+
+// UpdateComment replaces comment text and mentions, sets update time
+func (s *TaskRepository) UpdateComment(ctx context.Context, comment *models.Comment) error {
+	txn, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	prev, err := lockComment(ctx, txn, comment.TaskID, comment.ID)
+	if err != nil {
+		return err
+	}
+
+	query := `
+	UPDATE comments SET text=$3, updated_at=NOW()
+	WHERE id=$1 AND task_id=$2
+	RETURNING updated_at`
+
+	if err := txn.QueryRowContext(ctx, query, comment.ID, comment.TaskID, comment.Text).Scan(&comment.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	query = "DELETE FROM comment_mentions WHERE comment_id=$1"
+	if _, err := txn.ExecContext(ctx, query, comment.ID); err != nil {
+		return err
+	}
+
+	if err := saveMentions(ctx, txn, comment); err != nil {
+		return err
+	}
+
+	if err := auditComment(ctx, txn, comment.AuthorID, prev, comment); err != nil {
+		return err
+	}
+
+	return txn.Commit()
+}
+
+// DeleteComment deletes the comment, comments are deleted only by their authors
+func (s *TaskRepository) DeleteComment(ctx context.Context, taskID, commentID int64) error {
+	txn, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	prev, err := lockComment(ctx, txn, taskID, commentID)
+	if err != nil {
+		return err
+	}
+
+	query := "DELETE FROM comments WHERE id=$1 AND task_id=$2"
+	if _, err := txn.ExecContext(ctx, query, commentID, taskID); err != nil {
+		return err
+	}
+
+	if err := auditComment(ctx, txn, prev.AuthorID, prev, nil); err != nil {
+		return err
+	}
+
+	return txn.Commit()
+}
+
+func (s *TaskRepository) GetComment(ctx context.Context, taskID, commentID int64) (*models.Comment, error) {
+	query := `
+	SELECT id, task_id, author_id, text, created_at, updated_at
+	FROM comments WHERE id=$1 AND task_id=$2`
+
+	var comment models.Comment
+	if err := s.db.GetContext(ctx, &comment, query, commentID, taskID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return &comment, nil
+}
+
+// GetTaskComments returns task comments with mentions in chronological order
+func (s *TaskRepository) GetTaskComments(ctx context.Context, taskID int64) ([]*models.Comment, error) {
+	query := `
+	SELECT id, task_id, author_id, text, created_at, updated_at
+	FROM comments WHERE task_id=$1
+	ORDER BY created_at, id`
+
+	var comments []*models.Comment
+	if err := s.db.SelectContext(ctx, &comments, query, taskID); err != nil {
+		return nil, err
+	}
+	if len(comments) == 0 {
+		return comments, nil
+	}
+
+	commentIDs := make([]int64, 0, len(comments))
+	commentsMap := make(map[int64]*models.Comment, len(comments))
+	for _, comment := range comments {
+		commentIDs = append(commentIDs, comment.ID)
+		commentsMap[comment.ID] = comment
+	}
+
+	query = `
+	SELECT comment_id, user_id, username
+	FROM comment_mentions WHERE comment_id = ANY($1)
+	ORDER BY username`
+
+	var mentions []*models.Mention
+	if err := s.db.SelectContext(ctx, &mentions, query, pq.Array(commentIDs)); err != nil {
+		return nil, err
+	}
+	for _, mention := range mentions {
+		comment := commentsMap[mention.CommentID]
+		comment.Mentions = append(comment.Mentions, mention)
+	}
+
+	return comments, nil
+}
@@ -0,0 +1,248 @@
+package tasks_repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"github.com/liriquew/control_system/tasks_service/internal/models"
+)
+
+const (
+	auditEntityTask        = "task"
+	auditEntityComment     = "comment"
+	auditEntityWorkSession = "work_session"
+
+	auditActionRestore = "restore"
+
+	listAuditEventsBatchSize = 50
+)
+
+// auditRecord is a change of a single entity, Before is nil
+// for created entities and After is nil for deleted ones.
+// GroupID is zero for personal tasks, Action overrides the action
+// inferred from Before and After
+type auditRecord struct {
+	GroupID  int64
+	ActorID  int64
+	Entity   string
+	EntityID int64
+	Action   string
+	Before   map[string]any
+	After    map[string]any
+}
+
+func taskSnapshot(task *models.Task) map[string]any {
+	var assignedTo any
+	if task.AssignedTo.Valid {
+		assignedTo = task.AssignedTo.Int64
+	}
+
+	return map[string]any{
+		"title":            task.Title,
+		"description":      task.Description,
+		"planned_time":     task.PlannedTime,
+		"actual_time":      task.ActualTime,
+		"tags":             []int32(task.Tags),
+		"percent_complete": task.PercentComplete,
+		"crash_cost":       task.CrashCost,
+		"crash_min_time":   task.CrashMinTime,
+		"status":           task.Status,
+		"assigned_to":      assignedTo,
+	}
+}
+
+func commentSnapshot(comment *models.Comment) map[string]any {
+	mentions := make([]int64, 0, len(comment.Mentions))
+	for _, mention := range comment.Mentions {
+		mentions = append(mentions, mention.UserID)
+	}
+	slices.Sort(mentions)
+
+	return map[string]any{
+		"task_id":   comment.TaskID,
+		"author_id": comment.AuthorID,
+		"text":      comment.Text,
+		"mentions":  mentions,
+	}
+}
+
+func workSessionSnapshot(session *models.WorkSession) map[string]any {
+	var stoppedAt any
+	if session.StoppedAt.Valid {
+		stoppedAt = session.StoppedAt.Time
+	}
+
+	return map[string]any{
+		"task_id":    session.TaskID,
+		"user_id":    session.UserID,
+		"started_at": session.StartedAt,
+		"stopped_at": stoppedAt,
+	}
+}
+
+func auditJSON(fields map[string]any) (any, error) {
+	if fields == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+// recordAudit appends the change to the audit log, update keeps
+// only changed fields and is not recorded if nothing has changed
+func recordAudit(ctx context.Context, txn *sql.Tx, rec auditRecord) error {
+	action := "update"
+	switch {
+	case rec.Action != "":
+		action = rec.Action
+	case rec.Before == nil:
+		action = "create"
+	case rec.After == nil:
+		action = "delete"
+	default:
+		before := make(map[string]any)
+		after := make(map[string]any)
+		for field, value := range rec.After {
+			if !reflect.DeepEqual(rec.Before[field], value) {
+				before[field] = rec.Before[field]
+				after[field] = value
+			}
+		}
+		if len(after) == 0 {
+			return nil
+		}
+		rec.Before, rec.After = before, after
+	}
+
+	before, err := auditJSON(rec.Before)
+	if err != nil {
+		return err
+	}
+	after, err := auditJSON(rec.After)
+	if err != nil {
+		return err
+	}
+
+	var groupID sql.NullInt64
+	if rec.GroupID != 0 {
+		groupID = sql.NullInt64{Int64: rec.GroupID, Valid: true}
+	}
+
+	query := `
+	INSERT INTO audit_events (group_id, actor_id, entity, entity_id, action, before, after)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err = txn.ExecContext(ctx, query, groupID, rec.ActorID, rec.Entity, rec.EntityID, action, before, after)
+	return err
+}
+
+// auditTask records the task change, prev is nil for created task and task is nil for deleted one
+func auditTask(ctx context.Context, txn *sql.Tx, actorID int64, prev, task *models.Task) error {
+	rec := auditRecord{
+		ActorID: actorID,
+		Entity:  auditEntityTask,
+	}
+	if prev != nil {
+		rec.EntityID, rec.GroupID = prev.ID, prev.GroupID.Int64
+		rec.Before = taskSnapshot(prev)
+	}
+	if task != nil {
+		rec.EntityID, rec.GroupID = task.ID, task.GroupID.Int64
+		rec.After = taskSnapshot(task)
+	}
+
+	return recordAudit(ctx, txn, rec)
+}
+
+// auditTaskUpdate records changes made to the task in the transaction,
+// prev is the task locked before the changes
+func auditTaskUpdate(ctx context.Context, txn *sql.Tx, actorID int64, prev *models.Task) error {
+	task, err := lockTask(ctx, txn, prev.ID)
+	if err != nil {
+		return err
+	}
+
+	return auditTask(ctx, txn, actorID, prev, task)
+}
+
+// taskGroupID returns the task group or zero for personal task
+func taskGroupID(ctx context.Context, txn *sql.Tx, taskID int64) (int64, error) {
+	query := "SELECT group_id FROM tasks_groups WHERE task_id=$1"
+
+	var groupID int64
+	if err := txn.QueryRowContext(ctx, query, taskID).Scan(&groupID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return groupID, nil
+}
+
+// ListAuditEvents returns group audit events from newest to oldest
+func (s *TaskRepository) ListAuditEvents(ctx context.Context, req *tsks_pb.ListAuditEventsRequest) ([]*models.AuditEvent, error) {
+	query := `
+	SELECT
+		id, group_id, actor_id, entity, entity_id, action,
+		COALESCE(before::text, '') AS before, COALESCE(after::text, '') AS after, created_at
+	FROM audit_events
+	WHERE %s
+	ORDER BY created_at DESC, id DESC
+	LIMIT $%d`
+
+	conditions := []string{"group_id=$1"}
+	args := []any{req.GroupID}
+
+	if req.Entity != "" {
+		args = append(args, req.Entity)
+		conditions = append(conditions, fmt.Sprintf("entity=$%d", len(args)))
+	}
+	if req.EntityID != 0 {
+		args = append(args, req.EntityID)
+		conditions = append(conditions, fmt.Sprintf("entity_id=$%d", len(args)))
+	}
+	if req.ActorID != 0 {
+		args = append(args, req.ActorID)
+		conditions = append(conditions, fmt.Sprintf("actor_id=$%d", len(args)))
+	}
+	if req.From != nil {
+		args = append(args, req.From.AsTime())
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if req.To != nil {
+		args = append(args, req.To.AsTime())
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+	if req.BeforeTime != nil {
+		args = append(args, req.BeforeTime.AsTime(), req.BeforeID)
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = listAuditEventsBatchSize
+	}
+	args = append(args, limit)
+
+	query = fmt.Sprintf(query, strings.Join(conditions, " AND "), len(args))
+
+	var events []*models.AuditEvent
+	if err := s.db.SelectContext(ctx, &events, query, args...); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,73 @@
+package tasks_repository
+
+import (
+	"context"
+	"database/sql"
+
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+)
+
+// SaveTasks saves tasks in one transaction, returned item errors are in the
+// order of tasks, a failed item doesn't abort the rest of the batch
+func (s *TaskRepository) SaveTasks(ctx context.Context, tasks []*tsks_pb.Task) ([]error, error) {
+	return s.batch(ctx, len(tasks), func(txn *sql.Tx, i int) error {
+		return saveTask(ctx, txn, tasks[i])
+	})
+}
+
+// UpdateTasks updates tasks in one transaction, group tasks are updated
+// without creator check like in UpdateGroupTask
+func (s *TaskRepository) UpdateTasks(ctx context.Context, tasks []*tsks_pb.Task) ([]error, error) {
+	return s.batch(ctx, len(tasks), func(txn *sql.Tx, i int) error {
+		if tasks[i].GroupID != 0 {
+			return updateGroupTask(ctx, txn, tasks[i])
+		}
+		return updateTask(ctx, txn, tasks[i])
+	})
+}
+
+// DeleteTasks deletes group tasks if groupID isn't zero, otherwise user tasks
+func (s *TaskRepository) DeleteTasks(ctx context.Context, userID, groupID int64, taskIDs []int64) ([]error, error) {
+	return s.batch(ctx, len(taskIDs), func(txn *sql.Tx, i int) error {
+		if groupID != 0 {
+			return deleteGroupTask(ctx, txn, userID, taskIDs[i])
+		}
+		return deleteUserTask(ctx, txn, userID, taskIDs[i])
+	})
+}
+
+// batch runs n items in one transaction, each item runs in its own savepoint,
+// so the item error rolls back only this item
+func (s *TaskRepository) batch(ctx context.Context, n int, item func(txn *sql.Tx, i int) error) ([]error, error) {
+	txn, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		txn.Rollback()
+	}()
+
+	itemErrs := make([]error, n)
+	for i := range n {
+		if _, err := txn.ExecContext(ctx, "SAVEPOINT batch_item"); err != nil {
+			return nil, err
+		}
+
+		if itemErrs[i] = item(txn, i); itemErrs[i] != nil {
+			if _, err := txn.ExecContext(ctx, "ROLLBACK TO SAVEPOINT batch_item"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if _, err := txn.ExecContext(ctx, "RELEASE SAVEPOINT batch_item"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+
+	return itemErrs, nil
+}
@@ -0,0 +1,211 @@
+package tasks_repository
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"github.com/liriquew/control_system/tasks_service/internal/models"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// columns of sort keys, id is used as a tie breaker
+var taskSortColumns = map[tsks_pb.TaskSortKey]string{
+	tsks_pb.TaskSortKey_CreatedAt:   "t.created_at",
+	tsks_pb.TaskSortKey_PlannedTime: "t.planned_time",
+	tsks_pb.TaskSortKey_Title:       "t.title",
+}
+
+// taskCursor is a position after the last task of the page,
+// cursor is valid only for the sort it was created with
+type taskCursor struct {
+	SortBy      tsks_pb.TaskSortKey `json:"s"`
+	Asc         bool                `json:"a"`
+	ID          int64               `json:"i"`
+	CreatedAt   time.Time           `json:"c,omitempty"`
+	PlannedTime float64             `json:"p,omitempty"`
+	Title       string              `json:"t,omitempty"`
+}
+
+func newTaskCursor(req *tsks_pb.TaskListRequest, task *models.Task) string {
+	cursor := taskCursor{
+		SortBy: req.SortBy,
+		Asc:    req.Asc,
+		ID:     task.ID,
+	}
+	switch req.SortBy {
+	case tsks_pb.TaskSortKey_CreatedAt:
+		cursor.CreatedAt = task.CreatedAt
+	case tsks_pb.TaskSortKey_PlannedTime:
+		cursor.PlannedTime = task.PlannedTime
+	case tsks_pb.TaskSortKey_Title:
+		cursor.Title = task.Title
+	}
+
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// parseTaskCursor returns the sort value and id of the cursor
+func parseTaskCursor(req *tsks_pb.TaskListRequest) (any, int64, error) {
+	data, err := base64.RawURLEncoding.DecodeString(req.Cursor)
+	if err != nil {
+		return nil, 0, ErrInvalidCursor
+	}
+
+	var cursor taskCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, 0, ErrInvalidCursor
+	}
+	if cursor.SortBy != req.SortBy || cursor.Asc != req.Asc {
+		return nil, 0, ErrInvalidCursor
+	}
+
+	switch cursor.SortBy {
+	case tsks_pb.TaskSortKey_PlannedTime:
+		return cursor.PlannedTime, cursor.ID, nil
+	case tsks_pb.TaskSortKey_Title:
+		return cursor.Title, cursor.ID, nil
+	default:
+		return cursor.CreatedAt, cursor.ID, nil
+	}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// GetTaskList returns a page of tasks created by or assigned to the user
+// and the cursor of the next page
+func (s *TaskRepository) GetTaskList(ctx context.Context, userID int64, req *tsks_pb.TaskListRequest) ([]*models.Task, string, error) {
+	return s.listTasks(ctx, "(t.created_by=$1 OR tg.assigned_to=$1)", userID, req)
+}
+
+// GetGroupTaskList returns a page of group tasks, group is set by req.Filter.GroupID
+func (s *TaskRepository) GetGroupTaskList(ctx context.Context, req *tsks_pb.TaskListRequest) ([]*models.Task, string, error) {
+	return s.listTasks(ctx, "tg.group_id=$1", req.GetFilter().GetGroupID(), req)
+}
+
+// listTasks selects tasks matching scope condition with a single
+// parameter $1 and the request filter, sorted by the request sort key
+func (s *TaskRepository) listTasks(ctx context.Context, scope string, scopeArg any, req *tsks_pb.TaskListRequest) ([]*models.Task, string, error) {
+	query := `
+	SELECT
+		t.id, t.created_by, t.title, t.description,
+		t.planned_time, t.actual_time, t.created_at, t.tags,
+		t.percent_complete, t.completed_at, t.crash_cost, t.crash_min_time, t.status,
+		tg.group_id, tg.assigned_to
+	FROM tasks t LEFT JOIN tasks_groups tg ON t.id = tg.task_id
+	WHERE t.deleted_at IS NULL AND %s
+	ORDER BY %s
+	LIMIT $%d
+	`
+
+	conditions := []string{scope}
+	args := []any{scopeArg}
+	argPos := 1
+
+	filter := req.GetFilter()
+	if filter.GetGroupID() != 0 {
+		argPos++
+		conditions = append(conditions, fmt.Sprintf("tg.group_id=$%d", argPos))
+		args = append(args, filter.GroupID)
+	}
+	if filter.GetAssignedTo() != 0 {
+		argPos++
+		conditions = append(conditions, fmt.Sprintf("tg.assigned_to=$%d", argPos))
+		args = append(args, filter.AssignedTo)
+	}
+	if len(filter.GetTags()) != 0 {
+		argPos++
+		if filter.AllTags {
+			conditions = append(conditions, fmt.Sprintf("t.tags @> $%d::integer[]", argPos))
+		} else {
+			conditions = append(conditions, fmt.Sprintf("t.tags && $%d::integer[]", argPos))
+		}
+		args = append(args, pq.Array(filter.Tags))
+	}
+	switch filter.GetState() {
+	case tsks_pb.TaskState_Open:
+		conditions = append(conditions, "COALESCE(t.actual_time, 0) = 0")
+	case tsks_pb.TaskState_Completed:
+		conditions = append(conditions, "COALESCE(t.actual_time, 0) != 0")
+	}
+	if len(filter.GetStatuses()) != 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			name, _ := models.StatusFromProto(status)
+			statuses = append(statuses, name)
+		}
+
+		argPos++
+		conditions = append(conditions, fmt.Sprintf("t.status = ANY($%d)", argPos))
+		args = append(args, pq.Array(statuses))
+	}
+	if filter.GetCreatedFrom() != nil {
+		argPos++
+		conditions = append(conditions, fmt.Sprintf("t.created_at >= $%d", argPos))
+		args = append(args, filter.CreatedFrom.AsTime())
+	}
+	if filter.GetCreatedTo() != nil {
+		argPos++
+		conditions = append(conditions, fmt.Sprintf("t.created_at < $%d", argPos))
+		args = append(args, filter.CreatedTo.AsTime())
+	}
+	if filter.GetTitle() != "" {
+		argPos++
+		conditions = append(conditions, fmt.Sprintf("t.title ILIKE '%%' || $%d || '%%'", argPos))
+		args = append(args, likeEscaper.Replace(filter.Title))
+	}
+
+	column, ok := taskSortColumns[req.SortBy]
+	if !ok {
+		column = taskSortColumns[tsks_pb.TaskSortKey_CreatedAt]
+	}
+	direction, compare := "DESC", "<"
+	if req.Asc {
+		direction, compare = "ASC", ">"
+	}
+
+	if req.Cursor != "" {
+		value, id, err := parseTaskCursor(req)
+		if err != nil {
+			return nil, "", err
+		}
+
+		argPos += 2
+		conditions = append(conditions, fmt.Sprintf("(%s, t.id) %s ($%d, $%d)", column, compare, argPos-1, argPos))
+		args = append(args, value, id)
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = listTasksBatchSize
+	}
+	// one more task shows that there is the next page
+	argPos++
+	args = append(args, limit+1)
+
+	query = fmt.Sprintf(query,
+		strings.Join(conditions, " AND "),
+		fmt.Sprintf("%s %s, t.id %s", column, direction, direction),
+		argPos,
+	)
+
+	var tasks []*models.Task
+	if err := s.db.SelectContext(ctx, &tasks, query, args...); err != nil {
+		return nil, "", err
+	}
+
+	if len(tasks) <= limit {
+		return tasks, "", nil
+	}
+
+	tasks = tasks[:limit]
+	return tasks, newTaskCursor(req, tasks[limit-1]), nil
+}
@@ -0,0 +1,101 @@
+package tasks_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/liriquew/control_system/tasks_service/internal/models"
+)
+
+var (
+	ErrStatusChanged       = errors.New("task status changed")
+	ErrForbiddenTransition = errors.New("forbidden status transition")
+)
+
+func recordStatusChange(ctx context.Context, txn *sql.Tx, taskID int64, from, to string, changedBy int64) error {
+	query := `
+	INSERT INTO task_status_history (task_id, from_status, to_status, changed_by)
+	VALUES ($1, $2, $3, $4)`
+
+	_, err := txn.ExecContext(ctx, query, taskID, from, to, changedBy)
+	return err
+}
+
+// TransitionTask changes task status, if the task status isn't from anymore
+// returns ErrStatusChanged, task moved to done gets actual time.
+// Active work sessions are stopped when the task leaves in progress status
+func (s *TaskRepository) TransitionTask(ctx context.Context, taskID int64, from, to string, changedBy int64, actualTime float64) error {
+	txn, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	prev, err := lockTask(ctx, txn, taskID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return ErrStatusChanged
+		}
+		return err
+	}
+
+	query := "UPDATE tasks SET status=$3 WHERE id=$1 AND status=$2 RETURNING id"
+	args := []any{taskID, from, to}
+	if to == models.StatusDone {
+		if actualTime, err = completionTime(ctx, txn, taskID, actualTime); err != nil {
+			return err
+		}
+
+		query = `
+		UPDATE tasks SET status=$3, actual_time=$4, percent_complete=100, completed_at=NOW()
+		WHERE id=$1 AND status=$2 RETURNING id`
+		args = append(args, actualTime)
+	} else if to != models.StatusInProgress {
+		if _, err := stopWorkSessions(ctx, txn, taskID); err != nil {
+			return err
+		}
+	}
+
+	if err := txn.QueryRowContext(ctx, query, args...).Scan(&taskID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrStatusChanged
+		}
+		return err
+	}
+
+	if err := recordStatusChange(ctx, txn, taskID, from, to, changedBy); err != nil {
+		return err
+	}
+
+	if to == models.StatusDone {
+		query = "INSERT INTO outbox (task_id, op) VALUES ($1, $2)"
+		if _, err := txn.ExecContext(ctx, query, taskID, taskUpdateOperation); err != nil {
+			return err
+		}
+	}
+
+	if err := auditTaskUpdate(ctx, txn, changedBy, prev); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *TaskRepository) GetTaskStatusHistory(ctx context.Context, taskID int64) ([]*models.TaskStatusChange, error) {
+	query := `
+	SELECT from_status, to_status, changed_by, changed_at
+	FROM task_status_history WHERE task_id=$1
+	ORDER BY changed_at, id`
+
+	var changes []*models.TaskStatusChange
+	if err := s.db.SelectContext(ctx, &changes, query, taskID); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
 	"github.com/liriquew/control_system/tasks_service/internal/lib/config"
@@ -69,43 +71,64 @@ func (s *TaskRepository) SaveTask(ctx context.Context, task *tsks_pb.Task) (int6
 		txn.Rollback()
 	}()
 
-	query := `INSERT INTO tasks (created_by, title, description, planned_time, actual_time, tags)
-			  VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`
-	err = txn.QueryRowContext(
+	if err := saveTask(ctx, txn, task); err != nil {
+		return 0, err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return 0, err
+	}
+	return task.ID, nil
+}
+
+func saveTask(ctx context.Context, txn *sql.Tx, task *tsks_pb.Task) error {
+	// completed task is always done by 100 percent
+	percentComplete, completedAt, status := task.PercentComplete, sql.NullTime{}, models.StatusBacklog
+	if task.ActualTime != 0 {
+		percentComplete, completedAt, status = 100, sql.NullTime{Time: time.Now().UTC(), Valid: true}, models.StatusDone
+	}
+
+	query := `INSERT INTO tasks (created_by, title, description, planned_time, actual_time, tags, percent_complete, completed_at, crash_cost, crash_min_time, status)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id`
+	err := txn.QueryRowContext(
 		ctx,
 		query,
 		task.CreatedBy, task.Title, task.Description, task.PlannedTime, task.ActualTime, pq.Array(task.Tags),
+		percentComplete, completedAt, task.CrashCost, task.CrashMinTime, status,
 	).Scan(&task.ID)
 	if err != nil {
-		return 0, err
+		return err
 	}
 
 	if task.GroupID != 0 {
 		query = "INSERT INTO tasks_groups (task_id, group_id, assigned_to) VALUES ($1, $2, $3)"
-		if _, err := txn.Exec(query, task.ID, task.GroupID, task.AssignedTo); err != nil {
-			return 0, err
+		if _, err := txn.ExecContext(ctx, query, task.ID, task.GroupID, task.AssignedTo); err != nil {
+			return err
 		}
 	}
 
 	if task.ActualTime != 0 {
 		query := "INSERT INTO outbox (task_id, op) VALUES ($1, $2)"
 		if _, err := txn.ExecContext(ctx, query, task.ID, taskUpdateOperation); err != nil {
-			return 0, err
+			return err
 		}
 	}
 
-	if err := txn.Commit(); err != nil {
-		return 0, err
+	created, err := lockTask(ctx, txn, task.ID)
+	if err != nil {
+		return err
 	}
-	return task.ID, nil
+
+	return auditTask(ctx, txn, task.CreatedBy, nil, created)
 }
 
 func (s *TaskRepository) GetGroupTasks(ctx context.Context, groupID int64) ([]*models.Task, error) {
 	query := `
 	SELECT t.id, t.created_by, t.title, t.description, t.planned_time,
 		t.actual_time, t.created_at, t.tags,
+		t.percent_complete, t.completed_at, t.crash_cost, t.crash_min_time, t.status,
 		tg.group_id, tg.assigned_to
-	FROM tasks t JOIN tasks_groups tg ON t.id = tg.task_id WHERE tg.group_id=$1`
+	FROM tasks t JOIN tasks_groups tg ON t.id = tg.task_id WHERE tg.group_id=$1 AND t.deleted_at IS NULL`
 
 	var tasks []*models.Task
 	if err := s.db.SelectContext(ctx, &tasks, query, groupID); err != nil {
@@ -123,8 +146,9 @@ func (s *TaskRepository) GetTaskByID(ctx context.Context, taskID int64) (*models
 	query := `
 	SELECT
 		t.id, t.created_by, t.title, t.description, t.planned_time, t.actual_time, t.created_at, t.tags,
+		t.percent_complete, t.completed_at, t.crash_cost, t.crash_min_time, t.status,
 		tg.group_id, tg.assigned_to
-	FROM tasks t LEFT JOIN tasks_groups tg ON t.id = tg.task_id WHERE t.id=$1
+	FROM tasks t LEFT JOIN tasks_groups tg ON t.id = tg.task_id WHERE t.id=$1 AND t.deleted_at IS NULL
 	`
 
 	var task models.Task
@@ -140,103 +164,117 @@ func (s *TaskRepository) GetTaskByID(ctx context.Context, taskID int64) (*models
 	return &task, nil
 }
 
-func (s *TaskRepository) GetTaskList(ctx context.Context, userID, offset int64) ([]*models.Task, error) {
-	query := `
-	SELECT
-		t.id, t.created_by, t.title, t.description,
-		t.planned_time, t.actual_time, t.created_at, t.tags,
-		tg.group_id, tg.assigned_to
-	FROM tasks t LEFT JOIN tasks_groups tg ON t.id = tg.task_id WHERE t.created_by=$1 OR tg.assigned_to=$1
-	ORDER BY t.created_at DESC
-	OFFSET $2 LIMIT $3
-	`
-	var tasks []*models.Task
-	err := s.db.SelectContext(ctx, &tasks, query, userID, offset, listTasksBatchSize)
-	if err != nil && errors.Is(err, sql.ErrNoRows) {
-		return nil, err
+func (s *TaskRepository) UpdateTask(ctx context.Context, task *tsks_pb.Task) error {
+	txn, err := s.db.Begin()
+	if err != nil {
+		return err
 	}
+	defer func() {
+		txn.Rollback()
+	}()
 
-	return tasks, nil
-}
+	if err := updateTask(ctx, txn, task); err != nil {
+		return err
+	}
 
-func (s *TaskRepository) UpdateTask(ctx context.Context, task *tsks_pb.Task) error {
-	query := "UPDATE tasks SET %s WHERE created_by=$1 AND id=$2 RETURNING id"
+	if err := txn.Commit(); err != nil {
+		return err
+	}
 
-	var fields []string
-	args := []any{task.CreatedBy, task.ID}
-	argPos := 2
+	return nil
+}
 
-	if task.ActualTime != 0 {
-		argPos++
-		fields = append(fields, fmt.Sprintf("actual_time=$%d", argPos))
-		args = append(args, task.ActualTime)
-	}
-	if task.PlannedTime != 0 {
-		argPos++
-		fields = append(fields, fmt.Sprintf("planned_time=$%d", argPos))
-		args = append(args, task.PlannedTime)
+func (s *TaskRepository) UpdateGroupTask(ctx context.Context, task *tsks_pb.Task) error {
+	txn, err := s.db.Begin()
+	if err != nil {
+		return err
 	}
-	if task.Description != "" {
-		argPos++
-		fields = append(fields, fmt.Sprintf("description=$%d", argPos))
-		args = append(args, task.Description)
+	defer txn.Rollback()
+
+	if err := updateGroupTask(ctx, txn, task); err != nil {
+		return err
 	}
-	if task.Title != "" {
-		argPos++
-		fields = append(fields, fmt.Sprintf("title=$%d", argPos))
-		args = append(args, task.Title)
+
+	if err := txn.Commit(); err != nil {
+		return err
 	}
-	if len(task.Tags) != 0 {
-		argPos++
-		fields = append(fields, fmt.Sprintf("tags=$%d", argPos))
-		args = append(args, task.Tags)
+
+	return nil
+}
+
+func updateTask(ctx context.Context, txn *sql.Tx, task *tsks_pb.Task) error {
+	query := "UPDATE tasks SET %s WHERE created_by=$1 AND id=$2 RETURNING id"
+
+	prev, err := updateTaskFields(ctx, txn, task, query, []any{task.CreatedBy, task.ID})
+	if err != nil {
+		return err
 	}
 
-	query = fmt.Sprintf(query, strings.Join(fields, ", "))
+	return auditTaskUpdate(ctx, txn, task.CreatedBy, prev)
+}
 
-	txn, err := s.db.Begin()
+func updateGroupTask(ctx context.Context, txn *sql.Tx, task *tsks_pb.Task) error {
+	query := "UPDATE tasks SET %s WHERE id=$1 RETURNING id"
+
+	prev, err := updateTaskFields(ctx, txn, task, query, []any{task.ID})
 	if err != nil {
 		return err
 	}
-	defer func() {
-		txn.Rollback()
-	}()
 
-	if len(fields) != 0 {
-		err := txn.QueryRowContext(ctx, query, args...).Scan(&task.ID)
+	if task.AssignedTo != 0 {
+		query = "UPDATE tasks_groups SET assigned_to=$1 WHERE task_id=$2"
+		rowsAffected, err := txn.ExecContext(ctx, query, task.AssignedTo, task.ID)
 		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				return ErrNotFound
-			}
 			return err
 		}
-	}
-
-	if task.ActualTime != 0 {
-		query := "INSERT INTO outbox (task_id, op) VALUES ($1, $2)"
-		if _, err := txn.Exec(query, task.ID, taskUpdateOperation); err != nil {
+		rows, err := rowsAffected.RowsAffected()
+		if err != nil {
 			return err
 		}
-	}
+		if rows == 0 {
+			return ErrTaskNotInGroup
+		}
 
-	if err := txn.Commit(); err != nil {
-		return err
+		changes := appendTaskChange(nil, "assigned_to", formatNullInt(prev.AssignedTo), strconv.FormatInt(task.AssignedTo, 10))
+		if err := recordTaskChanges(ctx, txn, task.ID, task.CreatedBy, changes); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return auditTaskUpdate(ctx, txn, task.CreatedBy, prev)
 }
 
-func (s *TaskRepository) UpdateGroupTask(ctx context.Context, task *tsks_pb.Task) error {
-	query := "UPDATE tasks SET %s WHERE id=$1 RETURNING id"
+// updateTaskFields updates non-zero task fields, query must contain
+// the SET placeholder and use args as its first parameters.
+// Changed fields are recorded to the task activity, returns the task before update
+func updateTaskFields(ctx context.Context, txn *sql.Tx, task *tsks_pb.Task, query string, args []any) (*models.Task, error) {
+	prev, err := lockTask(ctx, txn, task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// setting actual time completes the task, actual time
+	// of the completed task can be corrected without status change
+	if task.ActualTime != 0 {
+		if prev.Status != models.StatusDone && !models.CanTransition(prev.Status, models.StatusDone) {
+			return nil, ErrForbiddenTransition
+		}
+		if task.ActualTime, err = completionTime(ctx, txn, task.ID, task.ActualTime); err != nil {
+			return nil, err
+		}
+	}
 
 	var fields []string
-	args := []any{task.ID}
-	argPos := 1
+	argPos := len(args)
 
 	if task.ActualTime != 0 {
 		argPos++
-		fields = append(fields, fmt.Sprintf("actual_time=$%d", argPos))
+		fields = append(fields, fmt.Sprintf("actual_time=$%d", argPos), "percent_complete=100", "completed_at=NOW()", "status='done'")
 		args = append(args, task.ActualTime)
+	} else if task.PercentComplete != 0 {
+		argPos++
+		fields = append(fields, fmt.Sprintf("percent_complete=$%d", argPos))
+		args = append(args, task.PercentComplete)
 	}
 	if task.PlannedTime != 0 {
 		argPos++
@@ -258,45 +296,90 @@ func (s *TaskRepository) UpdateGroupTask(ctx context.Context, task *tsks_pb.Task
 		fields = append(fields, fmt.Sprintf("tags=$%d", argPos))
 		args = append(args, task.Tags)
 	}
-
-	query = fmt.Sprintf(query, strings.Join(fields, ", "))
-
-	txn, err := s.db.Begin()
-	if err != nil {
-		return err
+	if task.CrashCost != 0 {
+		argPos++
+		fields = append(fields, fmt.Sprintf("crash_cost=$%d", argPos))
+		args = append(args, task.CrashCost)
+	}
+	if task.CrashMinTime != 0 {
+		argPos++
+		fields = append(fields, fmt.Sprintf("crash_min_time=$%d", argPos))
+		args = append(args, task.CrashMinTime)
 	}
-	defer txn.Rollback()
 
 	if len(fields) != 0 {
-		err = txn.QueryRowContext(ctx, query, args...).Scan(&task.ID)
+		query = fmt.Sprintf(query, strings.Join(fields, ", "))
+		err := txn.QueryRowContext(ctx, query, args...).Scan(&task.ID)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
-				return ErrNotFound
+				return nil, ErrNotFound
 			}
-			return err
+			return nil, err
 		}
 	}
 
-	if task.AssignedTo != 0 {
-		query = "UPDATE tasks_groups SET assigned_to=$1 WHERE task_id=$2"
-		rowsAffected, err := txn.ExecContext(ctx, query, task.AssignedTo, task.ID)
-		if err != nil {
-			return err
-		}
-		rows, err := rowsAffected.RowsAffected()
-		if err != nil {
-			return err
+	if task.ActualTime != 0 {
+		if prev.Status != models.StatusDone {
+			if err := recordStatusChange(ctx, txn, task.ID, prev.Status, models.StatusDone, task.CreatedBy); err != nil {
+				return nil, err
+			}
 		}
-		if rows == 0 {
-			return ErrTaskNotInGroup
+
+		query := "INSERT INTO outbox (task_id, op) VALUES ($1, $2)"
+		if _, err := txn.ExecContext(ctx, query, task.ID, taskUpdateOperation); err != nil {
+			return nil, err
 		}
 	}
 
-	if task.ActualTime != 0 {
-		query := "INSERT INTO outbox (task_id, op) VALUES ($1, $2)"
-		if _, err := txn.Exec(query, task.ID, taskUpdateOperation); err != nil {
-			return err
+	if err := recordTaskChanges(ctx, txn, task.ID, task.CreatedBy, taskChanges(prev, task)); err != nil {
+		return nil, err
+	}
+
+	return prev, nil
+}
+
+// TaskDone marks task as completed with actual time,
+// time tracked by work sessions takes precedence
+func (s *TaskRepository) TaskDone(ctx context.Context, taskID, userID int64, actualTime float64) error {
+	query := `
+	UPDATE tasks SET actual_time=$2, percent_complete=100, completed_at=NOW(), status='done'
+	WHERE id=$1 RETURNING id`
+
+	txn, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	prev, err := lockTask(ctx, txn, taskID)
+	if err != nil {
+		return err
+	}
+	if !models.CanTransition(prev.Status, models.StatusDone) {
+		return ErrForbiddenTransition
+	}
+	if actualTime, err = completionTime(ctx, txn, taskID, actualTime); err != nil {
+		return err
+	}
+
+	if err := txn.QueryRowContext(ctx, query, taskID, actualTime).Scan(&taskID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
 		}
+		return err
+	}
+
+	if err := recordStatusChange(ctx, txn, taskID, prev.Status, models.StatusDone, userID); err != nil {
+		return err
+	}
+
+	query = "INSERT INTO outbox (task_id, op) VALUES ($1, $2)"
+	if _, err := txn.ExecContext(ctx, query, taskID, taskUpdateOperation); err != nil {
+		return err
+	}
+
+	if err := auditTaskUpdate(ctx, txn, userID, prev); err != nil {
+		return err
 	}
 
 	if err := txn.Commit(); err != nil {
@@ -307,10 +390,6 @@ func (s *TaskRepository) UpdateGroupTask(ctx context.Context, task *tsks_pb.Task
 }
 
 func (s *TaskRepository) DeleteUserTask(ctx context.Context, userID, taskID int64) error {
-	query := `
-	DELETE FROM tasks
-	WHERE id=$1 AND created_by=$2`
-
 	txn, err := s.db.Begin()
 	if err != nil {
 		return err
@@ -319,12 +398,7 @@ func (s *TaskRepository) DeleteUserTask(ctx context.Context, userID, taskID int6
 		txn.Rollback()
 	}()
 
-	if _, err := s.db.ExecContext(ctx, query, taskID, userID); err != nil {
-		return fmt.Errorf("error executing delete query: %w", err)
-	}
-
-	query = "INSERT INTO outbox (task_id, op) VALUES ($1, $2)"
-	if _, err := txn.Exec(query, taskID, taskDeleteOperation); err != nil {
+	if err := deleteUserTask(ctx, txn, userID, taskID); err != nil {
 		return err
 	}
 
@@ -335,9 +409,7 @@ func (s *TaskRepository) DeleteUserTask(ctx context.Context, userID, taskID int6
 	return nil
 }
 
-func (s *TaskRepository) DeleteGroupTask(ctx context.Context, taskID int64) error {
-	query := `DELETE FROM tasks WHERE id=$1`
-
+func (s *TaskRepository) DeleteGroupTask(ctx context.Context, userID, taskID int64) error {
 	txn, err := s.db.Begin()
 	if err != nil {
 		return err
@@ -346,24 +418,139 @@ func (s *TaskRepository) DeleteGroupTask(ctx context.Context, taskID int64) erro
 		txn.Rollback()
 	}()
 
-	if _, err := s.db.ExecContext(ctx, query, taskID); err != nil {
+	if err := deleteGroupTask(ctx, txn, userID, taskID); err != nil {
+		return err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func deleteUserTask(ctx context.Context, txn *sql.Tx, userID, taskID int64) error {
+	query := `
+	UPDATE tasks SET deleted_at=NOW()
+	WHERE id=$1 AND created_by=$2 AND deleted_at IS NULL`
+
+	return deleteTask(ctx, txn, userID, taskID, query, taskID, userID)
+}
+
+func deleteGroupTask(ctx context.Context, txn *sql.Tx, userID, taskID int64) error {
+	query := `UPDATE tasks SET deleted_at=NOW() WHERE id=$1 AND deleted_at IS NULL`
+
+	return deleteTask(ctx, txn, userID, taskID, query, taskID)
+}
+
+// deleteTask runs the soft delete query, stops work on the task and records
+// deleted task to the audit log. Deleted task is kept until it's purged,
+// so predictions service is notified only by PurgeTasks
+func deleteTask(ctx context.Context, txn *sql.Tx, userID, taskID int64, query string, args ...any) error {
+	prev, err := lockTask(ctx, txn, taskID)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	res, err := txn.ExecContext(ctx, query, args...)
+	if err != nil {
 		return fmt.Errorf("error executing delete query: %w", err)
 	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
 
-	query = "INSERT INTO outbox (task_id, op) VALUES ($1, $2)"
-	if _, err := txn.Exec(query, taskID, taskDeleteOperation); err != nil {
+	if rows == 0 {
+		return nil
+	}
+
+	if _, err := stopWorkSessions(ctx, txn, taskID); err != nil {
 		return err
 	}
 
-	if err := txn.Commit(); err != nil {
+	return auditTask(ctx, txn, userID, prev, nil)
+}
+
+// RestoreUserTask restores deleted task created by the user
+func (s *TaskRepository) RestoreUserTask(ctx context.Context, userID, taskID int64) error {
+	query := `
+	UPDATE tasks SET deleted_at=NULL
+	WHERE id=$1 AND created_by=$2 AND deleted_at IS NOT NULL
+	RETURNING id`
+
+	return s.restoreTask(ctx, userID, taskID, query, taskID, userID)
+}
+
+// RestoreGroupTask restores deleted task of the group
+func (s *TaskRepository) RestoreGroupTask(ctx context.Context, userID, groupID, taskID int64) error {
+	query := `
+	UPDATE tasks t SET deleted_at=NULL
+	FROM tasks_groups tg
+	WHERE t.id=$1 AND tg.task_id=t.id AND tg.group_id=$2 AND t.deleted_at IS NOT NULL
+	RETURNING t.id`
+
+	return s.restoreTask(ctx, userID, taskID, query, taskID, groupID)
+}
+
+// restoreTask runs the restore query and records restored task to the audit log,
+// returns ErrNotFound if there is no such deleted task
+func (s *TaskRepository) restoreTask(ctx context.Context, userID, taskID int64, query string, args ...any) error {
+	txn, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
 		return err
 	}
+	defer txn.Rollback()
 
-	return nil
+	if err := txn.QueryRowContext(ctx, query, args...).Scan(&taskID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	task, err := lockTask(ctx, txn, taskID)
+	if err != nil {
+		return err
+	}
+
+	err = recordAudit(ctx, txn, auditRecord{
+		GroupID:  task.GroupID.Int64,
+		ActorID:  userID,
+		Entity:   auditEntityTask,
+		EntityID: taskID,
+		Action:   auditActionRestore,
+		After:    taskSnapshot(task),
+	})
+	if err != nil {
+		return err
+	}
+
+	return txn.Commit()
+}
+
+// PurgeTasks permanently deletes tasks deleted earlier than retention ago,
+// predictions service is notified about purged tasks through the outbox
+func (s *TaskRepository) PurgeTasks(ctx context.Context, retention time.Duration) (int64, error) {
+	query := `
+	WITH purged AS (
+		DELETE FROM tasks WHERE deleted_at < NOW() - make_interval(secs => $1)
+		RETURNING id
+	)
+	INSERT INTO outbox (task_id, op) SELECT id, $2 FROM purged`
+
+	res, err := s.db.ExecContext(ctx, query, retention.Seconds(), taskDeleteOperation)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
 }
 
 func (s *TaskRepository) TaskInAnyGroup(ctx context.Context, taskID int64) (int64, error) {
-	query := "SELECT group_id FROM tasks_groups WHERE task_id=$2"
+	query := `
+	SELECT tg.group_id FROM tasks_groups tg JOIN tasks t ON t.id = tg.task_id
+	WHERE tg.task_id=$1 AND t.deleted_at IS NULL`
 
 	var groupID int64
 	if err := s.db.QueryRowContext(ctx, query, taskID).Scan(&groupID); err != nil {
@@ -377,7 +564,9 @@ func (s *TaskRepository) TaskInAnyGroup(ctx context.Context, taskID int64) (int6
 }
 
 func (s *TaskRepository) TaskInGroup(ctx context.Context, groupID, taskID int64) error {
-	query := "SELECT 1 FROM tasks_groups WHERE group_id=$1 AND task_id=$2"
+	query := `
+	SELECT 1 FROM tasks_groups tg JOIN tasks t ON t.id = tg.task_id
+	WHERE tg.group_id=$1 AND tg.task_id=$2 AND t.deleted_at IS NULL`
 
 	var val int
 	if err := s.db.QueryRowContext(ctx, query, groupID, taskID).Scan(&val); err != nil {
@@ -395,9 +584,10 @@ func (s *TaskRepository) GetTasks(ctx context.Context, tasksIDs []int64) ([]*mod
 		SELECT
 			t.id, t.created_by, t.title, t.description,
 			t.planned_time, t.actual_time, t.created_at, t.tags,
+			t.percent_complete, t.completed_at, t.crash_cost, t.crash_min_time, t.status,
 			tg.group_id, tg.assigned_to
 		FROM tasks t LEFT JOIN tasks_groups tg ON t.id = tg.task_id
-		WHERE id = ANY($1)
+		WHERE id = ANY($1) AND t.deleted_at IS NULL
 	`
 
 	var tasks []*models.Task
@@ -439,11 +629,10 @@ func (s *TaskRepository) GetTasksToProduce(ctx context.Context) ([]*models.TaskP
 
 func (s *TaskRepository) GetTasksToDelete(ctx context.Context) ([]models.TaskPredictionData, error) {
 	const op = "tasks_repository.TaskRepository.GetTasksToDelete"
+	// task is already purged, only its id is left in the outbox
 	query := `
-		SELECT t.id, ob.id as outbox_id
-		FROM tasks t
-		JOIN outbox ob ON t.id = ob.task_id
-		LEFT JOIN tasks_groups tg ON t.id = tg.task_id
+		SELECT ob.task_id AS id, ob.id as outbox_id
+		FROM outbox ob
 		WHERE ob.op='delete' AND not ob.processed
 	`
 
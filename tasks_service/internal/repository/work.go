@@ -0,0 +1,169 @@
+package tasks_repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/liriquew/control_system/tasks_service/internal/models"
+)
+
+var (
+	ErrWorkSessionActive = errors.New("work session already started")
+	ErrNoWorkSession     = errors.New("no active work session")
+	ErrNoActualTime      = errors.New("actual time required")
+)
+
+const uniqueViolationCode = "23505"
+
+// StartWork opens work session of the user on the task, status is the task status
+// checked by the caller, task in backlog is moved to in progress
+func (s *TaskRepository) StartWork(ctx context.Context, taskID, userID int64, status string) (*models.WorkSession, error) {
+	txn, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	prev, err := lockTask(ctx, txn, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Status != status {
+		return nil, ErrStatusChanged
+	}
+
+	if status == models.StatusBacklog {
+		query := "UPDATE tasks SET status=$2 WHERE id=$1"
+		if _, err := txn.ExecContext(ctx, query, taskID, models.StatusInProgress); err != nil {
+			return nil, err
+		}
+		if err := recordStatusChange(ctx, txn, taskID, status, models.StatusInProgress, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	query := `
+	INSERT INTO work_sessions (task_id, user_id) VALUES ($1, $2)
+	RETURNING id, started_at`
+
+	session := &models.WorkSession{
+		TaskID: taskID,
+		UserID: userID,
+	}
+	if err := txn.QueryRowContext(ctx, query, taskID, userID).Scan(&session.ID, &session.StartedAt); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode {
+			return nil, ErrWorkSessionActive
+		}
+		return nil, err
+	}
+
+	if err := auditTaskUpdate(ctx, txn, userID, prev); err != nil {
+		return nil, err
+	}
+	err = recordAudit(ctx, txn, auditRecord{
+		GroupID:  prev.GroupID.Int64,
+		ActorID:  userID,
+		Entity:   auditEntityWorkSession,
+		EntityID: session.ID,
+		After:    workSessionSnapshot(session),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// StopWork stops active work session of the user on the task
+func (s *TaskRepository) StopWork(ctx context.Context, taskID, userID int64) (*models.WorkSession, error) {
+	txn, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	query := `
+	UPDATE work_sessions SET stopped_at=NOW()
+	WHERE task_id=$1 AND user_id=$2 AND stopped_at IS NULL
+	RETURNING id, task_id, user_id, started_at, stopped_at`
+
+	var session models.WorkSession
+	err = txn.QueryRowContext(ctx, query, taskID, userID).Scan(
+		&session.ID, &session.TaskID, &session.UserID, &session.StartedAt, &session.StoppedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoWorkSession
+		}
+		return nil, err
+	}
+
+	groupID, err := taskGroupID(ctx, txn, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	started := session
+	started.StoppedAt = sql.NullTime{}
+	err = recordAudit(ctx, txn, auditRecord{
+		GroupID:  groupID,
+		ActorID:  userID,
+		Entity:   auditEntityWorkSession,
+		EntityID: session.ID,
+		Before:   workSessionSnapshot(&started),
+		After:    workSessionSnapshot(&session),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+// stopWorkSessions stops active work sessions of the task and returns
+// time tracked by all task sessions in hours
+func stopWorkSessions(ctx context.Context, txn *sql.Tx, taskID int64) (float64, error) {
+	query := "UPDATE work_sessions SET stopped_at=NOW() WHERE task_id=$1 AND stopped_at IS NULL"
+	if _, err := txn.ExecContext(ctx, query, taskID); err != nil {
+		return 0, err
+	}
+
+	query = `
+	SELECT COALESCE(SUM(EXTRACT(EPOCH FROM stopped_at - started_at)), 0)::float8 / 3600
+	FROM work_sessions WHERE task_id=$1`
+
+	var tracked float64
+	if err := txn.QueryRowContext(ctx, query, taskID).Scan(&tracked); err != nil {
+		return 0, err
+	}
+
+	return tracked, nil
+}
+
+// completionTime returns actual time of the completed task, time tracked
+// by work sessions takes precedence over the reported actualTime
+func completionTime(ctx context.Context, txn *sql.Tx, taskID int64, actualTime float64) (float64, error) {
+	tracked, err := stopWorkSessions(ctx, txn, taskID)
+	if err != nil {
+		return 0, err
+	}
+	if tracked > 0 {
+		return tracked, nil
+	}
+	if actualTime <= 0 {
+		return 0, ErrNoActualTime
+	}
+
+	return actualTime, nil
+}
@@ -0,0 +1,43 @@
+package tasks
+
+import (
+	"context"
+
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"github.com/liriquew/control_system/tasks_service/internal/models"
+	"github.com/liriquew/control_system/tasks_service/pkg/logger/sl"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxAuditEventsLimit = 100
+
+// ListAuditEvents returns audit events of group tasks,
+// group admin permission is checked by the gateway
+func (s *Service) ListAuditEvents(ctx context.Context, req *tsks_pb.ListAuditEventsRequest) (*tsks_pb.AuditEventList, error) {
+	if _, err := s.authenticate(ctx); err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+	if req.GroupID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "group id required")
+	}
+	if req.Limit < 0 || req.Limit > maxAuditEventsLimit {
+		return nil, status.Error(codes.InvalidArgument, "limit must be between 0 and 100")
+	}
+
+	events, err := s.repository.ListAuditEvents(ctx, req)
+	if err != nil {
+		s.log.Error("error while listing audit events", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	resp := make([]*tsks_pb.AuditEvent, 0, len(events))
+	for _, event := range events {
+		resp = append(resp, models.ConvertAuditEventToProto(event))
+	}
+
+	return &tsks_pb.AuditEventList{
+		Events: resp,
+	}, nil
+}
@@ -0,0 +1,248 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"github.com/liriquew/control_system/tasks_service/internal/models"
+	repository "github.com/liriquew/control_system/tasks_service/internal/repository"
+	"github.com/liriquew/control_system/tasks_service/pkg/logger/sl"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBatchSize is the maximum number of tasks in one batch request
+const maxBatchSize = 100
+
+func validateBatchSize(n int) error {
+	if n == 0 {
+		return status.Error(codes.InvalidArgument, "empty batch")
+	}
+	if n > maxBatchSize {
+		return status.Errorf(codes.InvalidArgument, "batch size must be at most %d", maxBatchSize)
+	}
+
+	return nil
+}
+
+// batchResults collects per-item results, items that passed validation
+// are processed by the repository in one transaction
+type batchResults struct {
+	results []*tsks_pb.TaskResult
+	// indices of valid items in results
+	valid []int
+}
+
+func newBatchResults(n int) *batchResults {
+	return &batchResults{
+		results: make([]*tsks_pb.TaskResult, n),
+		valid:   make([]int, 0, n),
+	}
+}
+
+// add sets item result, item is valid if err is nil
+func (b *batchResults) add(i int, taskID int64, err error) {
+	b.results[i] = &tsks_pb.TaskResult{ID: taskID}
+	if err != nil {
+		st := status.Convert(err)
+		b.results[i].Code, b.results[i].Error = int32(st.Code()), st.Message()
+		return
+	}
+
+	b.valid = append(b.valid, i)
+}
+
+// applyItemErrors sets results of valid items from repository item errors
+func (s *Service) applyItemErrors(b *batchResults, itemErrs []error, taskID func(i int) int64) {
+	for j, i := range b.valid {
+		b.results[i].ID = taskID(i)
+
+		err := itemErrs[j]
+		switch {
+		case err == nil:
+			continue
+		case errors.Is(err, repository.ErrNotFound):
+			err = status.Error(codes.NotFound, "task not found")
+		case errors.Is(err, repository.ErrTaskNotInGroup):
+			err = status.Error(codes.InvalidArgument, "task not in group assined_to isn't required")
+		case errors.Is(err, repository.ErrForbiddenTransition):
+			err = status.Error(codes.FailedPrecondition, "task status changed, forbidden to complete task")
+		default:
+			s.log.Error("error while processing batch item", sl.Err(err))
+			err = status.Error(codes.Internal, "internal")
+		}
+
+		st := status.Convert(err)
+		b.results[i].Code, b.results[i].Error = int32(st.Code()), st.Message()
+	}
+}
+
+// loadBatchTasks returns tasks by ids, tasks which don't exist are absent in the map
+func (s *Service) loadBatchTasks(ctx context.Context, taskIDs []int64) (map[int64]*models.Task, error) {
+	tasks, err := s.repository.GetTasks(ctx, taskIDs)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		s.log.Error("error while getting batch tasks", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	tasksMap := make(map[int64]*models.Task, len(tasks))
+	for _, task := range tasks {
+		tasksMap[task.ID] = task
+	}
+
+	return tasksMap, nil
+}
+
+// checkBatchTask checks that the task exists and belongs to the batch group,
+// user tasks belong to their creator
+func checkBatchTask(task *models.Task, userID, groupID int64) error {
+	if task == nil {
+		return status.Error(codes.NotFound, "task not found")
+	}
+	if groupID != 0 && task.GroupID.Int64 != groupID {
+		return status.Error(codes.NotFound, "task not found")
+	}
+	if groupID == 0 && task.CreatedBy != userID {
+		return status.Error(codes.NotFound, "task not found")
+	}
+
+	return nil
+}
+
+func (s *Service) CreateTasks(ctx context.Context, req *tsks_pb.TasksBatch) (*tsks_pb.TasksBatchResult, error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	if err := validateBatchSize(len(req.Tasks)); err != nil {
+		return nil, err
+	}
+
+	batch := newBatchResults(len(req.Tasks))
+	for i, task := range req.Tasks {
+		task.CreatedBy, task.GroupID = userID, req.GroupID
+
+		err := validateNewTask(task)
+		if err == nil && (task.Title == "" || task.Description == "") {
+			err = status.Error(codes.InvalidArgument, "empty title or description")
+		}
+		if err == nil && (task.PlannedTime <= 0 || task.ActualTime < 0) {
+			err = status.Error(codes.InvalidArgument, "planned time must be greater than zero, actual time can't be negative")
+		}
+		batch.add(i, 0, err)
+	}
+
+	tasks := make([]*tsks_pb.Task, 0, len(batch.valid))
+	for _, i := range batch.valid {
+		tasks = append(tasks, req.Tasks[i])
+	}
+
+	itemErrs, err := s.repository.SaveTasks(ctx, tasks)
+	if err != nil {
+		s.log.Error("error while saving tasks", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+	s.applyItemErrors(batch, itemErrs, func(i int) int64 {
+		return req.Tasks[i].ID
+	})
+
+	return &tsks_pb.TasksBatchResult{
+		Results: batch.results,
+	}, nil
+}
+
+func (s *Service) UpdateTasks(ctx context.Context, req *tsks_pb.TasksBatch) (*tsks_pb.TasksBatchResult, error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	if err := validateBatchSize(len(req.Tasks)); err != nil {
+		return nil, err
+	}
+
+	taskIDs := make([]int64, 0, len(req.Tasks))
+	for _, task := range req.Tasks {
+		taskIDs = append(taskIDs, task.ID)
+	}
+	tasksFromDB, err := s.loadBatchTasks(ctx, taskIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := newBatchResults(len(req.Tasks))
+	for i, task := range req.Tasks {
+		task.CreatedBy, task.GroupID = userID, req.GroupID
+
+		err := checkBatchTask(tasksFromDB[task.ID], userID, req.GroupID)
+		if err == nil {
+			err = validateTaskUpdate(tasksFromDB[task.ID], task)
+		}
+		if err == nil && task.PlannedTime < 0 {
+			err = status.Error(codes.InvalidArgument, "planned time must be greater than zero")
+		}
+		batch.add(i, task.ID, err)
+	}
+
+	tasks := make([]*tsks_pb.Task, 0, len(batch.valid))
+	for _, i := range batch.valid {
+		tasks = append(tasks, req.Tasks[i])
+	}
+
+	itemErrs, err := s.repository.UpdateTasks(ctx, tasks)
+	if err != nil {
+		s.log.Error("error while updating tasks", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+	s.applyItemErrors(batch, itemErrs, func(i int) int64 {
+		return req.Tasks[i].ID
+	})
+
+	return &tsks_pb.TasksBatchResult{
+		Results: batch.results,
+	}, nil
+}
+
+func (s *Service) DeleteTasks(ctx context.Context, req *tsks_pb.DeleteTasksRequest) (*tsks_pb.TasksBatchResult, error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	if err := validateBatchSize(len(req.IDs)); err != nil {
+		return nil, err
+	}
+
+	tasksFromDB, err := s.loadBatchTasks(ctx, req.IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := newBatchResults(len(req.IDs))
+	for i, taskID := range req.IDs {
+		batch.add(i, taskID, checkBatchTask(tasksFromDB[taskID], userID, req.GroupID))
+	}
+
+	taskIDs := make([]int64, 0, len(batch.valid))
+	for _, i := range batch.valid {
+		taskIDs = append(taskIDs, req.IDs[i])
+	}
+
+	itemErrs, err := s.repository.DeleteTasks(ctx, userID, req.GroupID, taskIDs)
+	if err != nil {
+		s.log.Error("error while deleting tasks", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+	s.applyItemErrors(batch, itemErrs, func(i int) int64 {
+		return req.IDs[i]
+	})
+
+	return &tsks_pb.TasksBatchResult{
+		Results: batch.results,
+	}, nil
+}
@@ -0,0 +1,240 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"unicode/utf8"
+
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"github.com/liriquew/control_system/tasks_service/internal/models"
+	repository "github.com/liriquew/control_system/tasks_service/internal/repository"
+	"github.com/liriquew/control_system/tasks_service/pkg/logger/sl"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	maxCommentLength = 4000
+	maxMentions      = 20
+)
+
+// getAccessibleTask returns task if the user can see it, membership
+// in the group of group task is checked by the gateway
+func (s *Service) getAccessibleTask(ctx context.Context, taskID, userID int64) (*models.Task, error) {
+	task, err := s.repository.GetTaskByID(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+
+		s.log.Error("error while getting task:", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	if task.GroupID.Int64 == 0 && task.CreatedBy != userID {
+		return nil, status.Error(codes.PermissionDenied, "denied")
+	}
+
+	return task, nil
+}
+
+func validateComment(comment *tsks_pb.Comment) error {
+	if comment.Text == "" {
+		return status.Error(codes.InvalidArgument, "empty comment text")
+	}
+	if utf8.RuneCountInString(comment.Text) > maxCommentLength {
+		return status.Errorf(codes.InvalidArgument, "comment text must be at most %d characters", maxCommentLength)
+	}
+	if len(comment.Mentions) > maxMentions {
+		return status.Errorf(codes.InvalidArgument, "comment can mention at most %d users", maxMentions)
+	}
+
+	return nil
+}
+
+// resolveMentions returns mentioned users with usernames, mentions are allowed
+// only in group tasks, membership of mentioned users is checked by the gateway
+func (s *Service) resolveMentions(ctx context.Context, task *models.Task, mentions []*tsks_pb.Mention) ([]*models.Mention, error) {
+	userIDs := make([]int64, 0, len(mentions))
+	for _, mention := range mentions {
+		if !slices.Contains(userIDs, mention.UserID) {
+			userIDs = append(userIDs, mention.UserID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	if task.GroupID.Int64 == 0 {
+		return nil, status.Error(codes.InvalidArgument, "mentions are allowed only in group tasks")
+	}
+
+	users, err := s.auth.GetUsersDetails(ctx, userIDs)
+	if err != nil {
+		s.log.Error("error while getting mentioned users details", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+	if len(users) != len(userIDs) {
+		return nil, status.Error(codes.InvalidArgument, "unknown mentioned user")
+	}
+
+	return users, nil
+}
+
+func (s *Service) CreateComment(ctx context.Context, req *tsks_pb.Comment) (*tsks_pb.Comment, error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	if err := validateComment(req); err != nil {
+		return nil, err
+	}
+
+	task, err := s.getAccessibleTask(ctx, req.TaskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	mentions, err := s.resolveMentions(ctx, task, req.Mentions)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &models.Comment{
+		TaskID:   req.TaskID,
+		AuthorID: userID,
+		Text:     req.Text,
+		Mentions: mentions,
+	}
+	if err := s.repository.CreateComment(ctx, comment); err != nil {
+		s.log.Error("error while creating comment", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return models.ConvertCommentToProto(comment), nil
+}
+
+// getAuthoredComment returns comment if the user is its author
+func (s *Service) getAuthoredComment(ctx context.Context, taskID, commentID, userID int64) (*models.Comment, error) {
+	comment, err := s.repository.GetComment(ctx, taskID, commentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "comment not found")
+		}
+
+		s.log.Error("error while getting comment", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	if comment.AuthorID != userID {
+		return nil, status.Error(codes.PermissionDenied, "only comment author can change comment")
+	}
+
+	return comment, nil
+}
+
+// UpdateComment replaces text and mentions of the comment
+func (s *Service) UpdateComment(ctx context.Context, req *tsks_pb.Comment) (*tsks_pb.Comment, error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	if err := validateComment(req); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.getAuthoredComment(ctx, req.TaskID, req.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.getAccessibleTask(ctx, req.TaskID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Mentions, err = s.resolveMentions(ctx, task, req.Mentions); err != nil {
+		return nil, err
+	}
+	comment.Text = req.Text
+
+	if err := s.repository.UpdateComment(ctx, comment); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "comment not found")
+		}
+
+		s.log.Error("error while updating comment", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return models.ConvertCommentToProto(comment), nil
+}
+
+func (s *Service) DeleteComment(ctx context.Context, req *tsks_pb.CommentID) (*emptypb.Empty, error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	if _, err := s.getAuthoredComment(ctx, req.TaskID, req.ID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.DeleteComment(ctx, req.TaskID, req.ID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "comment not found")
+		}
+
+		s.log.Error("error while deleting comment", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetTaskActivity returns comments and field changes of the task merged in chronological order
+func (s *Service) GetTaskActivity(ctx context.Context, taskID *tsks_pb.TaskID) (*tsks_pb.TaskActivity, error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	if _, err := s.getAccessibleTask(ctx, taskID.ID, userID); err != nil {
+		return nil, err
+	}
+
+	comments, err := s.repository.GetTaskComments(ctx, taskID.ID)
+	if err != nil {
+		s.log.Error("error while getting task comments", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+	changes, err := s.repository.GetTaskChanges(ctx, taskID.ID)
+	if err != nil {
+		s.log.Error("error while getting task changes", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	items := make([]*tsks_pb.ActivityItem, 0, len(comments)+len(changes))
+	i, j := 0, 0
+	for i < len(comments) || j < len(changes) {
+		if j == len(changes) || (i < len(comments) && !comments[i].CreatedAt.After(changes[j].ChangedAt)) {
+			items = append(items, &tsks_pb.ActivityItem{Comment: models.ConvertCommentToProto(comments[i])})
+			i++
+			continue
+		}
+
+		items = append(items, &tsks_pb.ActivityItem{Change: models.ConvertTaskChangeToProto(changes[j])})
+		j++
+	}
+
+	return &tsks_pb.TaskActivity{
+		Items: items,
+	}, nil
+}
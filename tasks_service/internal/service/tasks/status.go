@@ -0,0 +1,130 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"github.com/liriquew/control_system/tasks_service/internal/models"
+	repository "github.com/liriquew/control_system/tasks_service/internal/repository"
+	"github.com/liriquew/control_system/tasks_service/pkg/logger/sl"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// checkTransitionPermission - self task is moved only by its creator,
+// group task is moved by its executor, creator can also return it
+// to backlog or cancel it
+func checkTransitionPermission(task *models.Task, userID int64, to string) error {
+	if task.GroupID.Int64 == 0 {
+		if task.CreatedBy != userID {
+			return status.Error(codes.PermissionDenied, "denied")
+		}
+		return nil
+	}
+
+	if task.AssignedTo.Int64 == userID && userID != 0 {
+		return nil
+	}
+	if task.CreatedBy == userID && (to == models.StatusBacklog || to == models.StatusCancelled) {
+		return nil
+	}
+	if task.AssignedTo.Int64 == 0 {
+		return status.Error(codes.FailedPrecondition, "forbidden to move task without assigned user")
+	}
+
+	return status.Error(codes.PermissionDenied, "only task executor can move task")
+}
+
+// TransitionTask moves task to another status if the transition is allowed,
+// moving to done completes the task with actual time like TaskDone
+func (s *Service) TransitionTask(ctx context.Context, req *tsks_pb.TransitionTaskRequest) (*emptypb.Empty, error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	to, ok := models.StatusFromProto(req.Status)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown status")
+	}
+	if req.ActualTime < 0 {
+		return nil, status.Error(codes.InvalidArgument, "actual time can't be negative")
+	}
+
+	task, err := s.repository.GetTaskByID(ctx, req.TaskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+
+		s.log.Error("error while getting task:", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	if err := checkTransitionPermission(task, userID, to); err != nil {
+		return nil, err
+	}
+	if !models.CanTransition(task.Status, to) {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("forbidden transition from %s to %s", task.Status, to))
+	}
+
+	if err := s.repository.TransitionTask(ctx, req.TaskID, task.Status, to, userID, req.ActualTime); err != nil {
+		if errors.Is(err, repository.ErrStatusChanged) {
+			return nil, status.Error(codes.Aborted, "task status changed")
+		}
+		if errors.Is(err, repository.ErrNoActualTime) {
+			return nil, status.Error(codes.InvalidArgument, "actual time must be greater than zero, task has no work sessions")
+		}
+
+		s.log.Error("error while moving task", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Service) GetTaskStatusHistory(ctx context.Context, taskID *tsks_pb.TaskID) (*tsks_pb.TaskStatusHistory, error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	task, err := s.repository.GetTaskByID(ctx, taskID.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+
+		s.log.Error("error while getting task:", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	// group membership is checked by the gateway, so group task history
+	// is returned only for the group from the request
+	if task.GroupID.Int64 != taskID.GroupID {
+		return nil, status.Error(codes.PermissionDenied, "denied")
+	}
+	if task.GroupID.Int64 == 0 && task.CreatedBy != userID {
+		return nil, status.Error(codes.PermissionDenied, "denied")
+	}
+
+	changes, err := s.repository.GetTaskStatusHistory(ctx, taskID.ID)
+	if err != nil {
+		s.log.Error("error while getting task status history", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	resp := make([]*tsks_pb.TaskStatusChange, 0, len(changes))
+	for _, change := range changes {
+		resp = append(resp, models.ConvertStatusChangeToProto(change))
+	}
+
+	return &tsks_pb.TaskStatusHistory{
+		Changes: resp,
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	authclient "github.com/liriquew/control_system/tasks_service/internal/grpc/clients/auth_client"
 	predictionsclient "github.com/liriquew/control_system/tasks_service/internal/grpc/clients/predictions_client"
 	"github.com/liriquew/control_system/tasks_service/internal/models"
 	repository "github.com/liriquew/control_system/tasks_service/internal/repository"
@@ -22,20 +23,44 @@ type Repository interface {
 	SaveTask(ctx context.Context, task *tsks_pb.Task) (int64, error)
 	GetGroupTasks(ctx context.Context, taskID int64) ([]*models.Task, error)
 	GetTaskByID(ctx context.Context, taskID int64) (*models.Task, error)
-	GetTaskList(ctx context.Context, userID, offset int64) ([]*models.Task, error)
+	GetTaskList(ctx context.Context, userID int64, req *tsks_pb.TaskListRequest) ([]*models.Task, string, error)
+	GetGroupTaskList(ctx context.Context, req *tsks_pb.TaskListRequest) ([]*models.Task, string, error)
 	UpdateTask(ctx context.Context, task *tsks_pb.Task) error
 	UpdateGroupTask(ctx context.Context, task *tsks_pb.Task) error
 	DeleteUserTask(ctx context.Context, userID, taskID int64) error
-	DeleteGroupTask(ctx context.Context, taskID int64) error
+	DeleteGroupTask(ctx context.Context, userID, taskID int64) error
+	RestoreUserTask(ctx context.Context, userID, taskID int64) error
+	RestoreGroupTask(ctx context.Context, userID, groupID, taskID int64) error
+	TaskDone(ctx context.Context, taskID, userID int64, actualTime float64) error
+	TransitionTask(ctx context.Context, taskID int64, from, to string, changedBy int64, actualTime float64) error
+	GetTaskStatusHistory(ctx context.Context, taskID int64) ([]*models.TaskStatusChange, error)
+	StartWork(ctx context.Context, taskID, userID int64, status string) (*models.WorkSession, error)
+	StopWork(ctx context.Context, taskID, userID int64) (*models.WorkSession, error)
+
+	CreateComment(ctx context.Context, comment *models.Comment) error
+	UpdateComment(ctx context.Context, comment *models.Comment) error
+	DeleteComment(ctx context.Context, taskID, commentID int64) error
+	GetComment(ctx context.Context, taskID, commentID int64) (*models.Comment, error)
+	GetTaskComments(ctx context.Context, taskID int64) ([]*models.Comment, error)
+	GetTaskChanges(ctx context.Context, taskID int64) ([]*models.TaskChange, error)
+	ListAuditEvents(ctx context.Context, req *tsks_pb.ListAuditEventsRequest) ([]*models.AuditEvent, error)
+
+	SaveTasks(ctx context.Context, tasks []*tsks_pb.Task) ([]error, error)
+	UpdateTasks(ctx context.Context, tasks []*tsks_pb.Task) ([]error, error)
+	DeleteTasks(ctx context.Context, userID, groupID int64, taskIDs []int64) ([]error, error)
 
 	TaskInAnyGroup(ctx context.Context, taskID int64) (int64, error)
 	TaskInGroup(ctx context.Context, groupID, taskID int64) error
 	GetTasks(ctx context.Context, taskIDs []int64) ([]*models.Task, error)
 }
 
+// maxTaskListLimit is the maximum page size of task lists
+const maxTaskListLimit = 100
+
 type Service struct {
 	tsks_pb.UnimplementedTasksServer
 	predictions *predictionsclient.Client
+	auth        *authclient.Client
 	repository  Repository
 	log         *slog.Logger
 }
@@ -43,11 +68,13 @@ type Service struct {
 func New(log *slog.Logger,
 	repository Repository,
 	predictionsClient *predictionsclient.Client,
+	authClient *authclient.Client,
 ) *Service {
 	return &Service{
 		log:         log,
 		repository:  repository,
 		predictions: predictionsClient,
+		auth:        authClient,
 	}
 }
 
@@ -78,8 +105,8 @@ func (s *Service) CreateTask(ctx context.Context, task *tsks_pb.Task) (*tsks_pb.
 	}
 	task.CreatedBy = userID
 
-	if task.GroupID != 0 && task.ActualTime != 0 && task.AssignedTo == 0 {
-		return nil, status.Error(codes.InvalidArgument, "forbidden to create completed task in group without task executor")
+	if err := validateNewTask(task); err != nil {
+		return nil, err
 	}
 
 	task.ID, err = s.repository.SaveTask(ctx, task)
@@ -91,6 +118,20 @@ func (s *Service) CreateTask(ctx context.Context, task *tsks_pb.Task) (*tsks_pb.
 	return &tsks_pb.TaskID{ID: task.ID}, nil
 }
 
+func validateNewTask(task *tsks_pb.Task) error {
+	if task.GroupID != 0 && task.ActualTime != 0 && task.AssignedTo == 0 {
+		return status.Error(codes.InvalidArgument, "forbidden to create completed task in group without task executor")
+	}
+	if task.PercentComplete < 0 || task.PercentComplete >= 100 {
+		return status.Error(codes.InvalidArgument, "percent complete must be in range [0, 100), use actual time to complete task")
+	}
+	if task.CrashCost < 0 || task.CrashMinTime < 0 {
+		return status.Error(codes.InvalidArgument, "crash cost and crash min time can't be negative")
+	}
+
+	return nil
+}
+
 func (s *Service) GetTask(ctx context.Context, taskID *tsks_pb.TaskID) (*tsks_pb.Task, error) {
 	userID, err := s.authenticate(ctx)
 	if err != nil {
@@ -122,20 +163,72 @@ func (s *Service) GetTaskList(ctx context.Context, req *tsks_pb.TaskListRequest)
 		return nil, err
 	}
 
-	tasks, err := s.repository.GetTaskList(ctx, userID, req.Offset)
+	if err := validateTaskListRequest(req); err != nil {
+		return nil, err
+	}
+
+	tasks, nextCursor, err := s.repository.GetTaskList(ctx, userID, req)
 	if err != nil {
-		s.log.Error("error while getting task:", sl.Err(err))
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+
+		s.log.Error("error while getting task list", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return convertTaskList(tasks, nextCursor), nil
+}
+
+func (s *Service) GetGroupTaskList(ctx context.Context, req *tsks_pb.TaskListRequest) (*tsks_pb.TaskList, error) {
+	if req.GetFilter().GetGroupID() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "group id required")
+	}
+	if err := validateTaskListRequest(req); err != nil {
+		return nil, err
+	}
+
+	tasks, nextCursor, err := s.repository.GetGroupTaskList(ctx, req)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+
+		s.log.Error("error while getting group task list", sl.Err(err))
 		return nil, status.Error(codes.Internal, "internal")
 	}
 
+	return convertTaskList(tasks, nextCursor), nil
+}
+
+func validateTaskListRequest(req *tsks_pb.TaskListRequest) error {
+	if req.Limit < 0 || req.Limit > maxTaskListLimit {
+		return status.Errorf(codes.InvalidArgument, "limit must be in range [0, %d]", maxTaskListLimit)
+	}
+	if _, ok := tsks_pb.TaskSortKey_name[int32(req.SortBy)]; !ok {
+		return status.Error(codes.InvalidArgument, "unknown sort key")
+	}
+	if _, ok := tsks_pb.TaskState_name[int32(req.GetFilter().GetState())]; !ok {
+		return status.Error(codes.InvalidArgument, "unknown task state")
+	}
+	filter := req.GetFilter()
+	if filter.GetCreatedFrom() != nil && filter.GetCreatedTo() != nil && !filter.CreatedFrom.AsTime().Before(filter.CreatedTo.AsTime()) {
+		return status.Error(codes.InvalidArgument, "created from must be before created to")
+	}
+
+	return nil
+}
+
+func convertTaskList(tasks []*models.Task, nextCursor string) *tsks_pb.TaskList {
 	resp := make([]*tsks_pb.Task, 0, len(tasks))
 	for _, task := range tasks {
 		resp = append(resp, models.ConvertModelToProto(task))
 	}
 
 	return &tsks_pb.TaskList{
-		Tasks: resp,
-	}, nil
+		Tasks:      resp,
+		NextCursor: nextCursor,
+	}
 }
 
 func (s *Service) UpdateTask(ctx context.Context, task *tsks_pb.Task) (*emptypb.Empty, error) {
@@ -153,18 +246,8 @@ func (s *Service) UpdateTask(ctx context.Context, task *tsks_pb.Task) (*emptypb.
 		return nil, status.Error(codes.Internal, "internal")
 	}
 
-	// validate cases
-	if taskFromDB.ActualTime != 0 && task.PlannedTime != 0 {
-		return nil, status.Error(codes.FailedPrecondition, "forbidden to change planned time after task completed")
-	}
-	if taskFromDB.ActualTime != 0 && task.AssignedTo != 0 {
-		return nil, status.Error(codes.FailedPrecondition, "forbidden to change user after task completed")
-	}
-	if taskFromDB.AssignedTo.Int64 == 0 && task.ActualTime != 0 {
-		return nil, status.Error(codes.FailedPrecondition, "forbidden to complete task without assigned user")
-	}
-	if taskFromDB.GroupID.Int64 == 0 && task.AssignedTo != 0 {
-		return nil, status.Error(codes.FailedPrecondition, "forbidden to assign task executor outside group")
+	if err := validateTaskUpdate(taskFromDB, task); err != nil {
+		return nil, err
 	}
 
 	if task.GroupID != 0 {
@@ -181,6 +264,9 @@ func (s *Service) UpdateTask(ctx context.Context, task *tsks_pb.Task) (*emptypb.
 		if errors.Is(err, repository.ErrTaskNotInGroup) {
 			return nil, status.Error(codes.InvalidArgument, "task not in group assined_to isn't required")
 		}
+		if errors.Is(err, repository.ErrForbiddenTransition) {
+			return nil, status.Error(codes.FailedPrecondition, "task status changed, forbidden to complete task")
+		}
 
 		return nil, err
 	}
@@ -188,6 +274,41 @@ func (s *Service) UpdateTask(ctx context.Context, task *tsks_pb.Task) (*emptypb.
 	return &emptypb.Empty{}, nil
 }
 
+// validateTaskUpdate checks that task update is allowed for the current task state,
+// done and cancelled tasks are closed
+func validateTaskUpdate(taskFromDB *models.Task, task *tsks_pb.Task) error {
+	closed := models.IsClosedStatus(taskFromDB.Status)
+	if closed && task.PlannedTime != 0 {
+		return status.Error(codes.FailedPrecondition, "forbidden to change planned time after task closed")
+	}
+	if closed && task.AssignedTo != 0 {
+		return status.Error(codes.FailedPrecondition, "forbidden to change user after task closed")
+	}
+	if task.ActualTime != 0 && taskFromDB.Status != models.StatusDone && !models.CanTransition(taskFromDB.Status, models.StatusDone) {
+		return status.Errorf(codes.FailedPrecondition, "forbidden transition from %s to %s", taskFromDB.Status, models.StatusDone)
+	}
+	if taskFromDB.AssignedTo.Int64 == 0 && task.ActualTime != 0 {
+		return status.Error(codes.FailedPrecondition, "forbidden to complete task without assigned user")
+	}
+	if taskFromDB.GroupID.Int64 == 0 && task.AssignedTo != 0 {
+		return status.Error(codes.FailedPrecondition, "forbidden to assign task executor outside group")
+	}
+	if task.PercentComplete < 0 || task.PercentComplete >= 100 {
+		return status.Error(codes.InvalidArgument, "percent complete must be in range [0, 100), use actual time to complete task")
+	}
+	if task.ActualTime < 0 {
+		return status.Error(codes.InvalidArgument, "actual time can't be negative")
+	}
+	if task.CrashCost < 0 || task.CrashMinTime < 0 {
+		return status.Error(codes.InvalidArgument, "crash cost and crash min time can't be negative")
+	}
+	if closed && task.PercentComplete != 0 {
+		return status.Error(codes.FailedPrecondition, "forbidden to change progress after task closed")
+	}
+
+	return nil
+}
+
 func (s *Service) DeleteTask(ctx context.Context, taskID *tsks_pb.TaskID) (*emptypb.Empty, error) {
 	userID, err := s.authenticate(ctx)
 	if err != nil {
@@ -207,7 +328,7 @@ func (s *Service) DeleteTask(ctx context.Context, taskID *tsks_pb.TaskID) (*empt
 	}
 
 	if taskID.GroupID != 0 {
-		err = s.repository.DeleteGroupTask(ctx, taskID.ID)
+		err = s.repository.DeleteGroupTask(ctx, userID, taskID.ID)
 	} else {
 		err = s.repository.DeleteUserTask(ctx, userID, taskID.ID)
 	}
@@ -223,6 +344,30 @@ func (s *Service) DeleteTask(ctx context.Context, taskID *tsks_pb.TaskID) (*empt
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Service) RestoreTask(ctx context.Context, taskID *tsks_pb.TaskID) (*emptypb.Empty, error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	if taskID.GroupID != 0 {
+		err = s.repository.RestoreGroupTask(ctx, userID, taskID.GroupID, taskID.ID)
+	} else {
+		err = s.repository.RestoreUserTask(ctx, userID, taskID.ID)
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "deleted task not found")
+		}
+
+		s.log.Error("error while restoring task", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *Service) PredictTask(ctx context.Context, taskID *tsks_pb.TaskID) (*tsks_pb.PredictedTask, error) {
 	task, err := s.repository.GetTaskByID(ctx, taskID.ID)
 	if err != nil {
@@ -255,6 +400,64 @@ func (s *Service) PredictTask(ctx context.Context, taskID *tsks_pb.TaskID) (*tsk
 	}, nil
 }
 
+// TaskDone completes task with actual time, only task executor
+// (or creator for self task) can complete it, actual time is computed
+// from work sessions if the task has them
+func (s *Service) TaskDone(ctx context.Context, req *tsks_pb.TaskDoneRequest) (*emptypb.Empty, error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	if req.Time < 0 {
+		return nil, status.Error(codes.InvalidArgument, "actual time can't be negative")
+	}
+
+	task, err := s.repository.GetTaskByID(ctx, req.TaskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+
+		s.log.Error("error while getting task:", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	if task.ActualTime != 0 {
+		return nil, status.Error(codes.FailedPrecondition, "task already completed")
+	}
+	if !models.CanTransition(task.Status, models.StatusDone) {
+		return nil, status.Errorf(codes.FailedPrecondition, "forbidden transition from %s to %s", task.Status, models.StatusDone)
+	}
+	if task.GroupID.Int64 != 0 && task.AssignedTo.Int64 == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "forbidden to complete task without assigned user")
+	}
+	if task.GroupID.Int64 != 0 && task.AssignedTo.Int64 != userID {
+		return nil, status.Error(codes.PermissionDenied, "only task executor can complete task")
+	}
+	if task.GroupID.Int64 == 0 && task.CreatedBy != userID {
+		return nil, status.Error(codes.PermissionDenied, "denied")
+	}
+
+	if err := s.repository.TaskDone(ctx, req.TaskID, userID, req.Time); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+		if errors.Is(err, repository.ErrNoActualTime) {
+			return nil, status.Error(codes.InvalidArgument, "actual time must be greater than zero, task has no work sessions")
+		}
+		if errors.Is(err, repository.ErrForbiddenTransition) {
+			return nil, status.Error(codes.FailedPrecondition, "task status changed, forbidden to complete task")
+		}
+
+		s.log.Error("error while completing task", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 // internal services API
 
 func (s *Service) TaskExists(ctx context.Context, req *tsks_pb.TaskExistsRequest) (*emptypb.Empty, error) {
@@ -315,6 +518,54 @@ func (s *Service) GetPredictedTasks(ctx context.Context, taskIDs *tsks_pb.TasksI
 		return nil, status.Error(codes.NotFound, fmt.Sprintf("some ids not found %v", badIDs))
 	}
 
+	return s.predictTaskList(ctx, tasks)
+}
+
+// PredictReassignedTasks predicts tasks with the models of the users from the request,
+// assignees are replaced only for prediction, stored tasks are not changed
+func (s *Service) PredictReassignedTasks(ctx context.Context, req *tsks_pb.ReassignedTasks) (*tsks_pb.PredictedTaskList, error) {
+	if len(req.Tasks) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no tasks to predict")
+	}
+
+	assignees := make(map[int64]int64, len(req.Tasks))
+	taskIDs := make([]int64, 0, len(req.Tasks))
+	for _, task := range req.Tasks {
+		if task.AssignedTo <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "bad assignee for task %d", task.TaskID)
+		}
+		if _, ok := assignees[task.TaskID]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "task %d reassigned twice", task.TaskID)
+		}
+
+		assignees[task.TaskID] = task.AssignedTo
+		taskIDs = append(taskIDs, task.TaskID)
+	}
+
+	tasks, err := s.repository.GetTasks(ctx, taskIDs)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "tasks not found")
+		}
+
+		s.log.Error("error while getting tasks to reassign", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+	if len(tasks) != len(taskIDs) {
+		return nil, status.Error(codes.NotFound, "tasks not found")
+	}
+
+	for _, task := range tasks {
+		task.AssignedTo.Int64 = assignees[task.ID]
+		task.AssignedTo.Valid = true
+	}
+
+	return s.predictTaskList(ctx, tasks)
+}
+
+// predictTaskList predicts time of the tasks with their assignees' models,
+// completed tasks keep their actual time
+func (s *Service) predictTaskList(ctx context.Context, tasks []*models.Task) (*tsks_pb.PredictedTaskList, error) {
 	predictedTasks, unpredictedUIDs, err := s.predictions.PredictList(ctx, tasks)
 	if err != nil {
 		if errors.Is(err, predictionsclient.ErrNotFound) {
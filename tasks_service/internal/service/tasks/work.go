@@ -0,0 +1,113 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+
+	tsks_pb "github.com/liriquew/control_system/services_protos/tasks_service"
+	"github.com/liriquew/control_system/tasks_service/internal/models"
+	repository "github.com/liriquew/control_system/tasks_service/internal/repository"
+	"github.com/liriquew/control_system/tasks_service/pkg/logger/sl"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkTaskExecutor - work on self task is tracked by its creator,
+// on group task by its executor
+func checkTaskExecutor(task *models.Task, userID int64) error {
+	if task.GroupID.Int64 == 0 {
+		if task.CreatedBy != userID {
+			return status.Error(codes.PermissionDenied, "denied")
+		}
+		return nil
+	}
+
+	if task.AssignedTo.Int64 == 0 {
+		return status.Error(codes.FailedPrecondition, "forbidden to track work on task without assigned user")
+	}
+	if task.AssignedTo.Int64 != userID {
+		return status.Error(codes.PermissionDenied, "only task executor can track work")
+	}
+
+	return nil
+}
+
+// StartWork starts work session of the task executor, task in backlog
+// is moved to in progress, user can work on a single task at a time
+func (s *Service) StartWork(ctx context.Context, taskID *tsks_pb.TaskID) (*tsks_pb.WorkSession, error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	task, err := s.repository.GetTaskByID(ctx, taskID.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+
+		s.log.Error("error while getting task:", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	if err := checkTaskExecutor(task, userID); err != nil {
+		return nil, err
+	}
+	if task.Status != models.StatusBacklog && task.Status != models.StatusInProgress {
+		return nil, status.Errorf(codes.FailedPrecondition, "forbidden to start work on task in status %s", task.Status)
+	}
+
+	session, err := s.repository.StartWork(ctx, taskID.ID, userID, task.Status)
+	if err != nil {
+		if errors.Is(err, repository.ErrWorkSessionActive) {
+			return nil, status.Error(codes.FailedPrecondition, "work session already started, stop it first")
+		}
+		if errors.Is(err, repository.ErrStatusChanged) {
+			return nil, status.Error(codes.Aborted, "task status changed")
+		}
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+
+		s.log.Error("error while starting work session", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return models.ConvertWorkSessionToProto(session), nil
+}
+
+// StopWork stops active work session of the user on the task,
+// the session is stopped even if the task was reassigned after its start
+func (s *Service) StopWork(ctx context.Context, taskID *tsks_pb.TaskID) (*tsks_pb.WorkSession, error) {
+	userID, err := s.authenticate(ctx)
+	if err != nil {
+		s.log.Error("error while authenticate user", sl.Err(err))
+		return nil, err
+	}
+
+	task, err := s.repository.GetTaskByID(ctx, taskID.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+
+		s.log.Error("error while getting task:", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	session, err := s.repository.StopWork(ctx, taskID.ID, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoWorkSession) {
+			if err := checkTaskExecutor(task, userID); err != nil {
+				return nil, err
+			}
+			return nil, status.Error(codes.FailedPrecondition, "no active work session on task")
+		}
+
+		s.log.Error("error while stopping work session", sl.Err(err))
+		return nil, status.Error(codes.Internal, "internal")
+	}
+
+	return models.ConvertWorkSessionToProto(session), nil
+}
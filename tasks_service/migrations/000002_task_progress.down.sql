@@ -0,0 +1,4 @@
+ALTER TABLE tasks
+    DROP CONSTRAINT IF EXISTS task_percent_complete,
+    DROP COLUMN IF EXISTS completed_at,
+    DROP COLUMN IF EXISTS percent_complete;
@@ -0,0 +1,6 @@
+ALTER TABLE tasks
+    ADD COLUMN percent_complete FLOAT NOT NULL DEFAULT 0,
+    ADD COLUMN completed_at TIMESTAMP,
+    ADD CONSTRAINT task_percent_complete CHECK (percent_complete >= 0 AND percent_complete <= 100);
+
+UPDATE tasks SET percent_complete = 100 WHERE actual_time IS NOT NULL AND actual_time != 0;
@@ -0,0 +1,5 @@
+ALTER TABLE tasks
+    DROP CONSTRAINT IF EXISTS task_crash_min_time,
+    DROP CONSTRAINT IF EXISTS task_crash_cost,
+    DROP COLUMN IF EXISTS crash_min_time,
+    DROP COLUMN IF EXISTS crash_cost;
@@ -0,0 +1,5 @@
+ALTER TABLE tasks
+    ADD COLUMN crash_cost FLOAT NOT NULL DEFAULT 0,
+    ADD COLUMN crash_min_time FLOAT NOT NULL DEFAULT 0,
+    ADD CONSTRAINT task_crash_cost CHECK (crash_cost >= 0),
+    ADD CONSTRAINT task_crash_min_time CHECK (crash_min_time >= 0);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS task_status_history;
+
+ALTER TABLE tasks
+    DROP CONSTRAINT IF EXISTS task_status,
+    DROP COLUMN IF EXISTS status;
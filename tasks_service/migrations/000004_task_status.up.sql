@@ -0,0 +1,19 @@
+ALTER TABLE tasks
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'backlog',
+    ADD CONSTRAINT task_status CHECK (status IN ('backlog', 'in_progress', 'blocked', 'review', 'done', 'cancelled'));
+
+UPDATE tasks SET status = 'done' WHERE actual_time IS NOT NULL AND actual_time != 0;
+UPDATE tasks SET status = 'in_progress' WHERE status = 'backlog' AND percent_complete > 0;
+
+CREATE TABLE IF NOT EXISTS task_status_history (
+    id BIGINT NOT NULL PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    task_id BIGINT NOT NULL,
+    from_status VARCHAR(16) NOT NULL,
+    to_status VARCHAR(16) NOT NULL,
+    changed_by BIGINT NOT NULL,
+    changed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_task_status_history_task FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_status_history_task ON task_status_history (task_id, changed_at);
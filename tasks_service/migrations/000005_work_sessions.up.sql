@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS work_sessions (
+    id BIGINT NOT NULL PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    task_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    started_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    stopped_at TIMESTAMP,
+
+    CONSTRAINT fk_work_sessions_task FOREIGN KEY (task_id) REFERENCES tasks (id) ON DELETE CASCADE,
+    CONSTRAINT work_session_period CHECK (stopped_at IS NULL OR stopped_at >= started_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_work_sessions_task ON work_sessions (task_id);
+-- user works on a single task at a time
+CREATE UNIQUE INDEX IF NOT EXISTS idx_work_sessions_active_user ON work_sessions (user_id) WHERE stopped_at IS NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS task_changes;
+DROP TABLE IF EXISTS comment_mentions;
+DROP TABLE IF EXISTS comments;